@@ -28,13 +28,14 @@ package main
 
 import (
 	"context"
-	"crypto/rsa"
 	"database/sql"
 	"embed"
+	"errors"
 	"flag"
 	"log"
 	"net/http"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/eclipse-basyx/basyx-go-components/internal/aasenvironment"
@@ -110,12 +111,12 @@ func runServer(ctx context.Context, configPath string) error {
 	common.AddCors(r, cfg)
 
 	preconfigurationCompleted := atomic.Bool{}
-	common.AddHealthEndpointWithProbe(r, cfg, func() (bool, string) {
+	preconfigurationCheck := common.HealthCheck{Name: "preconfiguration", Critical: true, Check: func(context.Context) error {
 		if preconfigurationCompleted.Load() {
-			return true, ""
+			return nil
 		}
-		return false, "AAS preconfiguration in progress"
-	})
+		return errors.New("AAS preconfiguration in progress")
+	}}
 
 	if err = common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "AAS Environment Service API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
 		log.Printf("Warning: failed to load OpenAPI spec for Swagger UI: %v", err)
@@ -123,6 +124,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -131,13 +135,20 @@ func runServer(ctx context.Context, configPath string) error {
 	if err != nil {
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err = common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION, preconfigurationCheck); err != nil {
+		return err
+	}
 
-	var privateKey *rsa.PrivateKey
+	var jwsKeySet *jws.KeySet
 	if cfg.JWS.PrivateKeyPath != "" {
-		privateKey, err = jws.LoadPrivateKey(cfg.JWS.PrivateKeyPath)
+		jwsKeySet, err = jws.LoadKeySet(cfg.JWS.PrivateKeyPath, cfg.JWS.PreviousPublicKeyPaths)
 		if err != nil {
 			return err
 		}
+		// SIGHUP reloads the key files so signing keys can be rotated without a restart.
+		jwsKeySet.ReloadOnSignal(ctx, syscall.SIGHUP)
+		r.Method(http.MethodGet, jws.JWKSPath, jwsKeySet)
 	}
 	signingOptions, err := jws.LoadSigningOptions(cfg.JWS.CertificateChainPath)
 	if err != nil {
@@ -156,12 +167,13 @@ func runServer(ctx context.Context, configPath string) error {
 	if err != nil {
 		return err
 	}
-	aasRepositoryPersistence.SetJWSPrivateKey(privateKey)
+	aasRepositoryPersistence.SetJWSKeySet(jwsKeySet)
 	aasRepositoryPersistence.SetJWSCertificateChain(signingOptions.CertificateChain)
-	submodelRepositoryPersistence, err := submodelrepositorydb.NewSubmodelDatabaseFromDB(sharedDB, privateKey, cfg.Server.StrictVerification)
+	submodelRepositoryPersistence, err := submodelrepositorydb.NewSubmodelDatabaseFromDB(sharedDB, nil, cfg.Server.StrictVerification)
 	if err != nil {
 		return err
 	}
+	submodelRepositoryPersistence.SetJWSKeySet(jwsKeySet)
 	submodelRepositoryPersistence.SetJWSCertificateChain(signingOptions.CertificateChain)
 	cdrPersistence, err := cdrdb.NewConceptDescriptionBackendFromDB(sharedDB)
 	if err != nil {
@@ -205,7 +217,8 @@ func runServer(ctx context.Context, configPath string) error {
 		persistence,
 	)
 	customDiscovery := aasenvironment.NewCustomDiscoveryService(
-		discoveryapi.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*discoveryPersistence),
+		discoveryapi.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*discoveryPersistence).
+			WithAssetLinkEventPublisher(history.NewEventPublisher(cfg.Eventing)),
 		persistence,
 	)
 	environmentStager := common.NewConnectionReservedUploadStager(
@@ -221,7 +234,7 @@ func runServer(ctx context.Context, configPath string) error {
 	aasRegistryCtrl := aasregistryopenapi.NewAssetAdministrationShellRegistryAPIAPIController(customAASRegistry, cfg.Server.ContextPath)
 	smRegistryCtrl := smregistryopenapi.NewSubmodelRegistryAPIAPIController(customSMRegistry, cfg.Server.ContextPath)
 	aasRepositoryCtrl := aasrepositoryopenapi.NewAssetAdministrationShellRepositoryAPIAPIController(customAASRepository, "", cfg.Server.StrictVerification)
-	smRepositoryCtrl := submodelrepositoryopenapi.NewSubmodelRepositoryAPIAPIController(customSMRepository, "", cfg.Server.StrictVerification)
+	smRepositoryCtrl := submodelrepositoryopenapi.NewSubmodelRepositoryAPIAPIController(customSMRepository, "", cfg.Server.StrictVerification, submodelrepositoryopenapi.WithStrictSchemaValidation(cfg.Server.StrictSchemaValidation))
 	cdrCtrl := cdropenapi.NewConceptDescriptionRepositoryAPIAPIController(customCDRepository, "", cfg.Server.StrictVerification)
 	discoveryCtrl := discoveryopenapi.NewAssetAdministrationShellBasicDiscoveryAPIAPIController(customDiscovery)
 	descriptionCtrl := discoveryopenapi.NewDescriptionAPIAPIController(aasenvironment.NewDescriptionService())
@@ -246,30 +259,51 @@ func runServer(ctx context.Context, configPath string) error {
 
 	for operation, rt := range aasRegistryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range smRegistryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range aasRepositoryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range smRepositoryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range cdrCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range discoveryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range descriptionCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 	versioningGuard.Cover(http.MethodPost, "/bulk/shell-descriptors")
@@ -291,7 +325,7 @@ func runServer(ctx context.Context, configPath string) error {
 
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("AAS Environment Service listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
-	runner, err := common.StartHTTPServer(ctx, "AASENV", cfg.Server, r)
+	runner, err := common.StartHTTPServer(common.ContextWithConfig(ctx, cfg), "AASENV", cfg.Server, r)
 	if err != nil {
 		return err
 	}
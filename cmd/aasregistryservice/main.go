@@ -40,8 +40,10 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/asyncbulk"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/binarycontent"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/descriptors"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	apis "github.com/eclipse-basyx/basyx-go-components/pkg/aasregistryapi"
 	"github.com/go-chi/chi/v5"
@@ -79,7 +81,6 @@ func runServer(ctx context.Context, configPath string) error {
 	r.Use(common.ConfigMiddleware(cfg))
 
 	common.AddCors(r, cfg)
-	common.AddHealthEndpoint(r, cfg)
 
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "AAS Registry Service API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
@@ -88,6 +89,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -100,6 +104,10 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ DB connect failed: %v", err)
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -127,6 +135,9 @@ func runServer(ctx context.Context, configPath string) error {
 	bulkHandler := aasregistryapi.NewBulkHTTPHandler(bulkSvc)
 
 	descSvc := aasregistryapi.NewDescriptionAPIAPIService()
+	if !common.IsOperationDisabled(cfg, "QueryAssetAdministrationShellDescriptors", http.MethodPost) {
+		descSvc.WithQueryCapabilities(grammar.NewQueryCapabilities("/query/shell-descriptors"))
+	}
 	descCtrl := apis.NewDescriptionAPIAPIController(descSvc)
 
 	base := common.NormalizeBasePath(cfg.Server.ContextPath)
@@ -153,12 +164,18 @@ func runServer(ctx context.Context, configPath string) error {
 	// Register all registry routes (protected)
 	for operation, rt := range smCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 
 	// Register all description routes (protected)
 	for operation, rt := range descCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 	versioningGuard.Cover(http.MethodPost, "/bulk/shell-descriptors")
@@ -172,7 +189,10 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️ AAS Registry listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "AASR", cfg.Server, r)
+	reachabilityChecker := descriptors.StartEndpointReachabilityCheckerIfEnabled(ctx, sharedDB, cfg)
+	defer reachabilityChecker.Stop()
+
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "AASR", cfg.Server, r)
 }
 
 func main() {
@@ -28,11 +28,11 @@ package main
 
 import (
 	"context"
-	"crypto/rsa"
 	"embed"
 	"flag"
 	"log"
 	"net/http"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -46,6 +46,7 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/jws"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	submodelrepositorydb "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
 	openapi "github.com/eclipse-basyx/basyx-go-components/pkg/aasrepositoryapi/go"
@@ -94,19 +95,20 @@ func runServer(ctx context.Context, configPath string) error {
 
 	common.AddCors(r, cfg)
 
-	common.AddHealthEndpoint(r, cfg)
-
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Asset Administration Shell Repository API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
 		log.Printf("Warning: failed to load OpenAPI spec for Swagger UI: %v", err)
 	}
 
-	var privateKey *rsa.PrivateKey
+	var jwsKeySet *jws.KeySet
 	if cfg.JWS.PrivateKeyPath != "" {
-		privateKey, err = jws.LoadPrivateKey(cfg.JWS.PrivateKeyPath)
+		jwsKeySet, err = jws.LoadKeySet(cfg.JWS.PrivateKeyPath, cfg.JWS.PreviousPublicKeyPaths)
 		if err != nil {
 			log.Printf("Warning: failed to load JWS private key: %v - /$signed Endpoints will be unavailable", err)
 		} else {
 			log.Println("JWS private key loaded successfully")
+			// SIGHUP reloads the key files so signing keys can be rotated without a restart.
+			jwsKeySet.ReloadOnSignal(ctx, syscall.SIGHUP)
+			r.Method(http.MethodGet, jws.JWKSPath, jwsKeySet)
 		}
 	}
 	signingOptions, err := jws.LoadSigningOptions(cfg.JWS.CertificateChainPath)
@@ -116,6 +118,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -128,6 +133,10 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ DB connect failed: %v", err)
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -146,7 +155,7 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ AAS DB init failed: %v", err)
 		return err
 	}
-	aasDatabase.SetJWSPrivateKey(privateKey)
+	aasDatabase.SetJWSKeySet(jwsKeySet)
 	aasDatabase.SetJWSCertificateChain(signingOptions.CertificateChain)
 
 	aasRegistryPersistence, err := aasregistrydb.NewPostgreSQLAASRegistryDatabaseFromDB(sharedDB, cfg.Server.CacheEnabled)
@@ -176,6 +185,9 @@ func runServer(ctx context.Context, configPath string) error {
 	aasCtrl := openapi.NewAssetAdministrationShellRepositoryAPIAPIController(aasSvc, "", cfg.Server.StrictVerification)
 
 	descSvc := openapi.NewDescriptionAPIAPIService()
+	if !common.IsOperationDisabled(cfg, "QueryAssetAdministrationShells", http.MethodPost) {
+		descSvc.WithQueryCapabilities(grammar.NewQueryCapabilities("/query/shells"))
+	}
 	descCtrl := openapi.NewDescriptionAPIAPIController(descSvc)
 
 	base := common.NormalizeBasePath(cfg.Server.ContextPath)
@@ -199,11 +211,17 @@ func runServer(ctx context.Context, configPath string) error {
 
 	for operation, rt := range aasCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 
 	for operation, rt := range descCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -212,7 +230,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️  Asset Administration Shell Repository listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "AASREPO", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "AASREPO", cfg.Server, r)
 }
 
 func main() {
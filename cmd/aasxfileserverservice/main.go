@@ -64,13 +64,14 @@ func runServer(ctx context.Context, configPath string) error {
 
 	common.AddCors(r, cfg)
 
-	common.AddHealthEndpoint(r, cfg)
-
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "AASX File Server API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
 		log.Printf("Warning: failed to load OpenAPI spec for Swagger UI: %v", err)
 	}
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -82,6 +83,10 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ DB connect failed: %v", err)
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -123,11 +128,17 @@ func runServer(ctx context.Context, configPath string) error {
 		common.AddVerificationEndpoint(apiRouter, cfg, binarycontent.NewStager(sharedDB))
 	}
 
-	for _, rt := range aasxCtrl.Routes() {
+	for operation, rt := range aasxCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
-	for _, rt := range descCtrl.Routes() {
+	for operation, rt := range descCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -136,7 +147,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️  AASX File Server listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "AASX", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "AASX", cfg.Server, r)
 }
 
 func main() {
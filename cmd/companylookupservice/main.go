@@ -63,9 +63,6 @@ func runServer(ctx context.Context, configPath string) error {
 
 	common.AddCors(r, cfg)
 
-	// --- Health Endpoint (public) ---
-	common.AddHealthEndpoint(r, cfg)
-
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Company Lookup Service API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
 		log.Printf("Warning: failed to load OpenAPI spec for Swagger UI: %v", err)
@@ -73,6 +70,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -84,6 +84,11 @@ func runServer(ctx context.Context, configPath string) error {
 		return err
 	}
 	defer func() { _ = sharedDB.Close() }()
+	// --- Health and Readiness Endpoints (public) ---
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -117,12 +122,18 @@ func runServer(ctx context.Context, configPath string) error {
 	}
 
 	// Register all company lookup routes
-	for _, rt := range companyLookupCtrl.Routes() {
+	for operation, rt := range companyLookupCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
 	// Register all description routes
-	for _, rt := range descCtrl.Routes() {
+	for operation, rt := range descCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -132,7 +143,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️ Company Lookup listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "COMPANYLOOKUP", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "COMPANYLOOKUP", cfg.Server, r)
 }
 
 func main() {
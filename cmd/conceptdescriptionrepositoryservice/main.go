@@ -40,6 +40,7 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/binarycontent"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	"github.com/eclipse-basyx/basyx-go-components/internal/conceptdescriptionrepository/api"
 	"github.com/eclipse-basyx/basyx-go-components/internal/conceptdescriptionrepository/persistence"
@@ -79,7 +80,6 @@ func runServer(ctx context.Context, configPath string) error {
 	r.Use(common.ConfigMiddleware(cfg))
 
 	common.AddCors(r, cfg)
-	common.AddHealthEndpoint(r, cfg)
 
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Concept Description Repository API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
@@ -91,6 +91,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -99,6 +102,10 @@ func runServer(ctx context.Context, configPath string) error {
 	if err != nil {
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -122,6 +129,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	// ==== Description Service ====
 	descSvc := api.NewDescriptionAPIAPIService()
+	if !common.IsOperationDisabled(cfg, "QueryConceptDescriptions", http.MethodPost) {
+		descSvc.WithQueryCapabilities(grammar.NewQueryCapabilities("/query/concept-descriptions"))
+	}
 	descCtrl := openapi.NewDescriptionAPIAPIController(descSvc)
 
 	base := common.NormalizeBasePath(cfg.Server.ContextPath)
@@ -147,10 +157,16 @@ func runServer(ctx context.Context, configPath string) error {
 
 	for operation, rt := range cdCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range descCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -160,7 +176,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️  Concept Description Repository listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "CDREPO", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "CDREPO", cfg.Server, r)
 }
 
 func main() {
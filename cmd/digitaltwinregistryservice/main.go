@@ -40,6 +40,7 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/asyncbulk"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/binarycontent"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/descriptors"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
@@ -85,7 +86,6 @@ func runServer(ctx context.Context, configPath string) error {
 
 	r.Use(common.ConfigMiddleware(cfg))
 	common.AddCors(r, cfg)
-	common.AddHealthEndpoint(r, cfg)
 
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Digital Twin Registry API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
@@ -97,6 +97,9 @@ func runServer(ctx context.Context, configPath string) error {
 	// === Database ===
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -109,6 +112,10 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("Shared DB connect failed: %v", err)
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -135,7 +142,8 @@ func runServer(ctx context.Context, configPath string) error {
 	}
 	log.Println("✅ Postgres connection established")
 
-	discoveryBaseSvc := discoveryapiinternal.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*discoveryDatabase)
+	discoveryBaseSvc := discoveryapiinternal.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*discoveryDatabase).
+		WithAssetLinkEventPublisher(history.NewEventPublisher(cfg.Eventing))
 	registrySvc := digitaltwinregistry.NewCustomRegistryService(
 		registryapiinternal.NewAssetAdministrationShellRegistryAPIAPIService(*registryDatabase),
 		discoveryBaseSvc,
@@ -176,22 +184,31 @@ func runServer(ctx context.Context, configPath string) error {
 
 	for operation, rt := range registryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		if rt.Method == "GET" && rt.Pattern == "/shell-descriptors" {
 			apiRouter.With(digitaltwinregistry.CreatedAfterMiddleware).Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 			continue
 		}
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range discoveryCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		if (rt.Method == "POST" && rt.Pattern == "/lookup/shellsByAssetLink") || (rt.Method == "GET" && rt.Pattern == "/lookup/shells") {
 			apiRouter.With(digitaltwinregistry.CreatedAfterMiddleware).Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 			continue
 		}
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range descriptionCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 	versioningGuard.Cover(http.MethodPost, "/bulk/shell-descriptors")
@@ -204,7 +221,10 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️ Digital Twin Registry listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "DTR", cfg.Server, r)
+	reachabilityChecker := descriptors.StartEndpointReachabilityCheckerIfEnabled(ctx, sharedDB, cfg)
+	defer reachabilityChecker.Stop()
+
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "DTR", cfg.Server, r)
 }
 
 func main() {
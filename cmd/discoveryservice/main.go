@@ -36,6 +36,7 @@ import (
 
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/binarycontent"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	"github.com/eclipse-basyx/basyx-go-components/internal/discoveryservice/api"
@@ -67,9 +68,6 @@ func runServer(ctx context.Context, configPath string) error {
 
 	common.AddCors(r, cfg)
 
-	// --- Health Endpoint (public) ---
-	common.AddHealthEndpoint(r, cfg)
-
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Discovery Service API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
 		log.Printf("Warning: failed to load OpenAPI spec for Swagger UI: %v", err)
@@ -78,6 +76,9 @@ func runServer(ctx context.Context, configPath string) error {
 	// === Database ===
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -90,6 +91,11 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ DB connect failed: %v", err)
 		return err
 	}
+	// --- Health and Readiness Endpoints (public) ---
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -106,7 +112,8 @@ func runServer(ctx context.Context, configPath string) error {
 	}
 	log.Println("✅ Postgres connection established")
 
-	smSvc := api.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*smDatabase)
+	smSvc := api.NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*smDatabase).
+		WithAssetLinkEventPublisher(history.NewEventPublisher(cfg.Eventing))
 	smCtrl := openapi.NewAssetAdministrationShellBasicDiscoveryAPIAPIController(smSvc)
 
 	// === Description Service (public) ===
@@ -130,12 +137,18 @@ func runServer(ctx context.Context, configPath string) error {
 	}
 
 	// Register all discovery routes (protected)
-	for _, rt := range smCtrl.Routes() {
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+	for operation, rt := range smCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 
 	// Register all description routes (protected)
-	for _, rt := range descCtrl.Routes() {
+	for operation, rt := range descCtrl.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -145,7 +158,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️ AAS Discovery listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "DISCOVERY", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "DISCOVERY", cfg.Server, r)
 }
 
 func main() {
@@ -80,10 +80,13 @@ func runServer(ctx context.Context, configPath string) error {
 	}
 
 	log.Printf("Server started on %s", addr)
-	return common.RunHTTPServer(ctx, "DPP", cfg.Server, router)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "DPP", cfg.Server, router)
 }
 
 func openSharedDatabase(ctx context.Context, cfg *common.Config, dsn string) (*sql.DB, error) {
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return nil, err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return nil, err
 	}
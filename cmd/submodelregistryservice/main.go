@@ -40,6 +40,7 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/binarycontent"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	smregistryapi "github.com/eclipse-basyx/basyx-go-components/internal/smregistry/api"
 	smregistrypostgresql "github.com/eclipse-basyx/basyx-go-components/internal/smregistry/persistence"
@@ -79,7 +80,6 @@ func runServer(ctx context.Context, configPath string) error {
 	r.Use(common.ConfigMiddleware(cfg))
 
 	common.AddCors(r, cfg)
-	common.AddHealthEndpoint(r, cfg)
 
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Submodel Registry Service API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
@@ -88,6 +88,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -100,6 +103,10 @@ func runServer(ctx context.Context, configPath string) error {
 		log.Printf("❌ DB connect failed: %v", err)
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -126,6 +133,9 @@ func runServer(ctx context.Context, configPath string) error {
 	bulkHandler := smregistryapi.NewBulkHTTPHandler(bulkSvc)
 
 	descSvc := smregistryapi.NewDescriptionAPIAPIService()
+	if !common.IsOperationDisabled(cfg, "QuerySubmodelDescriptors", http.MethodPost) {
+		descSvc.WithQueryCapabilities(grammar.NewQueryCapabilities("/query/submodel-descriptors"))
+	}
 	descCtrl := smregistryopenapi.NewDescriptionAPIAPIController(descSvc)
 
 	base := common.NormalizeBasePath(cfg.Server.ContextPath)
@@ -152,12 +162,18 @@ func runServer(ctx context.Context, configPath string) error {
 	// Register all registry routes (protected)
 	for _, rt := range smCtrl.OrderedRoutes() {
 		versioningGuard.ClassifyRoute(rt.Name, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, rt.Name, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 
 	// Register all description routes (protected)
 	for _, rt := range descCtrl.OrderedRoutes() {
 		versioningGuard.ClassifyRoute(rt.Name, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, rt.Name, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 	versioningGuard.Cover(http.MethodPost, "/bulk/submodel-descriptors")
@@ -171,7 +187,7 @@ func runServer(ctx context.Context, configPath string) error {
 	addr := common.ServerAddress(cfg.Server)
 	log.Printf("▶️ Submodel Registry listening on %s (contextPath=%q)\n", addr, cfg.Server.ContextPath)
 
-	return common.RunHTTPServer(ctx, "SMR", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "SMR", cfg.Server, r)
 }
 
 func main() {
@@ -28,11 +28,11 @@ package main
 
 import (
 	"context"
-	"crypto/rsa"
 	"embed"
 	"flag"
 	"log"
 	"net/http"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -45,6 +45,7 @@ import (
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/jws"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/security/abacpolicy"
 	smregistrydb "github.com/eclipse-basyx/basyx-go-components/internal/smregistry/persistence"
@@ -98,7 +99,6 @@ func runServer(ctx context.Context, configPath string) error {
 	r.Use(common.ConfigMiddleware(cfg))
 
 	common.AddCors(r, cfg)
-	common.AddHealthEndpoint(r, cfg)
 
 	// Add Swagger UI
 	if err := common.AddSwaggerUIFromFS(r, openapiSpec, "openapi.yaml", "Submodel Repository API", "/swagger", "/api-docs/openapi.yaml", cfg); err != nil {
@@ -108,14 +108,17 @@ func runServer(ctx context.Context, configPath string) error {
 	// Instantiate generated services & controllers
 	// ==== Submodel Repository Service ====
 
-	// Load JWS private key if configured
-	var privateKey *rsa.PrivateKey
+	// Load JWS signing keys if configured
+	var jwsKeySet *jws.KeySet
 	if cfg.JWS.PrivateKeyPath != "" {
-		privateKey, err = jws.LoadPrivateKey(cfg.JWS.PrivateKeyPath)
+		jwsKeySet, err = jws.LoadKeySet(cfg.JWS.PrivateKeyPath, cfg.JWS.PreviousPublicKeyPaths)
 		if err != nil {
 			log.Printf("Warning: failed to load JWS private key: %v - /$signed Endpoints will be unavailable", err)
 		} else {
 			log.Println("JWS private key loaded successfully")
+			// SIGHUP reloads the key files so signing keys can be rotated without a restart.
+			jwsKeySet.ReloadOnSignal(ctx, syscall.SIGHUP)
+			r.Method(http.MethodGet, jws.JWKSPath, jwsKeySet)
 		}
 	}
 	signingOptions, err := jws.LoadSigningOptions(cfg.JWS.CertificateChainPath)
@@ -125,6 +128,9 @@ func runServer(ctx context.Context, configPath string) error {
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
 
+	if err := common.WaitForDatabase(ctx, dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres)); err != nil {
+		return err
+	}
 	if err := common.ValidateSchemaVersionByDSN(dsn, common.CURRENT_DATABASE_VERSION); err != nil {
 		return err
 	}
@@ -133,6 +139,10 @@ func runServer(ctx context.Context, configPath string) error {
 	if err != nil {
 		return err
 	}
+	common.AddHealthEndpoint(r, cfg)
+	if err := common.AddDatabaseReadinessEndpoint(r, cfg, sharedDB, common.CURRENT_DATABASE_VERSION); err != nil {
+		return err
+	}
 	if cfg.Postgres.MaxOpenConnections > 0 {
 		sharedDB.SetMaxOpenConns(cfg.Postgres.MaxOpenConnections)
 	}
@@ -146,10 +156,11 @@ func runServer(ctx context.Context, configPath string) error {
 		return err
 	}
 
-	smDatabase, err := persistencepostgresql.NewSubmodelDatabaseFromDB(sharedDB, privateKey, cfg.Server.StrictVerification)
+	smDatabase, err := persistencepostgresql.NewSubmodelDatabaseFromDB(sharedDB, nil, cfg.Server.StrictVerification)
 	if err != nil {
 		return err
 	}
+	smDatabase.SetJWSKeySet(jwsKeySet)
 	smDatabase.SetJWSCertificateChain(signingOptions.CertificateChain)
 	smRegistryPersistence, err := smregistrydb.NewPostgreSQLSMBackendFromDB(sharedDB)
 	if err != nil {
@@ -179,13 +190,21 @@ func runServer(ctx context.Context, configPath string) error {
 		registrySyncConfig,
 		enableReferencingAASDescriptorEmbeddingSync,
 	)
-	smCtrl := openapi.NewSubmodelRepositoryAPIAPIController(smSvc, "", cfg.Server.StrictVerification)
+	smCtrl := openapi.NewSubmodelRepositoryAPIAPIController(smSvc, "", cfg.Server.StrictVerification, openapi.WithStrictSchemaValidation(cfg.Server.StrictSchemaValidation))
 
-	serializationSvc := api.NewSerializationAPIAPIService()
-	serializationCtrl := openapi.NewSerializationAPIAPIController(serializationSvc, "")
+	serializationSvc := api.NewSerializationAPIAPIService(*smDatabase)
+	importStager := common.NewConnectionReservedUploadStager(
+		binarycontent.NewStager(sharedDB), sharedDB.Stats().MaxOpenConnections, 1,
+	)
+	serializationCtrl := openapi.NewSerializationAPIAPIController(serializationSvc, "",
+		openapi.WithSerializationAPIAPIUploadStager(importStager, cfg.General.UploadMaxSizeBytes),
+	)
 
 	// ==== Description Service ====
 	descSvc := api.NewDescriptionAPIAPIService()
+	if !common.IsOperationDisabled(cfg, "QuerySubmodels", http.MethodPost) {
+		descSvc.WithQueryCapabilities(grammar.NewQueryCapabilities("/query/submodels"))
+	}
 	descCtrl := openapi.NewDescriptionAPIAPIController(descSvc)
 	base := common.NormalizeBasePath(cfg.Server.ContextPath)
 
@@ -208,20 +227,35 @@ func runServer(ctx context.Context, configPath string) error {
 	apiRouter.Use(history.AuditContextMiddleware(cfg))
 	abacpolicy.ExemptManagementMutationRoutesIfEnabled(cfg, versioningGuard, "submodelrepositoryservice")
 	abacpolicy.RegisterManagementRoutesIfEnabled(cfg, apiRouter, abacRepo, "submodelrepositoryservice")
+	if abacpolicy.ManagementRoutesEnabled(cfg, "submodelrepositoryservice") {
+		// List index repair only renumbers storage bookkeeping; element order
+		// and values stay unchanged, so it is exempt from history coverage.
+		versioningGuard.Exempt(http.MethodPost, api.ListIndexRepairPath)
+		api.RegisterListIndexManagementRoutes(apiRouter, smDatabase)
+	}
 	if cfg.Server.VerificationEndpointAvailable {
 		common.AddVerificationEndpoint(apiRouter, cfg, binarycontent.NewStager(sharedDB))
 	}
 
 	for operation, rt := range smCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
-		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		apiRouter.Method(rt.Method, rt.Pattern, common.JSONContentTypeMiddleware(rt.HandlerFunc))
 	}
 	for operation, rt := range serializationCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 	for operation, rt := range descCtrl.Routes() {
 		versioningGuard.ClassifyRoute(operation, rt.Method, rt.Pattern)
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
 		apiRouter.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
 	}
 
@@ -233,7 +267,7 @@ func runServer(ctx context.Context, configPath string) error {
 
 	// submodelrepository.TestNewSubmodelHandler(smDatabase)
 
-	return common.RunHTTPServer(ctx, "SMREPO", cfg.Server, r)
+	return common.RunHTTPServer(common.ContextWithConfig(ctx, cfg), "SMREPO", cfg.Server, r)
 }
 
 func main() {
@@ -0,0 +1,18 @@
+-- ============================================================================
+-- Project        : Eclipse BaSyx
+-- Organization   : Fraunhofer IESE
+-- File Type      : SQL Patch Script
+-- Patch Version  : 1.1.10
+-- Metamodel Ver. : 3.2
+-- ----------------------------------------------------------------------------
+-- Description:
+--   Database patch for creation-order submodel listings.
+--
+-- Copyright (c) Eclipse BaSyx Authors and Fraunhofer IESE
+-- SPDX-License-Identifier: MIT
+-- ============================================================================
+
+-- GET /submodels?orderBy=createdAt pages over (db_created_at, id). The column
+-- already exists on every supported schema; only the keyset index is new.
+CREATE INDEX IF NOT EXISTS ix_sm_created_at_id
+  ON submodel(db_created_at, id);
@@ -0,0 +1,23 @@
+-- ============================================================================
+-- Project        : Eclipse BaSyx
+-- Organization   : Fraunhofer IESE
+-- File Type      : SQL Patch Script
+-- Patch Version  : 1.1.9
+-- Metamodel Ver. : 3.2
+-- ----------------------------------------------------------------------------
+-- Description:
+--   Database patch for registry endpoint reachability tracking.
+--
+-- Copyright (c) Eclipse BaSyx Authors and Fraunhofer IESE
+-- SPDX-License-Identifier: MIT
+-- ============================================================================
+
+-- Result of the optional background probe of descriptor endpoints. All columns
+-- stay NULL until the endpoint has been probed at least once.
+ALTER TABLE IF EXISTS aas_descriptor_endpoint
+  ADD COLUMN IF NOT EXISTS reachability_status VARCHAR(32),
+  ADD COLUMN IF NOT EXISTS last_reachability_check_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS last_reachable_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS ix_aas_endpoint_reachability_status
+  ON aas_descriptor_endpoint(reachability_status);
@@ -38,6 +38,7 @@ import (
 	aasrepositoryapi "github.com/eclipse-basyx/basyx-go-components/internal/aasrepository/api"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	submodelrepositoryapi "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/api"
 )
 
 // CustomAASRepositoryService is a pass-through stub for future combined logic.
@@ -672,11 +673,9 @@ func (s *CustomAASRepositoryService) PatchSubmodelByIdMetadataAasRepository(ctx
 	if rawPatchJSON, hasRawPatch := common.GetSubmodelMetadataPatch(ctx); hasRawPatch {
 		patchJSON = rawPatchJSON
 	}
-	if patchJSON["modelType"] != "Submodel" {
-		err := errors.New("modelType for Submodel metadata must be 'Submodel'")
-		return newAASRepoErrorResponse(err, http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
+	if modelTypeErr := submodelrepositoryapi.ValidateSubmodelMetadataPatchModelType(patchJSON); modelTypeErr != nil {
+		return newAASRepoErrorResponse(modelTypeErr, http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
 	}
-	patchJSON["id"] = decodedSubmodelIdentifier
 
 	mergedSubmodel, mergeResponse, mergeOk := s.buildMergedPatchedSubmodel(ctx, operation, decodedSubmodelIdentifier, patchJSON, true)
 	if !mergeOk {
@@ -764,13 +763,14 @@ func (s *CustomAASRepositoryService) buildMergedPatchedSubmodel(ctx context.Cont
 		return nil, newAASRepoErrorResponse(existingJSONErr, http.StatusInternalServerError, operation, "ToJsonableCurrentSubmodel"), false
 	}
 
-	patchJSON["id"] = submodelID
-	mergedJSON := mergeSubmodelJSON(existingJSON, patchJSON)
+	var mergedSubmodel types.ISubmodel
+	var mergedErr error
 	if metadataOnly {
-		delete(mergedJSON, "submodelElements")
+		mergedSubmodel, mergedErr = submodelrepositoryapi.MergeSubmodelMetadataPatch(existingJSON, patchJSON, submodelID)
+	} else {
+		patchJSON["id"] = submodelID
+		mergedSubmodel, mergedErr = jsonization.SubmodelFromJsonable(submodelrepositoryapi.MergeJSONObjects(existingJSON, patchJSON))
 	}
-
-	mergedSubmodel, mergedErr := jsonization.SubmodelFromJsonable(mergedJSON)
 	if mergedErr != nil {
 		return nil, newAASRepoErrorResponse(mergedErr, http.StatusBadRequest, operation, "InvalidPatchedSubmodel"), false
 	}
@@ -111,7 +111,8 @@ func (s *CustomSubmodelRepositoryService) validateSyncDependencies(requireAASRep
 // PostSubmodel creates a new submodel and synchronizes descriptor writes in the same transaction.
 func (s *CustomSubmodelRepositoryService) PostSubmodel(ctx context.Context, submodel types.ISubmodel) (commonmodel.ImplResponse, error) {
 	const operation = "PostSubmodel"
-	if !s.syncConfig.SubmodelRegistryIntegration {
+	// Dry runs never persist, so there is nothing to synchronize to the registry.
+	if !s.syncConfig.SubmodelRegistryIntegration || common.IsDryRun(ctx) {
 		return s.SubmodelRepositoryAPIAPIService.PostSubmodel(ctx, submodel)
 	}
 	if dependencyErr := s.validateSyncDependencies(s.enableReferencingAASDescriptorEmbeddingSync, s.enableReferencingAASDescriptorEmbeddingSync); dependencyErr != nil {
@@ -159,7 +160,8 @@ func (s *CustomSubmodelRepositoryService) PostSubmodel(ctx context.Context, subm
 // PutSubmodelByID upserts a submodel and synchronizes descriptor writes in the same transaction.
 func (s *CustomSubmodelRepositoryService) PutSubmodelByID(ctx context.Context, submodelIdentifier string, submodel types.ISubmodel) (commonmodel.ImplResponse, error) {
 	const operation = "PutSubmodelByID"
-	if !s.syncConfig.SubmodelRegistryIntegration {
+	// Dry runs never persist, so there is nothing to synchronize to the registry.
+	if !s.syncConfig.SubmodelRegistryIntegration || common.IsDryRun(ctx) {
 		return s.SubmodelRepositoryAPIAPIService.PutSubmodelByID(ctx, submodelIdentifier, submodel)
 	}
 	if dependencyErr := s.validateSyncDependencies(s.enableReferencingAASDescriptorEmbeddingSync, s.enableReferencingAASDescriptorEmbeddingSync); dependencyErr != nil {
@@ -315,7 +317,7 @@ func (s *CustomSubmodelRepositoryService) PatchSubmodelByID(ctx context.Context,
 	}
 	patchJSON["id"] = decodedIdentifier
 
-	mergedJSON := mergeSubmodelJSON(existingJSON, patchJSON)
+	mergedJSON := submodelrepositoryapi.MergeJSONObjects(existingJSON, patchJSON)
 	mergedSubmodel, mergedErr := jsonization.SubmodelFromJsonable(mergedJSON)
 	if mergedErr != nil {
 		return newSubmodelRepoErrorResponse(mergedErr, http.StatusBadRequest, operation, "InvalidPatchedSubmodel"), nil
@@ -383,10 +385,9 @@ func (s *CustomSubmodelRepositoryService) PatchSubmodelByIDMetadata(ctx context.
 	if rawPatchJSON, hasRawPatch := common.GetSubmodelMetadataPatch(ctx); hasRawPatch {
 		patchJSON = rawPatchJSON
 	}
-	if patchJSON["modelType"] != "Submodel" {
-		return newSubmodelRepoErrorResponse(errors.New("modelType for Submodel metadata must be 'Submodel'"), http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
+	if modelTypeErr := submodelrepositoryapi.ValidateSubmodelMetadataPatchModelType(patchJSON); modelTypeErr != nil {
+		return newSubmodelRepoErrorResponse(modelTypeErr, http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
 	}
-	patchJSON["id"] = decodedIdentifier
 
 	existingSubmodels, _, getErr := s.persistence.SubmodelRepository.GetSubmodels(ctx, 1, "", decodedIdentifier, "", time.Time{}, time.Time{})
 	if getErr != nil {
@@ -410,9 +411,7 @@ func (s *CustomSubmodelRepositoryService) PatchSubmodelByIDMetadata(ctx context.
 		return newSubmodelRepoErrorResponse(existingJSONErr, http.StatusInternalServerError, operation, "ToJsonableCurrentSubmodel"), nil
 	}
 
-	mergedJSON := mergeSubmodelJSON(existingJSON, patchJSON)
-	delete(mergedJSON, "submodelElements")
-	mergedSubmodel, mergedErr := jsonization.SubmodelFromJsonable(mergedJSON)
+	mergedSubmodel, mergedErr := submodelrepositoryapi.MergeSubmodelMetadataPatch(existingJSON, patchJSON, decodedIdentifier)
 	if mergedErr != nil {
 		return newSubmodelRepoErrorResponse(mergedErr, http.StatusBadRequest, operation, "InvalidPatchedSubmodel"), nil
 	}
@@ -468,32 +467,6 @@ func newSubmodelRepoErrorResponse(err error, status int, operation string, info
 	return common.NewErrorResponse(err, status, "SMREPO", operation, info)
 }
 
-func mergeSubmodelJSON(base map[string]any, patch map[string]any) map[string]any {
-	merged := make(map[string]any, len(base))
-	for key, value := range base {
-		merged[key] = value
-	}
-
-	for key, patchValue := range patch {
-		if patchValue == nil {
-			delete(merged, key)
-			continue
-		}
-
-		baseValue, baseExists := merged[key]
-		baseMap, baseIsMap := baseValue.(map[string]any)
-		patchMap, patchIsMap := patchValue.(map[string]any)
-		if baseExists && baseIsMap && patchIsMap {
-			merged[key] = mergeSubmodelJSON(baseMap, patchMap)
-			continue
-		}
-
-		merged[key] = patchValue
-	}
-
-	return merged
-}
-
 func (s *CustomSubmodelRepositoryService) syncReferencingAASDescriptorsInTransaction(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -0,0 +1,117 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package aasenvironment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	submodelrepositoryapi "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/api"
+	submodelrepositorydb "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func configContext(t *testing.T) context.Context {
+	t.Helper()
+
+	var cfgCtx context.Context
+	handler := common.ConfigMiddleware(&common.Config{})(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		cfgCtx = r.Context()
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	require.NotNil(t, cfgCtx)
+	return cfgCtx
+}
+
+func dryRunContext(t *testing.T) context.Context {
+	t.Helper()
+	return common.WithDryRun(configContext(t), true)
+}
+
+// newDryRunSubmodelService wires the environment decorator with registry sync
+// enabled but without registry persistence: any attempt to take the sync path
+// fails dependency validation before touching the database.
+func newDryRunSubmodelService(t *testing.T) (*CustomSubmodelRepositoryService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	submodelDB, err := submodelrepositorydb.NewSubmodelDatabaseFromDB(db, nil, string(commonmodel.VerificationModeOff))
+	require.NoError(t, err)
+	base := submodelrepositoryapi.NewSubmodelRepositoryAPIAPIService(*submodelDB)
+
+	return NewCustomSubmodelRepositoryService(base, &Persistence{}, RegistrySyncConfig{SubmodelRegistryIntegration: true}), mock
+}
+
+func TestCustomSubmodelRepositoryServicePostSubmodelDryRunSkipsRegistrySync(t *testing.T) {
+	service, mock := newDryRunSubmodelService(t)
+	submodel := types.NewSubmodel("urn:example:sm:dry-run")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO .*submodel.*RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	response, err := service.PostSubmodel(dryRunContext(t), submodel)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.Code)
+	report, ok := response.Body.(submodelrepositoryapi.SubmodelDryRunReport)
+	require.True(t, ok, "expected dry-run report, got %#v", response.Body)
+	require.True(t, report.DryRun)
+	require.True(t, report.Valid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCustomSubmodelRepositoryServicePutSubmodelDryRunSkipsRegistrySync(t *testing.T) {
+	service, mock := newDryRunSubmodelService(t)
+	submodelID := "urn:example:sm:dry-run-put"
+	submodel := types.NewSubmodel(submodelID)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`.`).WillReturnError(errors.New("validation stops here"))
+	mock.ExpectRollback()
+
+	response, err := service.PutSubmodelByID(dryRunContext(t), common.EncodeString(submodelID), submodel)
+	require.NoError(t, err)
+	require.NotEqual(t, http.StatusCreated, response.Code)
+	require.NotEqual(t, http.StatusNoContent, response.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
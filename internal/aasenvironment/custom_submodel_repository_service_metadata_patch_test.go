@@ -0,0 +1,85 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package aasenvironment
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	smregistrydb "github.com/eclipse-basyx/basyx-go-components/internal/smregistry/persistence"
+	submodelrepositoryapi "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/api"
+	submodelrepositorydb "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomSubmodelRepositoryServicePatchMetadataAcceptsPatchWithoutModelType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	submodelRepository, err := submodelrepositorydb.NewSubmodelDatabaseFromDB(db, nil, string(commonmodel.VerificationModeOff))
+	require.NoError(t, err)
+	submodelRegistry, err := smregistrydb.NewPostgreSQLSMBackendFromDB(db)
+	require.NoError(t, err)
+	service := NewCustomSubmodelRepositoryServiceWithAASDescriptorEmbeddingSync(
+		submodelrepositoryapi.NewSubmodelRepositoryAPIAPIService(*submodelRepository),
+		&Persistence{SubmodelRepository: submodelRepository, SubmodelRegistry: submodelRegistry},
+		RegistrySyncConfig{SubmodelRegistryIntegration: true},
+		false,
+	)
+
+	// The patch passes validation and reaches the lookup of the stored
+	// submodel, which is stopped here.
+	mock.ExpectQuery(`.`).WillReturnError(errors.New("lookup stops here"))
+
+	ctx := common.WithSubmodelMetadataPatch(configContext(t), map[string]any{
+		"description": []any{map[string]any{"language": "en", "text": "patched"}},
+	})
+	response, err := service.PatchSubmodelByIDMetadata(ctx, common.EncodeString("urn:example:sm:metadata"), commonmodel.SubmodelMetadata{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, response.Code, "body=%#v", response.Body)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCustomSubmodelRepositoryServicePatchMetadataRejectsForeignModelType(t *testing.T) {
+	service := NewCustomSubmodelRepositoryServiceWithAASDescriptorEmbeddingSync(
+		nil,
+		&Persistence{SubmodelRepository: &submodelrepositorydb.SubmodelDatabase{}, SubmodelRegistry: &smregistrydb.PostgreSQLSMDatabase{}},
+		RegistrySyncConfig{SubmodelRegistryIntegration: true},
+		false,
+	)
+
+	ctx := common.WithSubmodelMetadataPatch(configContext(t), map[string]any{"modelType": "Property"})
+	response, err := service.PatchSubmodelByIDMetadata(ctx, common.EncodeString("urn:example:sm:metadata"), commonmodel.SubmodelMetadata{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+}
@@ -75,6 +75,7 @@ func TestMain(m *testing.M) {
 		Env:             runtime.Env(),
 		PreDownBeforeUp: true,
 		HealthURL:       aasEnvBaseURL + "/health",
+		ReadyURL:        testenv.ReadyURL(aasEnvBaseURL),
 		HealthTimeout:   3 * time.Minute,
 	}))
 }
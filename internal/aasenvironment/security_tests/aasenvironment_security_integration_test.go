@@ -547,6 +547,7 @@ func TestMain(m *testing.M) {
 		PreDownBeforeUp: true,
 		HealthURL:       testBaseURL + "/health",
 		HealthTimeout:   3 * time.Minute,
+		ReadyURL:        testenv.ReadyURL(testBaseURL),
 	})
 	_ = os.RemoveAll(securityEnv)
 	os.Exit(code)
@@ -46,6 +46,7 @@ import (
 	persistence_postgresql "github.com/eclipse-basyx/basyx-go-components/internal/aasregistry/persistence"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/createprecheck"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/descriptors"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
@@ -70,7 +71,13 @@ func NewAssetAdministrationShellRegistryAPIAPIService(databaseBackend persistenc
 }
 
 // GetAllAssetAdministrationShellDescriptors - Returns all Asset Administration Shell Descriptors
-func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllAssetAdministrationShellDescriptors(ctx context.Context, limit int32, cursor string, assetKind model.AssetKind, assetType string, assetIds []string, createdFrom time.Time, updatedFrom time.Time) (model.ImplResponse, error) {
+func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllAssetAdministrationShellDescriptors(ctx context.Context, limit int32, cursor string, assetKind model.AssetKind, assetType string, assetIds []string, createdFrom time.Time, updatedFrom time.Time, endpointInterface string, endpointProtocol string, reachable *bool) (model.ImplResponse, error) {
+	limit, limitErr := common.ResolvePageLimit(ctx, limit)
+	if limitErr != nil {
+		return common.NewErrorResponse(
+			limitErr, http.StatusBadRequest, componentName, "GetAllAssetAdministrationShellDescriptors", "LimitTooLarge",
+		), nil
+	}
 	internalCursor, resp, err := decodeCursor(strings.TrimSpace(cursor), "GetAllAssetAdministrationShellDescriptors")
 	if resp != nil || err != nil {
 		return *resp, err
@@ -85,16 +92,13 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllAssetAdministratio
 			err, http.StatusBadRequest, componentName, "GetAllAssetAdministrationShellDescriptors", "BadAssetIds",
 		), nil
 	}
-	fetch := func(pageLimit int32, pageCursor string) ([]model.AssetAdministrationShellDescriptor, string, error) {
-		return s.aasRegistryBackend.ListAssetAdministrationShellDescriptors(ctx, pageLimit, pageCursor, assetKind, decodedAssetType, createdFrom, updatedFrom)
-	}
-	var aasds []model.AssetAdministrationShellDescriptor
-	var nextCursor string
-	if assetIDFilter.IsEmpty() {
-		aasds, nextCursor, err = fetch(limit, internalCursor)
-	} else {
-		aasds, nextCursor, err = filterAssetAdministrationShellDescriptorPages(limit, internalCursor, fetch, assetIDFilter)
+	endpointFilter := descriptors.AASDescriptorEndpointFilter{
+		Interface: endpointInterface,
+		Protocol:  endpointProtocol,
+		Reachable: reachable,
+		AssetIDs:  assetIDFilter.AssetIDs(),
 	}
+	aasds, nextCursor, err := s.aasRegistryBackend.ListAssetAdministrationShellDescriptors(ctx, limit, internalCursor, assetKind, decodedAssetType, createdFrom, updatedFrom, endpointFilter)
 	if err != nil {
 		log.Printf("🧩 [%s] Error in GetAllAssetAdministrationShellDescriptors: list failed (limit=%d cursor=%q assetKind=%q assetType=%q): %v", componentName, limit, internalCursor, string(assetKind), assetType, err)
 		switch {
@@ -108,6 +112,23 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllAssetAdministratio
 			), err
 		}
 	}
+	if total := common.TotalCountFromContext(ctx); total != nil {
+		count, countErr := s.aasRegistryBackend.CountAssetAdministrationShellDescriptors(ctx, assetKind, decodedAssetType, createdFrom, updatedFrom, endpointFilter)
+		if countErr != nil {
+			log.Printf("🧩 [%s] Error in GetAllAssetAdministrationShellDescriptors: count failed: %v", componentName, countErr)
+			return common.NewErrorResponse(
+				countErr, http.StatusInternalServerError, componentName, "GetAllAssetAdministrationShellDescriptors", "CountFailed",
+			), countErr
+		}
+		total.Set(count)
+	}
+	prevCursor, err := s.aasRegistryBackend.PreviousAssetAdministrationShellDescriptorCursor(ctx, limit, internalCursor, assetKind, decodedAssetType, createdFrom, updatedFrom, endpointFilter)
+	if err != nil {
+		log.Printf("🧩 [%s] Error in GetAllAssetAdministrationShellDescriptors: previous cursor failed (cursor=%q): %v", componentName, internalCursor, err)
+		return common.NewErrorResponse(
+			err, http.StatusInternalServerError, componentName, "GetAllAssetAdministrationShellDescriptors", "PreviousCursorFailed",
+		), err
+	}
 	jsonable := make([]map[string]any, 0, len(aasds))
 	for _, aasd := range aasds {
 		j, toJsonErr := aasd.ToJsonable()
@@ -120,46 +141,7 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllAssetAdministratio
 		jsonable = append(jsonable, j)
 	}
 
-	return pagedResponse(jsonable, nextCursor), nil
-}
-
-type assetAdministrationShellDescriptorFetcher func(limit int32, cursor string) ([]model.AssetAdministrationShellDescriptor, string, error)
-
-func filterAssetAdministrationShellDescriptorPages(
-	limit int32,
-	cursor string,
-	fetch assetAdministrationShellDescriptorFetcher,
-	filter common.AssetIDFilter,
-) ([]model.AssetAdministrationShellDescriptor, string, error) {
-	if limit <= 0 {
-		limit = 100
-	}
-	pageLimit := limit + 1
-	result := make([]model.AssetAdministrationShellDescriptor, 0, limit)
-	currentCursor := cursor
-	for {
-		page, nextCursor, err := fetch(pageLimit, currentCursor)
-		if err != nil {
-			return nil, "", err
-		}
-		for _, descriptor := range page {
-			matches, matchErr := filter.Matches(descriptor.GlobalAssetId, descriptor.SpecificAssetIds)
-			if matchErr != nil {
-				return nil, "", matchErr
-			}
-			if !matches {
-				continue
-			}
-			if len(result) == int(limit) {
-				return result, descriptor.Id, nil
-			}
-			result = append(result, descriptor)
-		}
-		if nextCursor == "" || nextCursor == currentCursor {
-			return result, "", nil
-		}
-		currentCursor = nextCursor
-	}
+	return pagedResponseWithPrevious(jsonable, nextCursor, prevCursor), nil
 }
 
 // PostAssetAdministrationShellDescriptor - Creates a new Asset Administration Shell Descriptor, i.e. registers an AAS
@@ -371,6 +353,54 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) PutAssetAdministrationSh
 
 }
 
+// PatchAssetAdministrationShellDescriptorById - Updates the given fields of an existing Asset Administration Shell Descriptor
+// nolint:revive // defined by standard
+func (s *AssetAdministrationShellRegistryAPIAPIService) PatchAssetAdministrationShellDescriptorById(ctx context.Context, aasIdentifier string, patch map[string]any) (model.ImplResponse, error) {
+	decodedAAS, resp, err := decodePathParam(aasIdentifier, "aasIdentifier", "PatchAssetAdministrationShellDescriptorById", "BadRequest-Decode")
+	if resp != nil || err != nil {
+		return *resp, err
+	}
+
+	if exists, chkErr := s.aasRegistryBackend.ExistsAASByID(auth.WithoutQueryFilter(ctx), decodedAAS); chkErr != nil {
+		log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: existence check failed (aasId=%q): %v", componentName, decodedAAS, chkErr)
+		return common.NewErrorResponse(
+			chkErr, http.StatusInternalServerError, componentName, "PatchAssetAdministrationShellDescriptorById", "Unhandled-Precheck",
+		), chkErr
+	} else if !exists {
+		return common.NewErrorResponse(
+			common.NewErrNotFound("AASREG-PATCHAASDESC-NOTFOUND AAS Descriptor "+decodedAAS+" not found"), http.StatusNotFound, componentName, "PatchAssetAdministrationShellDescriptorById", "NotFound",
+		), nil
+	}
+
+	if _, err := s.aasRegistryBackend.PatchAdministrationShellDescriptor(ctx, decodedAAS, patch); err != nil {
+		switch {
+		case common.IsErrBadRequest(err):
+			log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: bad request (aasId=%q): %v", componentName, decodedAAS, err)
+			return common.NewErrorResponse(
+				err, http.StatusBadRequest, componentName, "PatchAssetAdministrationShellDescriptorById", "BadRequest",
+			), nil
+		case common.IsErrConflict(err):
+			log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: conflict (aasId=%q): %v", componentName, decodedAAS, err)
+			return common.NewErrorResponse(
+				err, http.StatusConflict, componentName, "PatchAssetAdministrationShellDescriptorById", "Conflict",
+			), nil
+		case common.IsErrNotFound(err):
+			deniedErr := common.NewErrDenied("AAS Descriptor access not allowed")
+			log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: not allowed (aasId=%q): %v", componentName, decodedAAS, err)
+			return common.NewErrorResponse(
+				deniedErr, http.StatusForbidden, componentName, "PatchAssetAdministrationShellDescriptorById", "DENIED",
+			), nil
+		default:
+			log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: internal (aasId=%q): %v", componentName, decodedAAS, err)
+			return common.NewErrorResponse(
+				err, http.StatusInternalServerError, componentName, "PatchAssetAdministrationShellDescriptorById", "Unhandled-Patch",
+			), err
+		}
+	}
+
+	return model.Response(http.StatusNoContent, nil), nil
+}
+
 // DeleteAssetAdministrationShellDescriptorById - Deletes an Asset Administration Shell Descriptor, i.e. de-registers an AAS
 // nolint:revive // defined by standard
 func (s *AssetAdministrationShellRegistryAPIAPIService) DeleteAssetAdministrationShellDescriptorById(ctx context.Context, aasIdentifier string) (model.ImplResponse, error) {
@@ -404,6 +434,12 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) DeleteAssetAdministratio
 
 // GetAllSubmodelDescriptorsThroughSuperpath - Returns all Submodel Descriptors
 func (s *AssetAdministrationShellRegistryAPIAPIService) GetAllSubmodelDescriptorsThroughSuperpath(ctx context.Context, aasIdentifier string, limit int32, cursor string) (model.ImplResponse, error) {
+	limit, limitErr := common.ResolvePageLimit(ctx, limit)
+	if limitErr != nil {
+		return common.NewErrorResponse(
+			limitErr, http.StatusBadRequest, componentName, "GetAllSubmodelDescriptorsThroughSuperpath", "LimitTooLarge",
+		), nil
+	}
 	// Decode AAS identifier from path
 	decodedAAS, resp, err := decodePathParam(aasIdentifier, "aasIdentifier", "GetAllSubmodelDescriptorsThroughSuperpath", "BadRequest-Decode")
 	if resp != nil || err != nil {
@@ -752,7 +788,7 @@ func (s *AssetAdministrationShellRegistryAPIAPIService) DeleteSubmodelDescriptor
 func (s *AssetAdministrationShellRegistryAPIAPIService) QueryAssetAdministrationShellDescriptors(ctx context.Context, limit int32, cursor string, query grammar.Query) (model.ImplResponse, error) {
 	ctx = auth.MergeQueryFilter(ctx, query)
 
-	aasds, nextCursor, err := s.aasRegistryBackend.ListAssetAdministrationShellDescriptors(ctx, limit, cursor, "", "", time.Time{}, time.Time{})
+	aasds, nextCursor, err := s.aasRegistryBackend.ListAssetAdministrationShellDescriptors(ctx, limit, cursor, "", "", time.Time{}, time.Time{}, descriptors.AASDescriptorEndpointFilter{})
 	if err != nil {
 		log.Printf("🧩 [%s] Error in QueryAssetAdministrationShellDescriptors: list failed (limit=%d cursor=%q ): %v", componentName, limit, cursor, err)
 		switch {
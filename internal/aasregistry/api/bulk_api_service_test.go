@@ -145,12 +145,43 @@ func TestBulkServiceCreateFailureResult(t *testing.T) {
 	require.EqualValues(t, 2, body["processedCount"])
 	require.EqualValues(t, 0, body["successfulCount"])
 	require.EqualValues(t, 2, body["failedCount"])
+	require.Equal(t, asyncbulk.ResultSummary{Total: 2, Succeeded: 0, Failed: 2}, body["summary"])
 	messages, ok := body["messages"].([]model.Message)
 	require.True(t, ok)
 	require.Len(t, messages, 1)
 	require.Equal(t, "Error", messages[0].MessageType)
 }
 
+func TestBulkServiceMixedResultSummaryCountsItems(t *testing.T) {
+	manager := asyncbulk.NewManager("AASR-BULK-TEST", time.Minute)
+	service := NewBulkService(aasBulkServiceStub{
+		putResult: asyncbulk.OperationResult{
+			Success:         false,
+			ProcessedCount:  3,
+			SuccessfulCount: 2,
+			FailedCount:     1,
+			Failures: []asyncbulk.ItemFailure{
+				{Index: 2, Identifier: "bad-id", StatusCode: http.StatusBadRequest, Message: "invalid descriptor"},
+			},
+		},
+	}, manager)
+
+	start := service.StartPut(context.Background(), []model.AssetAdministrationShellDescriptor{{Id: "id-1"}, {Id: "id-2"}, {Id: "bad-id"}})
+	require.Equal(t, http.StatusAccepted, start.Code)
+	handleID := extractAASHandleID(t, start)
+	awaitAASResultAvailability(t, service, handleID)
+
+	result := service.GetResult(context.Background(), handleID)
+	require.Equal(t, http.StatusBadRequest, result.Code)
+
+	body := result.Body.(map[string]any)
+	require.Equal(t, asyncbulk.ResultSummary{Total: 3, Succeeded: 2, Failed: 1}, body["summary"])
+	details, ok := body["details"].([]asyncbulk.ItemFailure)
+	require.True(t, ok)
+	require.Len(t, details, 1)
+	require.Equal(t, "bad-id", details[0].Identifier)
+}
+
 func awaitAASResultAvailability(t *testing.T, service *BulkService, handleID string) {
 	t.Helper()
 	deadline := time.Now().Add(2 * time.Second)
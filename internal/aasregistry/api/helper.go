@@ -64,10 +64,19 @@ func decodeOptionalQueryParam(raw, paramName, operation, errorDetail string) (st
 
 // pagedResponse builds the common paged envelope used across list endpoints.
 func pagedResponse[T any](results T, nextCursor string) model.ImplResponse {
+	return pagedResponseWithPrevious(results, nextCursor, "")
+}
+
+// pagedResponseWithPrevious builds the paged envelope of listings that also
+// report the cursor of the previous page.
+func pagedResponseWithPrevious[T any](results T, nextCursor string, prevCursor string) model.ImplResponse {
 	pm := model.PagedResultPagingMetadata{}
 	if nextCursor != "" {
 		pm.Cursor = common.EncodeString(nextCursor)
 	}
+	if prevCursor != "" {
+		pm.PrevCursor = common.EncodeString(prevCursor)
+	}
 
 	res := struct {
 		PagingMetadata model.PagedResultPagingMetadata `json:"paging_metadata"`
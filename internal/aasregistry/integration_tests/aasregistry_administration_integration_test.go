@@ -0,0 +1,84 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+//nolint:all
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAASRegistryRoundTripsAdministrativeInformation(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	descriptorID := fmt.Sprintf("https://example.com/ids/aasdesc/administration-%d", suffix)
+	submodelDescriptorID := fmt.Sprintf("https://example.com/ids/smdesc/administration-%d", suffix)
+	encodedDescriptorID := base64.RawURLEncoding.EncodeToString([]byte(descriptorID))
+	t.Cleanup(func() {
+		status, _, _ := doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+		if status != http.StatusNoContent && status != http.StatusNotFound {
+			t.Logf("cleanup delete returned unexpected status=%d", status)
+		}
+	})
+
+	endpoint := map[string]any{
+		"interface": "AAS-3.0",
+		"protocolInformation": map[string]any{
+			"href": "https://example.com/aas/" + encodedDescriptorID,
+		},
+	}
+	createAASDescriptor(t, map[string]any{
+		"id":        descriptorID,
+		"assetKind": "Instance",
+		"administration": map[string]any{
+			"version":  "2",
+			"revision": "7",
+		},
+		"endpoints": []any{endpoint},
+		"submodelDescriptors": []any{map[string]any{
+			"id": submodelDescriptorID,
+			"administration": map[string]any{
+				"version":  "1",
+				"revision": "3",
+			},
+			"endpoints": []any{endpoint},
+		}},
+	}, http.StatusCreated)
+
+	status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodGet, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+	require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+	descriptor := decodeAASRegistryMap(t, body)
+	require.Equal(t, map[string]any{"version": "2", "revision": "7"}, descriptor["administration"])
+
+	submodelDescriptors, _ := descriptor["submodelDescriptors"].([]any)
+	require.Len(t, submodelDescriptors, 1)
+	submodelDescriptor, _ := submodelDescriptors[0].(map[string]any)
+	require.Equal(t, map[string]any{"version": "1", "revision": "3"}, submodelDescriptor["administration"])
+}
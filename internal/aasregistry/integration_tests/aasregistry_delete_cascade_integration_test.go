@@ -0,0 +1,141 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package main
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteAASDescriptorCascadesToSubmodelDescriptors(t *testing.T) {
+	deleteAllAASDescriptorsHTTP(t)
+	descriptorID := fmt.Sprintf("https://example.com/ids/aasdesc/cascade-%d", time.Now().UnixNano())
+	encodedDescriptorID := base64.RawURLEncoding.EncodeToString([]byte(descriptorID))
+	submodelIDs := []string{
+		fmt.Sprintf("urn:example:ids:sm-desc:cascade-a-%d", time.Now().UnixNano()),
+		fmt.Sprintf("urn:example:ids:sm-desc:cascade-b-%d", time.Now().UnixNano()),
+	}
+	submodelDescriptors := make([]any, 0, len(submodelIDs))
+	for _, submodelID := range submodelIDs {
+		submodelDescriptors = append(submodelDescriptors, buildAASRegistrySubmodelDescriptorPayload(submodelID))
+	}
+
+	payload := map[string]any{
+		"id":            descriptorID,
+		"idShort":       "CascadeDescriptor",
+		"assetKind":     "Instance",
+		"globalAssetId": "urn:example:asset:cascade",
+		"extensions": []any{
+			map[string]any{"name": "cascade", "valueType": "xs:string", "value": "true"},
+		},
+		"specificAssetIds": []any{
+			map[string]any{"name": "serialNumber", "value": "cascade-001"},
+		},
+		"endpoints": []any{
+			map[string]any{
+				"interface": "AAS-3.0",
+				"protocolInformation": map[string]any{
+					"href":             "https://example.com/shells/" + encodedDescriptorID,
+					"endpointProtocol": "https",
+				},
+			},
+		},
+		"submodelDescriptors": submodelDescriptors,
+	}
+	status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodPost, aasRegistryBaseURL+"/shell-descriptors", payload)
+	require.Equal(t, http.StatusCreated, status, "response=%s", string(body))
+
+	db, err := sql.Open("pgx", aasRegistryIntegrationTestDSN)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	descriptorIDs := collectAASDescriptorRowIDs(t, db, descriptorID)
+	require.Len(t, descriptorIDs, 1+len(submodelIDs))
+
+	status, body, _ = doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+	require.Equal(t, http.StatusNoContent, status, "response=%s", string(body))
+	assertAASDescriptorStatus(t, descriptorID, http.StatusNotFound)
+
+	for _, table := range []struct {
+		name   string
+		column string
+	}{
+		{name: "descriptor", column: "id"},
+		{name: "descriptor_payload", column: "descriptor_id"},
+		{name: "aas_descriptor", column: "descriptor_id"},
+		{name: "aas_descriptor_endpoint", column: "descriptor_id"},
+		{name: "specific_asset_id", column: "descriptor_id"},
+		{name: "submodel_descriptor", column: "descriptor_id"},
+	} {
+		require.Zero(t, countRowsByDescriptorIDs(t, db, table.name, table.column, descriptorIDs), "orphan rows left in %s", table.name)
+	}
+}
+
+// collectAASDescriptorRowIDs returns the internal descriptor ids of the AAS
+// descriptor and all submodel descriptors attached to it.
+func collectAASDescriptorRowIDs(t *testing.T, db *sql.DB, aasID string) []int64 {
+	t.Helper()
+	parentIDs := goqu.From("aas_descriptor").Select(goqu.C("descriptor_id")).Where(goqu.C("id").Eq(aasID))
+	query, args, err := parentIDs.
+		Union(goqu.From("submodel_descriptor").Select(goqu.C("descriptor_id")).Where(goqu.C("aas_descriptor_id").In(parentIDs))).
+		ToSQL()
+	require.NoError(t, err)
+
+	rows, err := db.Query(query, args...)
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	return ids
+}
+
+func countRowsByDescriptorIDs(t *testing.T, db *sql.DB, table string, column string, ids []int64) int {
+	t.Helper()
+	query, args, err := goqu.From(table).
+		Select(goqu.COUNT(goqu.Star())).
+		Where(goqu.C(column).In(ids)).
+		ToSQL()
+	require.NoError(t, err)
+
+	var count int
+	require.NoError(t, db.QueryRow(query, args...).Scan(&count))
+	return count
+}
@@ -0,0 +1,182 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAASRegistryListFiltersByEndpointProtocolWithPagination(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	assetType := fmt.Sprintf("endpoint-protocol-%d", suffix)
+	descriptorEndpoints := map[string][]string{
+		fmt.Sprintf("https://example.com/ids/aasdesc/protocol-a-%d", suffix): {"HTTP", "MQTT"},
+		fmt.Sprintf("https://example.com/ids/aasdesc/protocol-b-%d", suffix): {"MQTT"},
+		fmt.Sprintf("https://example.com/ids/aasdesc/protocol-c-%d", suffix): {"HTTP", "HTTP"},
+		fmt.Sprintf("https://example.com/ids/aasdesc/protocol-d-%d", suffix): {"HTTP"},
+	}
+
+	for descriptorID, protocols := range descriptorEndpoints {
+		encodedDescriptorID := base64.RawURLEncoding.EncodeToString([]byte(descriptorID))
+		t.Cleanup(func() {
+			status, _, _ := doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+			if status != http.StatusNoContent && status != http.StatusNotFound {
+				t.Logf("cleanup delete returned unexpected status=%d", status)
+			}
+		})
+
+		endpoints := make([]any, 0, len(protocols))
+		for index, protocol := range protocols {
+			endpoints = append(endpoints, map[string]any{
+				"interface": "AAS-3.0",
+				"protocolInformation": map[string]any{
+					"href":             fmt.Sprintf("https://example.com/aas/%s/%d", encodedDescriptorID, index),
+					"endpointProtocol": protocol,
+				},
+			})
+		}
+		createAASDescriptor(t, map[string]any{
+			"id":        descriptorID,
+			"assetKind": "Instance",
+			"assetType": assetType,
+			"endpoints": endpoints,
+		}, http.StatusCreated)
+	}
+
+	encodedAssetType := base64.RawURLEncoding.EncodeToString([]byte(assetType))
+	seen := map[string]int{}
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		listURL := aasRegistryBaseURL + "/shell-descriptors?limit=1&endpointProtocol=HTTP&assetType=" + encodedAssetType
+		if cursor != "" {
+			listURL += "&cursor=" + url.QueryEscape(cursor)
+		}
+		status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodGet, listURL, nil)
+		require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+		payload := decodeAASRegistryMap(t, body)
+		result, _ := payload["result"].([]any)
+		require.LessOrEqual(t, len(result), 1)
+		for _, item := range result {
+			descriptor, _ := item.(map[string]any)
+			id, _ := descriptor["id"].(string)
+			seen[id]++
+		}
+
+		paging, _ := payload["paging_metadata"].(map[string]any)
+		cursor, _ = paging["cursor"].(string)
+		if cursor == "" {
+			break
+		}
+	}
+
+	require.Len(t, seen, 3, "expected only descriptors with an HTTP endpoint, got %v", seen)
+	for descriptorID, protocols := range descriptorEndpoints {
+		hasHTTP := false
+		for _, protocol := range protocols {
+			hasHTTP = hasHTTP || protocol == "HTTP"
+		}
+		if hasHTTP {
+			require.Equal(t, 1, seen[descriptorID], "descriptor %s must be returned exactly once", descriptorID)
+		} else {
+			require.NotContains(t, seen, descriptorID)
+		}
+	}
+}
+
+func TestAASRegistryListFiltersByEndpointInterfaceAndProtocol(t *testing.T) {
+	type endpoint struct {
+		iface    string
+		protocol string
+	}
+	suffix := time.Now().UnixNano()
+	assetType := fmt.Sprintf("endpoint-interface-%d", suffix)
+	matching := fmt.Sprintf("https://example.com/ids/aasdesc/interface-a-%d", suffix)
+	duplicate := fmt.Sprintf("https://example.com/ids/aasdesc/interface-b-%d", suffix)
+	descriptorEndpoints := map[string][]endpoint{
+		matching:  {{"SUBMODEL-3.0", "HTTP"}, {"AAS-3.0", "MQTT"}},
+		duplicate: {{"SUBMODEL-3.0", "HTTP"}, {"SUBMODEL-3.0", "HTTP"}},
+		// interface and protocol match on different endpoints only
+		fmt.Sprintf("https://example.com/ids/aasdesc/interface-c-%d", suffix): {{"SUBMODEL-3.0", "MQTT"}, {"AAS-3.0", "HTTP"}},
+		fmt.Sprintf("https://example.com/ids/aasdesc/interface-d-%d", suffix): {{"AAS-3.0", "HTTP"}},
+		fmt.Sprintf("https://example.com/ids/aasdesc/interface-e-%d", suffix): {{"SUBMODEL-3.0", "MQTT"}},
+	}
+
+	for descriptorID, descriptorEndpoint := range descriptorEndpoints {
+		encodedDescriptorID := base64.RawURLEncoding.EncodeToString([]byte(descriptorID))
+		t.Cleanup(func() {
+			status, _, _ := doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+			if status != http.StatusNoContent && status != http.StatusNotFound {
+				t.Logf("cleanup delete returned unexpected status=%d", status)
+			}
+		})
+
+		endpoints := make([]any, 0, len(descriptorEndpoint))
+		for index, ep := range descriptorEndpoint {
+			endpoints = append(endpoints, map[string]any{
+				"interface": ep.iface,
+				"protocolInformation": map[string]any{
+					"href":             fmt.Sprintf("https://example.com/aas/%s/%d", encodedDescriptorID, index),
+					"endpointProtocol": ep.protocol,
+				},
+			})
+		}
+		createAASDescriptor(t, map[string]any{
+			"id":        descriptorID,
+			"assetKind": "Instance",
+			"assetType": assetType,
+			"endpoints": endpoints,
+		}, http.StatusCreated)
+	}
+
+	encodedAssetType := base64.RawURLEncoding.EncodeToString([]byte(assetType))
+	listIDs := func(filter string) []string {
+		status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodGet, aasRegistryBaseURL+"/shell-descriptors?limit=50&assetType="+encodedAssetType+"&"+filter, nil)
+		require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+		payload := decodeAASRegistryMap(t, body)
+		result, _ := payload["result"].([]any)
+		ids := make([]string, 0, len(result))
+		for _, item := range result {
+			descriptor, _ := item.(map[string]any)
+			id, _ := descriptor["id"].(string)
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	require.ElementsMatch(t, []string{matching, duplicate}, listIDs("endpointInterface=SUBMODEL-3.0&endpointProtocol=HTTP"))
+	require.Len(t, listIDs("endpointInterface=SUBMODEL-3.0"), 4)
+	require.Len(t, listIDs("endpointInterface=AAS-3.0&endpointProtocol=MQTT"), 1)
+}
@@ -340,5 +340,6 @@ func TestMain(m *testing.M) {
 		ProjectName: runtime.ProjectName,
 		Env:         runtime.Env(),
 		HealthURL:   aasRegistryBaseURL + "/health",
+		ReadyURL:    testenv.ReadyURL(aasRegistryBaseURL),
 	}))
 }
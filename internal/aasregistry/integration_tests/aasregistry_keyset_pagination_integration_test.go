@@ -0,0 +1,104 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+//nolint:all
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAASRegistryPagingIsStableWhileDescriptorsAreInserted(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	assetType := fmt.Sprintf("keyset-paging-%d", suffix)
+	descriptorID := func(index int) string {
+		return fmt.Sprintf("https://example.com/ids/aasdesc/keyset-%d-%02d", suffix, index)
+	}
+	register := func(index int) {
+		id := descriptorID(index)
+		encodedID := base64.RawURLEncoding.EncodeToString([]byte(id))
+		t.Cleanup(func() {
+			status, _, _ := doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedID, nil)
+			if status != http.StatusNoContent && status != http.StatusNotFound {
+				t.Logf("cleanup delete returned unexpected status=%d", status)
+			}
+		})
+		createAASDescriptor(t, map[string]any{
+			"id":        id,
+			"assetKind": "Instance",
+			"assetType": assetType,
+		}, http.StatusCreated)
+	}
+
+	original := []int{2, 4, 6, 8, 10, 12}
+	for _, index := range original {
+		register(index)
+	}
+	insertsAfterPage := [][]int{{1, 7}, {5, 11}, {13}}
+
+	encodedAssetType := base64.RawURLEncoding.EncodeToString([]byte(assetType))
+	seen := map[string]int{}
+	cursor := ""
+	for page := 0; page < 20; page++ {
+		listURL := aasRegistryBaseURL + "/shell-descriptors?limit=2&assetType=" + encodedAssetType
+		if cursor != "" {
+			listURL += "&cursor=" + url.QueryEscape(cursor)
+		}
+		status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodGet, listURL, nil)
+		require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+		payload := decodeAASRegistryMap(t, body)
+		result, _ := payload["result"].([]any)
+		for _, item := range result {
+			descriptor, _ := item.(map[string]any)
+			id, _ := descriptor["id"].(string)
+			seen[id]++
+		}
+		if page < len(insertsAfterPage) {
+			for _, index := range insertsAfterPage[page] {
+				register(index)
+			}
+		}
+
+		paging, _ := payload["paging_metadata"].(map[string]any)
+		cursor, _ = paging["cursor"].(string)
+		if cursor == "" {
+			break
+		}
+	}
+
+	for _, index := range original {
+		require.Equal(t, 1, seen[descriptorID(index)], "descriptor %s must be returned exactly once", descriptorID(index))
+	}
+	for id, count := range seen {
+		require.Equal(t, 1, count, "descriptor %s was returned more than once", id)
+	}
+}
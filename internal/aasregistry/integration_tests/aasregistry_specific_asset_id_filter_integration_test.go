@@ -0,0 +1,141 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAASRegistryListFiltersBySpecificAssetIDsWithAndSemantics(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	manufacturer := fmt.Sprintf("manufacturer-%d", suffix)
+	scope := map[string]any{
+		"type": "ExternalReference",
+		"keys": []any{map[string]any{"type": "GlobalReference", "value": "BPNL000000000001"}},
+	}
+	descriptorAssetIDs := map[string][]any{
+		fmt.Sprintf("https://example.com/ids/aasdesc/said-a-%d", suffix): {
+			map[string]any{"name": "manufacturerId", "value": manufacturer},
+			map[string]any{"name": "partTypeId", "value": "gearbox"},
+		},
+		fmt.Sprintf("https://example.com/ids/aasdesc/said-b-%d", suffix): {
+			map[string]any{"name": "manufacturerId", "value": manufacturer},
+			map[string]any{"name": "partTypeId", "value": "motor"},
+		},
+		fmt.Sprintf("https://example.com/ids/aasdesc/said-c-%d", suffix): {
+			map[string]any{"name": "manufacturerId", "value": manufacturer, "externalSubjectId": scope},
+			map[string]any{"name": "partTypeId", "value": "gearbox"},
+		},
+	}
+	for descriptorID, specificAssetIDs := range descriptorAssetIDs {
+		encodedDescriptorID := base64.RawURLEncoding.EncodeToString([]byte(descriptorID))
+		t.Cleanup(func() {
+			status, _, _ := doAASRequest(t, aasNoRedirectClient, http.MethodDelete, aasRegistryBaseURL+"/shell-descriptors/"+encodedDescriptorID, nil)
+			if status != http.StatusNoContent && status != http.StatusNotFound {
+				t.Logf("cleanup delete returned unexpected status=%d", status)
+			}
+		})
+		createAASDescriptor(t, map[string]any{
+			"id":               descriptorID,
+			"assetKind":        "Instance",
+			"specificAssetIds": specificAssetIDs,
+		}, http.StatusCreated)
+	}
+	idA := fmt.Sprintf("https://example.com/ids/aasdesc/said-a-%d", suffix)
+	idB := fmt.Sprintf("https://example.com/ids/aasdesc/said-b-%d", suffix)
+	idC := fmt.Sprintf("https://example.com/ids/aasdesc/said-c-%d", suffix)
+
+	tests := []struct {
+		name     string
+		assetIDs []map[string]any
+		want     []string
+	}{
+		{
+			name:     "shared pair matches every holder including scoped ids",
+			assetIDs: []map[string]any{{"name": "manufacturerId", "value": manufacturer}},
+			want:     []string{idA, idB, idC},
+		},
+		{
+			name: "all pairs must match",
+			assetIDs: []map[string]any{
+				{"name": "manufacturerId", "value": manufacturer},
+				{"name": "partTypeId", "value": "gearbox"},
+			},
+			want: []string{idA, idC},
+		},
+		{
+			name: "externalSubjectId narrows to scoped ids",
+			assetIDs: []map[string]any{
+				{"name": "manufacturerId", "value": manufacturer, "externalSubjectId": scope},
+			},
+			want: []string{idC},
+		},
+		{
+			name: "pairs held by different descriptors match none",
+			assetIDs: []map[string]any{
+				{"name": "partTypeId", "value": "motor"},
+				{"name": "partTypeId", "value": "gearbox"},
+				{"name": "manufacturerId", "value": manufacturer},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{"limit": {"100"}}
+			for _, assetID := range tt.assetIDs {
+				data, err := json.Marshal(assetID)
+				require.NoError(t, err)
+				query.Add("assetIds", base64.RawURLEncoding.EncodeToString(data))
+			}
+			status, body, _ := doAASRequest(t, aasNoRedirectClient, http.MethodGet, aasRegistryBaseURL+"/shell-descriptors?"+query.Encode(), nil)
+			require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+			payload := decodeAASRegistryMap(t, body)
+			result, _ := payload["result"].([]any)
+			got := make([]string, 0, len(result))
+			for _, item := range result {
+				descriptor, _ := item.(map[string]any)
+				id, _ := descriptor["id"].(string)
+				got = append(got, id)
+			}
+			sort.Strings(got)
+			want := append([]string{}, tt.want...)
+			sort.Strings(want)
+			require.Equal(t, want, got)
+		})
+	}
+}
@@ -468,26 +468,41 @@ func (p *PostgreSQLAASRegistryDatabase) ReplaceAdministrationShellDescriptor(
 		if _, err := descriptors.GetAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasd.Id); err != nil {
 			return err
 		}
-		createdAt, err := descriptors.GetAASDescriptorCreatedAtByIDTx(ctx, tx, aasd.Id)
-		if err != nil {
-			return err
-		}
-		aasd.CreatedAt = &createdAt
-		if err := descriptors.DeleteAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasd.Id); err != nil {
-			return err
-		}
-		if err := descriptors.InsertAdministrationShellDescriptorTx(descriptors.WithAllowAASDescriptorCreatedAtOverride(ctx), tx, aasd); err != nil {
-			return err
+		stored, err := replaceAdministrationShellDescriptorTx(ctx, tx, aasd, previousSnapshot)
+		result = stored
+		return err
+	})
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	return result, nil
+}
+
+// PatchAdministrationShellDescriptor merge-updates an existing AAS descriptor
+// with patch and stores the result like ReplaceAdministrationShellDescriptor.
+// Fields and child collections that patch does not mention are kept.
+func (p *PostgreSQLAASRegistryDatabase) PatchAdministrationShellDescriptor(
+	ctx context.Context,
+	aasID string,
+	patch map[string]any,
+) (model.AssetAdministrationShellDescriptor, error) {
+	var result model.AssetAdministrationShellDescriptor
+	err := common.ExecuteInTransaction(p.db, "AASREG-PATCHAASDESC-STARTTX", "AASREG-PATCHAASDESC-COMMIT", func(tx *sql.Tx) error {
+		previousSnapshot, snapshotErr := loadDescriptorHistorySnapshotBeforeMutationTx(ctx, tx, aasID)
+		if snapshotErr != nil {
+			return snapshotErr
 		}
-		stored, err := descriptors.GetAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasd.Id)
+		existing, err := descriptors.GetAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasID)
 		if err != nil {
 			return err
 		}
-		if err := appendDescriptorHistoryTx(ctx, tx, stored, previousSnapshot, history.ChangeUpdated, false); err != nil {
+		merged, err := mergeAASDescriptorPatch(ctx, existing, patch)
+		if err != nil {
 			return err
 		}
+		stored, err := replaceAdministrationShellDescriptorTx(ctx, tx, merged, previousSnapshot)
 		result = stored
-		return nil
+		return err
 	})
 	if err != nil {
 		return model.AssetAdministrationShellDescriptor{}, err
@@ -495,6 +510,36 @@ func (p *PostgreSQLAASRegistryDatabase) ReplaceAdministrationShellDescriptor(
 	return result, nil
 }
 
+// replaceAdministrationShellDescriptorTx stores aasd in place of the existing
+// descriptor with the same id, keeps its creation time and appends the update
+// to the history.
+func replaceAdministrationShellDescriptorTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	aasd model.AssetAdministrationShellDescriptor,
+	previousSnapshot map[string]any,
+) (model.AssetAdministrationShellDescriptor, error) {
+	createdAt, err := descriptors.GetAASDescriptorCreatedAtByIDTx(ctx, tx, aasd.Id)
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	aasd.CreatedAt = &createdAt
+	if err := descriptors.DeleteAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasd.Id); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	if err := descriptors.InsertAdministrationShellDescriptorTx(descriptors.WithAllowAASDescriptorCreatedAtOverride(ctx), tx, aasd); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	stored, err := descriptors.GetAssetAdministrationShellDescriptorByIDTx(ctx, tx, aasd.Id)
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	if err := appendDescriptorHistoryTx(ctx, tx, stored, previousSnapshot, history.ChangeUpdated, false); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, err
+	}
+	return stored, nil
+}
+
 // UpsertAdministrationShellDescriptorInTransaction replaces an existing AAS
 // descriptor or inserts it when missing in the provided transaction.
 func (p *PostgreSQLAASRegistryDatabase) UpsertAdministrationShellDescriptorInTransaction(
@@ -605,7 +650,8 @@ func (p *PostgreSQLAASRegistryDatabase) DeleteAssetAdministrationShellDescriptor
 }
 
 // ListAssetAdministrationShellDescriptors lists AAS descriptors with optional
-// pagination and asset filtering, returning a next-page cursor when present.
+// pagination, asset and endpoint filtering, returning a next-page cursor when
+// present.
 func (p *PostgreSQLAASRegistryDatabase) ListAssetAdministrationShellDescriptors(
 	ctx context.Context,
 	limit int32,
@@ -614,8 +660,37 @@ func (p *PostgreSQLAASRegistryDatabase) ListAssetAdministrationShellDescriptors(
 	assetType string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointFilter descriptors.AASDescriptorEndpointFilter,
 ) ([]model.AssetAdministrationShellDescriptor, string, error) {
-	return descriptors.ListAssetAdministrationShellDescriptors(ctx, p.db, limit, cursor, assetKind, assetType, "", createdFrom, updatedFrom)
+	return descriptors.ListAssetAdministrationShellDescriptors(ctx, p.db, limit, cursor, assetKind, assetType, "", createdFrom, updatedFrom, endpointFilter)
+}
+
+// CountAssetAdministrationShellDescriptors counts the AAS descriptors
+// ListAssetAdministrationShellDescriptors would return across all pages.
+func (p *PostgreSQLAASRegistryDatabase) CountAssetAdministrationShellDescriptors(
+	ctx context.Context,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter descriptors.AASDescriptorEndpointFilter,
+) (int64, error) {
+	return descriptors.CountAssetAdministrationShellDescriptors(ctx, p.db, assetKind, assetType, createdFrom, updatedFrom, endpointFilter)
+}
+
+// PreviousAssetAdministrationShellDescriptorCursor returns the cursor of the
+// page before cursor in ListAssetAdministrationShellDescriptors.
+func (p *PostgreSQLAASRegistryDatabase) PreviousAssetAdministrationShellDescriptorCursor(
+	ctx context.Context,
+	limit int32,
+	cursor string,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter descriptors.AASDescriptorEndpointFilter,
+) (string, error) {
+	return descriptors.PreviousAssetAdministrationShellDescriptorCursor(ctx, p.db, limit, cursor, assetKind, assetType, createdFrom, updatedFrom, endpointFilter)
 }
 
 // ListSubmodelDescriptorsForAAS lists submodel descriptors for a given AAS ID
@@ -0,0 +1,108 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package aasregistrydatabase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+// mergeAASDescriptorPatch applies patch to the stored descriptor with the JSON
+// object merge semantics of the other PATCH endpoints: omitted fields are
+// kept, null removes a field, nested objects such as administration merge
+// recursively and arrays such as endpoints replace the stored array as a
+// whole. The identifier always stays the stored one.
+func mergeAASDescriptorPatch(ctx context.Context, existing model.AssetAdministrationShellDescriptor, patch map[string]any) (model.AssetAdministrationShellDescriptor, error) {
+	if patchID, ok := patch["id"]; ok && patchID != existing.Id {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest(fmt.Sprintf("AASREG-PATCHAASDESC-IDMISMATCH patch id %v does not match descriptor id %q", patchID, existing.Id))
+	}
+
+	existingJSON, err := existing.ToJsonable()
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewInternalServerError("AASREG-PATCHAASDESC-TOJSONABLE " + err.Error())
+	}
+	// Round trip the stored descriptor so nested values have the generic
+	// types the patch was decoded into and objects can be merged.
+	rawExisting, err := json.Marshal(existingJSON)
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewInternalServerError("AASREG-PATCHAASDESC-MARSHAL " + err.Error())
+	}
+	var base map[string]any
+	if err := json.Unmarshal(rawExisting, &base); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewInternalServerError("AASREG-PATCHAASDESC-UNMARSHAL " + err.Error())
+	}
+
+	merged := mergeJSONObjects(base, patch)
+	merged["id"] = existing.Id
+	rawMerged, err := json.Marshal(merged)
+	if err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest("AASREG-PATCHAASDESC-MARSHALMERGED " + err.Error())
+	}
+	var result model.AssetAdministrationShellDescriptor
+	if err := json.Unmarshal(rawMerged, &result); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest("AASREG-PATCHAASDESC-DECODE " + err.Error())
+	}
+	if err := model.AssertAssetAdministrationShellDescriptorRequired(result); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest("AASREG-PATCHAASDESC-REQUIRED " + err.Error())
+	}
+	if err := model.AssertAssetAdministrationShellDescriptorConstraints(result); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest("AASREG-PATCHAASDESC-CONSTRAINTS " + err.Error())
+	}
+	if err := model.AssertUniqueSubmodelDescriptorIDs(result.SubmodelDescriptors, common.AllowDuplicateSubmodelDescriptorIDsFromContext(ctx)); err != nil {
+		return model.AssetAdministrationShellDescriptor{}, common.NewErrBadRequest("AASREG-PATCHAASDESC-CONSTRAINTS " + err.Error())
+	}
+	return result, nil
+}
+
+func mergeJSONObjects(base map[string]any, patch map[string]any) map[string]any {
+	merged := make(map[string]any, len(base))
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(merged, key)
+			continue
+		}
+
+		baseValue, baseExists := merged[key]
+		baseMap, baseIsMap := baseValue.(map[string]any)
+		patchMap, patchIsMap := patchValue.(map[string]any)
+		if baseExists && baseIsMap && patchIsMap {
+			merged[key] = mergeJSONObjects(baseMap, patchMap)
+			continue
+		}
+
+		merged[key] = patchValue
+	}
+
+	return merged
+}
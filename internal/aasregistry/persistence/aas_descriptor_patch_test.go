@@ -0,0 +1,120 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package aasregistrydatabase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+const storedPatchDescriptorJSON = `{
+	"id": "aas-1",
+	"idShort": "shell",
+	"assetKind": "Instance",
+	"globalAssetId": "asset-1",
+	"specificAssetIds": [{"name": "serial", "value": "4711"}],
+	"endpoints": [
+		{"interface": "AAS-3.0", "protocolInformation": {"href": "https://one.example/shells/aas-1"}}
+	],
+	"submodelDescriptors": [
+		{
+			"id": "sm-1",
+			"idShort": "nameplate",
+			"endpoints": [{"interface": "SUBMODEL-3.0", "protocolInformation": {"href": "https://one.example/submodels/sm-1"}}]
+		}
+	]
+}`
+
+func storedPatchDescriptor(t *testing.T) model.AssetAdministrationShellDescriptor {
+	t.Helper()
+	var descriptor model.AssetAdministrationShellDescriptor
+	require.NoError(t, json.Unmarshal([]byte(storedPatchDescriptorJSON), &descriptor))
+	return descriptor
+}
+
+func decodePatch(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	var patch map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw), &patch))
+	return patch
+}
+
+func TestMergeAASDescriptorPatchAddsEndpointAndKeepsOtherFields(t *testing.T) {
+	existing := storedPatchDescriptor(t)
+
+	merged, err := mergeAASDescriptorPatch(context.Background(), existing, decodePatch(t, `{
+		"endpoints": [
+			{"interface": "AAS-3.0", "protocolInformation": {"href": "https://one.example/shells/aas-1"}},
+			{"interface": "AAS-3.0", "protocolInformation": {"href": "https://two.example/shells/aas-1"}}
+		]
+	}`))
+
+	require.NoError(t, err)
+	require.Len(t, merged.Endpoints, 2)
+	require.Equal(t, existing.Endpoints[0], merged.Endpoints[0])
+	require.Equal(t, "https://two.example/shells/aas-1", merged.Endpoints[1].ProtocolInformation.Href)
+	require.Equal(t, existing.Id, merged.Id)
+	require.Equal(t, existing.IdShort, merged.IdShort)
+	require.Equal(t, existing.GlobalAssetId, merged.GlobalAssetId)
+	require.Equal(t, existing.AssetKind, merged.AssetKind)
+	require.Equal(t, existing.SpecificAssetIds, merged.SpecificAssetIds)
+	require.Equal(t, existing.SubmodelDescriptors, merged.SubmodelDescriptors)
+}
+
+func TestMergeAASDescriptorPatchRemovesNullFields(t *testing.T) {
+	existing := storedPatchDescriptor(t)
+
+	merged, err := mergeAASDescriptorPatch(context.Background(), existing, decodePatch(t, `{"idShort": null, "globalAssetId": "asset-2"}`))
+
+	require.NoError(t, err)
+	require.Empty(t, merged.IdShort)
+	require.Equal(t, "asset-2", merged.GlobalAssetId)
+	require.Equal(t, existing.Endpoints, merged.Endpoints)
+}
+
+func TestMergeAASDescriptorPatchRejectsIDMismatch(t *testing.T) {
+	existing := storedPatchDescriptor(t)
+
+	_, err := mergeAASDescriptorPatch(context.Background(), existing, decodePatch(t, `{"id": "aas-2"}`))
+
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+	require.Contains(t, err.Error(), "AASREG-PATCHAASDESC-IDMISMATCH")
+}
+
+func TestMergeAASDescriptorPatchRejectsInvalidResult(t *testing.T) {
+	existing := storedPatchDescriptor(t)
+
+	_, err := mergeAASDescriptorPatch(context.Background(), existing, decodePatch(t, `{"endpoints": [{"interface": "AAS-3.0"}]}`))
+
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+}
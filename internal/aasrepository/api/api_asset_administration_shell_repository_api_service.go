@@ -753,7 +753,7 @@ func (s *AssetAdministrationShellRepositoryAPIAPIService) GetSubmodelByIdAasRepo
 		return response, ensureErr
 	}
 
-	return s.submodelAPI.GetSubmodelByID(ctx, submodelIdentifier, level, extent)
+	return s.submodelAPI.GetSubmodelByID(ctx, submodelIdentifier, level, extent, "")
 }
 
 // PutSubmodelByIdAasRepository - Creates or updates the Submodel
@@ -1170,7 +1170,7 @@ func (s *AssetAdministrationShellRepositoryAPIAPIService) GetAllSubmodelElements
 		return response, ensureErr
 	}
 
-	return s.submodelAPI.GetAllSubmodelElements(ctx, submodelIdentifier, limit, cursor, level, extent)
+	return s.submodelAPI.GetAllSubmodelElements(ctx, submodelIdentifier, limit, cursor, level, extent, "")
 }
 
 // PostSubmodelElementAasRepository - Creates a new submodel element
@@ -1628,6 +1628,7 @@ func TestMain(m *testing.M) {
 		Env:             runtime.Env(),
 		PreDownBeforeUp: true,
 		HealthURL:       aasRepositoryBaseURL + "/health",
+		ReadyURL:        testenv.ReadyURL(aasRepositoryBaseURL),
 		HealthTimeout:   150 * time.Second,
 	}))
 }
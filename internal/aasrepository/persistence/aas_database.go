@@ -60,6 +60,7 @@ type AssetAdministrationShellDatabase struct {
 	db               *sql.DB
 	verificationMode commonmodel.VerificationMode
 	privateKey       *rsa.PrivateKey
+	keySet           *jws.KeySet
 	signingOptions   jws.SigningOptions
 }
 
@@ -74,6 +75,12 @@ func (s *AssetAdministrationShellDatabase) SetJWSPrivateKey(privateKey *rsa.Priv
 	s.privateKey = privateKey
 }
 
+// SetJWSKeySet configures a reloadable key set for signed AAS responses. It
+// takes precedence over SetJWSPrivateKey.
+func (s *AssetAdministrationShellDatabase) SetJWSKeySet(keySet *jws.KeySet) {
+	s.keySet = keySet
+}
+
 // SetJWSCertificateChain configures the optional certificate chain embedded in
 // signed Asset Administration Shell responses.
 //
@@ -256,7 +263,7 @@ func (s *AssetAdministrationShellDatabase) loadAASHistorySnapshotBeforeMutationT
 //   - error: Error when signing is not configured, the AAS cannot be loaded,
 //     JSON conversion or canonicalization fails, or JWS signing fails.
 func (s *AssetAdministrationShellDatabase) GetSignedAssetAdministrationShell(ctx context.Context, aasID string) (string, error) {
-	if s.privateKey == nil {
+	if s.privateKey == nil && s.keySet == nil {
 		return "", errors.New("JWS signing not configured: private key not loaded")
 	}
 	aas, err := s.GetAssetAdministrationShellByID(ctx, aasID)
@@ -271,6 +278,9 @@ func (s *AssetAdministrationShellDatabase) GetSignedAssetAdministrationShell(ctx
 	if err != nil {
 		return "", err
 	}
+	if s.keySet != nil {
+		return s.keySet.Sign(payload, s.signingOptions)
+	}
 	return jws.SignPayloadWithOptions(s.privateKey, payload, s.signingOptions)
 }
 
@@ -287,11 +297,12 @@ func (s *AssetAdministrationShellDatabase) GetAssetAdministrationShellByIDAndDat
 	return aas, nil
 }
 
-func buildAASCollector() (*grammar.ResolvedFieldPathCollector, error) {
+func buildAASCollector(ctx context.Context) (*grammar.ResolvedFieldPathCollector, error) {
 	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAAS)
 	if err != nil {
 		return nil, common.NewInternalServerError("AASREPO-ABAC-COLLECTOR " + err.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	return collector, nil
 }
@@ -362,7 +373,7 @@ func (s *AssetAdministrationShellDatabase) checkAASVisibilityInTx(ctx context.Co
 	dialect := goqu.Dialect("postgres")
 	ds := buildGetAssetAdministrationShellDBIDByIdentifierDataset(&dialect, aasIdentifier)
 
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return false, false, collectorErr
 	}
@@ -807,7 +818,7 @@ func (s *AssetAdministrationShellDatabase) GetAssetAdministrationShells(ctx cont
 		return nil, "", common.NewInternalServerError("AASREPO-GETAASLIST-BUILDSQL " + err.Error())
 	}
 
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, "", collectorErr
 	}
@@ -893,7 +904,7 @@ func (s *AssetAdministrationShellDatabase) GetAssetAdministrationShellByID(ctx c
 	dialect := goqu.Dialect("postgres")
 	selectDS := buildGetAssetAdministrationShellDBIDByIdentifierDataset(&dialect, aasIdentifier)
 
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
@@ -1880,7 +1891,7 @@ func (s *AssetAdministrationShellDatabase) GetAllSubmodelReferencesByAASID(ctx c
 	dialect := goqu.Dialect("postgres")
 	selectDS := buildGetAssetAdministrationShellDBIDByIdentifierDataset(&dialect, aasIdentifier)
 
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, "", collectorErr
 	}
@@ -2178,7 +2189,7 @@ func (s *AssetAdministrationShellDatabase) getAssetAdministrationShellMapByDBIDI
 
 func (s *AssetAdministrationShellDatabase) getAssetAdministrationShellMapByDBIDWithQueryer(ctx context.Context, db aasDBQueryer, aasDBID int64) (types.IAssetAdministrationShell, error) {
 	dialect := goqu.Dialect("postgres")
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
@@ -2236,7 +2247,7 @@ func (s *AssetAdministrationShellDatabase) getAssetAdministrationShellMapsByDBID
 	}
 
 	dialect := goqu.Dialect("postgres")
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
@@ -2327,7 +2338,7 @@ func (s *AssetAdministrationShellDatabase) readSubmodelReferencePayloadsByAASDBI
 
 	dialect := goqu.Dialect("postgres")
 	submodelDS := buildGetSubmodelReferencePayloadsByAASIDsDataset(&dialect, aasDBIDs)
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
@@ -2666,7 +2677,7 @@ func parseSpecificAssetIDSemanticIDPayload(payload []byte) (types.IReference, bo
 func (s *AssetAdministrationShellDatabase) readSpecificAssetIDsByAssetInformationID(ctx context.Context, db aasDBQueryer, assetInformationID int64) ([]types.ISpecificAssetID, error) {
 	dialect := goqu.Dialect("postgres")
 	queryDS := buildReadSpecificAssetIDsByAssetInformationIDDataset(&dialect, assetInformationID)
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
@@ -2752,7 +2763,7 @@ func (s *AssetAdministrationShellDatabase) readSpecificAssetIDsByAssetInformatio
 
 	dialect := goqu.Dialect("postgres")
 	queryDS := buildReadSpecificAssetIDsByAssetInformationIDsDataset(&dialect, assetInformationIDs)
-	collector, collectorErr := buildAASCollector()
+	collector, collectorErr := buildAASCollector(ctx)
 	if collectorErr != nil {
 		return nil, collectorErr
 	}
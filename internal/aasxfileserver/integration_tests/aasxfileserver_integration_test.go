@@ -62,6 +62,7 @@ func TestMain(m *testing.M) {
 		Env:             runtime.Env(),
 		PreDownBeforeUp: true,
 		HealthURL:       baseURL + "/health",
+		ReadyURL:        testenv.ReadyURL(baseURL),
 		HealthTimeout:   2 * time.Minute,
 	}))
 }
@@ -27,6 +27,7 @@
 package sequences
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -52,7 +53,7 @@ func (dbc *DatabaseConnection) Execute(stepIndex int) (int, error) {
 	}
 
 	dsn := common.BuildPostgresDSN(cfg.Postgres)
-	db, err := common.NewDatabaseConnection(dsn)
+	db, err := common.NewDatabaseConnectionWithRetry(context.Background(), dsn, common.DatabaseConnectRetryFromConfig(cfg.Postgres))
 	if err != nil {
 		return 1, fmt.Errorf("BASYXCFG-DB-CONNECT: %w", err)
 	}
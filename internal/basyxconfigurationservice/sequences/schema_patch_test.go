@@ -74,6 +74,61 @@ func TestSchemaPatchExecuteAppliesPatchAndUpdatesVersion(t *testing.T) {
 	}
 }
 
+func TestSchemaPatchExecuteTwiceAppliesPatchOnlyOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() failed: %v", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	patchSQL := "ALTER TABLE IF EXISTS aas_identifier ADD COLUMN IF NOT EXISTS test_column TEXT;"
+	patchPath := writeTempSchema(t, patchSQL)
+	versionQuery := regexp.QuoteMeta(`SELECT "schema_version" FROM "basyxsystem" ORDER BY "identifier" ASC LIMIT 1`)
+
+	// First run: the database is on v1.0.0, so the patch is applied and the version is bumped.
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+		WithArgs(schemaAdvisoryLockID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(versionQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"schema_version"}).AddRow("v1.0.0"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(patchSQL)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "basyxsystem" SET "schema_version"=$1,"state"=$2`)).
+		WithArgs("v1.0.1", schemaStateClean).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
+		WithArgs(schemaAdvisoryLockID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Second run: the tracked version already matches, so neither the patch nor the version update runs again.
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+		WithArgs(schemaAdvisoryLockID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(versionQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"schema_version"}).AddRow("v1.0.1"))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
+		WithArgs(schemaAdvisoryLockID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	step := NewSchemaPatch(&ExecutionContext{DB: db}, patchPath, "v1.0.1")
+	for run := 1; run <= 2; run++ {
+		statusCode, execErr := step.Execute(run)
+		if execErr != nil {
+			t.Fatalf("run %d: unexpected error: %v", run, execErr)
+		}
+		if statusCode != 0 {
+			t.Fatalf("run %d: expected status code 0, got %d", run, statusCode)
+		}
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
 func TestSchemaPatchSeedsVersionRowWhenMissing(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -92,10 +92,21 @@ func (su *SchemaUpload) executeSchemaWithRc01Compatibility(schemaPath string, sc
 	return su.executeSchema(schemaSQL)
 }
 
+// executeSchema applies the schema in a single transaction. PostgreSQL DDL is
+// transactional, so a statement failing partway rolls back every object created
+// before it and the next start applies the schema from a clean state.
 func (su *SchemaUpload) executeSchema(schemaSQL string) error {
-	if _, err := su.ctx.DB.Exec(schemaSQL); err != nil {
+	tx, err := su.ctx.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("BASYXCFG-SCHEMA-BEGIN: %w", err)
+	}
+	if _, err = tx.Exec(schemaSQL); err != nil {
+		_ = tx.Rollback()
 		return fmt.Errorf("BASYXCFG-SCHEMA-EXECUTE: %w", err)
 	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("BASYXCFG-SCHEMA-COMMIT: %w", err)
+	}
 	return nil
 }
 
@@ -48,9 +48,17 @@ func (su *SchemaUpload) applyRc01Compatibility(schemaToLoad string) error {
 	if err != nil {
 		return fmt.Errorf("BASYXCFG-SCHEMA-RC01READFILE: %w", err)
 	}
-	if _, err = su.ctx.DB.Exec(string(compatibilitySQL)); err != nil {
+	tx, err := su.ctx.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("BASYXCFG-SCHEMA-RC01BEGIN: %w", err)
+	}
+	if _, err = tx.Exec(string(compatibilitySQL)); err != nil {
+		_ = tx.Rollback()
 		return fmt.Errorf("BASYXCFG-SCHEMA-RC01EXECUTE: %w", err)
 	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("BASYXCFG-SCHEMA-RC01COMMIT: %w", err)
+	}
 	return nil
 }
 
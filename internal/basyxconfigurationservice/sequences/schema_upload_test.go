@@ -109,8 +109,10 @@ func TestSchemaUploadExecuteSuccess(t *testing.T) {
 		WithArgs(schemaAdvisoryLockID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
 		WithArgs(schemaAdvisoryLockID).
@@ -151,8 +153,10 @@ func TestSchemaUploadExecuteLoadsBaseSchemaFromDirectory(t *testing.T) {
 		WithArgs(schemaAdvisoryLockID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schemaSQL)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
 		WithArgs(schemaAdvisoryLockID).
@@ -212,8 +216,10 @@ func TestSchemaUploadExecuteReturnsSchemaExecutionError(t *testing.T) {
 	schemaErr := errors.New("invalid schema")
 
 	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnError(schemaErr)
+	mock.ExpectRollback()
 	expectSchemaAdvisoryUnlock(mock)
 
 	statusCode, execErr := step.Execute(3)
@@ -241,12 +247,18 @@ func TestSchemaUploadExecuteAppliesRc01CompatibilityAndRetriesOnce(t *testing.T)
 	step, mock := newSchemaUploadTestStep(t, schemaPath)
 
 	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnError(newRc01PgError())
+	mock.ExpectRollback()
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(compatibilitySQL)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 	expectSchemaAdvisoryUnlock(mock)
 
 	statusCode, execErr := step.Execute(3)
@@ -269,12 +281,18 @@ func TestSchemaUploadExecuteReturnsErrorAfterFailedRc01Retry(t *testing.T) {
 	retryErr := newRc01PgError()
 
 	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnError(newRc01PgError())
+	mock.ExpectRollback()
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(compatibilitySQL)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(schema)).
 		WillReturnError(retryErr)
+	mock.ExpectRollback()
 	expectSchemaAdvisoryUnlock(mock)
 
 	statusCode, execErr := step.Execute(3)
@@ -295,6 +313,78 @@ func TestSchemaUploadExecuteReturnsErrorAfterFailedRc01Retry(t *testing.T) {
 	}
 }
 
+// A statement failing partway through the schema must roll back the statements
+// before it, so a later start re-applies the complete schema from a clean state.
+func TestSchemaUploadExecuteRollsBackMidSchemaFailureAndResumes(t *testing.T) {
+	schema := "CREATE TABLE IF NOT EXISTS first_table (id INT PRIMARY KEY);\n" +
+		"CREATE TABLE IF NOT EXISTS second_table (id INT REFERENCES missing_table(id));\n" +
+		"CREATE TABLE IF NOT EXISTS third_table (id INT PRIMARY KEY);"
+	step, mock := newSchemaUploadTestStep(t, writeTempSchema(t, schema))
+	midSchemaErr := errors.New(`relation "missing_table" does not exist`)
+
+	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(schema)).
+		WillReturnError(midSchemaErr)
+	mock.ExpectRollback()
+	expectSchemaAdvisoryUnlock(mock)
+
+	statusCode, execErr := step.Execute(3)
+	if execErr == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if statusCode != 1 {
+		t.Fatalf("expected status code 1, got %d", statusCode)
+	}
+	if !strings.Contains(execErr.Error(), "BASYXCFG-SCHEMA-EXECUTE") || !errors.Is(execErr, midSchemaErr) {
+		t.Fatalf("unexpected error: %v", execErr)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("failed schema must roll back without commit: %v", err)
+	}
+
+	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(schema)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	expectSchemaAdvisoryUnlock(mock)
+
+	statusCode, execErr = step.Execute(3)
+	if execErr != nil {
+		t.Fatalf("unexpected error on resumed start: %v", execErr)
+	}
+	if statusCode != 0 {
+		t.Fatalf("expected status code 0, got %d", statusCode)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
+func TestSchemaUploadExecuteReturnsCommitError(t *testing.T) {
+	schema := "CREATE TABLE IF NOT EXISTS test_table (id INT PRIMARY KEY);"
+	step, mock := newSchemaUploadTestStep(t, writeTempSchema(t, schema))
+
+	expectSchemaAdvisoryLock(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(schema)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit().WillReturnError(errors.New("connection reset"))
+	expectSchemaAdvisoryUnlock(mock)
+
+	statusCode, execErr := step.Execute(3)
+	if execErr == nil || !strings.Contains(execErr.Error(), "BASYXCFG-SCHEMA-COMMIT") {
+		t.Fatalf("expected commit error, got: %v", execErr)
+	}
+	if statusCode != 1 {
+		t.Fatalf("expected status code 1, got %d", statusCode)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
 func writeTempSchema(t *testing.T, sql string) string {
 	t.Helper()
 	path := t.TempDir() + "/schema.sql"
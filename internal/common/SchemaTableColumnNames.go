@@ -111,6 +111,10 @@ const (
 	ColEndpointProtocolVersion = "endpoint_protocol_version"
 	ColSecurityAttributes      = "security_attributes"
 
+	ColReachabilityStatus    = "reachability_status"
+	ColLastReachabilityCheck = "last_reachability_check_at"
+	ColLastReachableAt       = "last_reachable_at"
+
 	ColSemanticID              = "semantic_id"
 	ColSupplementalSemanticIDs = "supplemental_semantic_ids"
 	ColName                    = "name"
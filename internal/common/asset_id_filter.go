@@ -41,6 +41,7 @@ const GlobalAssetIDAssetLinkName = "globalAssetId"
 type AssetIDFilter struct {
 	globalAssetIDs   map[string]struct{}
 	specificAssetIDs map[string]struct{}
+	assetIDs         []types.ISpecificAssetID
 }
 
 // DecodeAssetIDFilter decodes the base64url-encoded SpecificAssetId query parameters.
@@ -60,6 +61,7 @@ func DecodeAssetIDFilter(encodedAssetIDs []string) (AssetIDFilter, error) {
 		if assetID.Name() == "" || assetID.Value() == "" {
 			return AssetIDFilter{}, NewErrBadRequest(fmt.Sprintf("COMMON-ASSETIDFILTER-EMPTY assetIds[%d]: name and value must not be empty", index))
 		}
+		filter.assetIDs = append(filter.assetIDs, assetID)
 		if assetID.Name() == GlobalAssetIDAssetLinkName {
 			filter.globalAssetIDs[assetID.Value()] = struct{}{}
 			continue
@@ -78,6 +80,12 @@ func (f AssetIDFilter) IsEmpty() bool {
 	return len(f.globalAssetIDs) == 0 && len(f.specificAssetIDs) == 0
 }
 
+// AssetIDs returns the decoded identifiers in request order, including
+// globalAssetId entries.
+func (f AssetIDFilter) AssetIDs() []types.ISpecificAssetID {
+	return f.assetIDs
+}
+
 // Matches reports whether the asset information matches at least one decoded identifier.
 func (f AssetIDFilter) Matches(globalAssetID string, specificAssetIDs []types.ISpecificAssetID) (bool, error) {
 	if f.IsEmpty() {
@@ -71,3 +71,17 @@ func encodeSpecificAssetID(t *testing.T, assetID types.ISpecificAssetID) string
 	require.NoError(t, err)
 	return Encode(data)
 }
+
+func TestDecodeAssetIDFilterKeepsDecodedAssetIDsInOrder(t *testing.T) {
+	filter, err := DecodeAssetIDFilter([]string{
+		encodeSpecificAssetID(t, types.NewSpecificAssetID("manufacturerId", "4711")),
+		"",
+		encodeSpecificAssetID(t, types.NewSpecificAssetID("globalAssetId", "asset-global")),
+	})
+	require.NoError(t, err)
+
+	assetIDs := filter.AssetIDs()
+	require.Len(t, assetIDs, 2)
+	require.Equal(t, "manufacturerId", assetIDs[0].Name())
+	require.Equal(t, "globalAssetId", assetIDs[1].Name())
+}
@@ -22,17 +22,22 @@
 *
 * SPDX-License-Identifier: MIT
 ******************************************************************************/
+// Author: Aaron Zielstorff ( Fraunhofer IESE )
 
-package descriptors
+package asyncbulk
 
-func applyCursorLimit[T any](items []T, limit int32, cursorFn func(T) string) ([]T, string) {
-	if limit < 0 {
-		return items, ""
-	}
-	intLimit := int(limit)
-	if len(items) > intLimit {
-		nextCursor := cursorFn(items[intLimit])
-		return items[:intLimit], nextCursor
+// ResultSummary aggregates the per-item outcomes of a bulk operation.
+type ResultSummary struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// Summary returns the success and failure counts of the operation result.
+func (r OperationResult) Summary() ResultSummary {
+	return ResultSummary{
+		Total:     r.ProcessedCount,
+		Succeeded: r.SuccessfulCount,
+		Failed:    r.FailedCount,
 	}
-	return items, ""
 }
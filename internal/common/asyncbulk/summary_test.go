@@ -22,22 +22,25 @@
 *
 * SPDX-License-Identifier: MIT
 ******************************************************************************/
+// Author: Aaron Zielstorff ( Fraunhofer IESE )
 
-package descriptors
+package asyncbulk
 
 import (
-	"context"
+	"testing"
 
-	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
 )
 
-func debugEnabled(ctx context.Context) bool {
-	if ctx == nil {
-		return false
+func TestOperationResultSummaryReportsMixedOutcome(t *testing.T) {
+	result := OperationResult{
+		ProcessedCount:  3,
+		SuccessfulCount: 2,
+		FailedCount:     1,
+		Failures: []ItemFailure{
+			{Index: 1, Identifier: "bad-id", StatusCode: 409, Message: "conflict"},
+		},
 	}
-	cfg, ok := common.ConfigFromContext(ctx)
-	if !ok || cfg == nil {
-		return false
-	}
-	return cfg.General.EnableDescriptorDebug
+
+	require.Equal(t, ResultSummary{Total: 3, Succeeded: 2, Failed: 1}, result.Summary())
 }
@@ -27,10 +27,14 @@ package builder
 
 import (
 	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"testing"
 
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
 	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +93,38 @@ func TestBuildBlobAllowsOmittedValue(t *testing.T) {
 	require.Equal(t, "text/plain", *blob.ContentType())
 	require.Nil(t, blob.Value())
 }
+
+func TestBuildBlobRoundTripsBinaryValueAsBase64(t *testing.T) {
+	t.Parallel()
+
+	for name, original := range map[string][]byte{
+		"binary":               {0x00, 0xff, 0x10, 0x80, 0x7f, 0x0a},
+		"valid base64 as text": []byte("abcd"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Postgres renders the stored base64url text of a bytea column as \x<hex> inside jsonb.
+			stored := `\\x` + hex.EncodeToString([]byte(common.Encode(original)))
+			value := json.RawMessage(`{"content_type":"application/octet-stream","value":"` + stored + `"}`)
+			element, err := buildBlob(model.SubmodelElementRow{
+				IDShort:   sql.NullString{String: "BinaryBlob", Valid: true},
+				ModelType: int64(types.ModelTypeBlob),
+				Value:     &value,
+			})
+			require.NoError(t, err)
+
+			blob, ok := element.(*types.Blob)
+			require.True(t, ok)
+			require.Equal(t, original, blob.Value())
+
+			jsonable, err := jsonization.ToJsonable(blob)
+			require.NoError(t, err)
+			encoded, ok := jsonable["value"].(string)
+			require.True(t, ok, "expected base64 string value, got %#v", jsonable["value"])
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			require.NoError(t, err)
+			require.Equal(t, original, decoded)
+		})
+	}
+}
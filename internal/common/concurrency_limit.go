@@ -0,0 +1,70 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// concurrencyLimitRetryAfterSeconds is the Retry-After hint sent with rejected requests.
+const concurrencyLimitRetryAfterSeconds = 1
+
+// ConcurrencyLimitMiddleware caps the number of requests served at the same time.
+// Requests above maxInFlight are rejected immediately with a standardized 503
+// response and a Retry-After header instead of queuing, so load spikes cannot
+// exhaust database connections or memory. A maxInFlight of zero or less
+// disables the limit and returns next unchanged.
+//
+// Parameters:
+//   - maxInFlight: Maximum number of concurrently served requests.
+//   - next: Handler that serves admitted requests.
+//
+// Returns:
+//   - http.Handler: next wrapped with the in-flight limit.
+func ConcurrencyLimitMiddleware(maxInFlight int, next http.Handler) http.Handler {
+	if maxInFlight <= 0 || next == nil {
+		return next
+	}
+	slots := make(chan struct{}, maxInFlight)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+			next.ServeHTTP(w, r)
+		default:
+			w.Header().Set("Retry-After", strconv.Itoa(concurrencyLimitRetryAfterSeconds))
+			_ = WriteErrorResponse(
+				w,
+				NewErrServiceUnavailable("COMMON-CONCURRENCYLIMIT-EXCEEDED too many concurrent requests"),
+				http.StatusServiceUnavailable,
+				"HTTPServer",
+				"ConcurrencyLimit",
+				"Exceeded",
+			)
+		}
+	})
+}
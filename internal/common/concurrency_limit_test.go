@@ -0,0 +1,102 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestConcurrencyLimitMiddlewareRejectsRequestsAboveLimit(t *testing.T) {
+	const limit = 3
+	const total = 8
+
+	entered := make(chan struct{}, total)
+	release := make(chan struct{})
+	handler := ConcurrencyLimitMiddleware(limit, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		entered <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	recorders := make([]*httptest.ResponseRecorder, total)
+	var wg sync.WaitGroup
+	for i := 0; i < limit; i++ {
+		recorders[i] = httptest.NewRecorder()
+		wg.Add(1)
+		go func(recorder *httptest.ResponseRecorder) {
+			defer wg.Done()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		}(recorders[i])
+	}
+	for i := 0; i < limit; i++ {
+		<-entered
+	}
+
+	// All slots are taken, so excess requests are answered without blocking.
+	for i := limit; i < total; i++ {
+		recorders[i] = httptest.NewRecorder()
+		handler.ServeHTTP(recorders[i], httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+	if len(entered) != 0 {
+		t.Fatal("requests above the limit must not reach the handler")
+	}
+	close(release)
+	wg.Wait()
+
+	succeeded, rejected := 0, 0
+	for _, recorder := range recorders {
+		switch recorder.Code {
+		case http.StatusOK:
+			succeeded++
+		case http.StatusServiceUnavailable:
+			rejected++
+			if recorder.Header().Get("Retry-After") == "" {
+				t.Fatal("expected Retry-After header on rejected request")
+			}
+		default:
+			t.Fatalf("unexpected status %d", recorder.Code)
+		}
+	}
+	if succeeded != limit || rejected != total-limit {
+		t.Fatalf("expected %d succeeded and %d rejected, got %d and %d", limit, total-limit, succeeded, rejected)
+	}
+}
+
+func TestConcurrencyLimitMiddlewareDisabledForNonPositiveLimit(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := ConcurrencyLimitMiddleware(0, next)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("expected unlimited handler to serve request, got %d", recorder.Code)
+	}
+}
@@ -29,6 +29,8 @@ package common
 import (
 	"context"
 	"net/http"
+	"strings"
+	"unicode"
 )
 
 // configKey is an unexported type used as the context key.
@@ -86,3 +88,62 @@ func UploadMaxSizeBytesFromContext(ctx context.Context) int64 {
 	}
 	return cfg.General.UploadMaxSizeBytes
 }
+
+const (
+	// SubmodelPutModeReplace makes PUT /submodels/{id} replace the complete element tree.
+	SubmodelPutModeReplace = "replace"
+	// SubmodelPutModeMerge makes PUT /submodels/{id} keep stored top-level elements missing from the body.
+	SubmodelPutModeMerge = "merge"
+)
+
+// SubmodelPutMergeFromContext reports whether PUT /submodels/{id} merges the
+// element tree instead of replacing it. Without a config in ctx, or with
+// general.submodelPutMode left empty, PUT replaces all elements.
+func SubmodelPutMergeFromContext(ctx context.Context) bool {
+	cfg, ok := ConfigFromContext(ctx)
+	return ok && cfg != nil && cfg.General.SubmodelPutMode == SubmodelPutModeMerge
+}
+
+// MaxSpecificAssetIDsFromContext returns the configured maximum number of
+// specificAssetIds per descriptor. Zero means no limit; without a config in
+// ctx the default limit applies.
+func MaxSpecificAssetIDsFromContext(ctx context.Context) int {
+	cfg, ok := ConfigFromContext(ctx)
+	if !ok || cfg == nil {
+		return DefaultConfig.GeneralMaxSpecificAssetIDs
+	}
+	return cfg.General.MaxSpecificAssetIDs
+}
+
+// MaxFieldPathDepthFromContext returns the configured maximum number of path
+// segments in a query field. Without a config in ctx the default applies.
+func MaxFieldPathDepthFromContext(ctx context.Context) int {
+	cfg, ok := ConfigFromContext(ctx)
+	if !ok || cfg == nil {
+		return DefaultConfig.GeneralMaxFieldPathDepth
+	}
+	return cfg.General.MaxFieldPathDepth
+}
+
+// AllowDuplicateSubmodelDescriptorIDsFromContext reports whether shell
+// descriptors may list several submodel descriptors with the same id. Without
+// a config in ctx duplicates are rejected.
+func AllowDuplicateSubmodelDescriptorIDsFromContext(ctx context.Context) bool {
+	cfg, ok := ConfigFromContext(ctx)
+	if !ok || cfg == nil {
+		return DefaultConfig.GeneralAllowDuplicateSMDescriptorIDs
+	}
+	return cfg.General.AllowDuplicateSubmodelDescriptorIds
+}
+
+// NormalizeIdentifierFromContext applies the configured identifier whitespace
+// policy. Trailing whitespace is stripped only when
+// general.trimIdentifierWhitespace is enabled; otherwise identifiers keep their
+// exact value.
+func NormalizeIdentifierFromContext(ctx context.Context, identifier string) string {
+	cfg, ok := ConfigFromContext(ctx)
+	if !ok || cfg == nil || !cfg.General.TrimIdentifierWhitespace {
+		return identifier
+	}
+	return strings.TrimRightFunc(identifier, unicode.IsSpace)
+}
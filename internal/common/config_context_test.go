@@ -54,3 +54,21 @@ func TestUploadMaxSizeBytesFromContext(t *testing.T) {
 		t.Fatalf("expected configured upload limit 4096, got %d", actual)
 	}
 }
+
+func TestNormalizeIdentifierFromContext(t *testing.T) {
+	const identifier = "urn:example:sm:1 \t"
+
+	if actual := NormalizeIdentifierFromContext(t.Context(), identifier); actual != identifier {
+		t.Fatalf("expected identifier without config to stay exact, got %q", actual)
+	}
+
+	cfg := &Config{}
+	if actual := NormalizeIdentifierFromContext(ContextWithConfig(t.Context(), cfg), identifier); actual != identifier {
+		t.Fatalf("expected identifier to stay exact when trimming is disabled, got %q", actual)
+	}
+
+	cfg.General.TrimIdentifierWhitespace = true
+	if actual := NormalizeIdentifierFromContext(ContextWithConfig(t.Context(), cfg), " urn:example:sm:1 \t"); actual != " urn:example:sm:1" {
+		t.Fatalf("expected only trailing whitespace to be trimmed, got %q", actual)
+	}
+}
@@ -34,6 +34,7 @@ package common
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"reflect"
 	"strings"
@@ -41,6 +42,7 @@ import (
 	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
+	"github.com/jackc/pgx/v5"
 	"github.com/spf13/viper"
 )
 
@@ -55,17 +57,33 @@ var DefaultConfig = struct {
 	ServerCacheEnabled                   bool
 	ServerStrictVerification             string
 	ServerVerificationEndpointAvailable  bool
+	ServerStrictSchemaValidation         bool
 	ServerReadHeaderTimeoutSeconds       int
 	ServerReadTimeoutSeconds             int
 	ServerWriteTimeoutSeconds            int
 	ServerIdleTimeoutSeconds             int
 	ServerShutdownTimeoutSeconds         int
+	ServerRequestTimeoutSeconds          int
+	ServerMaxConcurrentRequests          int
+	ServerMaxRequestBodyBytes            int64
+	ServerMaxUploadBodyBytes             int64
+	ServerResponseSizeWarningBytes       int64
+	ServerRequireContentType             bool
+	ServerDisabledOperations             []string
+	ServerRateLimitEnabled               bool
+	ServerRateLimitRequestsPerSecond     int
+	ServerRateLimitBurst                 int
+	ServerRateLimitScope                 string
 	PgPort                               int
 	PgDBName                             string
 	PgSSLMode                            string
 	PgMaxOpen                            int
 	PgMaxIdle                            int
 	PgConnLifetime                       int
+	PgConnectRetries                     int
+	PgConnectRetryIntervalSeconds        int
+	PgLogQueries                         bool
+	PgExplainQueries                     bool
 	AllowedOrigins                       []string
 	AllowedMethods                       []string
 	AllowedHeaders                       []string
@@ -77,8 +95,9 @@ var DefaultConfig = struct {
 	ABACPolicyFileImport                 string
 	ABACPolicyScope                      string
 	ABACManagementAPIEnabled             bool
+	ABACDebugFilterHeader                bool
+	ABACTrustInternalCalls               bool
 	GeneralImplicitCasts                 bool
-	GeneralDescriptorDebug               bool
 	GeneralDiscoveryIntegration          bool
 	GeneralSupportsSingularSSID          bool
 	GeneralEnableCustomHeaderMW          bool
@@ -86,12 +105,32 @@ var DefaultConfig = struct {
 	GeneralTrustedProxyCIDRs             []string
 	GeneralAASPreconfigPaths             []string
 	GeneralBulkBatchLimit                int
+	GeneralQueryMaxResults               int
+	GeneralQueryMaxResultsBehavior       string
+	GeneralMaxSpecificAssetIDs           int
+	GeneralMaxFieldPathDepth             int
+	GeneralDefaultPageLimit              int
+	GeneralMaxPageLimit                  int
+	GeneralDefaultSubmodelPageLimit      int
+	GeneralDefaultSMEPageLimit           int
+	GeneralDefaultQueryPageLimit         int
+	GeneralTrimIdentifierWhitespace      bool
+	GeneralAllowDuplicateSMDescriptorIDs bool
+	GeneralEndpointReachabilityEnabled   bool
+	GeneralEndpointReachabilityInterval  int
+	GeneralEndpointReachabilityTimeout   int
+	GeneralEndpointReachabilityPrivate   bool
+	GeneralSemanticIDResolutionEnabled   bool
+	GeneralSemanticIDResolutionTimeout   int
+	GeneralSemanticIDResolutionPrivate   bool
 	GeneralUploadMaxSizeBytes            int64
 	GeneralAASXMaxPartCount              int
 	GeneralAASXMaxOPCMetadataSizeBytes   int64
 	GeneralAASXMaxPartExpandedSizeBytes  int64
 	GeneralAASXMaxTotalExpandedSizeBytes int64
 	GeneralAASXMaxThumbnailSizeBytes     int64
+	GeneralAASXImportAtomic              bool
+	GeneralSubmodelPutMode               string
 	HistoryConfigMode                    string
 	HistoryConfigRetentionDays           int
 	HistoryConfigFullSnapshotInterval    int
@@ -126,17 +165,33 @@ var DefaultConfig = struct {
 	ServerCacheEnabled:                   false,
 	ServerStrictVerification:             defaultServerStrictVerification,
 	ServerVerificationEndpointAvailable:  true,
+	ServerStrictSchemaValidation:         false,
 	ServerReadHeaderTimeoutSeconds:       15,
 	ServerReadTimeoutSeconds:             300,
 	ServerWriteTimeoutSeconds:            300,
 	ServerIdleTimeoutSeconds:             60,
 	ServerShutdownTimeoutSeconds:         10,
+	ServerRequestTimeoutSeconds:          0,
+	ServerMaxConcurrentRequests:          0,
+	ServerMaxRequestBodyBytes:            16 << 20,
+	ServerMaxUploadBodyBytes:             128 << 20,
+	ServerResponseSizeWarningBytes:       0,
+	ServerRequireContentType:             false,
+	ServerDisabledOperations:             []string{},
+	ServerRateLimitEnabled:               false,
+	ServerRateLimitRequestsPerSecond:     50,
+	ServerRateLimitBurst:                 100,
+	ServerRateLimitScope:                 RateLimitScopeClient,
 	PgPort:                               5432,
 	PgDBName:                             "basyxTestDB",
 	PgSSLMode:                            "disable",
 	PgMaxOpen:                            50,
 	PgMaxIdle:                            50,
 	PgConnLifetime:                       5,
+	PgConnectRetries:                     5,
+	PgConnectRetryIntervalSeconds:        2,
+	PgLogQueries:                         false,
+	PgExplainQueries:                     false,
 	AllowedOrigins:                       []string{},
 	AllowedMethods:                       []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 	AllowedHeaders:                       []string{},
@@ -148,8 +203,9 @@ var DefaultConfig = struct {
 	ABACPolicyFileImport:                 "",
 	ABACPolicyScope:                      "",
 	ABACManagementAPIEnabled:             false,
+	ABACDebugFilterHeader:                false,
+	ABACTrustInternalCalls:               false,
 	GeneralImplicitCasts:                 true,
-	GeneralDescriptorDebug:               false,
 	GeneralDiscoveryIntegration:          false,
 	GeneralSupportsSingularSSID:          false,
 	GeneralEnableCustomHeaderMW:          false,
@@ -157,12 +213,32 @@ var DefaultConfig = struct {
 	GeneralTrustedProxyCIDRs:             []string{},
 	GeneralAASPreconfigPaths:             []string{},
 	GeneralBulkBatchLimit:                1000,
+	GeneralQueryMaxResults:               1000,
+	GeneralQueryMaxResultsBehavior:       QueryMaxResultsBehaviorTruncate,
+	GeneralMaxSpecificAssetIDs:           1000,
+	GeneralMaxFieldPathDepth:             32,
+	GeneralDefaultPageLimit:              100,
+	GeneralMaxPageLimit:                  1000,
+	GeneralDefaultSubmodelPageLimit:      0,
+	GeneralDefaultSMEPageLimit:           0,
+	GeneralDefaultQueryPageLimit:         0,
+	GeneralTrimIdentifierWhitespace:      false,
+	GeneralAllowDuplicateSMDescriptorIDs: false,
+	GeneralEndpointReachabilityEnabled:   false,
+	GeneralEndpointReachabilityInterval:  300,
+	GeneralEndpointReachabilityTimeout:   5,
+	GeneralEndpointReachabilityPrivate:   false,
+	GeneralSemanticIDResolutionEnabled:   false,
+	GeneralSemanticIDResolutionTimeout:   10,
+	GeneralSemanticIDResolutionPrivate:   false,
 	GeneralUploadMaxSizeBytes:            128 << 20,
 	GeneralAASXMaxPartCount:              defaultAASXMaxPartCount,
 	GeneralAASXMaxOPCMetadataSizeBytes:   defaultAASXMaxOPCMetadataSizeBytes,
 	GeneralAASXMaxPartExpandedSizeBytes:  defaultAASXMaxPartExpandedSizeBytes,
 	GeneralAASXMaxTotalExpandedSizeBytes: defaultAASXMaxTotalExpandedSizeBytes,
 	GeneralAASXMaxThumbnailSizeBytes:     defaultAASXMaxThumbnailSizeBytes,
+	GeneralAASXImportAtomic:              true,
+	GeneralSubmodelPutMode:               SubmodelPutModeReplace,
 	HistoryConfigMode:                    "off",
 	HistoryConfigRetentionDays:           0,
 	HistoryConfigFullSnapshotInterval:    1,
@@ -201,6 +277,10 @@ const (
 	ABACPolicyFileImportNever = "never"
 
 	maxABACPolicyScopeLength = 255
+
+	// EventingSinkLog writes published change events as JSON lines to the
+	// service log. It is the only eventing sink implemented so far.
+	EventingSinkLog = "log"
 )
 
 // PrintSplash displays the BaSyx Go API ASCII art logo to the console.
@@ -263,13 +343,15 @@ type Config struct {
 	JWS      JWSConfig      `mapstructure:"jws" yaml:"jws"`           // JWS signing configuration
 	Swagger  SwaggerConfig  `mapstructure:"swagger" yaml:"swagger"`   // Swagger/OpenAPI documentation configuration
 	History  HistoryConfig  `mapstructure:"history" yaml:"history"`   // History/audit behavior
-	Eventing EventingConfig `mapstructure:"eventing" yaml:"eventing"` // Eventing placeholders
+	Eventing EventingConfig `mapstructure:"eventing" yaml:"eventing"` // Change event publishing
 }
 
 // JWSConfig contains JSON Web Signature configuration parameters.
 type JWSConfig struct {
 	PrivateKeyPath       string `mapstructure:"privateKeyPath" yaml:"privateKeyPath"`             // Path to the RSA private key for signing
 	CertificateChainPath string `mapstructure:"certificateChainPath" yaml:"certificateChainPath"` // Path to PEM encoded X.509 certificates for x5c
+	// Paths to PEM encoded public keys of previous signing keys, published in the JWKS during a key rotation
+	PreviousPublicKeyPaths []string `mapstructure:"previousPublicKeyPaths" yaml:"previousPublicKeyPaths"`
 }
 
 // HistoryConfig contains history and audit configuration.
@@ -312,7 +394,8 @@ type HistoryIntegrityAnchorConfig struct {
 	Provider string `mapstructure:"provider" yaml:"provider" json:"provider"` // none today; immudb/Rekor/Trillian later
 }
 
-// EventingConfig reserves future-compatible eventing configuration.
+// EventingConfig controls change event publishing. Only the log sink is
+// implemented; the outbox is reserved for future broker adapters.
 type EventingConfig struct {
 	Enabled       bool     `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
 	Format        string   `mapstructure:"format" yaml:"format" json:"format"`
@@ -331,41 +414,77 @@ type SwaggerConfig struct {
 
 // ServerConfig contains HTTP server configuration parameters.
 type ServerConfig struct {
-	Host                          string `mapstructure:"host" yaml:"host"`                                                                         // HTTP server host (default: 0.0.0.0)
-	Port                          int    `mapstructure:"port" yaml:"port"`                                                                         // HTTP server port (default: 5004)
-	ContextPath                   string `mapstructure:"contextPath" yaml:"contextPath"`                                                           // Base path for all endpoints
-	CacheEnabled                  bool   `mapstructure:"cacheEnabled" yaml:"cacheEnabled"`                                                         // Enable/disable response caching
-	StrictVerification            string `mapstructure:"strictVerification" yaml:"strictVerification"`                                             // Verification mode: off|permissive|strict (default: permissive)
-	VerificationEndpointAvailable bool   `mapstructure:"verificationEndpointAvailable" yaml:"verificationEndpointAvailable"`                       // Enable/disable verification endpoint
-	ReadHeaderTimeoutSeconds      int    `mapstructure:"readHeaderTimeoutSeconds" yaml:"readHeaderTimeoutSeconds" json:"readHeaderTimeoutSeconds"` // Maximum time to read request headers
-	ReadTimeoutSeconds            int    `mapstructure:"readTimeoutSeconds" yaml:"readTimeoutSeconds" json:"readTimeoutSeconds"`                   // Maximum time to read an entire request
-	WriteTimeoutSeconds           int    `mapstructure:"writeTimeoutSeconds" yaml:"writeTimeoutSeconds" json:"writeTimeoutSeconds"`                // Maximum time before timing out response writes
-	IdleTimeoutSeconds            int    `mapstructure:"idleTimeoutSeconds" yaml:"idleTimeoutSeconds" json:"idleTimeoutSeconds"`                   // Maximum idle keep-alive connection time
-	ShutdownTimeoutSeconds        int    `mapstructure:"shutdownTimeoutSeconds" yaml:"shutdownTimeoutSeconds" json:"shutdownTimeoutSeconds"`       // Maximum graceful shutdown wait time
+	Host                          string   `mapstructure:"host" yaml:"host"`                                                                         // HTTP server host (default: 0.0.0.0)
+	Port                          int      `mapstructure:"port" yaml:"port"`                                                                         // HTTP server port (default: 5004)
+	ContextPath                   string   `mapstructure:"contextPath" yaml:"contextPath"`                                                           // Base path for all endpoints
+	CacheEnabled                  bool     `mapstructure:"cacheEnabled" yaml:"cacheEnabled"`                                                         // Enable/disable response caching
+	StrictVerification            string   `mapstructure:"strictVerification" yaml:"strictVerification"`                                             // Verification mode: off|permissive|strict (default: permissive)
+	VerificationEndpointAvailable bool     `mapstructure:"verificationEndpointAvailable" yaml:"verificationEndpointAvailable"`                       // Enable/disable verification endpoint
+	StrictSchemaValidation        bool     `mapstructure:"strictSchemaValidation" yaml:"strictSchemaValidation" json:"strictSchemaValidation"`       // Validate submodel request bodies against the IDTA Part 1 JSON schema
+	ReadHeaderTimeoutSeconds      int      `mapstructure:"readHeaderTimeoutSeconds" yaml:"readHeaderTimeoutSeconds" json:"readHeaderTimeoutSeconds"` // Maximum time to read request headers
+	ReadTimeoutSeconds            int      `mapstructure:"readTimeoutSeconds" yaml:"readTimeoutSeconds" json:"readTimeoutSeconds"`                   // Maximum time to read an entire request
+	WriteTimeoutSeconds           int      `mapstructure:"writeTimeoutSeconds" yaml:"writeTimeoutSeconds" json:"writeTimeoutSeconds"`                // Maximum time before timing out response writes
+	IdleTimeoutSeconds            int      `mapstructure:"idleTimeoutSeconds" yaml:"idleTimeoutSeconds" json:"idleTimeoutSeconds"`                   // Maximum idle keep-alive connection time
+	ShutdownTimeoutSeconds        int      `mapstructure:"shutdownTimeoutSeconds" yaml:"shutdownTimeoutSeconds" json:"shutdownTimeoutSeconds"`       // Maximum graceful shutdown wait time
+	RequestTimeoutSeconds         int      `mapstructure:"requestTimeoutSeconds" yaml:"requestTimeoutSeconds" json:"requestTimeoutSeconds"`          // Maximum handler time before answering 504 and canceling the request context; 0 disables the budget
+	MaxConcurrentRequests         int      `mapstructure:"maxConcurrentRequests" yaml:"maxConcurrentRequests" json:"maxConcurrentRequests"`          // Maximum in-flight requests before answering 503; 0 disables the limit
+	MaxRequestBodyBytes           int64    `mapstructure:"maxRequestBodyBytes" yaml:"maxRequestBodyBytes" json:"maxRequestBodyBytes"`                // Maximum non-upload request body size; larger bodies get 413
+	MaxUploadBodyBytes            int64    `mapstructure:"maxUploadBodyBytes" yaml:"maxUploadBodyBytes" json:"maxUploadBodyBytes"`                   // Maximum request body size on upload, attachment and package routes; larger bodies get 413
+	ResponseSizeWarningBytes      int64    `mapstructure:"responseSizeWarningBytes" yaml:"responseSizeWarningBytes" json:"responseSizeWarningBytes"` // JSON responses above this size carry a Warning header; 0 disables the warning
+	RequireContentType            bool     `mapstructure:"requireContentType" yaml:"requireContentType" json:"requireContentType"`                   // Reject POST, PUT and PATCH bodies sent without a Content-Type header with 415
+	DisabledOperations            []string `mapstructure:"disabledOperations" yaml:"disabledOperations" json:"disabledOperations"`                   // Operation names or HTTP methods whose API routes are not registered
+
+	RateLimit RateLimitConfig `mapstructure:"rateLimit" yaml:"rateLimit" json:"rateLimit"` // Token-bucket request rate limit answering 429 with Retry-After
+	TLS       TLSConfig       `mapstructure:"tls" yaml:"tls" json:"tls"`                   // HTTPS listener; plain HTTP when no certificate is configured
+}
+
+// TLSConfig enables HTTPS on the service listener when both a certificate and
+// a key file are configured.
+type TLSConfig struct {
+	CertFile string `mapstructure:"certFile" yaml:"certFile" json:"certFile"` // PEM certificate chain of the listener
+	KeyFile  string `mapstructure:"keyFile" yaml:"keyFile" json:"keyFile"`    // PEM private key of the certificate
+}
+
+// Enabled reports whether a certificate is configured for the listener.
+func (cfg TLSConfig) Enabled() bool {
+	return strings.TrimSpace(cfg.CertFile) != "" || strings.TrimSpace(cfg.KeyFile) != ""
+}
+
+// RateLimitConfig controls the optional token-bucket rate limiter applied by NewConfiguredHTTPServer.
+type RateLimitConfig struct {
+	Enabled           bool   `mapstructure:"enabled" yaml:"enabled" json:"enabled"`                               // Enable request rate limiting
+	RequestsPerSecond int    `mapstructure:"requestsPerSecond" yaml:"requestsPerSecond" json:"requestsPerSecond"` // Sustained requests per second
+	Burst             int    `mapstructure:"burst" yaml:"burst" json:"burst"`                                     // Requests accepted at once before the rate applies
+	Scope             string `mapstructure:"scope" yaml:"scope" json:"scope"`                                     // client (per source IP) or global
 }
 
 // PostgresConfig contains PostgreSQL database connection parameters.
 // It includes connection pooling settings for optimal performance.
 type PostgresConfig struct {
-	DSN                     string `mapstructure:"dsn" yaml:"dsn"`                                         // Complete PostgreSQL DSN; mutually exclusive with connection fields
-	Host                    string `mapstructure:"host" yaml:"host"`                                       // Database host address
-	Port                    int    `mapstructure:"port" yaml:"port"`                                       // Database port (default: 5432)
-	User                    string `mapstructure:"user" yaml:"user"`                                       // Database username
-	Password                string `mapstructure:"password" yaml:"password"`                               // Database password
-	DBName                  string `mapstructure:"dbname" yaml:"dbname"`                                   // Database name
-	SSLMode                 string `mapstructure:"sslmode" yaml:"sslmode"`                                 // SSL mode: disable|allow|prefer|require|verify-ca|verify-full
-	SSLCert                 string `mapstructure:"sslcert" yaml:"sslcert"`                                 // Client certificate path
-	SSLKey                  string `mapstructure:"sslkey" yaml:"sslkey"`                                   // Client private key path
-	SSLRootCert             string `mapstructure:"sslrootcert" yaml:"sslrootcert"`                         // Root certificate path
-	ConnectTimeoutSeconds   int    `mapstructure:"connectTimeoutSeconds" yaml:"connectTimeoutSeconds"`     // Connection timeout in seconds
-	ApplicationName         string `mapstructure:"applicationName" yaml:"applicationName"`                 // PostgreSQL application_name
-	FallbackApplicationName string `mapstructure:"fallbackApplicationName" yaml:"fallbackApplicationName"` // PostgreSQL fallback_application_name
-	SearchPath              string `mapstructure:"searchPath" yaml:"searchPath"`                           // PostgreSQL search_path
-	Options                 string `mapstructure:"options" yaml:"options"`                                 // PostgreSQL startup options
-	TimeZone                string `mapstructure:"timezone" yaml:"timezone"`                               // PostgreSQL session timezone
-	MaxOpenConnections      int    `mapstructure:"maxOpenConnections" yaml:"maxOpenConnections"`           // Maximum open connections
-	MaxIdleConnections      int    `mapstructure:"maxIdleConnections" yaml:"maxIdleConnections"`           // Maximum idle connections
-	ConnMaxLifetimeMinutes  int    `mapstructure:"connMaxLifetimeMinutes" yaml:"connMaxLifetimeMinutes"`   // Connection lifetime in minutes
+	DSN                         string `mapstructure:"dsn" yaml:"dsn"`                                                 // Complete PostgreSQL DSN; mutually exclusive with connection fields
+	ReplicaDSN                  string `mapstructure:"replicaDsn" yaml:"replicaDsn"`                                   // Read replica DSN; checked as a non-critical subsystem by the health endpoint
+	Host                        string `mapstructure:"host" yaml:"host"`                                               // Database host address
+	Port                        int    `mapstructure:"port" yaml:"port"`                                               // Database port (default: 5432)
+	User                        string `mapstructure:"user" yaml:"user"`                                               // Database username
+	Password                    string `mapstructure:"password" yaml:"password"`                                       // Database password
+	DBName                      string `mapstructure:"dbname" yaml:"dbname"`                                           // Database name
+	SSLMode                     string `mapstructure:"sslmode" yaml:"sslmode"`                                         // SSL mode: disable|allow|prefer|require|verify-ca|verify-full
+	SSLCert                     string `mapstructure:"sslcert" yaml:"sslcert"`                                         // Client certificate path
+	SSLKey                      string `mapstructure:"sslkey" yaml:"sslkey"`                                           // Client private key path
+	SSLRootCert                 string `mapstructure:"sslrootcert" yaml:"sslrootcert"`                                 // Root certificate path
+	ConnectTimeoutSeconds       int    `mapstructure:"connectTimeoutSeconds" yaml:"connectTimeoutSeconds"`             // Connection timeout in seconds
+	ApplicationName             string `mapstructure:"applicationName" yaml:"applicationName"`                         // PostgreSQL application_name
+	FallbackApplicationName     string `mapstructure:"fallbackApplicationName" yaml:"fallbackApplicationName"`         // PostgreSQL fallback_application_name
+	SearchPath                  string `mapstructure:"searchPath" yaml:"searchPath"`                                   // PostgreSQL search_path
+	Options                     string `mapstructure:"options" yaml:"options"`                                         // PostgreSQL startup options
+	TimeZone                    string `mapstructure:"timezone" yaml:"timezone"`                                       // PostgreSQL session timezone
+	MaxOpenConnections          int    `mapstructure:"maxOpenConnections" yaml:"maxOpenConnections"`                   // Maximum open connections
+	MaxIdleConnections          int    `mapstructure:"maxIdleConnections" yaml:"maxIdleConnections"`                   // Maximum idle connections
+	ConnMaxLifetimeMinutes      int    `mapstructure:"connMaxLifetimeMinutes" yaml:"connMaxLifetimeMinutes"`           // Connection lifetime in minutes
+	ConnectRetries              int    `mapstructure:"connectRetries" yaml:"connectRetries"`                           // Retries of the startup connection check before giving up (0 disables retries)
+	ConnectRetryIntervalSeconds int    `mapstructure:"connectRetryIntervalSeconds" yaml:"connectRetryIntervalSeconds"` // Wait before the first retry; doubles per retry up to 30 seconds
+	LogQueries                  bool   `mapstructure:"logQueries" yaml:"logQueries"`                                   // Log generated SQL with redacted arguments (debugging only)
+	ExplainQueries              bool   `mapstructure:"explainQueries" yaml:"explainQueries"`                           // Log EXPLAIN plans of heavy read queries; requires logQueries (debugging only)
 }
 
 // CorsConfig contains Cross-Origin Resource Sharing (CORS) policy settings.
@@ -379,7 +498,6 @@ type CorsConfig struct {
 // GeneralConfig contains non-domain-specific configuration.
 type GeneralConfig struct {
 	EnableImplicitCasts                    bool     `mapstructure:"enableImplicitCasts" yaml:"enableImplicitCasts" json:"enableImplicitCasts"`                                                          // Enable implicit casts during backend simplification
-	EnableDescriptorDebug                  bool     `mapstructure:"enableDescriptorDebug" yaml:"enableDescriptorDebug" json:"enableDescriptorDebug"`                                                    // Enable descriptor query debug output
 	DiscoveryIntegration                   bool     `mapstructure:"discoveryIntegration" yaml:"discoveryIntegration" json:"discoveryIntegration"`                                                       // Enable integration with discovery aas_identifier linking
 	EnableCustomMiddlewareHeaderInjection  bool     `mapstructure:"enableCustomMiddlewareHeaderInjection" yaml:"enableCustomMiddlewareHeaderInjection" json:"enableCustomMiddlewareHeaderInjection"`    // Enable custom security middleware header injections
 	SupportsSingularSupplementalSemanticId bool     `mapstructure:"supportsSingularSupplementalSemanticId" yaml:"supportsSingularSupplementalSemanticId" json:"supportsSingularSupplementalSemanticId"` // Use singular supplementalSemanticId for SubmodelDescriptor I/O
@@ -394,8 +512,48 @@ type GeneralConfig struct {
 	AASXMaxPartExpandedSizeBytes           int64    `mapstructure:"aasxMaxPartExpandedSizeBytes" yaml:"aasxMaxPartExpandedSizeBytes" json:"aasxMaxPartExpandedSizeBytes"`                               // Maximum expanded size of one AASX payload part
 	AASXMaxTotalExpandedSizeBytes          int64    `mapstructure:"aasxMaxTotalExpandedSizeBytes" yaml:"aasxMaxTotalExpandedSizeBytes" json:"aasxMaxTotalExpandedSizeBytes"`                            // Maximum combined expanded AASX payload size
 	AASXMaxThumbnailSizeBytes              int64    `mapstructure:"aasxMaxThumbnailSizeBytes" yaml:"aasxMaxThumbnailSizeBytes" json:"aasxMaxThumbnailSizeBytes"`                                        // Maximum expanded size of an AASX thumbnail
+	AASXImportAtomic                       bool     `mapstructure:"aasxImportAtomic" yaml:"aasxImportAtomic" json:"aasxImportAtomic"`                                                                   // Roll back a whole AASX import when one submodel fails
+	SubmodelPutMode                        string   `mapstructure:"submodelPutMode" yaml:"submodelPutMode" json:"submodelPutMode"`                                                                      // replace|merge for the element tree of PUT /submodels/{id}
 	AASPreconfigPaths                      []string `mapstructure:"aasPreconfigPaths" yaml:"aasPreconfigPaths" json:"aasPreconfigPaths"`                                                                // Files/directories loaded at startup for AAS preconfiguration
 	BulkBatchLimit                         int      `mapstructure:"bulkBatchLimit" yaml:"bulkBatchLimit" json:"bulkBatchLimit"`                                                                         // Maximum row count per generated bulk SQL statement
+	QueryMaxResults                        int      `mapstructure:"queryMaxResults" yaml:"queryMaxResults" json:"queryMaxResults"`                                                                      // Hard upper bound of items returned by one query request
+	QueryMaxResultsBehavior                string   `mapstructure:"queryMaxResultsBehavior" yaml:"queryMaxResultsBehavior" json:"queryMaxResultsBehavior"`                                              // reject|truncate when a query request exceeds queryMaxResults
+	MaxSpecificAssetIDs                    int      `mapstructure:"maxSpecificAssetIds" yaml:"maxSpecificAssetIds" json:"maxSpecificAssetIds"`                                                          // Maximum specificAssetIds per AAS descriptor on create/replace; 0 disables the limit
+	MaxFieldPathDepth                      int      `mapstructure:"maxFieldPathDepth" yaml:"maxFieldPathDepth" json:"maxFieldPathDepth"`                                                                // Maximum path segments of a field in queries and ABAC formulas
+	DefaultPageLimit                       int      `mapstructure:"defaultPageLimit" yaml:"defaultPageLimit" json:"defaultPageLimit"`                                                                   // Page size of list endpoints when the request has no limit
+	MaxPageLimit                           int      `mapstructure:"maxPageLimit" yaml:"maxPageLimit" json:"maxPageLimit"`                                                                               // Largest limit accepted by list endpoints; 0 disables the check
+	DefaultSubmodelPageLimit               int      `mapstructure:"defaultSubmodelPageLimit" yaml:"defaultSubmodelPageLimit" json:"defaultSubmodelPageLimit"`                                           // Default page size of submodel list endpoints; 0 uses defaultPageLimit
+	DefaultSubmodelElementPageLimit        int      `mapstructure:"defaultSubmodelElementPageLimit" yaml:"defaultSubmodelElementPageLimit" json:"defaultSubmodelElementPageLimit"`                      // Default page size of submodel element list endpoints; 0 uses defaultPageLimit
+	DefaultQueryPageLimit                  int      `mapstructure:"defaultQueryPageLimit" yaml:"defaultQueryPageLimit" json:"defaultQueryPageLimit"`                                                    // Default page size of query endpoints; 0 uses defaultPageLimit
+	TrimIdentifierWhitespace               bool     `mapstructure:"trimIdentifierWhitespace" yaml:"trimIdentifierWhitespace" json:"trimIdentifierWhitespace"`                                           // Strip trailing whitespace from identifiers in paths and bodies
+	AllowDuplicateSubmodelDescriptorIds    bool     `mapstructure:"allowDuplicateSubmodelDescriptorIds" yaml:"allowDuplicateSubmodelDescriptorIds" json:"allowDuplicateSubmodelDescriptorIds"`          // Accept shell descriptors listing one submodel descriptor id twice
+
+	EndpointReachability EndpointReachabilityConfig `mapstructure:"endpointReachability" yaml:"endpointReachability" json:"endpointReachability"` // Background probing of registry descriptor endpoints
+	SemanticIDResolution SemanticIDResolutionConfig `mapstructure:"semanticIdResolution" yaml:"semanticIdResolution" json:"semanticIdResolution"` // Lookup of submodels in other repositories by semanticId
+}
+
+// SemanticIDResolutionConfig points the repository to the submodel registry
+// that is asked for submodels of a semanticId stored in other repositories.
+type SemanticIDResolutionConfig struct {
+	Enabled             bool   `mapstructure:"enabled" yaml:"enabled" json:"enabled"`                                     // Enable lookups by semanticId
+	SubmodelRegistryURL string `mapstructure:"submodelRegistryUrl" yaml:"submodelRegistryUrl" json:"submodelRegistryUrl"` // Base URL of the submodel registry API
+	TimeoutSeconds      int    `mapstructure:"timeoutSeconds" yaml:"timeoutSeconds" json:"timeoutSeconds"`                // Timeout of one registry or repository request
+	// AllowPrivateNetworks lets the resolver read submodels from private
+	// (RFC 1918, RFC 4193) addresses. Loopback, link-local and cloud metadata
+	// addresses are never read because registered descriptors choose the href.
+	AllowPrivateNetworks bool `mapstructure:"allowPrivateNetworks" yaml:"allowPrivateNetworks" json:"allowPrivateNetworks"`
+}
+
+// EndpointReachabilityConfig controls the registry background checker that
+// probes HTTP descriptor endpoints and records whether they answered.
+type EndpointReachabilityConfig struct {
+	Enabled         bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`                         // Enable periodic endpoint probing
+	IntervalSeconds int  `mapstructure:"intervalSeconds" yaml:"intervalSeconds" json:"intervalSeconds"` // Pause between two probe rounds
+	TimeoutSeconds  int  `mapstructure:"timeoutSeconds" yaml:"timeoutSeconds" json:"timeoutSeconds"`    // Timeout of one endpoint probe
+	// AllowPrivateNetworks lets the checker probe private (RFC 1918, RFC 4193)
+	// addresses. Loopback, link-local and cloud metadata addresses are never
+	// probed because any client that can register a descriptor chooses the href.
+	AllowPrivateNetworks bool `mapstructure:"allowPrivateNetworks" yaml:"allowPrivateNetworks" json:"allowPrivateNetworks"`
 }
 
 // OIDCProviderConfig contains OpenID Connect authentication provider settings.
@@ -422,11 +580,13 @@ type OIDCConfig struct {
 
 // ABACConfig contains Attribute-Based Access Control authorization settings.
 type ABACConfig struct {
-	Enabled          bool                    `mapstructure:"enabled" yaml:"enabled" json:"enabled"`                             // Enable/disable ABAC
-	ModelPath        string                  `mapstructure:"modelPath" yaml:"modelPath" json:"modelPath"`                       // Path to access control model
-	PolicyFileImport string                  `mapstructure:"policyFileImport" yaml:"policyFileImport" json:"policyFileImport"`  // always|if_missing|never; empty uses the service default
-	PolicyScope      string                  `mapstructure:"policyScope" yaml:"policyScope" json:"policyScope"`                 // Optional DB policy namespace; empty uses the service default
-	ManagementAPI    ABACManagementAPIConfig `mapstructure:"managementApi" yaml:"managementApi" json:"managementApi,omitempty"` // Runtime ABAC policy management API
+	Enabled                 bool                    `mapstructure:"enabled" yaml:"enabled" json:"enabled"`                                                 // Enable/disable ABAC
+	ModelPath               string                  `mapstructure:"modelPath" yaml:"modelPath" json:"modelPath"`                                           // Path to access control model
+	PolicyFileImport        string                  `mapstructure:"policyFileImport" yaml:"policyFileImport" json:"policyFileImport"`                      // always|if_missing|never; empty uses the service default
+	PolicyScope             string                  `mapstructure:"policyScope" yaml:"policyScope" json:"policyScope"`                                     // Optional DB policy namespace; empty uses the service default
+	ManagementAPI           ABACManagementAPIConfig `mapstructure:"managementApi" yaml:"managementApi" json:"managementApi,omitempty"`                     // Runtime ABAC policy management API
+	EnableDebugFilterHeader bool                    `mapstructure:"enableDebugFilterHeader" yaml:"enableDebugFilterHeader" json:"enableDebugFilterHeader"` // Return the applied query filter in X-ABAC-Filter; development only
+	TrustInternalCalls      bool                    `mapstructure:"trustInternalCalls" yaml:"trustInternalCalls" json:"trustInternalCalls"`                // Skip ABAC for in-process composition calls marked as trusted
 }
 
 // ABACManagementAPIConfig controls the protected runtime ABAC management API.
@@ -505,6 +665,7 @@ func LoadConfig(configPath string, configMode ConfigMode) (*Config, error) {
 	applyAASPreconfigPathOverrides(cfg)
 	applyServerEnvOverrides(cfg)
 	applyGeneralEnvOverrides(cfg)
+	applyJWSEnvOverrides(cfg)
 	if err = validatePostgresConfig(v, cfg.Postgres); err != nil {
 		return nil, err
 	}
@@ -526,10 +687,23 @@ func LoadConfig(configPath string, configMode ConfigMode) (*Config, error) {
 	if configMode == NORMAL {
 		log.Println("✅ Configuration loaded successfully")
 		PrintConfiguration(cfg)
+		if cfg.Postgres.ExplainQueries {
+			log.Println("⚠️ postgres.explainQueries is enabled: heavy read queries run an additional EXPLAIN; do not use in production")
+		}
+		warnDeprecatedConfigKeys(v)
 	}
 	return cfg, nil
 }
 
+// warnDeprecatedConfigKeys logs keys that are still set although the service
+// no longer reads them. Config loading ignores unknown keys, so without the
+// warning a removed switch would silently stop having an effect.
+func warnDeprecatedConfigKeys(v *viper.Viper) {
+	if v.IsSet("general.enableDescriptorDebug") {
+		log.Println("⚠️ general.enableDescriptorDebug is deprecated and ignored; use postgres.logQueries to log descriptor SQL")
+	}
+}
+
 func applyGeneralEnvOverrides(cfg *Config) {
 	if cfg == nil {
 		return
@@ -540,6 +714,80 @@ func applyGeneralEnvOverrides(cfg *Config) {
 			cfg.General.BulkBatchLimit = parsed
 		}
 	}
+	applyFirstIntEnv(func(value int) { cfg.General.MaxSpecificAssetIDs = value },
+		"GENERAL_MAX_SPECIFIC_ASSET_IDS",
+		"BASYX_GENERAL_MAX_SPECIFIC_ASSET_IDS",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.MaxFieldPathDepth = value },
+		"GENERAL_MAX_FIELD_PATH_DEPTH",
+		"BASYX_GENERAL_MAX_FIELD_PATH_DEPTH",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.DefaultPageLimit = value },
+		"GENERAL_DEFAULT_PAGE_LIMIT",
+		"BASYX_GENERAL_DEFAULT_PAGE_LIMIT",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.MaxPageLimit = value },
+		"GENERAL_MAX_PAGE_LIMIT",
+		"BASYX_GENERAL_MAX_PAGE_LIMIT",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.DefaultSubmodelPageLimit = value },
+		"GENERAL_DEFAULT_SUBMODEL_PAGE_LIMIT",
+		"BASYX_GENERAL_DEFAULT_SUBMODEL_PAGE_LIMIT",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.DefaultSubmodelElementPageLimit = value },
+		"GENERAL_DEFAULT_SUBMODEL_ELEMENT_PAGE_LIMIT",
+		"BASYX_GENERAL_DEFAULT_SUBMODEL_ELEMENT_PAGE_LIMIT",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.DefaultQueryPageLimit = value },
+		"GENERAL_DEFAULT_QUERY_PAGE_LIMIT",
+		"BASYX_GENERAL_DEFAULT_QUERY_PAGE_LIMIT",
+	)
+	if value, ok := lookupFirstTrimmedEnv("GENERAL_SUBMODEL_PUT_MODE", "BASYX_GENERAL_SUBMODEL_PUT_MODE"); ok {
+		cfg.General.SubmodelPutMode = value
+	}
+	applyFirstBoolEnv(func(value bool) { cfg.General.AASXImportAtomic = value },
+		"GENERAL_AASX_IMPORT_ATOMIC",
+		"BASYX_GENERAL_AASX_IMPORT_ATOMIC",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.TrimIdentifierWhitespace = value },
+		"GENERAL_TRIM_IDENTIFIER_WHITESPACE",
+		"BASYX_GENERAL_TRIM_IDENTIFIER_WHITESPACE",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.AllowDuplicateSubmodelDescriptorIds = value },
+		"GENERAL_ALLOW_DUPLICATE_SUBMODEL_DESCRIPTOR_IDS",
+		"BASYX_GENERAL_ALLOW_DUPLICATE_SUBMODEL_DESCRIPTOR_IDS",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.EndpointReachability.Enabled = value },
+		"GENERAL_ENDPOINT_REACHABILITY_ENABLED",
+		"BASYX_GENERAL_ENDPOINT_REACHABILITY_ENABLED",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.EndpointReachability.IntervalSeconds = value },
+		"GENERAL_ENDPOINT_REACHABILITY_INTERVAL_SECONDS",
+		"BASYX_GENERAL_ENDPOINT_REACHABILITY_INTERVAL_SECONDS",
+	)
+	applyFirstIntEnv(func(value int) { cfg.General.EndpointReachability.TimeoutSeconds = value },
+		"GENERAL_ENDPOINT_REACHABILITY_TIMEOUT_SECONDS",
+		"BASYX_GENERAL_ENDPOINT_REACHABILITY_TIMEOUT_SECONDS",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.EndpointReachability.AllowPrivateNetworks = value },
+		"GENERAL_ENDPOINT_REACHABILITY_ALLOW_PRIVATE_NETWORKS",
+		"BASYX_GENERAL_ENDPOINT_REACHABILITY_ALLOW_PRIVATE_NETWORKS",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.SemanticIDResolution.Enabled = value },
+		"GENERAL_SEMANTIC_ID_RESOLUTION_ENABLED",
+		"BASYX_GENERAL_SEMANTIC_ID_RESOLUTION_ENABLED",
+	)
+	if value, ok := lookupFirstTrimmedEnv("GENERAL_SEMANTIC_ID_RESOLUTION_SUBMODEL_REGISTRY_URL", "BASYX_GENERAL_SEMANTIC_ID_RESOLUTION_SUBMODEL_REGISTRY_URL"); ok {
+		cfg.General.SemanticIDResolution.SubmodelRegistryURL = value
+	}
+	applyFirstIntEnv(func(value int) { cfg.General.SemanticIDResolution.TimeoutSeconds = value },
+		"GENERAL_SEMANTIC_ID_RESOLUTION_TIMEOUT_SECONDS",
+		"BASYX_GENERAL_SEMANTIC_ID_RESOLUTION_TIMEOUT_SECONDS",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.General.SemanticIDResolution.AllowPrivateNetworks = value },
+		"GENERAL_SEMANTIC_ID_RESOLUTION_ALLOW_PRIVATE_NETWORKS",
+		"BASYX_GENERAL_SEMANTIC_ID_RESOLUTION_ALLOW_PRIVATE_NETWORKS",
+	)
 }
 
 func applyServerEnvOverrides(cfg *Config) {
@@ -566,6 +814,64 @@ func applyServerEnvOverrides(cfg *Config) {
 		"SERVER_SHUTDOWN_TIMEOUT_SECONDS",
 		"BASYX_SERVER_SHUTDOWN_TIMEOUT_SECONDS",
 	)
+	applyFirstIntEnv(func(value int) { cfg.Server.RequestTimeoutSeconds = value },
+		"SERVER_REQUEST_TIMEOUT_SECONDS",
+		"BASYX_SERVER_REQUEST_TIMEOUT_SECONDS",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.MaxConcurrentRequests = value },
+		"SERVER_MAX_CONCURRENT_REQUESTS",
+		"BASYX_SERVER_MAX_CONCURRENT_REQUESTS",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.MaxRequestBodyBytes = int64(value) },
+		"SERVER_MAX_REQUEST_BODY_BYTES",
+		"BASYX_SERVER_MAX_REQUEST_BODY_BYTES",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.MaxUploadBodyBytes = int64(value) },
+		"SERVER_MAX_UPLOAD_BODY_BYTES",
+		"BASYX_SERVER_MAX_UPLOAD_BODY_BYTES",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.ResponseSizeWarningBytes = int64(value) },
+		"SERVER_RESPONSE_SIZE_WARNING_BYTES",
+		"BASYX_SERVER_RESPONSE_SIZE_WARNING_BYTES",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.Server.RateLimit.Enabled = value },
+		"SERVER_RATE_LIMIT_ENABLED",
+		"BASYX_SERVER_RATE_LIMIT_ENABLED",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.RateLimit.RequestsPerSecond = value },
+		"SERVER_RATE_LIMIT_REQUESTS_PER_SECOND",
+		"BASYX_SERVER_RATE_LIMIT_REQUESTS_PER_SECOND",
+	)
+	applyFirstIntEnv(func(value int) { cfg.Server.RateLimit.Burst = value },
+		"SERVER_RATE_LIMIT_BURST",
+		"BASYX_SERVER_RATE_LIMIT_BURST",
+	)
+	if value, ok := lookupFirstTrimmedEnv("SERVER_RATE_LIMIT_SCOPE", "BASYX_SERVER_RATE_LIMIT_SCOPE"); ok {
+		cfg.Server.RateLimit.Scope = value
+	}
+	applyFirstBoolEnv(func(value bool) { cfg.Server.StrictSchemaValidation = value },
+		"SERVER_STRICT_SCHEMA_VALIDATION",
+		"BASYX_SERVER_STRICT_SCHEMA_VALIDATION",
+	)
+	applyFirstBoolEnv(func(value bool) { cfg.Server.RequireContentType = value },
+		"SERVER_REQUIRE_CONTENT_TYPE",
+		"BASYX_SERVER_REQUIRE_CONTENT_TYPE",
+	)
+	if value, ok := lookupFirstTrimmedEnv("SERVER_DISABLED_OPERATIONS", "BASYX_SERVER_DISABLED_OPERATIONS"); ok {
+		cfg.Server.DisabledOperations = parseCommaSeparated(value)
+	}
+	if value, ok := lookupFirstTrimmedEnv("SERVER_TLS_CERT_FILE", "BASYX_SERVER_TLS_CERT_FILE"); ok {
+		cfg.Server.TLS.CertFile = value
+	}
+	if value, ok := lookupFirstTrimmedEnv("SERVER_TLS_KEY_FILE", "BASYX_SERVER_TLS_KEY_FILE"); ok {
+		cfg.Server.TLS.KeyFile = value
+	}
+}
+
+func applyJWSEnvOverrides(cfg *Config) {
+	if value, ok := lookupFirstTrimmedEnv("JWS_PREVIOUS_PUBLIC_KEY_PATHS", "BASYX_JWS_PREVIOUS_PUBLIC_KEY_PATHS"); ok {
+		cfg.JWS.PreviousPublicKeyPaths = parseCommaSeparated(value)
+	}
 }
 
 func validateGeneralConfig(cfg *Config) error {
@@ -575,6 +881,51 @@ func validateGeneralConfig(cfg *Config) error {
 	if cfg.General.BulkBatchLimit <= 0 {
 		return fmt.Errorf("CONFIG-GENERAL-BULKBATCHLIMIT general.bulkBatchLimit must be greater than 0")
 	}
+	if cfg.General.QueryMaxResults <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-QUERYMAXRESULTS general.queryMaxResults must be greater than 0, got %d", cfg.General.QueryMaxResults)
+	}
+	switch strings.ToLower(strings.TrimSpace(cfg.General.QueryMaxResultsBehavior)) {
+	case "", QueryMaxResultsBehaviorReject, QueryMaxResultsBehaviorTruncate:
+		cfg.General.QueryMaxResultsBehavior = strings.ToLower(strings.TrimSpace(cfg.General.QueryMaxResultsBehavior))
+	default:
+		return fmt.Errorf("CONFIG-GENERAL-QUERYMAXRESULTSBEHAVIOR unsupported general.queryMaxResultsBehavior %q", cfg.General.QueryMaxResultsBehavior)
+	}
+	switch strings.ToLower(strings.TrimSpace(cfg.General.SubmodelPutMode)) {
+	case "", SubmodelPutModeReplace, SubmodelPutModeMerge:
+		cfg.General.SubmodelPutMode = strings.ToLower(strings.TrimSpace(cfg.General.SubmodelPutMode))
+	default:
+		return fmt.Errorf("CONFIG-GENERAL-SUBMODELPUTMODE unsupported general.submodelPutMode %q", cfg.General.SubmodelPutMode)
+	}
+	if cfg.General.MaxSpecificAssetIDs < 0 {
+		return fmt.Errorf("CONFIG-GENERAL-MAXSPECIFICASSETIDS general.maxSpecificAssetIds must not be negative")
+	}
+	if cfg.General.MaxFieldPathDepth <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-MAXFIELDPATHDEPTH general.maxFieldPathDepth must be greater than 0, got %d", cfg.General.MaxFieldPathDepth)
+	}
+	if cfg.General.DefaultPageLimit <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-DEFAULTPAGELIMIT general.defaultPageLimit must be greater than 0")
+	}
+	if cfg.General.MaxPageLimit < 0 {
+		return fmt.Errorf("CONFIG-GENERAL-MAXPAGELIMIT general.maxPageLimit must not be negative")
+	}
+	if cfg.General.MaxPageLimit > 0 && cfg.General.DefaultPageLimit > cfg.General.MaxPageLimit {
+		return fmt.Errorf("CONFIG-GENERAL-DEFAULTPAGELIMIT general.defaultPageLimit must not exceed general.maxPageLimit")
+	}
+	for _, scoped := range []struct {
+		key   string
+		value int
+	}{
+		{key: "defaultSubmodelPageLimit", value: cfg.General.DefaultSubmodelPageLimit},
+		{key: "defaultSubmodelElementPageLimit", value: cfg.General.DefaultSubmodelElementPageLimit},
+		{key: "defaultQueryPageLimit", value: cfg.General.DefaultQueryPageLimit},
+	} {
+		if scoped.value < 0 {
+			return fmt.Errorf("CONFIG-GENERAL-SCOPEDPAGELIMIT general.%s must not be negative", scoped.key)
+		}
+		if cfg.General.MaxPageLimit > 0 && scoped.value > cfg.General.MaxPageLimit {
+			return fmt.Errorf("CONFIG-GENERAL-SCOPEDPAGELIMIT general.%s must not exceed general.maxPageLimit", scoped.key)
+		}
+	}
 	if cfg.General.UploadMaxSizeBytes <= 0 {
 		return fmt.Errorf("CONFIG-GENERAL-UPLOADMAXSIZE general.uploadMaxSizeBytes must be greater than 0")
 	}
@@ -593,6 +944,36 @@ func validateGeneralConfig(cfg *Config) error {
 	if cfg.General.AASXMaxThumbnailSizeBytes <= 0 || cfg.General.AASXMaxThumbnailSizeBytes > cfg.General.AASXMaxPartExpandedSizeBytes {
 		return fmt.Errorf("CONFIG-GENERAL-AASXTHUMBNAILSIZE general.aasxMaxThumbnailSizeBytes must be greater than 0 and no greater than general.aasxMaxPartExpandedSizeBytes")
 	}
+	if err := validateEndpointReachabilityConfig(cfg.General.EndpointReachability); err != nil {
+		return err
+	}
+	return validateSemanticIDResolutionConfig(cfg.General.SemanticIDResolution)
+}
+
+func validateSemanticIDResolutionConfig(cfg SemanticIDResolutionConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	registryURL, err := url.Parse(strings.TrimSpace(cfg.SubmodelRegistryURL))
+	if err != nil || (registryURL.Scheme != "http" && registryURL.Scheme != "https") || registryURL.Host == "" {
+		return fmt.Errorf("CONFIG-GENERAL-SEMANTICIDREGISTRYURL general.semanticIdResolution.submodelRegistryUrl must be an absolute http(s) URL")
+	}
+	if cfg.TimeoutSeconds <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-SEMANTICIDTIMEOUT general.semanticIdResolution.timeoutSeconds must be greater than 0")
+	}
+	return nil
+}
+
+func validateEndpointReachabilityConfig(cfg EndpointReachabilityConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.IntervalSeconds <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-REACHABILITYINTERVAL general.endpointReachability.intervalSeconds must be greater than 0")
+	}
+	if cfg.TimeoutSeconds <= 0 {
+		return fmt.Errorf("CONFIG-GENERAL-REACHABILITYTIMEOUT general.endpointReachability.timeoutSeconds must be greater than 0")
+	}
 	return nil
 }
 
@@ -609,10 +990,72 @@ func validateServerConfig(cfg ServerConfig) error {
 			return fmt.Errorf("CONFIG-SERVER-TIMEOUT %s must be greater than 0", key)
 		}
 	}
+	if cfg.RequestTimeoutSeconds < 0 {
+		return fmt.Errorf("CONFIG-SERVER-REQUESTTIMEOUT server.requestTimeoutSeconds must not be negative")
+	}
+	if cfg.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("CONFIG-SERVER-MAXCONCURRENT server.maxConcurrentRequests must not be negative")
+	}
+	if cfg.MaxRequestBodyBytes <= 0 {
+		return fmt.Errorf("CONFIG-SERVER-MAXBODY server.maxRequestBodyBytes must be greater than 0")
+	}
+	if cfg.MaxUploadBodyBytes <= 0 {
+		return fmt.Errorf("CONFIG-SERVER-MAXUPLOADBODY server.maxUploadBodyBytes must be greater than 0")
+	}
+	if cfg.ResponseSizeWarningBytes < 0 {
+		return fmt.Errorf("CONFIG-SERVER-RESPONSESIZEWARNING server.responseSizeWarningBytes must not be negative")
+	}
+	for _, operation := range cfg.DisabledOperations {
+		if strings.TrimSpace(operation) == "" {
+			return fmt.Errorf("CONFIG-SERVER-DISABLEDOPS server.disabledOperations must not contain empty entries")
+		}
+	}
+	if err := validateRateLimitConfig(cfg.RateLimit); err != nil {
+		return err
+	}
+	return validateTLSConfig(cfg.TLS)
+}
+
+func validateTLSConfig(cfg TLSConfig) error {
+	if !cfg.Enabled() {
+		return nil
+	}
+	if strings.TrimSpace(cfg.CertFile) == "" || strings.TrimSpace(cfg.KeyFile) == "" {
+		return fmt.Errorf("CONFIG-SERVER-TLS server.tls.certFile and server.tls.keyFile must be set together")
+	}
 	return nil
 }
 
+func validateRateLimitConfig(cfg RateLimitConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		return fmt.Errorf("CONFIG-SERVER-RATELIMIT server.rateLimit.requestsPerSecond must be greater than 0")
+	}
+	if cfg.Burst <= 0 {
+		return fmt.Errorf("CONFIG-SERVER-RATELIMIT server.rateLimit.burst must be greater than 0")
+	}
+	switch strings.ToLower(strings.TrimSpace(cfg.Scope)) {
+	case RateLimitScopeClient, RateLimitScopeGlobal:
+		return nil
+	default:
+		return fmt.Errorf("CONFIG-SERVER-RATELIMIT unsupported server.rateLimit.scope %q", cfg.Scope)
+	}
+}
+
 func validatePostgresConfig(v *viper.Viper, cfg PostgresConfig) error {
+	if cfg.ExplainQueries && !cfg.LogQueries {
+		return fmt.Errorf("CONFIG-POSTGRES-EXPLAINQUERIES postgres.explainQueries requires postgres.logQueries; both are debugging aids and must not be enabled in production")
+	}
+	if err := validatePostgresConnectRetry(cfg); err != nil {
+		return err
+	}
+	if replicaDSN := strings.TrimSpace(cfg.ReplicaDSN); replicaDSN != "" {
+		if _, err := pgx.ParseConfig(NormalizePostgresDSN(replicaDSN)); err != nil {
+			return fmt.Errorf("CONFIG-POSTGRES-REPLICADSN postgres.replicaDsn is not a valid PostgreSQL DSN")
+		}
+	}
 	if strings.TrimSpace(cfg.DSN) != "" {
 		conflictingKeys := explicitlyConfiguredPostgresConnectionKeys(v)
 		if len(conflictingKeys) > 0 {
@@ -632,6 +1075,16 @@ func validatePostgresConfig(v *viper.Viper, cfg PostgresConfig) error {
 	return nil
 }
 
+func validatePostgresConnectRetry(cfg PostgresConfig) error {
+	if cfg.ConnectRetries < 0 {
+		return fmt.Errorf("CONFIG-POSTGRES-CONNECTRETRIES postgres.connectRetries must not be negative")
+	}
+	if cfg.ConnectRetries > 0 && cfg.ConnectRetryIntervalSeconds <= 0 {
+		return fmt.Errorf("CONFIG-POSTGRES-CONNECTRETRYINTERVAL postgres.connectRetryIntervalSeconds must be greater than 0 when postgres.connectRetries is set")
+	}
+	return nil
+}
+
 func explicitlyConfiguredPostgresConnectionKeys(v *viper.Viper) []string {
 	keys := []string{
 		"host",
@@ -934,8 +1387,16 @@ func validateIntegrityAnchorConfig(cfg HistoryIntegrityAnchorConfig) error {
 }
 
 func validateEventingConfig(cfg EventingConfig) error {
-	if cfg.Enabled || cfg.OutboxEnabled || len(cfg.Sinks) > 0 {
-		return fmt.Errorf("CONFIG-EVENTING-NOTIMPLEMENTED eventing publishing and outbox processing are not implemented yet")
+	if cfg.OutboxEnabled {
+		return fmt.Errorf("CONFIG-EVENTING-NOTIMPLEMENTED eventing outbox processing is not implemented yet")
+	}
+	for _, sink := range cfg.Sinks {
+		if !strings.EqualFold(strings.TrimSpace(sink), EventingSinkLog) {
+			return fmt.Errorf("CONFIG-EVENTING-SINK unsupported eventing sink %q; only %q is implemented", sink, EventingSinkLog)
+		}
+	}
+	if cfg.Enabled && len(cfg.Sinks) == 0 {
+		return fmt.Errorf("CONFIG-EVENTING-NOSINK eventing.enabled requires at least one eventing.sinks entry")
 	}
 	return nil
 }
@@ -1088,11 +1549,25 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.cacheEnabled", false)
 	v.SetDefault("server.strictVerification", DefaultConfig.ServerStrictVerification)
 	v.SetDefault("server.verificationEndpointAvailable", DefaultConfig.ServerVerificationEndpointAvailable)
+	v.SetDefault("server.strictSchemaValidation", DefaultConfig.ServerStrictSchemaValidation)
 	v.SetDefault("server.readHeaderTimeoutSeconds", DefaultConfig.ServerReadHeaderTimeoutSeconds)
 	v.SetDefault("server.readTimeoutSeconds", DefaultConfig.ServerReadTimeoutSeconds)
 	v.SetDefault("server.writeTimeoutSeconds", DefaultConfig.ServerWriteTimeoutSeconds)
 	v.SetDefault("server.idleTimeoutSeconds", DefaultConfig.ServerIdleTimeoutSeconds)
 	v.SetDefault("server.shutdownTimeoutSeconds", DefaultConfig.ServerShutdownTimeoutSeconds)
+	v.SetDefault("server.requestTimeoutSeconds", DefaultConfig.ServerRequestTimeoutSeconds)
+	v.SetDefault("server.maxConcurrentRequests", DefaultConfig.ServerMaxConcurrentRequests)
+	v.SetDefault("server.maxRequestBodyBytes", DefaultConfig.ServerMaxRequestBodyBytes)
+	v.SetDefault("server.maxUploadBodyBytes", DefaultConfig.ServerMaxUploadBodyBytes)
+	v.SetDefault("server.responseSizeWarningBytes", DefaultConfig.ServerResponseSizeWarningBytes)
+	v.SetDefault("server.requireContentType", DefaultConfig.ServerRequireContentType)
+	v.SetDefault("server.disabledOperations", DefaultConfig.ServerDisabledOperations)
+	v.SetDefault("server.rateLimit.enabled", DefaultConfig.ServerRateLimitEnabled)
+	v.SetDefault("server.rateLimit.requestsPerSecond", DefaultConfig.ServerRateLimitRequestsPerSecond)
+	v.SetDefault("server.rateLimit.burst", DefaultConfig.ServerRateLimitBurst)
+	v.SetDefault("server.rateLimit.scope", DefaultConfig.ServerRateLimitScope)
+	v.SetDefault("server.tls.certFile", "")
+	v.SetDefault("server.tls.keyFile", "")
 
 	// PostgreSQL defaults
 	v.SetDefault("postgres.host", "db")
@@ -1101,6 +1576,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("postgres.password", "admin123")
 	v.SetDefault("postgres.dbname", "basyxTestDB")
 	v.SetDefault("postgres.dsn", "")
+	v.SetDefault("postgres.replicaDsn", "")
 	v.SetDefault("postgres.sslmode", DefaultConfig.PgSSLMode)
 	v.SetDefault("postgres.sslcert", "")
 	v.SetDefault("postgres.sslkey", "")
@@ -1114,6 +1590,10 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("postgres.maxOpenConnections", 50)
 	v.SetDefault("postgres.maxIdleConnections", 50)
 	v.SetDefault("postgres.connMaxLifetimeMinutes", 5)
+	v.SetDefault("postgres.connectRetries", DefaultConfig.PgConnectRetries)
+	v.SetDefault("postgres.connectRetryIntervalSeconds", DefaultConfig.PgConnectRetryIntervalSeconds)
+	v.SetDefault("postgres.logQueries", DefaultConfig.PgLogQueries)
+	v.SetDefault("postgres.explainQueries", DefaultConfig.PgExplainQueries)
 
 	// CORS defaults
 	v.SetDefault("cors.allowedOrigins", []string{})
@@ -1129,10 +1609,13 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("abac.policyFileImport", DefaultConfig.ABACPolicyFileImport)
 	v.SetDefault("abac.policyScope", DefaultConfig.ABACPolicyScope)
 	v.SetDefault("abac.managementApi.enabled", DefaultConfig.ABACManagementAPIEnabled)
+	v.SetDefault("abac.enableDebugFilterHeader", DefaultConfig.ABACDebugFilterHeader)
+	v.SetDefault("abac.trustInternalCalls", DefaultConfig.ABACTrustInternalCalls)
 
 	// JWS defaults
 	v.SetDefault("jws.privateKeyPath", "")
 	v.SetDefault("jws.certificateChainPath", "")
+	v.SetDefault("jws.previousPublicKeyPaths", []string{})
 
 	// History/audit defaults
 	v.SetDefault("history.mode", "off")
@@ -1172,7 +1655,6 @@ func setDefaults(v *viper.Viper) {
 
 	// General defaults
 	v.SetDefault("general.enableImplicitCasts", true)
-	v.SetDefault("general.enableDescriptorDebug", false)
 	v.SetDefault("general.discoveryIntegration", false)
 	v.SetDefault("general.enableCustomMiddlewareHeaderInjection", false)
 	v.SetDefault("general.supportsSingularSupplementalSemanticId", false)
@@ -1187,8 +1669,29 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("general.aasxMaxPartExpandedSizeBytes", DefaultConfig.GeneralAASXMaxPartExpandedSizeBytes)
 	v.SetDefault("general.aasxMaxTotalExpandedSizeBytes", DefaultConfig.GeneralAASXMaxTotalExpandedSizeBytes)
 	v.SetDefault("general.aasxMaxThumbnailSizeBytes", DefaultConfig.GeneralAASXMaxThumbnailSizeBytes)
+	v.SetDefault("general.aasxImportAtomic", DefaultConfig.GeneralAASXImportAtomic)
+	v.SetDefault("general.submodelPutMode", DefaultConfig.GeneralSubmodelPutMode)
 	v.SetDefault("general.aasPreconfigPaths", []string{})
 	v.SetDefault("general.bulkBatchLimit", DefaultConfig.GeneralBulkBatchLimit)
+	v.SetDefault("general.queryMaxResults", DefaultConfig.GeneralQueryMaxResults)
+	v.SetDefault("general.queryMaxResultsBehavior", DefaultConfig.GeneralQueryMaxResultsBehavior)
+	v.SetDefault("general.maxSpecificAssetIds", DefaultConfig.GeneralMaxSpecificAssetIDs)
+	v.SetDefault("general.maxFieldPathDepth", DefaultConfig.GeneralMaxFieldPathDepth)
+	v.SetDefault("general.defaultPageLimit", DefaultConfig.GeneralDefaultPageLimit)
+	v.SetDefault("general.maxPageLimit", DefaultConfig.GeneralMaxPageLimit)
+	v.SetDefault("general.defaultSubmodelPageLimit", DefaultConfig.GeneralDefaultSubmodelPageLimit)
+	v.SetDefault("general.defaultSubmodelElementPageLimit", DefaultConfig.GeneralDefaultSMEPageLimit)
+	v.SetDefault("general.defaultQueryPageLimit", DefaultConfig.GeneralDefaultQueryPageLimit)
+	v.SetDefault("general.trimIdentifierWhitespace", DefaultConfig.GeneralTrimIdentifierWhitespace)
+	v.SetDefault("general.allowDuplicateSubmodelDescriptorIds", DefaultConfig.GeneralAllowDuplicateSMDescriptorIDs)
+	v.SetDefault("general.endpointReachability.enabled", DefaultConfig.GeneralEndpointReachabilityEnabled)
+	v.SetDefault("general.endpointReachability.intervalSeconds", DefaultConfig.GeneralEndpointReachabilityInterval)
+	v.SetDefault("general.endpointReachability.timeoutSeconds", DefaultConfig.GeneralEndpointReachabilityTimeout)
+	v.SetDefault("general.endpointReachability.allowPrivateNetworks", DefaultConfig.GeneralEndpointReachabilityPrivate)
+	v.SetDefault("general.semanticIdResolution.enabled", DefaultConfig.GeneralSemanticIDResolutionEnabled)
+	v.SetDefault("general.semanticIdResolution.submodelRegistryUrl", "")
+	v.SetDefault("general.semanticIdResolution.timeoutSeconds", DefaultConfig.GeneralSemanticIDResolutionTimeout)
+	v.SetDefault("general.semanticIdResolution.allowPrivateNetworks", DefaultConfig.GeneralSemanticIDResolutionPrivate)
 
 }
 
@@ -1241,11 +1744,25 @@ func PrintConfiguration(cfg *Config) {
 	add("Cache Enabled", cfg.Server.CacheEnabled, DefaultConfig.ServerCacheEnabled)
 	add("Verification Mode", cfg.Server.StrictVerification, DefaultConfig.ServerStrictVerification)
 	add("Verification Endpoint Available", cfg.Server.VerificationEndpointAvailable, DefaultConfig.ServerVerificationEndpointAvailable)
+	add("Strict Schema Validation", cfg.Server.StrictSchemaValidation, DefaultConfig.ServerStrictSchemaValidation)
 	add("Read Header Timeout (s)", cfg.Server.ReadHeaderTimeoutSeconds, DefaultConfig.ServerReadHeaderTimeoutSeconds)
 	add("Read Timeout (s)", cfg.Server.ReadTimeoutSeconds, DefaultConfig.ServerReadTimeoutSeconds)
 	add("Write Timeout (s)", cfg.Server.WriteTimeoutSeconds, DefaultConfig.ServerWriteTimeoutSeconds)
 	add("Idle Timeout (s)", cfg.Server.IdleTimeoutSeconds, DefaultConfig.ServerIdleTimeoutSeconds)
 	add("Shutdown Timeout (s)", cfg.Server.ShutdownTimeoutSeconds, DefaultConfig.ServerShutdownTimeoutSeconds)
+	add("Request Timeout (s)", cfg.Server.RequestTimeoutSeconds, DefaultConfig.ServerRequestTimeoutSeconds)
+	add("Max Concurrent Requests", cfg.Server.MaxConcurrentRequests, DefaultConfig.ServerMaxConcurrentRequests)
+	add("Max Request Body (bytes)", cfg.Server.MaxRequestBodyBytes, DefaultConfig.ServerMaxRequestBodyBytes)
+	add("Max Upload Body (bytes)", cfg.Server.MaxUploadBodyBytes, DefaultConfig.ServerMaxUploadBodyBytes)
+	add("Response Size Warning (bytes)", cfg.Server.ResponseSizeWarningBytes, DefaultConfig.ServerResponseSizeWarningBytes)
+	add("Require Content-Type", cfg.Server.RequireContentType, DefaultConfig.ServerRequireContentType)
+	add("Disabled Operations", cfg.Server.DisabledOperations, DefaultConfig.ServerDisabledOperations)
+	add("Rate Limit Enabled", cfg.Server.RateLimit.Enabled, DefaultConfig.ServerRateLimitEnabled)
+	add("Rate Limit Requests/s", cfg.Server.RateLimit.RequestsPerSecond, DefaultConfig.ServerRateLimitRequestsPerSecond)
+	add("Rate Limit Burst", cfg.Server.RateLimit.Burst, DefaultConfig.ServerRateLimitBurst)
+	add("Rate Limit Scope", cfg.Server.RateLimit.Scope, DefaultConfig.ServerRateLimitScope)
+	add("TLS Certificate", cfg.Server.TLS.CertFile, "")
+	add("TLS Key", cfg.Server.TLS.KeyFile, "")
 
 	lines = append(lines, divider)
 
@@ -1257,6 +1774,11 @@ func PrintConfiguration(cfg *Config) {
 	add("Max Open Connections", cfg.Postgres.MaxOpenConnections, DefaultConfig.PgMaxOpen)
 	add("Max Idle Connections", cfg.Postgres.MaxIdleConnections, DefaultConfig.PgMaxIdle)
 	add("Conn Max Lifetime (min)", cfg.Postgres.ConnMaxLifetimeMinutes, DefaultConfig.PgConnLifetime)
+	add("Connect Retries", cfg.Postgres.ConnectRetries, DefaultConfig.PgConnectRetries)
+	add("Connect Retry Interval (s)", cfg.Postgres.ConnectRetryIntervalSeconds, DefaultConfig.PgConnectRetryIntervalSeconds)
+	add("Log Queries", cfg.Postgres.LogQueries, DefaultConfig.PgLogQueries)
+	add("Explain Queries", cfg.Postgres.ExplainQueries, DefaultConfig.PgExplainQueries)
+	add("Read Replica Configured", strings.TrimSpace(cfg.Postgres.ReplicaDSN) != "", false)
 
 	lines = append(lines, divider)
 
@@ -1277,6 +1799,8 @@ func PrintConfiguration(cfg *Config) {
 		add("Policy File Import", cfg.ABAC.PolicyFileImport, DefaultConfig.ABACPolicyFileImport)
 		add("Policy Scope", cfg.ABAC.PolicyScope, DefaultConfig.ABACPolicyScope)
 		add("Management API Enabled", cfg.ABAC.ManagementAPI.Enabled, DefaultConfig.ABACManagementAPIEnabled)
+		add("Debug Filter Header", cfg.ABAC.EnableDebugFilterHeader, DefaultConfig.ABACDebugFilterHeader)
+		add("Trust Internal Calls", cfg.ABAC.TrustInternalCalls, DefaultConfig.ABACTrustInternalCalls)
 
 		lines = append(lines, "🔹 OIDC:")
 		add("Trustlist Path", cfg.OIDC.TrustlistPath, DefaultConfig.OIDCTrustlistPath)
@@ -1287,12 +1811,33 @@ func PrintConfiguration(cfg *Config) {
 	// General
 	lines = append(lines, "General:")
 	add("Bulk Batch Limit", cfg.General.BulkBatchLimit, DefaultConfig.GeneralBulkBatchLimit)
+	add("Query Max Results", cfg.General.QueryMaxResults, DefaultConfig.GeneralQueryMaxResults)
+	add("Query Max Results Behavior", cfg.General.QueryMaxResultsBehavior, DefaultConfig.GeneralQueryMaxResultsBehavior)
+	add("Max Specific Asset IDs", cfg.General.MaxSpecificAssetIDs, DefaultConfig.GeneralMaxSpecificAssetIDs)
+	add("Max Field Path Depth", cfg.General.MaxFieldPathDepth, DefaultConfig.GeneralMaxFieldPathDepth)
+	add("Default Page Limit", cfg.General.DefaultPageLimit, DefaultConfig.GeneralDefaultPageLimit)
+	add("Max Page Limit", cfg.General.MaxPageLimit, DefaultConfig.GeneralMaxPageLimit)
+	add("Default Submodel Page Limit", cfg.General.DefaultSubmodelPageLimit, DefaultConfig.GeneralDefaultSubmodelPageLimit)
+	add("Default Submodel Element Page Limit", cfg.General.DefaultSubmodelElementPageLimit, DefaultConfig.GeneralDefaultSMEPageLimit)
+	add("Default Query Page Limit", cfg.General.DefaultQueryPageLimit, DefaultConfig.GeneralDefaultQueryPageLimit)
+	add("Trim Identifier Whitespace", cfg.General.TrimIdentifierWhitespace, DefaultConfig.GeneralTrimIdentifierWhitespace)
+	add("Allow Duplicate SM Descriptor IDs", cfg.General.AllowDuplicateSubmodelDescriptorIds, DefaultConfig.GeneralAllowDuplicateSMDescriptorIDs)
 	add("Upload Max Size (bytes)", cfg.General.UploadMaxSizeBytes, DefaultConfig.GeneralUploadMaxSizeBytes)
 	add("AASX Max Part Count", cfg.General.AASXMaxPartCount, DefaultConfig.GeneralAASXMaxPartCount)
 	add("AASX Max OPC Metadata Size (bytes)", cfg.General.AASXMaxOPCMetadataSizeBytes, DefaultConfig.GeneralAASXMaxOPCMetadataSizeBytes)
 	add("AASX Max Part Expanded Size (bytes)", cfg.General.AASXMaxPartExpandedSizeBytes, DefaultConfig.GeneralAASXMaxPartExpandedSizeBytes)
 	add("AASX Max Total Expanded Size (bytes)", cfg.General.AASXMaxTotalExpandedSizeBytes, DefaultConfig.GeneralAASXMaxTotalExpandedSizeBytes)
 	add("AASX Max Thumbnail Size (bytes)", cfg.General.AASXMaxThumbnailSizeBytes, DefaultConfig.GeneralAASXMaxThumbnailSizeBytes)
+	add("AASX Import Atomic", cfg.General.AASXImportAtomic, DefaultConfig.GeneralAASXImportAtomic)
+	add("Submodel PUT Mode", cfg.General.SubmodelPutMode, DefaultConfig.GeneralSubmodelPutMode)
+	add("Endpoint Reachability Enabled", cfg.General.EndpointReachability.Enabled, DefaultConfig.GeneralEndpointReachabilityEnabled)
+	add("Endpoint Reachability Interval (s)", cfg.General.EndpointReachability.IntervalSeconds, DefaultConfig.GeneralEndpointReachabilityInterval)
+	add("Endpoint Reachability Timeout (s)", cfg.General.EndpointReachability.TimeoutSeconds, DefaultConfig.GeneralEndpointReachabilityTimeout)
+	add("Endpoint Reachability Private Networks", cfg.General.EndpointReachability.AllowPrivateNetworks, DefaultConfig.GeneralEndpointReachabilityPrivate)
+	add("SemanticId Resolution Enabled", cfg.General.SemanticIDResolution.Enabled, DefaultConfig.GeneralSemanticIDResolutionEnabled)
+	add("SemanticId Resolution Registry", cfg.General.SemanticIDResolution.SubmodelRegistryURL, "")
+	add("SemanticId Resolution Timeout (s)", cfg.General.SemanticIDResolution.TimeoutSeconds, DefaultConfig.GeneralSemanticIDResolutionTimeout)
+	add("SemanticId Resolution Private Networks", cfg.General.SemanticIDResolution.AllowPrivateNetworks, DefaultConfig.GeneralSemanticIDResolutionPrivate)
 
 	lines = append(lines, divider)
 
@@ -1318,6 +1863,9 @@ func PrintConfiguration(cfg *Config) {
 			lines = append(lines, "  Certificate Chain Mounted: false ❌")
 		}
 	}
+	for _, path := range cfg.JWS.PreviousPublicKeyPaths {
+		lines = append(lines, fmt.Sprintf("  Previous Public Key Path: %s", path))
+	}
 
 	lines = append(lines, divider)
 
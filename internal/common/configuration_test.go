@@ -32,6 +32,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -82,6 +83,18 @@ func captureLogOutput(t *testing.T) *bytes.Buffer {
 	return &output
 }
 
+func TestLoadConfigWarnsAboutDeprecatedDescriptorDebugKey(t *testing.T) {
+	output := captureLogOutput(t)
+	t.Setenv("GENERAL_ENABLEDESCRIPTORDEBUG", "true")
+
+	if _, err := LoadConfig("", NORMAL); err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if !strings.Contains(output.String(), "general.enableDescriptorDebug is deprecated") {
+		t.Fatalf("expected deprecation warning, got %q", output.String())
+	}
+}
+
 func TestServerStrictVerificationDefaultIsPermissive(t *testing.T) {
 	withUnsetEnv(t, "SERVER_STRICTVERIFICATION")
 	captureLogOutput(t)
@@ -174,6 +187,122 @@ func TestBulkBatchLimitRejectsNonPositiveValues(t *testing.T) {
 	}
 }
 
+func TestExplainQueriesRequiresLogQueries(t *testing.T) {
+	withUnsetEnv(t, "POSTGRES_LOGQUERIES")
+	captureLogOutput(t)
+	t.Setenv("POSTGRES_EXPLAINQUERIES", "true")
+
+	_, err := LoadConfig("", NORMAL)
+	if err == nil || !strings.Contains(err.Error(), "CONFIG-POSTGRES-EXPLAINQUERIES") {
+		t.Fatalf("expected CONFIG-POSTGRES-EXPLAINQUERIES error, got %v", err)
+	}
+
+	t.Setenv("POSTGRES_LOGQUERIES", "true")
+	cfg, err := LoadConfig("", NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if !cfg.Postgres.ExplainQueries || !cfg.Postgres.LogQueries {
+		t.Fatalf("expected explainQueries and logQueries to be enabled, got %+v", cfg.Postgres)
+	}
+}
+
+func TestMaxSpecificAssetIDsDefaultsAndRejectsNegativeValues(t *testing.T) {
+	for _, key := range []string{"GENERAL_MAX_SPECIFIC_ASSET_IDS", "BASYX_GENERAL_MAX_SPECIFIC_ASSET_IDS"} {
+		withUnsetEnv(t, key)
+	}
+	captureLogOutput(t)
+
+	cfg, err := LoadConfig("", NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if cfg.General.MaxSpecificAssetIDs != 1000 {
+		t.Fatalf("expected maxSpecificAssetIds default 1000, got %d", cfg.General.MaxSpecificAssetIDs)
+	}
+	if actual := MaxSpecificAssetIDsFromContext(context.Background()); actual != 1000 {
+		t.Fatalf("expected context fallback maxSpecificAssetIds 1000, got %d", actual)
+	}
+
+	t.Setenv("GENERAL_MAX_SPECIFIC_ASSET_IDS", "-1")
+	_, err = LoadConfig("", NORMAL)
+	if err == nil {
+		t.Fatal("expected config load error for negative maxSpecificAssetIds")
+	}
+	if !strings.Contains(err.Error(), "CONFIG-GENERAL-MAXSPECIFICASSETIDS") {
+		t.Fatalf("expected CONFIG-GENERAL-MAXSPECIFICASSETIDS error, got %v", err)
+	}
+}
+
+func TestMaxFieldPathDepthEnvOverrideAndValidation(t *testing.T) {
+	for _, key := range []string{"GENERAL_MAX_FIELD_PATH_DEPTH", "BASYX_GENERAL_MAX_FIELD_PATH_DEPTH"} {
+		withUnsetEnv(t, key)
+	}
+	captureLogOutput(t)
+
+	cfg, err := LoadConfig("", NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if cfg.General.MaxFieldPathDepth != 32 {
+		t.Fatalf("expected maxFieldPathDepth default 32, got %d", cfg.General.MaxFieldPathDepth)
+	}
+
+	t.Setenv("BASYX_GENERAL_MAX_FIELD_PATH_DEPTH", "8")
+	cfg, err = LoadConfig("", NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if actual := MaxFieldPathDepthFromContext(ContextWithConfig(context.Background(), cfg)); actual != 8 {
+		t.Fatalf("expected maxFieldPathDepth 8 from env, got %d", actual)
+	}
+
+	t.Setenv("GENERAL_MAX_FIELD_PATH_DEPTH", "0")
+	_, err = LoadConfig("", NORMAL)
+	if err == nil || !strings.Contains(err.Error(), "CONFIG-GENERAL-MAXFIELDPATHDEPTH") {
+		t.Fatalf("expected CONFIG-GENERAL-MAXFIELDPATHDEPTH error, got %v", err)
+	}
+}
+
+func TestPageLimitsDefaultAndRejectInconsistentValues(t *testing.T) {
+	for _, key := range []string{
+		"GENERAL_DEFAULT_PAGE_LIMIT", "BASYX_GENERAL_DEFAULT_PAGE_LIMIT",
+		"GENERAL_MAX_PAGE_LIMIT", "BASYX_GENERAL_MAX_PAGE_LIMIT",
+	} {
+		withUnsetEnv(t, key)
+	}
+	captureLogOutput(t)
+
+	cfg, err := LoadConfig("", NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if cfg.General.DefaultPageLimit != 100 || cfg.General.MaxPageLimit != 1000 {
+		t.Fatalf("expected page limit defaults 100/1000, got %d/%d", cfg.General.DefaultPageLimit, cfg.General.MaxPageLimit)
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		wantCode string
+	}{
+		{name: "zero default", env: map[string]string{"GENERAL_DEFAULT_PAGE_LIMIT": "0"}, wantCode: "CONFIG-GENERAL-DEFAULTPAGELIMIT"},
+		{name: "negative max", env: map[string]string{"GENERAL_MAX_PAGE_LIMIT": "-1"}, wantCode: "CONFIG-GENERAL-MAXPAGELIMIT"},
+		{name: "default above max", env: map[string]string{"GENERAL_DEFAULT_PAGE_LIMIT": "50", "GENERAL_MAX_PAGE_LIMIT": "10"}, wantCode: "CONFIG-GENERAL-DEFAULTPAGELIMIT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			_, err := LoadConfig("", NORMAL)
+			if err == nil || !strings.Contains(err.Error(), tt.wantCode) {
+				t.Fatalf("expected %s error, got %v", tt.wantCode, err)
+			}
+		})
+	}
+}
+
 func TestPrintConfigurationMarksPermissiveVerificationModeAsDefault(t *testing.T) {
 	output := captureLogOutput(t)
 	cfg := &Config{
@@ -438,6 +567,29 @@ func TestLoadConfigRejectsNegativePostgresConnectTimeout(t *testing.T) {
 	}
 }
 
+func TestLoadConfigValidatesPostgresConnectRetry(t *testing.T) {
+	captureLogOutput(t)
+	path := writeTempConfig(t, "postgres:\n  host: db\n")
+	cfg, err := LoadConfig(path, NORMAL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	retry := DatabaseConnectRetryFromConfig(cfg.Postgres)
+	if retry.Retries != DefaultConfig.PgConnectRetries || retry.Interval != time.Duration(DefaultConfig.PgConnectRetryIntervalSeconds)*time.Second {
+		t.Fatalf("unexpected default connect retry %+v", retry)
+	}
+
+	for content, code := range map[string]string{
+		"postgres:\n  connectRetries: -1\n":                                  "CONFIG-POSTGRES-CONNECTRETRIES",
+		"postgres:\n  connectRetries: 3\n  connectRetryIntervalSeconds: 0\n": "CONFIG-POSTGRES-CONNECTRETRYINTERVAL",
+	} {
+		_, err = LoadConfig(writeTempConfig(t, content), NORMAL)
+		if err == nil || !strings.Contains(err.Error(), code) {
+			t.Fatalf("expected %s, got %v", code, err)
+		}
+	}
+}
+
 func TestLoadConfigAppliesHistoryAndEventingDefaults(t *testing.T) {
 	withUnsetEnv(t, "BASYX_HISTORY_MODE")
 	withUnsetEnv(t, "BASYX_HISTORY_RETENTION_DAYS")
@@ -678,6 +830,9 @@ func TestValidateHistoryAndEventingConfigAcceptsDiffBackedSnapshotInterval(t *te
 func TestValidateGeneralConfigAASXLimits(t *testing.T) {
 	valid := GeneralConfig{
 		BulkBatchLimit:                1000,
+		QueryMaxResults:               1000,
+		MaxFieldPathDepth:             32,
+		DefaultPageLimit:              100,
 		UploadMaxSizeBytes:            128 << 20,
 		AASXMaxPartCount:              10000,
 		AASXMaxOPCMetadataSizeBytes:   16 << 20,
@@ -693,6 +848,8 @@ func TestValidateGeneralConfigAASXLimits(t *testing.T) {
 		name   string
 		mutate func(*GeneralConfig)
 	}{
+		{name: "query max results", mutate: func(cfg *GeneralConfig) { cfg.QueryMaxResults = 0 }},
+		{name: "field path depth", mutate: func(cfg *GeneralConfig) { cfg.MaxFieldPathDepth = 0 }},
 		{name: "part count", mutate: func(cfg *GeneralConfig) { cfg.AASXMaxPartCount = 0 }},
 		{name: "OPC metadata", mutate: func(cfg *GeneralConfig) { cfg.AASXMaxOPCMetadataSizeBytes = 0 }},
 		{name: "part size", mutate: func(cfg *GeneralConfig) { cfg.AASXMaxPartExpandedSizeBytes = 0 }},
@@ -710,6 +867,27 @@ func TestValidateGeneralConfigAASXLimits(t *testing.T) {
 	}
 }
 
+func TestValidateSemanticIDResolutionConfig(t *testing.T) {
+	if err := validateSemanticIDResolutionConfig(SemanticIDResolutionConfig{}); err != nil {
+		t.Fatalf("expected disabled resolution to be valid, got %v", err)
+	}
+	valid := SemanticIDResolutionConfig{Enabled: true, SubmodelRegistryURL: "http://submodel-registry:8080", TimeoutSeconds: 10}
+	if err := validateSemanticIDResolutionConfig(valid); err != nil {
+		t.Fatalf("expected valid resolution config, got %v", err)
+	}
+
+	relative := valid
+	relative.SubmodelRegistryURL = "submodel-registry:8080"
+	if err := validateSemanticIDResolutionConfig(relative); err == nil || !strings.Contains(err.Error(), "CONFIG-GENERAL-SEMANTICIDREGISTRYURL") {
+		t.Fatalf("expected CONFIG-GENERAL-SEMANTICIDREGISTRYURL, got %v", err)
+	}
+	noTimeout := valid
+	noTimeout.TimeoutSeconds = 0
+	if err := validateSemanticIDResolutionConfig(noTimeout); err == nil || !strings.Contains(err.Error(), "CONFIG-GENERAL-SEMANTICIDTIMEOUT") {
+		t.Fatalf("expected CONFIG-GENERAL-SEMANTICIDTIMEOUT, got %v", err)
+	}
+}
+
 func TestValidateHistoryAndEventingConfigAcceptsCompleteS3EvidenceConfig(t *testing.T) {
 	cfg := Config{
 		JWS: JWSConfig{PrivateKeyPath: "fallback-key.pem"},
@@ -759,6 +937,17 @@ func TestValidateHistoryAndEventingConfigAcceptsEvidenceWithHistoryOff(t *testin
 	}
 }
 
+func TestValidateHistoryAndEventingConfigAcceptsLogEventSink(t *testing.T) {
+	cfg := Config{
+		History:  HistoryConfig{Mode: "off", FullSnapshotInterval: 1, Immutability: "none", AuditIdentityMode: "none"},
+		Eventing: EventingConfig{Enabled: true, Sinks: []string{" LOG "}},
+	}
+
+	if err := validateHistoryAndEventingConfig(&cfg); err != nil {
+		t.Fatalf("expected log sink to be accepted, got %v", err)
+	}
+}
+
 func TestValidateHistoryAndEventingConfigRejectsUnsupportedFeatures(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -820,6 +1009,14 @@ func TestValidateHistoryAndEventingConfigRejectsUnsupportedFeatures(t *testing.T
 			name:   "eventing",
 			config: Config{History: HistoryConfig{Mode: "off", FullSnapshotInterval: 1, Immutability: "none", AuditIdentityMode: "none"}, Eventing: EventingConfig{Enabled: true}},
 		},
+		{
+			name:   "eventing broker sink",
+			config: Config{History: HistoryConfig{Mode: "off", FullSnapshotInterval: 1, Immutability: "none", AuditIdentityMode: "none"}, Eventing: EventingConfig{Enabled: true, Sinks: []string{"kafka"}}},
+		},
+		{
+			name:   "eventing outbox",
+			config: Config{History: HistoryConfig{Mode: "off", FullSnapshotInterval: 1, Immutability: "none", AuditIdentityMode: "none"}, Eventing: EventingConfig{Enabled: true, Sinks: []string{EventingSinkLog}, OutboxEnabled: true}},
+		},
 	}
 
 	for _, test := range tests {
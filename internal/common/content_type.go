@@ -0,0 +1,116 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+package common
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// JSONContentTypeMiddleware rejects POST, PUT and PATCH requests whose body is
+// not announced as JSON with a standardized 415 before the handler decodes it.
+// JSON media types are application/json and every "+json" structured syntax
+// suffix such as application/merge-patch+json.
+//
+// Binary upload routes (see isUploadRoute) pass unchanged and are left to the
+// multipart check of their handlers. The exemption is decided by route, the
+// same way RequestBodyLimitMiddleware picks the upload limit, so a multipart
+// body sent to a JSON endpoint is still rejected. Requests without a body or
+// without a Content-Type header pass, so clients that never set the header
+// keep working.
+//
+// Parameters:
+//   - next: Handler that serves accepted requests.
+//
+// Returns:
+//   - http.Handler: next wrapped with the Content-Type check.
+func JSONContentTypeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := strings.TrimSpace(r.Header.Get("Content-Type"))
+		if !hasRequestBody(r) || contentType == "" || isJSONMediaType(contentType) || isUploadRoute(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		_ = WriteErrorResponse(
+			w,
+			errors.New("COMMON-CONTENTTYPE-UNSUPPORTED Content-Type '"+contentType+"' is not supported; use application/json"),
+			http.StatusUnsupportedMediaType,
+			"HTTPServer",
+			"JSONContentType",
+			"Unsupported",
+		)
+	})
+}
+
+// RequireContentTypeMiddleware rejects POST, PUT and PATCH requests that carry
+// a body without a Content-Type header with a standardized 415. It complements
+// JSONContentTypeMiddleware, which lets such requests pass for compatibility.
+// A required value of false returns next unchanged.
+//
+// Parameters:
+//   - required: Whether a Content-Type header is mandatory for request bodies.
+//   - next: Handler that serves accepted requests.
+//
+// Returns:
+//   - http.Handler: next wrapped with the header check.
+func RequireContentTypeMiddleware(required bool, next http.Handler) http.Handler {
+	if !required || next == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !hasRequestBody(r) || strings.TrimSpace(r.Header.Get("Content-Type")) != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		_ = WriteErrorResponse(
+			w,
+			errors.New("COMMON-CONTENTTYPE-MISSING request body without Content-Type header; use application/json"),
+			http.StatusUnsupportedMediaType,
+			"HTTPServer",
+			"RequireContentType",
+			"Missing",
+		)
+	})
+}
+
+func hasRequestBody(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return false
+	}
+	return r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
+
+func isJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	mediaType = strings.ToLower(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
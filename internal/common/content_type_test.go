@@ -0,0 +1,148 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJSONContentTypeMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		body        string
+		contentType string
+		want        int
+	}{
+		{name: "json", method: http.MethodPost, body: `{}`, contentType: "application/json", want: http.StatusCreated},
+		{name: "json with charset", method: http.MethodPut, body: `{}`, contentType: "Application/JSON; charset=utf-8", want: http.StatusCreated},
+		{name: "merge patch", method: http.MethodPatch, body: `{}`, contentType: "application/merge-patch+json", want: http.StatusCreated},
+		{name: "missing header", method: http.MethodPost, body: `{}`, want: http.StatusCreated},
+		{name: "multipart on a JSON route", method: http.MethodPut, body: `{}`, contentType: "multipart/form-data; boundary=x", want: http.StatusUnsupportedMediaType},
+		{name: "octet-stream on a JSON route", method: http.MethodPost, body: `{}`, contentType: "application/octet-stream", want: http.StatusUnsupportedMediaType},
+		{name: "empty body", method: http.MethodPost, contentType: "text/plain", want: http.StatusCreated},
+		{name: "read request", method: http.MethodGet, body: `{}`, contentType: "text/plain", want: http.StatusCreated},
+		{name: "text/plain", method: http.MethodPost, body: `{}`, contentType: "text/plain", want: http.StatusUnsupportedMediaType},
+		{name: "xml", method: http.MethodPut, body: `<x/>`, contentType: "application/xml", want: http.StatusUnsupportedMediaType},
+		{name: "form", method: http.MethodPatch, body: `a=b`, contentType: "application/x-www-form-urlencoded", want: http.StatusUnsupportedMediaType},
+		{name: "malformed", method: http.MethodPost, body: `{}`, contentType: "application/json; =", want: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := JSONContentTypeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+			request := httptest.NewRequest(tt.method, "/submodels", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				request.Header.Set("Content-Type", tt.contentType)
+			}
+			response := httptest.NewRecorder()
+
+			handler.ServeHTTP(response, request)
+
+			if response.Code != tt.want {
+				t.Fatalf("expected status %d, got %d body=%s", tt.want, response.Code, response.Body.String())
+			}
+			if tt.want == http.StatusUnsupportedMediaType && !strings.Contains(response.Body.String(), "COMMON-CONTENTTYPE-UNSUPPORTED") {
+				t.Fatalf("expected coded error body, got %s", response.Body.String())
+			}
+		})
+	}
+}
+
+func TestJSONContentTypeMiddlewareLeavesUploadRoutesToTheirHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{name: "attachment upload", method: http.MethodPut, path: "/submodels/c20=/submodel-elements/file/attachment", want: http.StatusCreated},
+		{name: "thumbnail upload", method: http.MethodPut, path: "/shells/YWFz/asset-information/thumbnail", want: http.StatusCreated},
+		{name: "JSON route", method: http.MethodPut, path: "/submodels/c20=", want: http.StatusUnsupportedMediaType},
+		{name: "attachment path with wrong method", method: http.MethodPost, path: "/submodels/c20=/submodel-elements/file/attachment", want: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := JSONContentTypeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+			request := httptest.NewRequest(tt.method, tt.path, strings.NewReader("--x--"))
+			request.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+			response := httptest.NewRecorder()
+
+			handler.ServeHTTP(response, request)
+
+			if response.Code != tt.want {
+				t.Fatalf("expected status %d, got %d body=%s", tt.want, response.Code, response.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequireContentTypeMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		required    bool
+		method      string
+		body        string
+		contentType string
+		want        int
+	}{
+		{name: "disabled passes missing header", method: http.MethodPost, body: `{}`, want: http.StatusCreated},
+		{name: "missing header", required: true, method: http.MethodPost, body: `{}`, want: http.StatusUnsupportedMediaType},
+		{name: "json", required: true, method: http.MethodPut, body: `{}`, contentType: "application/json", want: http.StatusCreated},
+		{name: "upload", required: true, method: http.MethodPost, body: `x`, contentType: "multipart/form-data; boundary=x", want: http.StatusCreated},
+		{name: "empty body", required: true, method: http.MethodPost, want: http.StatusCreated},
+		{name: "read request", required: true, method: http.MethodGet, body: `{}`, want: http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequireContentTypeMiddleware(tt.required, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+			request := httptest.NewRequest(tt.method, "/submodels", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				request.Header.Set("Content-Type", tt.contentType)
+			}
+			response := httptest.NewRecorder()
+
+			handler.ServeHTTP(response, request)
+
+			if response.Code != tt.want {
+				t.Fatalf("expected status %d, got %d body=%s", tt.want, response.Code, response.Body.String())
+			}
+			if tt.want == http.StatusUnsupportedMediaType && !strings.Contains(response.Body.String(), "COMMON-CONTENTTYPE-MISSING") {
+				t.Fatalf("expected coded error body, got %s", response.Body.String())
+			}
+		})
+	}
+}
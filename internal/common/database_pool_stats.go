@@ -0,0 +1,96 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+)
+
+// DatabasePoolStats is the JSON view of sql.DBStats for one connection pool.
+type DatabasePoolStats struct {
+	Name               string `json:"name"`
+	MaxOpenConnections int    `json:"maxOpenConnections"`
+	OpenConnections    int    `json:"openConnections"`
+	InUse              int    `json:"inUse"`
+	Idle               int    `json:"idle"`
+	WaitCount          int64  `json:"waitCount"`
+	WaitDurationMillis int64  `json:"waitDurationMillis"`
+	MaxIdleClosed      int64  `json:"maxIdleClosed"`
+	MaxIdleTimeClosed  int64  `json:"maxIdleTimeClosed"`
+	MaxLifetimeClosed  int64  `json:"maxLifetimeClosed"`
+}
+
+// NewDatabasePoolStats converts sql.DBStats into its JSON representation.
+func NewDatabasePoolStats(name string, stats sql.DBStats) DatabasePoolStats {
+	return DatabasePoolStats{
+		Name:               name,
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMillis: stats.WaitDuration.Milliseconds(),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	}
+}
+
+// DatabasePoolStatsHandler returns live connection-pool statistics for the
+// given databases, sorted by name. The handler performs no authorization of
+// its own; callers must mount it behind the management API protection.
+func DatabasePoolStatsHandler(databases map[string]*sql.DB) http.HandlerFunc {
+	names := make([]string, 0, len(databases))
+	for name, db := range databases {
+		if db != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return func(w http.ResponseWriter, _ *http.Request) {
+		stats := make([]DatabasePoolStats, 0, len(names))
+		for _, name := range names {
+			stats = append(stats, NewDatabasePoolStats(name, databases[name].Stats()))
+		}
+
+		payload, err := json.Marshal(stats)
+		if err != nil {
+			_ = WriteErrorResponse(w, fmt.Errorf("COMMON-DBPOOLSTATS-MARSHAL %w", err), http.StatusInternalServerError, "COMMON", "DatabasePoolStats", "MarshalResponse")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err = w.Write(payload); err != nil {
+			log.Printf("COMMON-DBPOOLSTATS-WRITE response write failed: %v", err)
+		}
+	}
+}
@@ -26,9 +26,16 @@
 package common
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func TestValidateSchemaVersion(t *testing.T) {
@@ -118,3 +125,173 @@ func TestValidateSchemaVersion(t *testing.T) {
 		}
 	})
 }
+
+func TestApplyDefaultSessionTimeZone(t *testing.T) {
+	params := map[string]string{"application_name": "basyx"}
+	applyDefaultSessionTimeZone(params)
+	if params["TimeZone"] != "UTC" {
+		t.Fatalf("expected UTC session time zone, got %v", params)
+	}
+
+	configured := map[string]string{"timezone": "Europe/Berlin"}
+	applyDefaultSessionTimeZone(configured)
+	if len(configured) != 1 || configured["timezone"] != "Europe/Berlin" {
+		t.Fatalf("expected configured time zone to be kept, got %v", configured)
+	}
+}
+
+func TestTimestamptzRoundTripIsUTCRegardlessOfLocalTimeZone(t *testing.T) {
+	previousLocal := time.Local
+	time.Local = time.FixedZone("UTC+5:30", 5*60*60+30*60)
+	t.Cleanup(func() { time.Local = previousLocal })
+
+	written := time.Date(2026, time.March, 1, 23, 30, 0, 0, time.FixedZone("UTC-8", -8*60*60))
+
+	typeMap := pgtype.NewMap()
+	registerUTCTimestamptz(typeMap)
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		encoded, err := typeMap.Encode(pgtype.TimestamptzOID, format, written, nil)
+		if err != nil {
+			t.Fatalf("encode timestamptz: %v", err)
+		}
+
+		var scanned pgtype.Timestamptz
+		if err := typeMap.Scan(pgtype.TimestamptzOID, format, encoded, &scanned); err != nil {
+			t.Fatalf("scan timestamptz: %v", err)
+		}
+		read := scanned.Time
+		if read.Location() != time.UTC {
+			t.Fatalf("format %d: expected UTC location, got %s", format, read.Location())
+		}
+		if !read.Equal(written) {
+			t.Fatalf("format %d: expected instant %s, got %s", format, written, read)
+		}
+		if got := read.Format(time.RFC3339); got != "2026-03-02T07:30:00Z" {
+			t.Fatalf("format %d: expected RFC3339 UTC serialization, got %s", format, got)
+		}
+	}
+}
+
+func TestNewDatabaseConnectionWithRetryWaitsForDelayedListener(t *testing.T) {
+	reserved, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("reserve port: %v", err)
+	}
+	address := reserved.Addr().String()
+	_ = reserved.Close()
+
+	const startupDelay = 300 * time.Millisecond
+	listening := make(chan net.Listener, 1)
+	go func() {
+		time.Sleep(startupDelay)
+		listener, listenErr := net.Listen("tcp", address)
+		if listenErr != nil {
+			close(listening)
+			return
+		}
+		listening <- listener
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+			go serveFakePostgres(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		if listener, ok := <-listening; ok {
+			_ = listener.Close()
+		}
+	})
+
+	started := time.Now()
+	dsn := fmt.Sprintf("postgres://basyx:basyx@%s/basyx?sslmode=disable", address)
+	db, err := NewDatabaseConnectionWithRetry(context.Background(), dsn, DatabaseConnectRetry{Retries: 10, Interval: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("expected connection within the retry window, got %v", err)
+	}
+	_ = db.Close()
+	if elapsed := time.Since(started); elapsed < startupDelay {
+		t.Fatalf("expected to wait for the delayed listener, connected after %s", elapsed)
+	}
+}
+
+func TestNewDatabaseConnectionWithRetryGivesUp(t *testing.T) {
+	reserved, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("reserve port: %v", err)
+	}
+	address := reserved.Addr().String()
+	_ = reserved.Close()
+
+	attempts := 0
+	err = retryDatabaseConnect(context.Background(), DatabaseConnectRetry{Retries: 2, Interval: time.Millisecond}, func() error {
+		attempts++
+		conn, dialErr := net.Dial("tcp", address)
+		if dialErr == nil {
+			_ = conn.Close()
+		}
+		return dialErr
+	})
+	if err == nil {
+		t.Fatal("expected error once retries are used up, got nil")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected first attempt plus 2 retries, got %d attempts", attempts)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = retryDatabaseConnect(ctx, DatabaseConnectRetry{Retries: 5, Interval: time.Hour}, func() error {
+		return errors.New("connection refused")
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled wait, got %v", err)
+	}
+}
+
+// serveFakePostgres accepts any startup, answers queries such as the ping
+// with an empty result and ends on Terminate.
+func serveFakePostgres(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	backend := pgproto3.NewBackend(conn, conn)
+	startup, err := backend.ReceiveStartupMessage()
+	if err != nil {
+		return
+	}
+	if _, ok := startup.(*pgproto3.SSLRequest); ok {
+		if _, err = conn.Write([]byte("N")); err != nil {
+			return
+		}
+		if _, err = backend.ReceiveStartupMessage(); err != nil {
+			return
+		}
+	}
+
+	backend.Send(&pgproto3.AuthenticationOk{})
+	backend.Send(&pgproto3.BackendKeyData{ProcessID: 1, SecretKey: []byte{0, 0, 0, 1}})
+	backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	if err = backend.Flush(); err != nil {
+		return
+	}
+
+	for {
+		message, receiveErr := backend.Receive()
+		if receiveErr != nil {
+			return
+		}
+		switch message.(type) {
+		case *pgproto3.Query:
+			backend.Send(&pgproto3.EmptyQueryResponse{})
+			backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+			if err = backend.Flush(); err != nil {
+				return
+			}
+		case *pgproto3.Terminate:
+			return
+		}
+	}
+}
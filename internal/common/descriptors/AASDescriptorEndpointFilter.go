@@ -0,0 +1,50 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import "github.com/FriedJannik/aas-go-sdk/types"
+
+// AASDescriptorEndpointFilter restricts AAS descriptor listings by the
+// endpoints and asset identifiers of a descriptor. The zero value applies no
+// restriction.
+type AASDescriptorEndpointFilter struct {
+	// Interface keeps descriptors exposing at least one endpoint with this
+	// interface. Combined with Protocol, both must hold for the same endpoint.
+	Interface string
+	// Protocol keeps descriptors exposing at least one endpoint with this
+	// protocol.
+	Protocol string
+	// Reachable keeps descriptors with (true) or without (false) an endpoint
+	// that answered the last reachability probe; nil disables the condition.
+	Reachable *bool
+	// AssetIDs keeps descriptors matching every given asset identifier. A
+	// globalAssetId entry matches the descriptor's globalAssetId, any other
+	// entry matches a specificAssetId with the same name and value. Entries
+	// without an externalSubjectId match scoped and unscoped specificAssetIds
+	// alike; entries with one only match specificAssetIds scoped to the same
+	// subject.
+	AssetIDs []types.ISpecificAssetID
+}
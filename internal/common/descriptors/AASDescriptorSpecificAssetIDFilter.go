@@ -0,0 +1,95 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import (
+	"fmt"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+// applySpecificAssetIDFilter restricts the page query according to
+// filter.AssetIDs. Every entry becomes its own EXISTS condition, so the entries
+// are combined with AND and a descriptor holding several matching
+// specificAssetIds is still counted once by the peek limit.
+func applySpecificAssetIDFilter(ds *goqu.SelectDataset, filter AASDescriptorEndpointFilter) *goqu.SelectDataset {
+	d := goqu.Dialect(common.Dialect)
+	for index, assetID := range filter.AssetIDs {
+		if assetID == nil {
+			continue
+		}
+		if assetID.Name() == common.GlobalAssetIDAssetLinkName {
+			ds = ds.Where(common.TAASDescriptor.Col(common.ColGlobalAssetID).Eq(assetID.Value()))
+			continue
+		}
+		alias := fmt.Sprintf("specific_asset_id_filter_%d", index)
+		specificAssetID := goqu.T(common.TblSpecificAssetID).As(alias)
+		conditions := []exp.Expression{
+			specificAssetID.Col(common.ColDescriptorID).Eq(common.TDescriptor.Col(common.ColID)),
+			specificAssetID.Col(common.ColName).Eq(assetID.Name()),
+			specificAssetID.Col(common.ColValue).Eq(assetID.Value()),
+		}
+		if externalSubjectID := assetID.ExternalSubjectID(); externalSubjectID != nil {
+			conditions = append(conditions, externalSubjectIDMatchCondition(specificAssetID, alias, externalSubjectID))
+		}
+		ds = ds.Where(goqu.L("EXISTS ?", d.From(specificAssetID).Select(goqu.L("1")).Where(conditions...)))
+	}
+	return ds
+}
+
+// externalSubjectIDMatchCondition requires the specificAssetId aliased as
+// specificAssetID to carry an externalSubjectId with the same type and the
+// same keys in the same order as reference.
+func externalSubjectIDMatchCondition(specificAssetID exp.AliasedExpression, alias string, reference types.IReference) exp.Expression {
+	d := goqu.Dialect(common.Dialect)
+	referenceTable := goqu.T("specific_asset_id_external_subject_id_reference").As(alias + "_ref")
+	keyTable := goqu.T("specific_asset_id_external_subject_id_reference_key")
+
+	conditions := []exp.Expression{
+		referenceTable.Col(common.ColID).Eq(specificAssetID.Col(common.ColID)),
+		referenceTable.Col(common.ColType).Eq(reference.Type()),
+	}
+	keys := reference.Keys()
+	for position, key := range keys {
+		keyAlias := keyTable.As(fmt.Sprintf("%s_key_%d", alias, position))
+		conditions = append(conditions, goqu.L("EXISTS ?", d.From(keyAlias).Select(goqu.L("1")).Where(
+			keyAlias.Col(common.ColReferenceID).Eq(referenceTable.Col(common.ColID)),
+			keyAlias.Col(common.ColPosition).Eq(position),
+			keyAlias.Col(common.ColType).Eq(key.Type()),
+			keyAlias.Col(common.ColValue).Eq(key.Value()),
+		)))
+	}
+	extraKeys := keyTable.As(alias + "_key_extra")
+	conditions = append(conditions, goqu.L("NOT EXISTS ?", d.From(extraKeys).Select(goqu.L("1")).Where(
+		extraKeys.Col(common.ColReferenceID).Eq(referenceTable.Col(common.ColID)),
+		extraKeys.Col(common.ColPosition).Gte(len(keys)),
+	)))
+
+	return goqu.L("EXISTS ?", d.From(referenceTable).Select(goqu.L("1")).Where(conditions...))
+}
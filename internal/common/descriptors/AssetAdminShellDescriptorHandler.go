@@ -46,7 +46,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/FriedJannik/aas-go-sdk/stringification"
@@ -172,6 +171,9 @@ func insertAdministrationShellDescriptorTx(ctx context.Context, tx *sql.Tx, aasd
 }
 
 func insertAdministrationShellDescriptorDetailsTx(ctx context.Context, tx *sql.Tx, descriptorID int64, aasd model.AssetAdministrationShellDescriptor, insertAASDescriptor bool) error {
+	if err := ensureSpecificAssetIDLimit(ctx, aasd); err != nil {
+		return err
+	}
 	d := goqu.Dialect(common.Dialect)
 
 	descriptionPayload, err := buildLangStringTextPayload(aasd.Description)
@@ -471,7 +473,7 @@ func buildAASDescriptorUpdateRecord(
 func GetAssetAdministrationShellDescriptorByID(
 	ctx context.Context, db *sql.DB, aasIdentifier string,
 ) (model.AssetAdministrationShellDescriptor, error) {
-	result, _, err := listAssetAdministrationShellDescriptors(ctx, db, 1, "", "", "", aasIdentifier, time.Time{}, time.Time{}, true)
+	result, _, err := listAssetAdministrationShellDescriptors(ctx, db, 1, "", "", "", aasIdentifier, time.Time{}, time.Time{}, AASDescriptorEndpointFilter{}, true)
 	if err != nil {
 		return model.AssetAdministrationShellDescriptor{}, err
 	}
@@ -487,7 +489,7 @@ func GetAssetAdministrationShellDescriptorByID(
 func GetAssetAdministrationShellDescriptorByIDTx(
 	ctx context.Context, tx *sql.Tx, aasIdentifier string,
 ) (model.AssetAdministrationShellDescriptor, error) {
-	result, _, err := listAssetAdministrationShellDescriptors(ctx, tx, 1, "", "", "", aasIdentifier, time.Time{}, time.Time{}, false)
+	result, _, err := listAssetAdministrationShellDescriptors(ctx, tx, 1, "", "", "", aasIdentifier, time.Time{}, time.Time{}, AASDescriptorEndpointFilter{}, false)
 	if err != nil {
 		return model.AssetAdministrationShellDescriptor{}, err
 	}
@@ -685,13 +687,15 @@ func buildListAssetAdministrationShellDescriptorsQuery(
 	identifiable string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
 ) (*goqu.SelectDataset, error) {
 	d := goqu.Dialect(common.Dialect)
 	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
 	if err != nil {
 		return nil, err
 	}
-	pageDS, err := buildListAASDescriptorPageQuery(ctx, peekLimit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom, collector)
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
+	pageDS, err := buildListAASDescriptorPageQuery(ctx, peekLimit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom, endpointFilter, collector)
 	if err != nil {
 		return nil, err
 	}
@@ -786,6 +790,7 @@ func buildListAASDescriptorPageQuery(
 	identifiable string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
 	collector *grammar.ResolvedFieldPathCollector,
 ) (*goqu.SelectDataset, error) {
 	if peekLimit < 0 {
@@ -808,9 +813,7 @@ func buildListAASDescriptorPageQuery(
 		return nil, err
 	}
 
-	if cursor != "" {
-		ds = ds.Where(common.TAASDescriptor.Col(common.ColAASID).Gte(cursor))
-	}
+	ds = common.WhereKeysetCursor(ds, common.TAASDescriptor.Col(common.ColAASID), cursor)
 
 	if assetType != "" {
 		ds = ds.Where(common.TAASDescriptor.Col(common.ColAssetType).Eq(assetType))
@@ -820,7 +823,7 @@ func buildListAASDescriptorPageQuery(
 		assetKindAsString := model.GetAssetKindString(assetKind)
 		convertedAssetKind, ok := stringification.AssetKindFromString(assetKindAsString)
 		if !ok {
-			return nil, errors.New("Invalid asset kind: " + assetKindAsString)
+			return nil, common.NewErrBadRequest("AASDESC-LISTAASDESC-ASSETKIND Invalid asset kind: " + assetKindAsString)
 		}
 		ds = ds.Where(common.TAASDescriptor.Col(common.ColAssetKind).Eq(convertedAssetKind))
 	}
@@ -828,6 +831,11 @@ func buildListAASDescriptorPageQuery(
 	if identifiable != "" {
 		ds = ds.Where(common.TAASDescriptor.Col(common.ColID).Eq(identifiable))
 	}
+
+	ds = applyEndpointFilter(ds, endpointFilter)
+	ds = applyEndpointReachableFilter(ds, endpointFilter)
+	ds = applySpecificAssetIDFilter(ds, endpointFilter)
+
 	switch {
 	case !createdFrom.IsZero() && !updatedFrom.IsZero():
 		ds = ds.Where(goqu.Or(
@@ -847,8 +855,58 @@ func buildListAASDescriptorPageQuery(
 	return ds, nil
 }
 
+// applyEndpointFilter restricts the page query to descriptors exposing at
+// least one endpoint matching the interface and protocol of filter. Both conditions
+// are checked against the same joined endpoint row, so a descriptor with one
+// endpoint of the right interface and another of the right protocol does not
+// match. A descriptor may expose several matching endpoints, so the result is
+// made DISTINCT to keep the peek limit counting descriptors rather than
+// endpoints.
+func applyEndpointFilter(ds *goqu.SelectDataset, filter AASDescriptorEndpointFilter) *goqu.SelectDataset {
+	endpointInterface := filter.Interface
+	endpointProtocol := filter.Protocol
+	if endpointInterface == "" && endpointProtocol == "" {
+		return ds
+	}
+	endpointFilter := goqu.T(common.TblAASDescriptorEndpoint).As("endpoint_filter")
+	ds = ds.InnerJoin(
+		endpointFilter,
+		goqu.On(endpointFilter.Col(common.ColDescriptorID).Eq(common.TDescriptor.Col(common.ColID))),
+	)
+	if endpointInterface != "" {
+		ds = ds.Where(endpointFilter.Col(common.ColInterface).Eq(endpointInterface))
+	}
+	if endpointProtocol != "" {
+		ds = ds.Where(endpointFilter.Col(common.ColEndpointProtocol).Eq(endpointProtocol))
+	}
+	return ds.Distinct()
+}
+
+// applyEndpointReachableFilter restricts the page query according to
+// filter.Reachable. reachable=true keeps descriptors with at least
+// one endpoint whose last probe succeeded; reachable=false keeps the remaining
+// descriptors, including those that have not been probed yet.
+func applyEndpointReachableFilter(ds *goqu.SelectDataset, filter AASDescriptorEndpointFilter) *goqu.SelectDataset {
+	if filter.Reachable == nil {
+		return ds
+	}
+	reachable := *filter.Reachable
+	endpointFilter := goqu.T(common.TblAASDescriptorEndpoint).As("endpoint_reachable_filter")
+	reachableEndpoint := goqu.Dialect(common.Dialect).
+		From(endpointFilter).
+		Select(goqu.L("1")).
+		Where(
+			endpointFilter.Col(common.ColDescriptorID).Eq(common.TDescriptor.Col(common.ColID)),
+			endpointFilter.Col(common.ColReachabilityStatus).Eq(EndpointReachabilityReachable),
+		)
+	if reachable {
+		return ds.Where(goqu.L("EXISTS ?", reachableEndpoint))
+	}
+	return ds.Where(goqu.L("NOT EXISTS ?", reachableEndpoint))
+}
+
 // ListAssetAdministrationShellDescriptors lists AAS descriptors with optional
-// filtering by AssetKind and AssetType. Results are ordered by AAS Id
+// filtering by AssetKind, AssetType and endpointFilter. Results are ordered by AAS Id
 // ascending and support cursor‑based pagination where the cursor is the AAS Id
 // of the first element to include (i.e. Id >= cursor).
 //
@@ -867,13 +925,136 @@ func ListAssetAdministrationShellDescriptors(
 	identifiable string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
 ) ([]model.AssetAdministrationShellDescriptor, string, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ListAssetAdministrationShellDescriptors took %s\n", time.Since(start))
-		}(time.Now())
+	return listAssetAdministrationShellDescriptors(ctx, db, limit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom, endpointFilter, true)
+}
+
+// CountAssetAdministrationShellDescriptors counts the AAS descriptors
+// matching the filters of ListAssetAdministrationShellDescriptors, including
+// the endpoint filter, the filters stored in ctx and the ABAC formula, across
+// all pages.
+func CountAssetAdministrationShellDescriptors(
+	ctx context.Context,
+	db DBQueryer,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
+) (int64, error) {
+	ds, err := buildCountAssetAdministrationShellDescriptorsQuery(ctx, assetKind, assetType, createdFrom, updatedFrom, endpointFilter)
+	if err != nil {
+		return 0, err
+	}
+	sqlStr, args, err := ds.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	common.LogQuery(ctx, sqlStr, args)
+
+	var count int64
+	if err := db.QueryRowContext(ctx, sqlStr, args...).Scan(&count); err != nil {
+		return 0, common.NewInternalServerError("AASREG-COUNTAAS-EXECSQL " + err.Error())
+	}
+	return count, nil
+}
+
+func buildCountAssetAdministrationShellDescriptorsQuery(
+	ctx context.Context,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
+) (*goqu.SelectDataset, error) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		return nil, err
+	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
+	pageDS, err := buildListAASDescriptorPageQuery(ctx, 0, "", assetKind, assetType, "", createdFrom, updatedFrom, endpointFilter, collector)
+	if err != nil {
+		return nil, err
+	}
+	return goqu.Dialect(common.Dialect).
+		From(pageDS.ClearOrder().ClearLimit().As("aas_count_data")).
+		Select(goqu.COUNT("*")), nil
+}
+
+// PreviousAssetAdministrationShellDescriptorCursor returns the cursor of the
+// page that precedes the page starting at cursor in a
+// ListAssetAdministrationShellDescriptors listing with the same filters. It is
+// empty when no descriptor precedes cursor.
+func PreviousAssetAdministrationShellDescriptorCursor(
+	ctx context.Context,
+	db DBQueryer,
+	limit int32,
+	cursor string,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
+) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	limit, err := common.ResolvePageLimit(ctx, limit)
+	if err != nil {
+		return "", err
+	}
+	ds, err := buildPreviousAssetAdministrationShellDescriptorPageQuery(ctx, limit, cursor, assetKind, assetType, createdFrom, updatedFrom, endpointFilter)
+	if err != nil {
+		return "", err
 	}
-	return listAssetAdministrationShellDescriptors(ctx, db, limit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom, true)
+	sqlStr, args, err := ds.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	common.LogQuery(ctx, sqlStr, args)
+
+	rows, err := db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		return "", common.NewInternalServerError("AASREG-PREVAASCURSOR-EXECSQL " + err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	previousCursor := ""
+	for rows.Next() {
+		var descriptorID int64
+		if err := rows.Scan(&descriptorID, &previousCursor); err != nil {
+			return "", common.NewInternalServerError("AASREG-PREVAASCURSOR-SCAN " + err.Error())
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", common.NewInternalServerError("AASREG-PREVAASCURSOR-ROWS " + err.Error())
+	}
+	return previousCursor, nil
+}
+
+func buildPreviousAssetAdministrationShellDescriptorPageQuery(
+	ctx context.Context,
+	limit int32,
+	cursor string,
+	assetKind model.AssetKind,
+	assetType string,
+	createdFrom time.Time,
+	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
+) (*goqu.SelectDataset, error) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		return nil, err
+	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
+	pageDS, err := buildListAASDescriptorPageQuery(ctx, 0, "", assetKind, assetType, "", createdFrom, updatedFrom, endpointFilter, collector)
+	if err != nil {
+		return nil, err
+	}
+	return common.WherePreviousKeysetPage(pageDS.ClearLimit(), common.TAASDescriptor.Col(common.ColAASID), cursor, limit), nil
 }
 
 //nolint:revive // has to be refactored later. i have no time
@@ -887,10 +1068,9 @@ func listAssetAdministrationShellDescriptors(
 	identifiable string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointFilter AASDescriptorEndpointFilter,
 	allowParallel bool,
 ) ([]model.AssetAdministrationShellDescriptor, string, error) {
-	db = withDescriptorDebugQueryer(ctx, db)
-
 	if limit <= 0 {
 		limit = 100
 	}
@@ -904,18 +1084,16 @@ func listAssetAdministrationShellDescriptors(
 		}
 	}
 	peekLimit := limit + 1
-	ds, err := buildListAssetAdministrationShellDescriptorsQuery(ctx, peekLimit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom)
+	ds, err := buildListAssetAdministrationShellDescriptorsQuery(ctx, peekLimit, cursor, assetKind, assetType, identifiable, createdFrom, updatedFrom, endpointFilter)
 	if err != nil {
 		return nil, "", err
 	}
 	sqlStr, args, err := ds.ToSQL()
-	if debugEnabled(ctx) {
-		_, _ = fmt.Println(sqlStr)
-	}
-
 	if err != nil {
 		return nil, "", err
 	}
+	common.LogQuery(ctx, sqlStr, args)
+	common.ExplainQuery(ctx, db, sqlStr, args)
 
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
@@ -960,7 +1138,7 @@ func listAssetAdministrationShellDescriptors(
 		return nil, "", common.NewInternalServerError("Failed to iterate AAS descriptors. See server logs for details.")
 	}
 
-	descRows, nextCursor := applyCursorLimit(descRows, limit, func(r model.AssetAdministrationShellDescriptorRow) string {
+	descRows, nextCursor := common.SplitKeysetPage(descRows, limit, func(r model.AssetAdministrationShellDescriptorRow) string {
 		return r.IDStr
 	})
 
@@ -1093,6 +1271,7 @@ func existsAASByID(ctx context.Context, db DBQueryer, aasID string) (bool, error
 		return false, err
 	}
 
+	common.LogQuery(ctx, sqlStr, args)
 	var one int
 	if scanErr := db.QueryRowContext(ctx, sqlStr, args...).Scan(&one); scanErr != nil {
 		if errors.Is(scanErr, sql.ErrNoRows) {
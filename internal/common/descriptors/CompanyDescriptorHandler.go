@@ -510,9 +510,7 @@ func ListCompanyDescriptors(
 			payload.Col(common.ColDescriptionPayload),
 		)
 
-	if cursor != "" {
-		ds = ds.Where(comp.Col(common.ColCompanyDomain).Gte(cursor))
-	}
+	ds = common.WhereKeysetCursor(ds, comp.Col(common.ColCompanyDomain), cursor)
 
 	if strings.TrimSpace(name) != "" {
 		nameLower := strings.ToLower(name)
@@ -587,11 +585,9 @@ func ListCompanyDescriptors(
 		return nil, "", common.NewInternalServerError("Failed to iterate Company Descriptors. See server logs for details.")
 	}
 
-	var nextCursor string
-	if len(descRows) > int(limit) {
-		nextCursor = descRows[limit].IDStr
-		descRows = descRows[:limit]
-	}
+	descRows, nextCursor := common.SplitKeysetPage(descRows, limit, func(r model.CompanyDescriptorRow) string {
+		return r.IDStr
+	})
 
 	if len(descRows) == 0 {
 		return []model.CompanyDescriptor{}, nextCursor, nil
@@ -0,0 +1,249 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+const (
+	// EndpointReachabilityReachable marks an endpoint that answered the last probe.
+	EndpointReachabilityReachable = "reachable"
+	// EndpointReachabilityUnreachable marks an endpoint whose last probe failed.
+	EndpointReachabilityUnreachable = "unreachable"
+)
+
+// EndpointReachabilityChecker periodically probes the HTTP(S) hrefs of all
+// stored descriptor endpoints and records the outcome in the endpoint table.
+//
+// Each distinct href is probed once per round with HEAD, falling back to GET
+// when the server does not support HEAD. An endpoint counts as reachable when
+// it answers with a status below 400 or with 401/403, because protected
+// endpoints are alive even if the anonymous probe is not authorized.
+//
+// Hrefs are chosen by whoever registers a descriptor, so the probe client
+// refuses to connect to loopback, link-local and cloud metadata addresses,
+// and to private networks unless allowPrivateNetworks is set. The check runs
+// on the resolved address at dial time. Redirects are never followed; a
+// redirect answer already shows that the endpoint is alive.
+type EndpointReachabilityChecker struct {
+	db       *sql.DB
+	client   *http.Client
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewEndpointReachabilityChecker creates a checker from the general
+// endpointReachability configuration. The checker does nothing until Start.
+func NewEndpointReachabilityChecker(db *sql.DB, cfg common.EndpointReachabilityConfig) *EndpointReachabilityChecker {
+	return &EndpointReachabilityChecker{
+		db: db,
+		client: common.NewOutboundHTTPClient(time.Duration(cfg.TimeoutSeconds)*time.Second, func(ip net.IP) bool {
+			return common.OutboundAddressAllowed(ip, cfg.AllowPrivateNetworks)
+		}),
+		interval: time.Duration(cfg.IntervalSeconds) * time.Second,
+		now:      time.Now,
+	}
+}
+
+// StartEndpointReachabilityCheckerIfEnabled starts a checker when
+// general.endpointReachability.enabled is set and returns it so the caller can
+// stop it on shutdown. It returns nil when the checker is disabled.
+func StartEndpointReachabilityCheckerIfEnabled(ctx context.Context, db *sql.DB, cfg *common.Config) *EndpointReachabilityChecker {
+	if cfg == nil || !cfg.General.EndpointReachability.Enabled {
+		return nil
+	}
+	checker := NewEndpointReachabilityChecker(db, cfg.General.EndpointReachability)
+	checker.Start(ctx)
+	log.Printf("🔎 Endpoint reachability checker started (interval=%s timeout=%s)", checker.interval, checker.client.Timeout)
+	return checker
+}
+
+// Start runs a probe round immediately and then once per interval until ctx is
+// cancelled or Stop is called. Calling Start on a running checker is a no-op.
+func (c *EndpointReachabilityChecker) Start(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		return
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go func(done chan struct{}) {
+		defer close(done)
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-timer.C:
+				if err := c.CheckOnce(runCtx); err != nil && runCtx.Err() == nil {
+					log.Printf("DESCRIPTORS-REACHABILITY-ROUND probe round failed: %v", err)
+				}
+				timer.Reset(c.interval)
+			}
+		}
+	}(c.done)
+}
+
+// Stop cancels the background loop and waits until an in-flight round has
+// returned. It is safe to call on a nil or never started checker.
+func (c *EndpointReachabilityChecker) Stop() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	cancel, done := c.cancel, c.done
+	c.cancel, c.done = nil, nil
+	c.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// CheckOnce probes every distinct HTTP(S) endpoint href once and stores the
+// result. Probe failures are recorded as unreachable; only database errors and
+// cancellation abort the round.
+func (c *EndpointReachabilityChecker) CheckOnce(ctx context.Context) error {
+	hrefs, err := c.listHTTPEndpointHrefs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, href := range hrefs {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		reachable := c.probe(ctx, href)
+		if err = c.recordReachability(ctx, href, reachable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *EndpointReachabilityChecker) listHTTPEndpointHrefs(ctx context.Context) ([]string, error) {
+	query, args, err := goqu.Dialect(common.Dialect).
+		From(common.TAASDescriptorEndpoint).
+		Select(common.TAASDescriptorEndpoint.Col(common.ColHref)).
+		Distinct().
+		Where(goqu.Or(
+			common.TAASDescriptorEndpoint.Col(common.ColHref).ILike("http://%"),
+			common.TAASDescriptorEndpoint.Col(common.ColHref).ILike("https://%"),
+		)).
+		Order(common.TAASDescriptorEndpoint.Col(common.ColHref).Asc()).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return nil, common.NewInternalServerError("DESCRIPTORS-REACHABILITY-BUILDLIST " + err.Error())
+	}
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, common.NewInternalServerError("DESCRIPTORS-REACHABILITY-LIST " + err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var hrefs []string
+	for rows.Next() {
+		var href string
+		if err = rows.Scan(&href); err != nil {
+			return nil, common.NewInternalServerError("DESCRIPTORS-REACHABILITY-SCAN " + err.Error())
+		}
+		hrefs = append(hrefs, href)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, common.NewInternalServerError("DESCRIPTORS-REACHABILITY-ROWS " + err.Error())
+	}
+	return hrefs, nil
+}
+
+func (c *EndpointReachabilityChecker) recordReachability(ctx context.Context, href string, reachable bool) error {
+	checkedAt := c.now().UTC()
+	record := goqu.Record{
+		common.ColReachabilityStatus:    EndpointReachabilityUnreachable,
+		common.ColLastReachabilityCheck: checkedAt,
+	}
+	if reachable {
+		record[common.ColReachabilityStatus] = EndpointReachabilityReachable
+		record[common.ColLastReachableAt] = checkedAt
+	}
+	query, args, err := goqu.Dialect(common.Dialect).
+		Update(common.TAASDescriptorEndpoint).
+		Set(record).
+		Where(common.TAASDescriptorEndpoint.Col(common.ColHref).Eq(href)).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return common.NewInternalServerError("DESCRIPTORS-REACHABILITY-BUILDUPDATE " + err.Error())
+	}
+	if _, err = c.db.ExecContext(ctx, query, args...); err != nil {
+		return common.NewInternalServerError("DESCRIPTORS-REACHABILITY-UPDATE " + err.Error())
+	}
+	return nil
+}
+
+func (c *EndpointReachabilityChecker) probe(ctx context.Context, href string) bool {
+	status, ok := c.probeWithMethod(ctx, http.MethodHead, href)
+	if ok && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, ok = c.probeWithMethod(ctx, http.MethodGet, href)
+	}
+	if !ok {
+		return false
+	}
+	return status < http.StatusBadRequest || status == http.StatusUnauthorized || status == http.StatusForbidden
+}
+
+func (c *EndpointReachabilityChecker) probeWithMethod(ctx context.Context, method string, href string) (int, bool) {
+	request, err := http.NewRequestWithContext(ctx, method, href, nil)
+	if err != nil {
+		return 0, false
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return 0, false
+	}
+	_ = response.Body.Close()
+	return response.StatusCode, true
+}
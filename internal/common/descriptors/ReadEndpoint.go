@@ -29,8 +29,6 @@ package descriptors
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	// nolint:revive
@@ -92,11 +90,6 @@ func ReadEndpointsByDescriptorIDs(
 	descriptorIDs []int64,
 	joinOnMainTable string,
 ) (map[int64][]model.Endpoint, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ReadEndpointsByDescriptorIDs took %s\n", time.Since(start))
-		}(time.Now())
-	}
 	out := make(map[int64][]model.Endpoint, len(descriptorIDs))
 	if len(descriptorIDs) == 0 {
 		return out, nil
@@ -161,6 +154,7 @@ func ReadEndpointsByDescriptorIDs(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	ds, err = auth.AddFilterQueryFromContext(ctx, ds, "$aasdesc#endpoints[]", collector)
 	if err != nil {
 		return nil, err
@@ -171,6 +165,7 @@ func ReadEndpointsByDescriptorIDs(
 		return nil, err
 	}
 
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, err
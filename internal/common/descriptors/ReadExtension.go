@@ -108,6 +108,7 @@ func ReadExtensionsByDescriptorIDs(
 		return nil, err
 	}
 
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, err
@@ -109,6 +109,7 @@ func ReadSpecificAssetExternalSubjectReferencesBySpecificAssetIDs(
 	if err != nil {
 		return nil, fmt.Errorf("REFREAD-EXTSUBJECT-COLLECTOR: %w", err)
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	collector.AllowInlineAliases(
 		"descriptor",
 		"aas_descriptor",
@@ -197,6 +198,7 @@ func ReadSubmodelDescriptorSupplementalSemanticReferencesByDescriptorIDs(
 	if err != nil {
 		return nil, fmt.Errorf("REFREAD-SUPPSMDESC-COLLECTOR: %w", err)
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	collector.AllowInlineAliases(
 		"submodel_descriptor",
 		"aasdesc_submodel_descriptor_supplemental_semantic_id_reference",
@@ -248,6 +250,7 @@ func ReadSubmodelSupplementalSemanticReferencesBySubmodelIDs(
 	if err != nil {
 		return nil, fmt.Errorf("REFREAD-SUPPSM-COLLECTOR: %w", err)
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	collector.AllowInlineAliases(
 		"s",
 		"sm_supplemental_semantic_id_reference",
@@ -284,6 +287,7 @@ func ReadSubmodelElementSupplementalSemanticReferencesByElementIDs(
 	if err != nil {
 		return nil, fmt.Errorf("REFREAD-SUPPSME-COLLECTOR: %w", err)
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	collector.AllowInlineAliases(
 		"submodel_element",
 		"sme_supplemental_semantic_id_reference",
@@ -434,6 +438,7 @@ func queryReferenceRowsByOwnerIDs(
 		return nil, fmt.Errorf("REFREAD-BUILDQUERY: %w", err)
 	}
 
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, fmt.Errorf("REFREAD-QUERYDB: %w", err)
@@ -552,6 +557,7 @@ func readContextReferences1ToManyByOwnerIDs(
 		return nil, fmt.Errorf("%s-BUILDQUERY: %w", spec.errPrefix, err)
 	}
 
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s-QUERYDB: %w", spec.errPrefix, err)
@@ -29,8 +29,6 @@ package descriptors
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"time"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/doug-martin/goqu/v9"
@@ -91,11 +89,6 @@ func ReadSpecificAssetIDsByDescriptorIDs(
 	db DBQueryer,
 	descriptorIDs []int64,
 ) (map[int64][]types.ISpecificAssetID, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ReadSpecificAssetIDsByDescriptorIDs took %s\n", time.Since(start))
-		}(time.Now())
-	}
 	out := make(map[int64][]types.ISpecificAssetID, len(descriptorIDs))
 	if len(descriptorIDs) == 0 {
 		return out, nil
@@ -110,6 +103,7 @@ func ReadSpecificAssetIDsByDescriptorIDs(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	collector.AllowInlineAliases(
 		"descriptor",
 		"aas_descriptor",
@@ -191,10 +185,7 @@ func ReadSpecificAssetIDsByDescriptorIDs(
 	if err != nil {
 		return nil, err
 	}
-	if debugEnabled(ctx) {
-		_, _ = fmt.Println(sqlStr)
-	}
-
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, err
@@ -29,8 +29,6 @@ package descriptors
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"time"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/doug-martin/goqu/v9"
@@ -79,11 +77,6 @@ func ReadSubmodelDescriptorsByDescriptorIDs(
 	db DBQueryer,
 	descriptorIDs []int64,
 ) (map[int64][]model.SubmodelDescriptor, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ReadSubmodelDescriptorsByDescriptorIDs took %s\n", time.Since(start))
-		}(time.Now())
-	}
 	if len(descriptorIDs) == 0 {
 		return map[int64][]model.SubmodelDescriptor{}, nil
 	}
@@ -101,6 +94,7 @@ func ReadSubmodelDescriptorsByDescriptorIDs(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	const dataAlias = "smd_by_desc_data"
 	maskedColumns := []auth.MaskedInnerColumnSpec{
 		{Fragment: "$smdesc#idShort", FlagAlias: "flag_smdesc_idshort", RawAlias: "c2"},
@@ -220,11 +214,6 @@ func ReadSubmodelDescriptorsByAASDescriptorIDs(
 	aasDescriptorIDs []int64,
 	isMain bool,
 ) (map[int64][]model.SubmodelDescriptor, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ReadSubmodelDescriptorsByAASDescriptorIDs took %s\n", time.Since(start))
-		}(time.Now())
-	}
 	out := make(map[int64][]model.SubmodelDescriptor, len(aasDescriptorIDs))
 	if len(aasDescriptorIDs) == 0 {
 		return out, nil
@@ -243,6 +232,7 @@ func ReadSubmodelDescriptorsByAASDescriptorIDs(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	const dataAlias = "smd_by_aas_data"
 	maskedColumns := []auth.MaskedInnerColumnSpec{
 		{Fragment: "$aasdesc#submodelDescriptors[].idShort", FlagAlias: "flag_aas_smdesc_idshort", RawAlias: "c2"},
@@ -521,9 +511,7 @@ func readSubmodelDescriptorRows(
 	if err != nil {
 		return nil, nil, err
 	}
-	if debugEnabled(ctx) {
-		_, _ = fmt.Println(sqlStr)
-	}
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, nil, err
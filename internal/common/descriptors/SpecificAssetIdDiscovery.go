@@ -29,8 +29,6 @@ package descriptors
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"time"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/doug-martin/goqu/v9"
@@ -93,11 +91,6 @@ func ReadSpecificAssetIDsByAASRef(
 	db DBQueryer,
 	aasRef int64,
 ) ([]types.ISpecificAssetID, error) {
-	if debugEnabled(ctx) {
-		defer func(start time.Time) {
-			_, _ = fmt.Printf("ReadSpecificAssetIDsByAASRef took %s\n", time.Since(start))
-		}(time.Now())
-	}
 
 	d := goqu.Dialect(common.Dialect)
 	tAASIdentifier := goqu.T(common.TblAASIdentifier)
@@ -108,6 +101,7 @@ func ReadSpecificAssetIDsByAASRef(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	expressions, err := auth.GetColumnSelectStatement(ctx, bdColumns, collector)
 	if err != nil {
 		return nil, err
@@ -158,10 +152,7 @@ func ReadSpecificAssetIDsByAASRef(
 	if err != nil {
 		return nil, err
 	}
-	if debugEnabled(ctx) {
-		_, _ = fmt.Println(sqlStr)
-	}
-
+	common.LogQuery(ctx, sqlStr, args)
 	rows, err := db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, err
@@ -124,7 +124,7 @@ func ListSubmodelDescriptorsForAAS(
 		list = list[lo:]
 	}
 
-	list, nextCursor := applyCursorLimit(list, limit, func(r model.SubmodelDescriptor) string {
+	list, nextCursor := common.SplitKeysetPage(list, limit, func(r model.SubmodelDescriptor) string {
 		return r.Id
 	})
 
@@ -725,9 +725,7 @@ func listSubmodelDescriptorIDsWithoutAAS(
 		).
 		Where(smd.Col(common.ColAASDescriptorID).IsNull())
 
-	if cursor != "" {
-		ds = ds.Where(smd.Col(common.ColAASID).Gte(cursor))
-	}
+	ds = common.WhereKeysetCursor(ds, smd.Col(common.ColAASID), cursor)
 	switch {
 	case !createdFrom.IsZero() && !updatedFrom.IsZero():
 		ds = ds.Where(goqu.Or(
@@ -774,7 +772,7 @@ func listSubmodelDescriptorIDsWithoutAAS(
 		return nil, "", common.NewInternalServerError("Failed to iterate submodel descriptor ids. See server logs for details.")
 	}
 
-	pageRows, nextCursor := applyCursorLimit(pageRows, limit, func(r submodelDescriptorPageRow) string {
+	pageRows, nextCursor := common.SplitKeysetPage(pageRows, limit, func(r submodelDescriptorPageRow) string {
 		return r.SubmodelID
 	})
 
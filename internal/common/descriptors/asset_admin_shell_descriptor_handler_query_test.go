@@ -33,7 +33,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
 )
@@ -65,6 +67,7 @@ func TestBuildListAssetAdministrationShellDescriptorsQuery_UsesPagedInnerQueryAn
 		"",
 		time.Time{},
 		time.Time{},
+		AASDescriptorEndpointFilter{},
 	)
 	if err != nil {
 		t.Fatalf("buildListAssetAdministrationShellDescriptorsQuery returned error: %v", err)
@@ -125,7 +128,7 @@ func TestBuildListAssetAdministrationShellDescriptorsQuery_ReusesSameMaskConditi
 		},
 	})
 
-	ds, err := buildListAssetAdministrationShellDescriptorsQuery(ctx, 2, "", "", "", "", time.Time{}, time.Time{})
+	ds, err := buildListAssetAdministrationShellDescriptorsQuery(ctx, 2, "", "", "", "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{})
 	if err != nil {
 		t.Fatalf("buildListAssetAdministrationShellDescriptorsQuery returned error: %v", err)
 	}
@@ -138,3 +141,353 @@ func TestBuildListAssetAdministrationShellDescriptorsQuery_ReusesSameMaskConditi
 		t.Fatalf("expected exactly 1 EXISTS for shared fragment condition, got %d: %s", got, sql)
 	}
 }
+
+func TestBuildListAASDescriptorPageQuery_AssetFilters(t *testing.T) {
+	tests := []struct {
+		name       string
+		assetKind  model.AssetKind
+		assetType  string
+		cursor     string
+		wantSQL    []string
+		notWantSQL []string
+	}{
+		{
+			name:       "no filter",
+			notWantSQL: []string{`"asset_kind"`, `"asset_type"`},
+		},
+		{
+			name:       "assetKind only",
+			assetKind:  model.ASSETKIND_INSTANCE,
+			wantSQL:    []string{`"aas_descriptor"."asset_kind" = $`},
+			notWantSQL: []string{`"asset_type"`},
+		},
+		{
+			name:       "assetType only",
+			assetType:  "urn:example:type",
+			wantSQL:    []string{`"aas_descriptor"."asset_type" = $`},
+			notWantSQL: []string{`"asset_kind"`},
+		},
+		{
+			name:      "assetKind and assetType with cursor",
+			assetKind: model.ASSETKIND_TYPE,
+			assetType: "urn:example:type",
+			cursor:    "urn:example:aas:2",
+			wantSQL: []string{
+				`"aas_descriptor"."asset_kind" = $`,
+				`"aas_descriptor"."asset_type" = $`,
+				`"aas_descriptor"."id" >= $`,
+				` AND `,
+				`ORDER BY "aas_descriptor"."id" ASC LIMIT $`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+			if err != nil {
+				t.Fatalf("failed to create collector: %v", err)
+			}
+			ds, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, tt.cursor, tt.assetKind, tt.assetType, "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{}, collector)
+			if err != nil {
+				t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+			}
+			sql, args, err := ds.Prepared(true).ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL returned error: %v", err)
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(sql, want) {
+					t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+				}
+			}
+			for _, notWant := range tt.notWantSQL {
+				if strings.Contains(sql, notWant) {
+					t.Fatalf("expected SQL not to contain %q, got: %s", notWant, sql)
+				}
+			}
+			if tt.assetType != "" && !containsArg(args, tt.assetType) {
+				t.Fatalf("expected prepared args to contain assetType %q, got: %#v", tt.assetType, args)
+			}
+		})
+	}
+}
+
+func TestBuildListAASDescriptorPageQuery_RejectsUnknownAssetKind(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+	_, err = buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", model.AssetKind("Bogus"), "", "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{}, collector)
+	if !common.IsErrBadRequest(err) {
+		t.Fatalf("expected bad request error for unknown asset kind, got: %v", err)
+	}
+}
+
+func containsArg(args []interface{}, want string) bool {
+	for _, arg := range args {
+		if v, ok := arg.(string); ok && v == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBuildListAASDescriptorPageQuery_EndpointProtocolFilterIsDistinct(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+
+	filter := AASDescriptorEndpointFilter{Protocol: "HTTP"}
+	ds, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "urn:example:aas:2", model.ASSETKIND_INSTANCE, "", "", time.Time{}, time.Time{}, filter, collector)
+	if err != nil {
+		t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+	}
+	sql, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		`SELECT DISTINCT`,
+		`INNER JOIN "aas_descriptor_endpoint" AS "endpoint_filter"`,
+		`"endpoint_filter"."endpoint_protocol" = $`,
+		`"aas_descriptor"."asset_kind" = $`,
+		`ORDER BY "aas_descriptor"."id" ASC LIMIT $`,
+	} {
+		if !strings.Contains(sql, want) {
+			t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+		}
+	}
+	if !containsArg(args, "HTTP") {
+		t.Fatalf("expected prepared args to contain endpoint protocol, got: %#v", args)
+	}
+
+	unfiltered, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", "", "", "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{}, collector)
+	if err != nil {
+		t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+	}
+	unfilteredSQL, _, err := unfiltered.ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+	if strings.Contains(unfilteredSQL, "endpoint_filter") || strings.Contains(unfilteredSQL, "DISTINCT") {
+		t.Fatalf("expected no endpoint join without filter, got: %s", unfilteredSQL)
+	}
+}
+
+func TestBuildListAASDescriptorPageQuery_EndpointInterfaceAndProtocolShareJoin(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+
+	filter := AASDescriptorEndpointFilter{Interface: "SUBMODEL-3.0", Protocol: "HTTP"}
+	ds, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", "", "", "", time.Time{}, time.Time{}, filter, collector)
+	if err != nil {
+		t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+	}
+	sql, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		`SELECT DISTINCT`,
+		`"endpoint_filter"."interface" = $`,
+		`"endpoint_filter"."endpoint_protocol" = $`,
+	} {
+		if !strings.Contains(sql, want) {
+			t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+		}
+	}
+	if n := strings.Count(sql, `INNER JOIN "aas_descriptor_endpoint"`); n != 1 {
+		t.Fatalf("expected interface and protocol to share one endpoint join, got %d: %s", n, sql)
+	}
+	if !containsArg(args, "SUBMODEL-3.0") || !containsArg(args, "HTTP") {
+		t.Fatalf("expected prepared args to contain interface and protocol, got: %#v", args)
+	}
+
+	interfaceOnly, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", "", "", "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{Interface: "AAS-3.0"}, collector)
+	if err != nil {
+		t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+	}
+	interfaceOnlySQL, _, err := interfaceOnly.ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+	if !strings.Contains(interfaceOnlySQL, `"endpoint_filter"."interface" = 'AAS-3.0'`) || strings.Contains(interfaceOnlySQL, `"endpoint_filter"."endpoint_protocol"`) {
+		t.Fatalf("expected interface-only endpoint filter, got: %s", interfaceOnlySQL)
+	}
+}
+
+func TestBuildListAASDescriptorPageQuery_EndpointReachableFilter(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		reachable bool
+		want      string
+	}{
+		{name: "reachable", reachable: true, want: `EXISTS (SELECT 1 FROM "aas_descriptor_endpoint" AS "endpoint_reachable_filter"`},
+		{name: "unreachable", reachable: false, want: `NOT EXISTS (SELECT 1 FROM "aas_descriptor_endpoint" AS "endpoint_reachable_filter"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := AASDescriptorEndpointFilter{Reachable: &tt.reachable}
+			ds, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", "", "", "", time.Time{}, time.Time{}, filter, collector)
+			if err != nil {
+				t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+			}
+			sql, args, err := ds.Prepared(true).ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL returned error: %v", err)
+			}
+			for _, want := range []string{
+				tt.want,
+				`"endpoint_reachable_filter"."descriptor_id" = "descriptor"."id"`,
+				`"endpoint_reachable_filter"."reachability_status" = $`,
+			} {
+				if !strings.Contains(sql, want) {
+					t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+				}
+			}
+			if !tt.reachable && strings.Count(sql, "NOT EXISTS") != 1 {
+				t.Fatalf("expected exactly one NOT EXISTS, got: %s", sql)
+			}
+			if !containsArg(args, EndpointReachabilityReachable) {
+				t.Fatalf("expected prepared args to contain reachable status, got: %#v", args)
+			}
+		})
+	}
+}
+
+func TestBuildListAASDescriptorPageQuery_SpecificAssetIDFilterCombinesEntriesWithAnd(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+
+	scoped := types.NewSpecificAssetID("partInstanceId", "P-1")
+	scoped.SetExternalSubjectID(types.NewReference(
+		types.ReferenceTypesExternalReference,
+		[]types.IKey{types.NewKey(types.KeyTypesGlobalReference, "BPNL000000000001")},
+	))
+	filter := AASDescriptorEndpointFilter{AssetIDs: []types.ISpecificAssetID{
+		types.NewSpecificAssetID(common.GlobalAssetIDAssetLinkName, "urn:example:asset"),
+		types.NewSpecificAssetID("manufacturerPartId", "MP-1"),
+		scoped,
+	}}
+	ds, err := buildListAASDescriptorPageQuery(contextWithABACDisabled(t), 3, "", "", "", "", time.Time{}, time.Time{}, filter, collector)
+	if err != nil {
+		t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+	}
+	sql, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		`"aas_descriptor"."global_asset_id" = $`,
+		`EXISTS (SELECT 1 FROM "specific_asset_id" AS "specific_asset_id_filter_1"`,
+		`"specific_asset_id_filter_1"."descriptor_id" = "descriptor"."id"`,
+		`EXISTS (SELECT 1 FROM "specific_asset_id" AS "specific_asset_id_filter_2"`,
+		`EXISTS (SELECT 1 FROM "specific_asset_id_external_subject_id_reference" AS "specific_asset_id_filter_2_ref"`,
+		`"specific_asset_id_filter_2_ref"."id" = "specific_asset_id_filter_2"."id"`,
+		`"specific_asset_id_filter_2_key_0"."value" = $`,
+		`NOT EXISTS (SELECT 1 FROM "specific_asset_id_external_subject_id_reference_key" AS "specific_asset_id_filter_2_key_extra"`,
+	} {
+		if !strings.Contains(sql, want) {
+			t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+		}
+	}
+	if strings.Contains(sql, `"specific_asset_id_filter_1_ref"`) {
+		t.Fatalf("expected unscoped entry to ignore externalSubjectId, got: %s", sql)
+	}
+	if strings.Contains(sql, "DISTINCT") || strings.Contains(sql, `" OR "`) {
+		t.Fatalf("expected entries to be ANDed without DISTINCT, got: %s", sql)
+	}
+	for _, want := range []string{"urn:example:asset", "MP-1", "P-1", "BPNL000000000001"} {
+		if !containsArg(args, want) {
+			t.Fatalf("expected prepared args to contain %q, got: %#v", want, args)
+		}
+	}
+}
+
+func TestBuildCountAssetAdministrationShellDescriptorsQuery_UsesPageFilters(t *testing.T) {
+	collector, err := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootAASDesc)
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+	createdFrom := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	reachable := true
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		assetKind      model.AssetKind
+		assetType      string
+		createdFrom    time.Time
+		endpointFilter AASDescriptorEndpointFilter
+	}{
+		{name: "unfiltered", ctx: contextWithABACDisabled(t)},
+		{name: "asset filters", ctx: contextWithABACDisabled(t), assetKind: model.ASSETKIND_INSTANCE, assetType: "urn:example:type"},
+		{name: "endpoint protocol", ctx: contextWithABACDisabled(t), endpointFilter: AASDescriptorEndpointFilter{Protocol: "HTTP"}},
+		{name: "reachable", ctx: contextWithABACDisabled(t), createdFrom: createdFrom, endpointFilter: AASDescriptorEndpointFilter{Reachable: &reachable}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageDS, err := buildListAASDescriptorPageQuery(tt.ctx, 3, "", tt.assetKind, tt.assetType, "", tt.createdFrom, time.Time{}, tt.endpointFilter, collector)
+			if err != nil {
+				t.Fatalf("buildListAASDescriptorPageQuery returned error: %v", err)
+			}
+			pageSQL, _, err := pageDS.ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL returned error: %v", err)
+			}
+			orderIndex := strings.Index(pageSQL, ` ORDER BY "aas_descriptor"."id" ASC LIMIT 3`)
+			if orderIndex < 0 {
+				t.Fatalf("expected page SQL to end with order and limit, got: %s", pageSQL)
+			}
+
+			countDS, err := buildCountAssetAdministrationShellDescriptorsQuery(tt.ctx, tt.assetKind, tt.assetType, tt.createdFrom, time.Time{}, tt.endpointFilter)
+			if err != nil {
+				t.Fatalf("buildCountAssetAdministrationShellDescriptorsQuery returned error: %v", err)
+			}
+			countSQL, _, err := countDS.ToSQL()
+			if err != nil {
+				t.Fatalf("ToSQL returned error: %v", err)
+			}
+			want := `SELECT COUNT(*) FROM (` + pageSQL[:orderIndex] + `) AS "aas_count_data"`
+			if countSQL != want {
+				t.Fatalf("expected count SQL\n%s\ngot\n%s", want, countSQL)
+			}
+		})
+	}
+}
+
+func TestBuildPreviousAssetAdministrationShellDescriptorPageQuery(t *testing.T) {
+	ds, err := buildPreviousAssetAdministrationShellDescriptorPageQuery(contextWithABACDisabled(t), 3, "urn:example:aas:5", model.ASSETKIND_INSTANCE, "", time.Time{}, time.Time{}, AASDescriptorEndpointFilter{})
+	if err != nil {
+		t.Fatalf("buildPreviousAssetAdministrationShellDescriptorPageQuery returned error: %v", err)
+	}
+	sql, _, err := ds.ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+	for _, want := range []string{
+		`"aas_descriptor"."asset_kind" = `,
+		`("aas_descriptor"."id" < 'urn:example:aas:5')`,
+	} {
+		if !strings.Contains(sql, want) {
+			t.Fatalf("expected SQL to contain %q, got: %s", want, sql)
+		}
+	}
+	if !strings.HasSuffix(sql, `ORDER BY "aas_descriptor"."id" DESC LIMIT 3`) {
+		t.Fatalf("expected the nearest descriptors first, got: %s", sql)
+	}
+}
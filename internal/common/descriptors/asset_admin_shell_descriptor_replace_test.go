@@ -0,0 +1,100 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+func descriptorWithEndpoints(hrefs ...string) model.AssetAdministrationShellDescriptor {
+	endpoints := make([]model.Endpoint, 0, len(hrefs))
+	for _, href := range hrefs {
+		endpoints = append(endpoints, model.Endpoint{
+			Interface:           "AAS-3.0",
+			ProtocolInformation: model.ProtocolInformation{Href: href},
+		})
+	}
+	return model.AssetAdministrationShellDescriptor{Id: "urn:example:aas:1", Endpoints: endpoints}
+}
+
+func expectDescriptorDetailsReplaced(mock sqlmock.Sqlmock, hrefs ...string) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "aas_descriptor" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "descriptor" WHERE ("id" IN ((SELECT "descriptor_id" FROM "submodel_descriptor" WHERE ("aas_descriptor_id" = 42))))`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "aas_descriptor_endpoint" WHERE ("descriptor_id" = 42)`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "specific_asset_id" WHERE ("descriptor_id" = 42)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "descriptor_payload" WHERE ("descriptor_id" = 42)`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "descriptor_payload"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for i, href := range hrefs {
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "aas_descriptor_endpoint"`) + `.*` + regexp.QuoteMeta(`'`+href+`'`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(100 + i)))
+	}
+}
+
+func TestReplaceAdministrationShellDescriptorDetailsTxPersistsOnlyLatestEndpoints(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	first := []string{"https://example.org/a1", "https://example.org/a2"}
+	second := []string{"https://example.org/b1"}
+
+	mock.ExpectBegin()
+	expectDescriptorDetailsReplaced(mock, first...)
+	expectDescriptorDetailsReplaced(mock, second...)
+	mock.ExpectCommit()
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("failed to begin transaction: %v", err)
+	}
+	if err = replaceAdministrationShellDescriptorDetailsTx(context.Background(), tx, 42, descriptorWithEndpoints(first...)); err != nil {
+		t.Fatalf("first replace returned error: %v", err)
+	}
+	if err = replaceAdministrationShellDescriptorDetailsTx(context.Background(), tx, 42, descriptorWithEndpoints(second...)); err != nil {
+		t.Fatalf("second replace returned error: %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("failed to commit transaction: %v", err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sqlmock expectations: %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import (
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdministrativeInfoPayloadRoundTripsVersionAndRevision(t *testing.T) {
+	version := "2"
+	revision := "7"
+	admin := types.NewAdministrativeInformation()
+	admin.SetVersion(&version)
+	admin.SetRevision(&revision)
+
+	payload, err := buildAdministrativeInfoPayload(admin)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version":"2","revision":"7"}`, string(payload))
+
+	parsed, err := parseAdministrativeInfoPayload(payload)
+	require.NoError(t, err)
+	require.NotNil(t, parsed)
+	require.Equal(t, "2", *parsed.Version())
+	require.Equal(t, "7", *parsed.Revision())
+}
+
+func TestAdministrativeInfoPayloadTreatsMissingValueAsAbsent(t *testing.T) {
+	payload, err := buildAdministrativeInfoPayload(nil)
+	require.NoError(t, err)
+	require.Equal(t, "{}", string(payload))
+
+	parsed, err := parseAdministrativeInfoPayload(payload)
+	require.NoError(t, err)
+	require.Nil(t, parsed)
+}
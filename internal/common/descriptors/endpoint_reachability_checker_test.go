@@ -0,0 +1,200 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+*******************************************************************************/
+
+package descriptors
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+func TestEndpointReachabilityCheckerRecordsToggledAvailability(t *testing.T) {
+	var available atomic.Bool
+	available.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !available.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	checkedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	checker := NewEndpointReachabilityChecker(db, common.EndpointReachabilityConfig{Enabled: true, IntervalSeconds: 60, TimeoutSeconds: 2})
+	checker.now = func() time.Time { return checkedAt }
+	checker.client = loopbackProbeClient()
+	href := server.URL + "/shells/abc"
+
+	mock.ExpectQuery(`SELECT DISTINCT "aas_descriptor_endpoint"."href" FROM "aas_descriptor_endpoint" WHERE \(\("aas_descriptor_endpoint"."href" ILIKE \$1\) OR \("aas_descriptor_endpoint"."href" ILIKE \$2\)\)`).
+		WithArgs("http://%", "https://%").
+		WillReturnRows(sqlmock.NewRows([]string{"href"}).AddRow(href))
+	mock.ExpectExec(`UPDATE "aas_descriptor_endpoint" SET "last_reachability_check_at"=\$1,"last_reachable_at"=\$2,"reachability_status"=\$3 WHERE \("aas_descriptor_endpoint"."href" = \$4\)`).
+		WithArgs(checkedAt, checkedAt, EndpointReachabilityReachable, href).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err = checker.CheckOnce(t.Context()); err != nil {
+		t.Fatalf("first probe round failed: %v", err)
+	}
+
+	available.Store(false)
+	mock.ExpectQuery(`SELECT DISTINCT "aas_descriptor_endpoint"."href"`).
+		WillReturnRows(sqlmock.NewRows([]string{"href"}).AddRow(href))
+	mock.ExpectExec(`UPDATE "aas_descriptor_endpoint" SET "last_reachability_check_at"=\$1,"reachability_status"=\$2 WHERE \("aas_descriptor_endpoint"."href" = \$3\)`).
+		WithArgs(checkedAt, EndpointReachabilityUnreachable, href).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err = checker.CheckOnce(t.Context()); err != nil {
+		t.Fatalf("second probe round failed: %v", err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
+func TestEndpointReachabilityCheckerProbeStatusClassification(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{status: http.StatusOK, want: true},
+		{status: http.StatusNoContent, want: true},
+		{status: http.StatusUnauthorized, want: true},
+		{status: http.StatusForbidden, want: true},
+		{status: http.StatusNotFound, want: false},
+		{status: http.StatusBadGateway, want: false},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+		checker := NewEndpointReachabilityChecker(nil, common.EndpointReachabilityConfig{TimeoutSeconds: 2})
+		checker.client = loopbackProbeClient()
+		if got := checker.probe(t.Context(), server.URL); got != tt.want {
+			t.Errorf("status %d: expected reachable=%v, got %v", tt.status, tt.want, got)
+		}
+		server.Close()
+	}
+
+	checker := NewEndpointReachabilityChecker(nil, common.EndpointReachabilityConfig{TimeoutSeconds: 2})
+	if checker.probe(t.Context(), "http://127.0.0.1:1/unreachable") {
+		t.Fatal("expected connection failure to be unreachable")
+	}
+}
+
+func TestEndpointReachabilityCheckerRefusesInternalAddresses(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checker := NewEndpointReachabilityChecker(nil, common.EndpointReachabilityConfig{TimeoutSeconds: 2, AllowPrivateNetworks: true})
+	if checker.probe(t.Context(), server.URL) {
+		t.Fatal("expected loopback endpoint to be refused")
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("expected no request to reach the loopback server, got %d", hits.Load())
+	}
+}
+
+func TestEndpointReachabilityCheckerDoesNotFollowRedirects(t *testing.T) {
+	var targetHits atomic.Int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		targetHits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	redirecting := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	defer redirecting.Close()
+
+	checker := NewEndpointReachabilityChecker(nil, common.EndpointReachabilityConfig{TimeoutSeconds: 2})
+	checker.client = loopbackProbeClient()
+	if !checker.probe(t.Context(), redirecting.URL) {
+		t.Fatal("expected redirect answer to count as reachable")
+	}
+	if targetHits.Load() != 0 {
+		t.Fatalf("expected redirect not to be followed, target got %d requests", targetHits.Load())
+	}
+}
+
+// loopbackProbeClient returns the production probe client with the address
+// guard relaxed so tests can probe httptest servers on 127.0.0.1.
+func loopbackProbeClient() *http.Client {
+	return common.NewOutboundHTTPClient(2*time.Second, func(net.IP) bool { return true })
+}
+
+func TestEndpointReachabilityCheckerStopsCleanly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	mock.ExpectQuery(`SELECT DISTINCT "aas_descriptor_endpoint"."href"`).
+		WillReturnRows(sqlmock.NewRows([]string{"href"}))
+
+	cfg := &common.Config{General: common.GeneralConfig{EndpointReachability: common.EndpointReachabilityConfig{Enabled: true, IntervalSeconds: 3600, TimeoutSeconds: 1}}}
+	checker := StartEndpointReachabilityCheckerIfEnabled(t.Context(), db, cfg)
+	if checker == nil {
+		t.Fatal("expected checker to start when enabled")
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for mock.ExpectationsWereMet() != nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	checker.Stop()
+	checker.Stop()
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expected initial probe round before stop: %v", err)
+	}
+
+	if StartEndpointReachabilityCheckerIfEnabled(t.Context(), db, &common.Config{}) != nil {
+		t.Fatal("expected no checker when disabled")
+	}
+	var disabled *EndpointReachabilityChecker
+	disabled.Stop()
+}
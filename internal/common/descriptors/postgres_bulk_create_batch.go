@@ -94,6 +94,11 @@ func BuildAdministrationShellDescriptorsCreateBatch(
 	tx *sql.Tx,
 	descriptors []model.AssetAdministrationShellDescriptor,
 ) (*common.PostgreSQLBatch, error) {
+	for _, descriptor := range descriptors {
+		if err := ensureSpecificAssetIDLimit(ctx, descriptor); err != nil {
+			return nil, err
+		}
+	}
 	counts := countBulkCreateIDs(ctx, descriptors)
 	ids, err := reserveBulkCreateIDs(ctx, tx, counts)
 	if err != nil {
@@ -52,6 +52,9 @@ func BuildAdministrationShellDescriptorCreateBatch(
 	ctx context.Context,
 	descriptor model.AssetAdministrationShellDescriptor,
 ) (*common.PostgreSQLBatch, error) {
+	if err := ensureSpecificAssetIDLimit(ctx, descriptor); err != nil {
+		return nil, err
+	}
 	batch := &common.PostgreSQLBatch{}
 	descriptorID := common.PostgreSQLCurrentSequenceValue(common.TblDescriptor, common.ColID)
 
@@ -0,0 +1,92 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package descriptors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+func descriptorWithSpecificAssetIDs(count int) model.AssetAdministrationShellDescriptor {
+	specificAssetIDs := make([]types.ISpecificAssetID, 0, count)
+	for i := 0; i < count; i++ {
+		specificAssetIDs = append(specificAssetIDs, types.NewSpecificAssetID(fmt.Sprintf("key-%d", i), fmt.Sprintf("value-%d", i)))
+	}
+	return model.AssetAdministrationShellDescriptor{
+		Id:               "urn:example:aas:limit",
+		SpecificAssetIds: specificAssetIDs,
+	}
+}
+
+func contextWithSpecificAssetIDLimit(limit int) context.Context {
+	return common.ContextWithConfig(context.Background(), &common.Config{
+		General: common.GeneralConfig{MaxSpecificAssetIDs: limit},
+	})
+}
+
+func TestEnsureSpecificAssetIDLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limit   int
+		count   int
+		wantErr bool
+	}{
+		{name: "below limit", limit: 2, count: 1},
+		{name: "at limit", limit: 2, count: 2},
+		{name: "above limit", limit: 2, count: 3, wantErr: true},
+		{name: "disabled", limit: 0, count: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ensureSpecificAssetIDLimit(contextWithSpecificAssetIDLimit(tt.limit), descriptorWithSpecificAssetIDs(tt.count))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr && !common.IsErrBadRequest(err) {
+				t.Fatalf("expected bad request error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBuildAdministrationShellDescriptorCreateBatchRejectsTooManySpecificAssetIDs(t *testing.T) {
+	t.Parallel()
+
+	_, err := BuildAdministrationShellDescriptorCreateBatch(contextWithSpecificAssetIDLimit(2), descriptorWithSpecificAssetIDs(3))
+	if !common.IsErrBadRequest(err) {
+		t.Fatalf("expected bad request error, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import "strings"
+
+// IsOperationDisabled reports whether server.disabledOperations switches off
+// the API route with the given operation name and HTTP method. Entries match
+// either an operation name such as "PostSubmodel" or an HTTP method such as
+// "POST", both case-insensitively. Disabled routes are not registered, so the
+// router answers 405 when the path serves other methods and 404 otherwise.
+//
+// Parameters:
+//   - cfg: Loaded service configuration; nil disables nothing.
+//   - operation: Operation name of the generated controller route.
+//   - method: HTTP method of the route.
+//
+// Returns:
+//   - bool: True when the route must not be registered.
+func IsOperationDisabled(cfg *Config, operation string, method string) bool {
+	if cfg == nil {
+		return false
+	}
+	for _, disabled := range cfg.Server.DisabledOperations {
+		disabled = strings.TrimSpace(disabled)
+		if strings.EqualFold(disabled, operation) || strings.EqualFold(disabled, method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIsOperationDisabledMatchesOperationNamesAndMethods(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{DisabledOperations: []string{"post", "DeleteSubmodelByID"}}}
+
+	tests := []struct {
+		operation string
+		method    string
+		want      bool
+	}{
+		{operation: "PostSubmodel", method: http.MethodPost, want: true},
+		{operation: "DeleteSubmodelByID", method: http.MethodDelete, want: true},
+		{operation: "DeleteSubmodelElementByPath", method: http.MethodDelete, want: false},
+		{operation: "GetAllSubmodels", method: http.MethodGet, want: false},
+	}
+	for _, tt := range tests {
+		if got := IsOperationDisabled(cfg, tt.operation, tt.method); got != tt.want {
+			t.Fatalf("IsOperationDisabled(%q, %q) = %v, want %v", tt.operation, tt.method, got, tt.want)
+		}
+	}
+	if IsOperationDisabled(nil, "PostSubmodel", http.MethodPost) {
+		t.Fatal("expected nil config to disable nothing")
+	}
+}
+
+func TestLoadConfigAppliesDisabledOperationsEnvironmentOverride(t *testing.T) {
+	t.Setenv("SERVER_DISABLED_OPERATIONS", "POST, PUT,PATCH,DELETE")
+
+	cfg, err := LoadConfig("", QUIET)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+
+	want := []string{"POST", "PUT", "PATCH", "DELETE"}
+	if !reflect.DeepEqual(cfg.Server.DisabledOperations, want) {
+		t.Fatalf("expected disabled operations %v, got %v", want, cfg.Server.DisabledOperations)
+	}
+}
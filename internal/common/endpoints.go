@@ -54,14 +54,16 @@ const verifyMaxPayloadBytes = 128 << 20
 const verifyMultipartFileField = "file"
 const verifyMultipartPayloadField = "payload"
 
-// HealthProbe reports if the service is healthy and optionally returns detail text.
-type HealthProbe func() (bool, string)
+// HealthProbe reports if the service is healthy and optionally returns detail
+// text. The ctx parameter is the probe request's context, so a probe stops when
+// the client goes away.
+type HealthProbe func(ctx context.Context) (bool, string)
 
 // AddHealthEndpoint registers a health check endpoint on the provided router.
 //
-// The health endpoint provides a simple way to verify that the service is running
-// and responsive. It's commonly used by load balancers, monitoring systems,
-// and container orchestrators to determine service health.
+// The health endpoint is the liveness probe: it only reports that the process
+// is running and responsive, and never touches the database. Dependency checks
+// belong to the readiness endpoint registered by AddDatabaseReadinessEndpoint.
 //
 // Endpoint details:
 //   - Method: GET
@@ -91,11 +93,11 @@ func AddHealthEndpoint(r *chi.Mux, config *Config) {
 
 // AddHealthEndpointWithProbe registers a health endpoint with optional readiness probing.
 func AddHealthEndpointWithProbe(r *chi.Mux, config *Config, probe HealthProbe) {
-	r.Get(config.Server.ContextPath+"/health", func(w http.ResponseWriter, _ *http.Request) {
+	r.Get(config.Server.ContextPath+"/health", func(w http.ResponseWriter, req *http.Request) {
 		if probe != nil {
-			healthy, details := probe()
+			healthy, details := probe(req.Context())
 			if !healthy {
-				response := map[string]string{"status": "DOWN"}
+				response := map[string]string{"status": HealthStateDown}
 				if strings.TrimSpace(details) != "" {
 					response["details"] = details
 				}
@@ -104,11 +106,11 @@ func AddHealthEndpointWithProbe(r *chi.Mux, config *Config, probe HealthProbe) {
 			}
 		}
 
-		writeHealthResponse(w, http.StatusOK, map[string]string{"status": "UP"})
+		writeHealthResponse(w, http.StatusOK, map[string]string{"status": HealthStateUp})
 	})
 }
 
-func writeHealthResponse(w http.ResponseWriter, statusCode int, body map[string]string) {
+func writeHealthResponse(w http.ResponseWriter, statusCode int, body any) {
 	responsePayload, err := json.Marshal(body)
 	if err != nil {
 		log.Printf("COMMON-WRITEHEALTH-MARSHAL response marshal failed: %v", err)
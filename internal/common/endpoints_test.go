@@ -29,6 +29,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -38,6 +39,7 @@ import (
 	"strings"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -69,7 +71,7 @@ func TestAddHealthEndpoint_UsesJSONResponseFormat(t *testing.T) {
 func TestAddHealthEndpointWithProbe_ReturnsServiceUnavailableOnProbeFailure(t *testing.T) {
 	router := chi.NewRouter()
 	cfg := &Config{Server: ServerConfig{ContextPath: "/api"}}
-	AddHealthEndpointWithProbe(router, cfg, func() (bool, string) {
+	AddHealthEndpointWithProbe(router, cfg, func(context.Context) (bool, string) {
 		return false, "AAS preconfiguration in progress"
 	})
 
@@ -96,6 +98,38 @@ func TestAddHealthEndpointWithProbe_ReturnsServiceUnavailableOnProbeFailure(t *t
 	}
 }
 
+func TestAddDatabaseReadinessEndpoint_ReportsDownUntilSchemaIsQueryable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() failed: %v", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	router := chi.NewRouter()
+	cfg := &Config{Server: ServerConfig{ContextPath: "/api"}}
+	if err = AddDatabaseReadinessEndpoint(router, cfg, db, CURRENT_DATABASE_VERSION); err != nil {
+		t.Fatalf("AddDatabaseReadinessEndpoint() failed: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT "schema_version", "state" FROM "basyxsystem"`).
+		WillReturnError(errors.New(`relation "basyxsystem" does not exist`))
+	mock.ExpectQuery(`SELECT "schema_version", "state" FROM "basyxsystem"`).
+		WillReturnRows(sqlmock.NewRows([]string{"schema_version", "state"}).AddRow(CURRENT_DATABASE_VERSION, cleanSchemaState))
+
+	for _, want := range []int{http.StatusServiceUnavailable, http.StatusOK} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/readyz", nil))
+		if rec.Code != want {
+			t.Fatalf("expected status %d, got %d body=%s", want, rec.Code, rec.Body.String())
+		}
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
 func TestVerifyPayload_RawJSON(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/verify", strings.NewReader(`{"assetAdministrationShells":[],"submodels":[],"conceptDescriptions":[]}`))
 	req.Header.Set("Content-Type", "application/json")
@@ -618,3 +652,23 @@ func assertStandardErrorResponse(t *testing.T, responseBody []byte, expectedCode
 		t.Fatalf("expected correlation ID and timestamp, got %#v", body[0])
 	}
 }
+
+func TestAddHealthEndpointWithProbe_PassesRequestContextToProbe(t *testing.T) {
+	router := chi.NewRouter()
+	cfg := &Config{Server: ServerConfig{ContextPath: "/api"}}
+	AddHealthEndpointWithProbe(router, cfg, func(ctx context.Context) (bool, string) {
+		if ctx.Err() != nil {
+			return false, "client went away"
+		}
+		return true, ""
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil).WithContext(ctx))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected the probe to see the canceled request context, got status %d", rec.Code)
+	}
+}
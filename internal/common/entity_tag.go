@@ -0,0 +1,52 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import "strings"
+
+// EntityTag returns a strong HTTP entity tag for a JSON-compatible
+// representation. The tag is the quoted CanonicalJSONHash of value, so equal
+// representations always produce the same tag.
+func EntityTag(value any) (string, error) {
+	hash, err := CanonicalJSONHash(value)
+	if err != nil {
+		return "", err
+	}
+	return `"` + hash + `"`, nil
+}
+
+// IfMatchSatisfied reports whether an If-Match header value matches etag
+// (RFC 9110, section 13.1.1). "*" matches any current representation and weak
+// tags never match because If-Match requires the strong comparison.
+func IfMatchSatisfied(ifMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || (candidate == etag && !strings.HasPrefix(candidate, "W/")) {
+			return true
+		}
+	}
+	return false
+}
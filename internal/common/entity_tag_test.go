@@ -0,0 +1,77 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEntityTagIsStableAcrossKeyOrder(t *testing.T) {
+	first, err := EntityTag(json.RawMessage(`{"idShort":"Target","value":"42"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := EntityTag(map[string]any{"value": "42", "idShort": "Target"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed, err := EntityTag(map[string]any{"value": "43", "idShort": "Target"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first != second {
+		t.Fatalf("expected equal tags for equal representations, got %s and %s", first, second)
+	}
+	if first == changed {
+		t.Fatalf("expected a different tag after a change, got %s for both", first)
+	}
+	if len(first) < 2 || first[0] != '"' || first[len(first)-1] != '"' {
+		t.Fatalf("expected a quoted entity tag, got %s", first)
+	}
+}
+
+func TestIfMatchSatisfied(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		ifMatch string
+		want    bool
+	}{
+		{ifMatch: `"abc"`, want: true},
+		{ifMatch: `"other", "abc"`, want: true},
+		{ifMatch: "*", want: true},
+		{ifMatch: `"other"`, want: false},
+		{ifMatch: `W/"abc"`, want: false},
+		{ifMatch: `abc`, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IfMatchSatisfied(tt.ifMatch, etag); got != tt.want {
+			t.Errorf("IfMatchSatisfied(%q) = %v, want %v", tt.ifMatch, got, tt.want)
+		}
+	}
+}
@@ -194,6 +194,38 @@ func NewErrServiceUnavailable(message string) error {
 	return errors.New("503 Service Unavailable: " + message)
 }
 
+// NewErrGatewayTimeout creates a standardized "504 Gateway Timeout" error.
+//
+// Parameters:
+//   - message: Description of the exceeded time budget.
+//
+// Returns:
+//   - error: An error with message format "504 Gateway Timeout: <message>"
+//
+// Example:
+//
+//	err := NewErrGatewayTimeout("request exceeded 30s")
+//	// Returns error: "504 Gateway Timeout: request exceeded 30s"
+func NewErrGatewayTimeout(message string) error {
+	return errors.New("504 Gateway Timeout: " + message)
+}
+
+// NewErrTooManyRequests creates a standardized "429 Too Many Requests" error.
+//
+// Parameters:
+//   - message: Description of the exceeded rate limit.
+//
+// Returns:
+//   - error: An error with message format "429 Too Many Requests: <message>"
+//
+// Example:
+//
+//	err := NewErrTooManyRequests("request rate exceeded")
+//	// Returns error: "429 Too Many Requests: request rate exceeded"
+func NewErrTooManyRequests(message string) error {
+	return errors.New("429 Too Many Requests: " + message)
+}
+
 // NewErrMethodNotAllowed creates a standardized "405 Method Not Allowed" error.
 //
 // Parameters:
@@ -210,6 +242,22 @@ func NewErrMethodNotAllowed(message string) error {
 	return errors.New("405 Method Not Allowed: " + message)
 }
 
+// NewErrPreconditionFailed creates a standardized "412 Precondition Failed" error.
+//
+// Parameters:
+//   - message: Description of the request precondition that did not hold
+//
+// Returns:
+//   - error: An error with message format "412 Precondition Failed: <message>"
+//
+// Example:
+//
+//	err := NewErrPreconditionFailed("If-Match does not match the current entity tag")
+//	// Returns error: "412 Precondition Failed: If-Match does not match the current entity tag"
+func NewErrPreconditionFailed(message string) error {
+	return errors.New("412 Precondition Failed: " + message)
+}
+
 // NewErrConflict creates a standardized "409 Conflict" error.
 //
 // Parameters:
@@ -361,6 +409,17 @@ func IsErrMethodNotAllowed(err error) bool {
 	return hasErrorPrefix(err, "405 Method Not Allowed: ")
 }
 
+// IsErrPreconditionFailed checks if the given error is a "412 Precondition Failed" error.
+//
+// Parameters:
+//   - err: The error to check
+//
+// Returns:
+//   - bool: true if the error is a 412 Precondition Failed error, false otherwise
+func IsErrPreconditionFailed(err error) bool {
+	return hasErrorPrefix(err, "412 Precondition Failed: ")
+}
+
 func hasErrorPrefix(err error, prefix string) bool {
 	for current := err; current != nil; current = errors.Unwrap(current) {
 		if strings.HasPrefix(current.Error(), prefix) {
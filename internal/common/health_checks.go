@@ -0,0 +1,158 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// healthCheckTimeout bounds a single subsystem check of the readiness endpoint.
+const healthCheckTimeout = 5 * time.Second
+
+// Health states reported in the status field of the health and readiness endpoints.
+const (
+	HealthStateUp       = "UP"
+	HealthStateDegraded = "DEGRADED"
+	HealthStateDown     = "DOWN"
+)
+
+// HealthCheck is one subsystem checked by the readiness endpoint. A failing
+// Critical check makes the service DOWN; a failing non-critical check, such
+// as a read replica next to a working primary, only makes it DEGRADED.
+type HealthCheck struct {
+	Name     string
+	Critical bool
+	Check    func(ctx context.Context) error
+}
+
+// HealthReport is the body of the readiness endpoint.
+// Degraded is set together with the DEGRADED status so clients that only
+// look at the HTTP status can still detect reduced service.
+type HealthReport struct {
+	Status   string            `json:"status"`
+	Degraded bool              `json:"degraded,omitempty"`
+	Checks   map[string]string `json:"checks"`
+}
+
+// DatabaseHealthCheck returns a HealthCheck that pings db.
+func DatabaseHealthCheck(name string, db interface {
+	PingContext(ctx context.Context) error
+}, critical bool) HealthCheck {
+	return HealthCheck{Name: name, Critical: critical, Check: db.PingContext}
+}
+
+// EvaluateHealthChecks runs all checks and combines them into one report:
+// UP when all pass, DOWN when a critical check fails, and DEGRADED when only
+// non-critical checks fail.
+func EvaluateHealthChecks(ctx context.Context, checks []HealthCheck) HealthReport {
+	report := HealthReport{Status: HealthStateUp, Checks: make(map[string]string, len(checks))}
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := check.Check(checkCtx)
+		cancel()
+		if err == nil {
+			report.Checks[check.Name] = HealthStateUp
+			continue
+		}
+		log.Printf("COMMON-HEALTH-CHECK %s failed: %v", check.Name, err)
+		report.Checks[check.Name] = HealthStateDown
+		switch {
+		case check.Critical:
+			report.Status = HealthStateDown
+		case report.Status == HealthStateUp:
+			report.Status = HealthStateDegraded
+		}
+	}
+	report.Degraded = report.Status == HealthStateDegraded
+	return report
+}
+
+// SchemaVersionHealthCheck returns a critical HealthCheck that passes once db
+// answers and basyxsystem holds the expected, clean schema version.
+func SchemaVersionHealthCheck(name string, db *sql.DB, expectedVersion string) HealthCheck {
+	return HealthCheck{Name: name, Critical: true, Check: func(ctx context.Context) error {
+		return CheckSchemaVersion(ctx, db, expectedVersion)
+	}}
+}
+
+// AddReadinessEndpointWithChecks registers {contextPath}/readyz backed by
+// subsystem checks. UP and DEGRADED are answered with 200, so load balancers
+// keep routing to a service that still works with reduced capacity; DOWN is
+// answered with 503. The body is a HealthReport listing every check.
+func AddReadinessEndpointWithChecks(r *chi.Mux, config *Config, checks ...HealthCheck) {
+	r.Get(config.Server.ContextPath+"/readyz", func(w http.ResponseWriter, req *http.Request) {
+		report := EvaluateHealthChecks(req.Context(), checks)
+		statusCode := http.StatusOK
+		if report.Status == HealthStateDown {
+			statusCode = http.StatusServiceUnavailable
+		}
+		writeHealthResponse(w, statusCode, report)
+	})
+}
+
+// AddDatabaseReadinessEndpoint registers {contextPath}/readyz for a service
+// backed by primary. All database checks of a service live here; /health is
+// the liveness probe and never touches the database. The primary database is a
+// critical check named "database" that passes once basyxsystem holds
+// expectedVersion. When postgres.replicaDsn is configured, the read replica is
+// an additional non-critical check named "replica", so an unreachable replica
+// reports DEGRADED instead of DOWN. The extra checks follow in order.
+//
+// The replica pool is only used by the readiness endpoint. It connects lazily,
+// keeps at most one connection, and lives as long as the process.
+func AddDatabaseReadinessEndpoint(r *chi.Mux, config *Config, primary *sql.DB, expectedVersion string, extra ...HealthCheck) error {
+	checks := []HealthCheck{SchemaVersionHealthCheck("database", primary, expectedVersion)}
+	if replicaDSN := strings.TrimSpace(config.Postgres.ReplicaDSN); replicaDSN != "" {
+		replica, err := openReplicaHealthDatabase(replicaDSN)
+		if err != nil {
+			return fmt.Errorf("COMMON-READYZ-REPLICADSN %w", err)
+		}
+		checks = append(checks, DatabaseHealthCheck("replica", replica, false))
+	}
+	AddReadinessEndpointWithChecks(r, config, append(checks, extra...)...)
+	return nil
+}
+
+func openReplicaHealthDatabase(dsn string) (*sql.DB, error) {
+	connConfig, err := pgx.ParseConfig(NormalizePostgresDSN(dsn))
+	if err != nil {
+		return nil, err
+	}
+	db := stdlib.OpenDB(*connConfig)
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	return db, nil
+}
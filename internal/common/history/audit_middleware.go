@@ -88,7 +88,7 @@ func auditPolicyID(cfg *common.Config, mode string) string {
 
 func buildRequestAuditContext(r *http.Request, mode string, policyID string) AuditContext {
 	audit := AuditContext{
-		RequestID:     firstHeaderValue(r, "X-Request-ID", "X-Request-Id", "Request-ID"),
+		RequestID:     requestAuditID(r),
 		CorrelationID: firstHeaderValue(r, "X-Correlation-ID", "X-Correlation-Id", "Correlation-ID"),
 		HTTPMethod:    strings.ToUpper(strings.TrimSpace(r.Method)),
 	}
@@ -151,6 +151,15 @@ func populateAuditRoute(r *http.Request, audit *AuditContext) {
 	audit.Operation = audit.HTTPMethod + " " + r.URL.Path
 }
 
+// requestAuditID prefers the request ID assigned by common.RequestIDMiddleware
+// so audit rows match the service logs.
+func requestAuditID(r *http.Request) string {
+	if requestID := common.RequestIDFromContext(r.Context()); requestID != "" {
+		return requestID
+	}
+	return firstHeaderValue(r, "X-Request-ID", "X-Request-Id", "Request-ID")
+}
+
 func firstHeaderValue(r *http.Request, names ...string) string {
 	for _, name := range names {
 		if value := strings.TrimSpace(r.Header.Get(name)); value != "" {
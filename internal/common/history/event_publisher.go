@@ -0,0 +1,87 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+// LogEventPublisher writes every published ChangeEvent as one JSON line to the
+// service log. The line carries a topic built from TopicPrefix, EntityType and
+// ChangeType, such as "basyx.assetlinks.created", so log shippers can route
+// events until broker adapters exist.
+type LogEventPublisher struct {
+	TopicPrefix string
+}
+
+// Publish logs event and never fails for a serializable event.
+//
+// Parameters:
+//   - context.Context: Ignored request context.
+//   - event: Change event to log.
+//
+// Returns:
+//   - error: Non-nil when event cannot be serialized.
+func (p LogEventPublisher) Publish(_ context.Context, event ChangeEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("HISTORY-EVENTS-MARSHAL failed to serialize change event: %w", err)
+	}
+	log.Printf("HISTORY-EVENTS-PUBLISH topic=%s %s", eventTopic(p.TopicPrefix, event), payload)
+	return nil
+}
+
+// NewEventPublisher returns the publisher configured by eventing, or nil when
+// eventing is disabled. Configuration loading already rejects sinks other than
+// common.EventingSinkLog.
+//
+// Parameters:
+//   - cfg: Eventing configuration of the service.
+//
+// Returns:
+//   - EventPublisher: Publisher for the configured sinks, or nil.
+func NewEventPublisher(cfg common.EventingConfig) EventPublisher {
+	if !cfg.Enabled {
+		return nil
+	}
+	return LogEventPublisher{TopicPrefix: strings.TrimSpace(cfg.TopicPrefix)}
+}
+
+func eventTopic(prefix string, event ChangeEvent) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{prefix, event.EntityType, event.ChangeType} {
+		if part = strings.ToLower(strings.TrimSpace(part)); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
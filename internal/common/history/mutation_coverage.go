@@ -51,8 +51,10 @@ var versionedMutationOperations = map[string]struct{}{
 	"DeleteSubmodelDescriptorByIdThroughSuperpath":    {},
 	"DeleteSubmodelElementByPathAasRepository":        {},
 	"DeleteSubmodelElementByPathSubmodelRepo":         {},
+	"DeleteSubmodelElementsByPathSubmodelRepo":        {},
 	"DeleteSubmodelReferenceAasRepository":            {},
 	"DeleteThumbnailAasRepository":                    {},
+	"PatchAssetAdministrationShellDescriptorById":     {},
 	"PatchSubmodelAasRepository":                      {},
 	"PatchSubmodelByIDMetadata":                       {},
 	"PatchSubmodelByIDValueOnly":                      {},
@@ -64,6 +66,7 @@ var versionedMutationOperations = map[string]struct{}{
 	"PatchSubmodelElementByPathSubmodelRepo":          {},
 	"PatchSubmodelElementByPathValueOnlySubmodelRepo": {},
 	"PatchSubmodelElementValueByPathAasRepository":    {},
+	"PatchSubmodelElementsValueOnlySubmodelRepo":      {},
 	"PatchSubmodelElementValueByPathMetadata":         {},
 	"PatchSubmodelElementValueByPathValueOnly":        {},
 	"PostAssetAdministrationShell":                    {},
@@ -159,10 +159,11 @@ type AnchorClient interface {
 	Anchor(ctx context.Context, batch AnchorBatch) (*AnchorResult, error)
 }
 
-// EventPublisher is the extension point for future CloudEvents-compatible publishing.
+// EventPublisher is the extension point for CloudEvents-compatible publishing.
 //
-// The history package defines the interface before eventing is wired so service
-// code can keep event publication separate from history persistence.
+// Service code publishes committed changes through this interface so event
+// delivery stays separate from history persistence. NewEventPublisher returns
+// the configured implementation.
 type EventPublisher interface {
 	Publish(ctx context.Context, event ChangeEvent) error
 }
@@ -27,6 +27,7 @@ package common
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
@@ -46,6 +47,19 @@ type HTTPServerRunner struct {
 	serviceCode     string
 	shutdownTimeout time.Duration
 	serveErr        chan error
+	useTLS          bool
+}
+
+// serverTLSCipherSuites are the TLS 1.2 suites offered by HTTPS listeners:
+// ECDHE key exchange with AEAD ciphers only. TLS 1.3 suites are not
+// configurable and always enabled.
+var serverTLSCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
 }
 
 // SignalContext returns a context that is canceled when the process receives
@@ -71,12 +85,41 @@ func ServerAddress(cfg ServerConfig) string {
 // The ctx parameter becomes the base context for accepted connections, allowing
 // request handlers to observe service shutdown through r.Context(). The cfg
 // parameter supplies the listen address and timeout values; unset timeout values
-// use secure BaSyx defaults. The returned server is not started.
+// use secure BaSyx defaults. Services pass a ctx carrying their *Config (see
+// ContextWithConfig) so the rate limiter honors trusted proxy headers. The
+// returned server is not started.
+//
+// The handler is wrapped, from outermost to innermost, with:
+//   - RequestIDMiddleware, so every response carries an X-Request-ID.
+//   - serverRateLimitMiddleware when cfg.RateLimit is enabled; /health and
+//     /readyz are exempt.
+//   - RequestTimeoutMiddleware for a positive cfg.RequestTimeoutSeconds.
+//   - ConcurrencyLimitMiddleware for a positive cfg.MaxConcurrentRequests.
+//   - RequestBodyLimitMiddleware; unset body and upload limits use the BaSyx defaults.
+//   - RequireContentTypeMiddleware when cfg.RequireContentType is set.
+//   - ResponseSizeWarningMiddleware for a positive cfg.ResponseSizeWarningBytes.
 func NewConfiguredHTTPServer(ctx context.Context, cfg ServerConfig, handler http.Handler) *http.Server {
 	baseCtx := ctx
 	if baseCtx == nil {
 		baseCtx = context.TODO()
 	}
+
+	// The chain is built from the innermost middleware outwards, so the last
+	// wrapper sees a request first. The request ID is assigned before anything
+	// can reject the request, so 429, 504 and 503 answers carry it too. The
+	// rate limit turns excess requests away before they start the timeout or
+	// occupy a concurrency slot. The concurrency limit sits inside the timeout
+	// so a request answered with 504 keeps its slot until the handler has
+	// actually returned. Body size and Content-Type checks run last, on
+	// admitted requests only.
+	handler = ResponseSizeWarningMiddleware(cfg.ResponseSizeWarningBytes, handler)
+	handler = RequireContentTypeMiddleware(cfg.RequireContentType, handler)
+	handler = RequestBodyLimitMiddleware(serverMaxRequestBodyBytes(cfg.MaxRequestBodyBytes), serverMaxUploadBodyBytes(cfg.MaxUploadBodyBytes), handler)
+	handler = ConcurrencyLimitMiddleware(cfg.MaxConcurrentRequests, handler)
+	handler = RequestTimeoutMiddleware(time.Duration(cfg.RequestTimeoutSeconds)*time.Second, handler)
+	handler = serverRateLimitMiddleware(cfg, handler)
+	handler = RequestIDMiddleware(handler)
+
 	return &http.Server{
 		Addr:              ServerAddress(cfg),
 		Handler:           handler,
@@ -90,17 +133,44 @@ func NewConfiguredHTTPServer(ctx context.Context, cfg ServerConfig, handler http
 	}
 }
 
+// newServerTLSConfig loads the certificate of cfg and returns the listener
+// TLS configuration: TLS 1.2 or newer, AEAD cipher suites, and ALPN for
+// HTTP/2 with HTTP/1.1 fallback.
+func newServerTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(strings.TrimSpace(cfg.CertFile), strings.TrimSpace(cfg.KeyFile))
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates:     []tls.Certificate{certificate},
+		MinVersion:       tls.VersionTLS12,
+		CipherSuites:     serverTLSCipherSuites,
+		CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP256},
+		NextProtos:       []string{"h2", "http/1.1"},
+	}, nil
+}
+
 // StartHTTPServer binds and starts a configured HTTP server in a managed
 // goroutine. The serviceCode parameter is normalized and used as the prefix for
 // coded RUNSERVER errors. The ctx parameter is propagated to request contexts
-// and is later passed to Wait to trigger graceful shutdown. The returned runner
-// has already bound its listener; startup failures are returned immediately.
+// and is later passed to Wait to trigger graceful shutdown. When cfg.TLS names
+// a certificate, the server speaks HTTPS with HTTP/2; otherwise plain HTTP.
+// The returned runner has already bound its listener; startup failures,
+// including unreadable certificates, are returned immediately.
 func StartHTTPServer(ctx context.Context, serviceCode string, cfg ServerConfig, handler http.Handler) (*HTTPServerRunner, error) {
 	normalizedServiceCode := normalizeServiceCode(serviceCode)
 	if ctx == nil {
 		return nil, fmt.Errorf("%s-RUNSERVER-CONTEXT context must not be nil", normalizedServiceCode)
 	}
 	server := NewConfiguredHTTPServer(ctx, cfg, handler)
+	useTLS := cfg.TLS.Enabled()
+	if useTLS {
+		tlsConfig, err := newServerTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("%s-RUNSERVER-TLS %w", normalizedServiceCode, err)
+		}
+		server.TLSConfig = tlsConfig
+	}
 	listener, err := net.Listen("tcp", server.Addr)
 	if err != nil {
 		return nil, fmt.Errorf("%s-RUNSERVER-LISTEN %w", normalizedServiceCode, err)
@@ -111,6 +181,7 @@ func StartHTTPServer(ctx context.Context, serviceCode string, cfg ServerConfig,
 		serviceCode:     normalizedServiceCode,
 		shutdownTimeout: serverTimeout(cfg.ShutdownTimeoutSeconds, DefaultConfig.ServerShutdownTimeoutSeconds),
 		serveErr:        make(chan error, 1),
+		useTLS:          useTLS,
 	}
 	go runner.serve(listener)
 	return runner, nil
@@ -163,7 +234,13 @@ func (runner *HTTPServerRunner) Wait(ctx context.Context) error {
 }
 
 func (runner *HTTPServerRunner) serve(listener net.Listener) {
-	err := runner.server.Serve(listener)
+	var err error
+	if runner.useTLS {
+		// The certificate is already part of server.TLSConfig.
+		err = runner.server.ServeTLS(listener, "", "")
+	} else {
+		err = runner.server.Serve(listener)
+	}
 	if errors.Is(err, http.ErrServerClosed) {
 		runner.serveErr <- nil
 		return
@@ -216,6 +293,20 @@ func serverTimeout(seconds int, defaultSeconds int) time.Duration {
 	return time.Duration(seconds) * time.Second
 }
 
+func serverMaxRequestBodyBytes(maxBodyBytes int64) int64 {
+	if maxBodyBytes <= 0 {
+		return DefaultConfig.ServerMaxRequestBodyBytes
+	}
+	return maxBodyBytes
+}
+
+func serverMaxUploadBodyBytes(maxUploadBodyBytes int64) int64 {
+	if maxUploadBodyBytes <= 0 {
+		return DefaultConfig.ServerMaxUploadBodyBytes
+	}
+	return maxUploadBodyBytes
+}
+
 func normalizeServiceCode(serviceCode string) string {
 	normalized := strings.ToUpper(strings.TrimSpace(serviceCode))
 	if normalized == "" {
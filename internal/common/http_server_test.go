@@ -30,6 +30,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -100,6 +101,50 @@ func TestNewConfiguredHTTPServerDefaultsZeroTimeouts(t *testing.T) {
 	}
 }
 
+func TestNewConfiguredHTTPServerHoldsConcurrencySlotUntilTimedOutHandlerReturns(t *testing.T) {
+	release := make(chan struct{})
+	exited := make(chan struct{}, 2)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+		exited <- struct{}{}
+	})
+	server := NewConfiguredHTTPServer(t.Context(), ServerConfig{RequestTimeoutSeconds: 1, MaxConcurrentRequests: 1}, handler)
+
+	first := httptest.NewRecorder()
+	server.Handler.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/submodels", nil))
+	if first.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected 504 for the slow request, got %d", first.Code)
+	}
+
+	second := httptest.NewRecorder()
+	server.Handler.ServeHTTP(second, httptest.NewRequest(http.MethodGet, "/submodels", nil))
+	if second.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 while the timed-out handler still runs, got %d", second.Code)
+	}
+
+	close(release)
+	select {
+	case <-exited:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed-out handler did not return")
+	}
+
+	// The slot is released right after the handler returns, so retry briefly.
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		third := httptest.NewRecorder()
+		server.Handler.ServeHTTP(third, httptest.NewRequest(http.MethodGet, "/submodels", nil))
+		if third.Code == http.StatusNoContent {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 204 once the slot is free, got %d", third.Code)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestRunServerContextCancellationShutsDownHTTPServer(t *testing.T) {
 	cfg := ServerConfig{
 		Host:                   "127.0.0.1",
@@ -0,0 +1,157 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeSelfSignedCertificate writes a certificate for 127.0.0.1 and its key
+// as PEM files and returns their paths with the parsed certificate.
+func writeSelfSignedCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "basyx-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return certFile, keyFile, certificate
+}
+
+func TestStartHTTPServerServesHTTPSWithConfiguredCertificate(t *testing.T) {
+	certFile, keyFile, certificate := writeSelfSignedCertificate(t)
+	cfg := ServerConfig{
+		Host:                   "127.0.0.1",
+		ShutdownTimeoutSeconds: 1,
+		TLS:                    TLSConfig{CertFile: certFile, KeyFile: keyFile},
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	runner, err := StartHTTPServer(t.Context(), "test", cfg, handler)
+	if err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = runner.server.Close()
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(certificate)
+	client := &http.Client{
+		Timeout: 2 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+			ForceAttemptHTTP2: true,
+		},
+	}
+	response, err := client.Get("https://" + runner.server.Addr + "/")
+	if err != nil {
+		t.Fatalf("HTTPS request failed: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", response.StatusCode)
+	}
+	if response.ProtoMajor != 2 {
+		t.Fatalf("expected HTTP/2, got %s", response.Proto)
+	}
+	if response.TLS == nil || response.TLS.Version < tls.VersionTLS12 {
+		t.Fatalf("expected TLS 1.2 or newer, got %+v", response.TLS)
+	}
+}
+
+func TestStartHTTPServerReturnsTLSErrorForMissingCertificate(t *testing.T) {
+	dir := t.TempDir()
+	cfg := ServerConfig{
+		Host: "127.0.0.1",
+		TLS:  TLSConfig{CertFile: filepath.Join(dir, "missing.crt"), KeyFile: filepath.Join(dir, "missing.key")},
+	}
+
+	runner, err := StartHTTPServer(t.Context(), "test", cfg, http.NotFoundHandler())
+	if err == nil || runner != nil {
+		t.Fatalf("expected startup failure, got runner=%v err=%v", runner, err)
+	}
+	if !strings.Contains(err.Error(), "TEST-RUNSERVER-TLS") {
+		t.Fatalf("expected TEST-RUNSERVER-TLS error, got %v", err)
+	}
+}
+
+func TestValidateTLSConfigRequiresCertificateAndKey(t *testing.T) {
+	if err := validateTLSConfig(TLSConfig{}); err != nil {
+		t.Fatalf("expected plain HTTP config to be valid, got %v", err)
+	}
+	if err := validateTLSConfig(TLSConfig{CertFile: "server.crt"}); err == nil || !strings.Contains(err.Error(), "CONFIG-SERVER-TLS") {
+		t.Fatalf("expected CONFIG-SERVER-TLS for a certificate without key, got %v", err)
+	}
+}
@@ -55,6 +55,11 @@ type SigningOptions struct {
 	// JWS "x5c" protected header. Leave it empty when no certificate chain
 	// should be embedded in signed responses.
 	CertificateChain []string
+
+	// KeyID is written as "kid" protected header. When empty, the RFC 7638
+	// thumbprint of the signing key is used, which matches the key ids
+	// published by KeySet.
+	KeyID string
 }
 
 // LoadPrivateKey reads and parses an RSA private key from a PEM file.
@@ -243,6 +248,7 @@ func SignPayload(privateKey *rsa.PrivateKey, payload []byte) (string, error) {
 //   - "sigT": Current UTC signing time formatted as RFC3339.
 //   - "sid": A random UUID-style signature identifier generated per signature.
 //   - "x5c": Optional certificate chain from options.CertificateChain.
+//   - "kid": options.KeyID or the RFC 7638 thumbprint of the signing key.
 //
 // Parameters:
 //   - privateKey: RSA private key used for RS256 signing.
@@ -264,7 +270,14 @@ func SignPayloadWithOptions(privateKey *rsa.PrivateKey, payload []byte, options
 	if err != nil {
 		return "", err
 	}
-	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: privateKey}, signerOptions)
+	keyID := options.KeyID
+	if keyID == "" {
+		if keyID, err = KeyID(&privateKey.PublicKey); err != nil {
+			return "", err
+		}
+	}
+	signingKey := jose.JSONWebKey{Key: privateKey, KeyID: keyID, Algorithm: string(jose.RS256)}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: signingKey}, signerOptions)
 	if err != nil {
 		return "", fmt.Errorf("JWS-SIGN-NEWSIGNER %w", err)
 	}
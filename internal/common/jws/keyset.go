@@ -0,0 +1,253 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package jws
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+
+	jose "gopkg.in/go-jose/go-jose.v2"
+)
+
+// JWKSPath is the well-known route that publishes the verification keys of a
+// KeySet.
+const JWKSPath = "/.well-known/jwks.json"
+
+// KeySet holds the RSA key used for signing responses together with the public
+// keys of previously used signing keys. Publishing both through JWKS lets
+// verifiers accept signatures created before a key rotation until the previous
+// key is removed from the configuration.
+//
+// A KeySet created by LoadKeySet can be reloaded at runtime; signers always
+// read the current key, so a rotation takes effect without a restart.
+type KeySet struct {
+	mu                     sync.RWMutex
+	privateKeyPath         string
+	previousPublicKeyPaths []string
+	signingKey             *rsa.PrivateKey
+	signingKeyID           string
+	verificationKeys       []jose.JSONWebKey
+}
+
+// KeyID returns the RFC 7638 JWK thumbprint of publicKey as base64url string.
+// It is used as "kid" in signed responses and in the published JWKS.
+//
+// Parameters:
+//   - publicKey: RSA public key to identify.
+//
+// Returns:
+//   - string: Base64url encoded SHA-256 thumbprint.
+//   - error: Error when publicKey is nil or the thumbprint cannot be computed.
+func KeyID(publicKey *rsa.PublicKey) (string, error) {
+	if publicKey == nil {
+		return "", fmt.Errorf("JWS-KEYID-NILKEY public key must not be nil")
+	}
+	jwk := jose.JSONWebKey{Key: publicKey}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("JWS-KEYID-THUMBPRINT %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// NewKeySet creates a KeySet from in-memory keys. Such a KeySet cannot be
+// reloaded.
+//
+// Parameters:
+//   - signingKey: RSA private key used for new signatures.
+//   - previousKeys: Public keys of earlier signing keys that verifiers should
+//     still accept.
+//
+// Returns:
+//   - *KeySet: Key set signing with signingKey.
+//   - error: Error when signingKey is nil or a key id cannot be computed.
+func NewKeySet(signingKey *rsa.PrivateKey, previousKeys ...*rsa.PublicKey) (*KeySet, error) {
+	keySet := &KeySet{}
+	if err := keySet.set(signingKey, previousKeys); err != nil {
+		return nil, err
+	}
+	return keySet, nil
+}
+
+// LoadKeySet loads the signing key and the public keys of previous signing keys
+// from PEM files. The returned KeySet remembers the paths so that Reload can
+// pick up rotated files.
+//
+// Parameters:
+//   - privateKeyPath: Filesystem path to the PEM encoded signing key.
+//   - previousPublicKeyPaths: Filesystem paths to PEM encoded public keys of
+//     earlier signing keys. Blank entries and missing files are ignored, so
+//     operators can configure the paths before the first rotation.
+//
+// Returns:
+//   - *KeySet: Loaded key set.
+//   - error: Error when a key file cannot be read or parsed.
+func LoadKeySet(privateKeyPath string, previousPublicKeyPaths []string) (*KeySet, error) {
+	keySet := &KeySet{
+		privateKeyPath:         strings.TrimSpace(privateKeyPath),
+		previousPublicKeyPaths: previousPublicKeyPaths,
+	}
+	if err := keySet.Reload(); err != nil {
+		return nil, err
+	}
+	return keySet, nil
+}
+
+// Reload re-reads the key files the KeySet was loaded from. On error the
+// previously loaded keys stay active.
+//
+// Returns:
+//   - error: Error when the KeySet was not created by LoadKeySet or a key file
+//     cannot be read or parsed.
+func (k *KeySet) Reload() error {
+	if k.privateKeyPath == "" {
+		return fmt.Errorf("JWS-KEYSET-RELOAD-NOPATH key set was not loaded from files")
+	}
+	signingKey, err := LoadPrivateKey(k.privateKeyPath)
+	if err != nil {
+		return fmt.Errorf("JWS-KEYSET-RELOAD-PRIVATEKEY %w", err)
+	}
+	previousKeys := make([]*rsa.PublicKey, 0, len(k.previousPublicKeyPaths))
+	for _, path := range k.previousPublicKeyPaths {
+		if strings.TrimSpace(path) == "" {
+			continue
+		}
+		publicKey, loadErr := LoadPublicKey(strings.TrimSpace(path))
+		if errors.Is(loadErr, fs.ErrNotExist) {
+			// Previous key slots may stay empty until the first rotation.
+			continue
+		}
+		if loadErr != nil {
+			return fmt.Errorf("JWS-KEYSET-RELOAD-PUBLICKEY %s: %w", path, loadErr)
+		}
+		previousKeys = append(previousKeys, publicKey)
+	}
+	return k.set(signingKey, previousKeys)
+}
+
+func (k *KeySet) set(signingKey *rsa.PrivateKey, previousKeys []*rsa.PublicKey) error {
+	if signingKey == nil {
+		return fmt.Errorf("JWS-KEYSET-NILKEY signing key must not be nil")
+	}
+	signingKeyID, err := KeyID(&signingKey.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	verificationKeys := []jose.JSONWebKey{newVerificationKey(&signingKey.PublicKey, signingKeyID)}
+	seen := map[string]bool{signingKeyID: true}
+	for _, publicKey := range previousKeys {
+		keyID, keyErr := KeyID(publicKey)
+		if keyErr != nil {
+			return keyErr
+		}
+		if seen[keyID] {
+			continue
+		}
+		seen[keyID] = true
+		verificationKeys = append(verificationKeys, newVerificationKey(publicKey, keyID))
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.signingKey = signingKey
+	k.signingKeyID = signingKeyID
+	k.verificationKeys = verificationKeys
+	return nil
+}
+
+func newVerificationKey(publicKey *rsa.PublicKey, keyID string) jose.JSONWebKey {
+	return jose.JSONWebKey{Key: publicKey, KeyID: keyID, Algorithm: string(jose.RS256), Use: "sig"}
+}
+
+// SigningKey returns the current signing key and its key id.
+func (k *KeySet) SigningKey() (*rsa.PrivateKey, string) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.signingKey, k.signingKeyID
+}
+
+// Sign signs payload with the current signing key and writes its key id as
+// "kid" protected header. See SignPayloadWithOptions for the other headers.
+func (k *KeySet) Sign(payload []byte, options SigningOptions) (string, error) {
+	signingKey, signingKeyID := k.SigningKey()
+	options.KeyID = signingKeyID
+	return SignPayloadWithOptions(signingKey, payload, options)
+}
+
+// JWKS returns the public keys verifiers should accept, current signing key
+// first.
+func (k *KeySet) JWKS() jose.JSONWebKeySet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	keys := make([]jose.JSONWebKey, len(k.verificationKeys))
+	copy(keys, k.verificationKeys)
+	return jose.JSONWebKeySet{Keys: keys}
+}
+
+// ServeHTTP publishes the JWKS of the KeySet as application/jwk-set+json.
+func (k *KeySet) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if err := json.NewEncoder(w).Encode(k.JWKS()); err != nil {
+		log.Printf("JWS-JWKS-ENCODE failed to write JWKS: %v", err)
+	}
+}
+
+// ReloadOnSignal reloads the KeySet whenever one of signals is received until
+// ctx is done. Failed reloads are logged and keep the previous keys.
+func (k *KeySet) ReloadOnSignal(ctx context.Context, signals ...os.Signal) {
+	received := make(chan os.Signal, 1)
+	signal.Notify(received, signals...)
+	go func() {
+		defer signal.Stop(received)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-received:
+				if err := k.Reload(); err != nil {
+					log.Printf("JWS key set reload failed, keeping previous keys: %v", err)
+					continue
+				}
+				_, keyID := k.SigningKey()
+				log.Printf("JWS key set reloaded, signing with kid %s", keyID)
+			}
+		}
+	}()
+}
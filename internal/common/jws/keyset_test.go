@@ -0,0 +1,192 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package jws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	jose "gopkg.in/go-jose/go-jose.v2"
+)
+
+func TestKeySetSignatureKeyIDResolvesInPublishedJWKS(t *testing.T) {
+	t.Parallel()
+
+	signingKey := newTestRSAKey(t)
+	previousKey := newTestRSAKey(t)
+	keySet, err := NewKeySet(signingKey, &previousKey.PublicKey)
+	require.NoError(t, err)
+
+	compact, err := keySet.Sign([]byte(`{"id":"sm"}`), SigningOptions{})
+	require.NoError(t, err)
+
+	jwks := fetchJWKS(t, keySet)
+	require.Len(t, jwks.Keys, 2)
+	requireVerifiesWithPublishedKey(t, compact, jwks)
+
+	_, signingKeyID := keySet.SigningKey()
+	require.Equal(t, signingKeyID, jwks.Keys[0].KeyID)
+	previousKeyID, err := KeyID(&previousKey.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, previousKeyID, jwks.Keys[1].KeyID)
+}
+
+func TestSignPayloadWritesThumbprintKeyID(t *testing.T) {
+	t.Parallel()
+
+	signingKey := newTestRSAKey(t)
+	compact, err := SignPayload(signingKey, []byte(`{}`))
+	require.NoError(t, err)
+
+	signed, err := jose.ParseSigned(compact)
+	require.NoError(t, err)
+	expected, err := KeyID(&signingKey.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, signed.Signatures[0].Protected.KeyID)
+}
+
+func TestKeySetReloadRotatesSigningKeyAndKeepsPreviousKeyPublished(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	privateKeyPath := filepath.Join(dir, "signing.pem")
+	previousKeyPath := filepath.Join(dir, "previous.pem")
+	firstKey := newTestRSAKey(t)
+	writePrivateKeyFile(t, privateKeyPath, firstKey)
+
+	keySet, err := LoadKeySet(privateKeyPath, []string{previousKeyPath})
+	require.NoError(t, err)
+	require.Len(t, keySet.JWKS().Keys, 1, "missing previous key files are skipped")
+
+	beforeRotation, err := keySet.Sign([]byte(`{"v":1}`), SigningOptions{})
+	require.NoError(t, err)
+	_, firstKeyID := keySet.SigningKey()
+
+	writePublicKeyFile(t, previousKeyPath, &firstKey.PublicKey)
+	require.NoError(t, keySet.Reload())
+	require.Len(t, keySet.JWKS().Keys, 1, "previous key equal to the signing key is published once")
+
+	secondKey := newTestRSAKey(t)
+	writePrivateKeyFile(t, privateKeyPath, secondKey)
+	require.NoError(t, keySet.Reload())
+
+	afterRotation, err := keySet.Sign([]byte(`{"v":2}`), SigningOptions{})
+	require.NoError(t, err)
+	_, secondKeyID := keySet.SigningKey()
+	require.NotEqual(t, firstKeyID, secondKeyID)
+
+	jwks := fetchJWKS(t, keySet)
+	require.Len(t, jwks.Keys, 2)
+	requireVerifiesWithPublishedKey(t, beforeRotation, jwks)
+	requireVerifiesWithPublishedKey(t, afterRotation, jwks)
+}
+
+func TestKeySetReloadFailureKeepsPreviousKeys(t *testing.T) {
+	t.Parallel()
+
+	privateKeyPath := filepath.Join(t.TempDir(), "signing.pem")
+	writePrivateKeyFile(t, privateKeyPath, newTestRSAKey(t))
+	keySet, err := LoadKeySet(privateKeyPath, nil)
+	require.NoError(t, err)
+	_, keyID := keySet.SigningKey()
+
+	require.NoError(t, os.WriteFile(privateKeyPath, []byte("not a key"), 0o600))
+	require.ErrorContains(t, keySet.Reload(), "JWS-KEYSET-RELOAD-PRIVATEKEY")
+
+	_, keyIDAfterFailure := keySet.SigningKey()
+	require.Equal(t, keyID, keyIDAfterFailure)
+}
+
+func TestNewKeySetCannotBeReloaded(t *testing.T) {
+	t.Parallel()
+
+	keySet, err := NewKeySet(newTestRSAKey(t))
+	require.NoError(t, err)
+	require.ErrorContains(t, keySet.Reload(), "JWS-KEYSET-RELOAD-NOPATH")
+
+	_, err = NewKeySet(nil)
+	require.ErrorContains(t, err, "JWS-KEYSET-NILKEY")
+}
+
+func fetchJWKS(t *testing.T, keySet *KeySet) jose.JSONWebKeySet {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	keySet.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, JWKSPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/jwk-set+json", recorder.Header().Get("Content-Type"))
+	require.NotContains(t, recorder.Body.String(), `"d"`, "JWKS must not leak private key material")
+
+	var jwks jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jwks))
+	return jwks
+}
+
+func requireVerifiesWithPublishedKey(t *testing.T, compact string, jwks jose.JSONWebKeySet) {
+	t.Helper()
+
+	signed, err := jose.ParseSigned(compact)
+	require.NoError(t, err)
+	keyID := signed.Signatures[0].Protected.KeyID
+	require.NotEmpty(t, keyID)
+
+	keys := jwks.Key(keyID)
+	require.Len(t, keys, 1, "kid %s must resolve in the JWKS", keyID)
+	_, err = signed.Verify(keys[0].Key)
+	require.NoError(t, err)
+}
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func writePrivateKeyFile(t *testing.T, path string, key *rsa.PrivateKey) {
+	t.Helper()
+
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, os.WriteFile(path, pemData, 0o600))
+}
+
+func writePublicKeyFile(t *testing.T, path string, key *rsa.PublicKey) {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+}
@@ -0,0 +1,74 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// Registry and discovery listings page with a keyset on the unique identifier
+// they are sorted by. The cursor is the identifier of the first row of the next
+// page, so a page is defined by "identifier >= cursor" instead of an OFFSET.
+// Rows inserted while a client iterates either sort before the cursor and are
+// not returned, or sort after it and are returned once; already listed rows
+// are never shifted into or out of later pages.
+
+// WhereKeysetCursor restricts ds to rows whose key sorts at or after cursor.
+// An empty cursor selects the first page. The caller must order ds ascending
+// by the same key.
+func WhereKeysetCursor(ds *goqu.SelectDataset, key exp.Comparable, cursor string) *goqu.SelectDataset {
+	if cursor == "" {
+		return ds
+	}
+	return ds.Where(key.Gte(cursor))
+}
+
+// WherePreviousKeysetPage restricts ds to the limit rows whose key sorts
+// directly before cursor, nearest first. The key of the last row is the cursor
+// of the previous page; no rows mean cursor already starts the first page. It
+// replaces the order of ds.
+func WherePreviousKeysetPage(ds *goqu.SelectDataset, key exp.IdentifierExpression, cursor string, limit int32) *goqu.SelectDataset {
+	ds = ds.Where(key.Lt(cursor)).Order(key.Desc())
+	if limit > 0 {
+		ds = ds.Limit(uint(limit)) // #nosec G115 -- limit is positive
+	}
+	return ds
+}
+
+// SplitKeysetPage trims items fetched with limit+1 rows to limit rows and
+// returns the key of the first omitted row as the next cursor. A negative
+// limit returns all items without a cursor.
+func SplitKeysetPage[T any](items []T, limit int32, keyFn func(T) string) ([]T, string) {
+	if limit < 0 {
+		return items, ""
+	}
+	intLimit := int(limit)
+	if len(items) > intLimit {
+		return items[:intLimit], keyFn(items[intLimit])
+	}
+	return items, ""
+}
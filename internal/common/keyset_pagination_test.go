@@ -0,0 +1,177 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+)
+
+func TestWhereKeysetCursorBuildsInclusiveLowerBound(t *testing.T) {
+	base := goqu.Dialect(Dialect).From("aas_descriptor").Select("id").Order(goqu.C("id").Asc())
+
+	firstPage, _, err := WhereKeysetCursor(base, goqu.C("id"), "").ToSQL()
+	if err != nil {
+		t.Fatalf("build first page: %v", err)
+	}
+	if strings.Contains(firstPage, "WHERE") {
+		t.Fatalf("expected no cursor condition on first page, got %s", firstPage)
+	}
+
+	nextPage, _, err := WhereKeysetCursor(base, goqu.C("id"), "urn:b").ToSQL()
+	if err != nil {
+		t.Fatalf("build next page: %v", err)
+	}
+	if !strings.Contains(nextPage, `WHERE ("id" >= 'urn:b')`) {
+		t.Fatalf("expected inclusive keyset condition, got %s", nextPage)
+	}
+}
+
+func TestSplitKeysetPage(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	page, cursor := SplitKeysetPage(items, 2, func(id string) string { return id })
+	if strings.Join(page, ",") != "a,b" || cursor != "c" {
+		t.Fatalf("expected page a,b with cursor c, got %v cursor %q", page, cursor)
+	}
+
+	page, cursor = SplitKeysetPage(items, 3, func(id string) string { return id })
+	if len(page) != 3 || cursor != "" {
+		t.Fatalf("expected full last page without cursor, got %v cursor %q", page, cursor)
+	}
+
+	page, cursor = SplitKeysetPage(items, -1, func(id string) string { return id })
+	if len(page) != 3 || cursor != "" {
+		t.Fatalf("expected unlimited page without cursor, got %v cursor %q", page, cursor)
+	}
+}
+
+func TestKeysetPagingIsStableUnderConcurrentInserts(t *testing.T) {
+	table := []string{"urn:02", "urn:04", "urn:06", "urn:08", "urn:10", "urn:12"}
+	original := append([]string{}, table...)
+	inserts := [][]string{{"urn:01", "urn:07"}, {"urn:05", "urn:11"}, {"urn:13"}}
+
+	fetch := func(cursor string, limit int32) ([]string, string) {
+		sorted := append([]string{}, table...)
+		sort.Strings(sorted)
+		rows := make([]string, 0, limit+1)
+		for _, id := range sorted {
+			if (cursor == "" || id >= cursor) && len(rows) < int(limit)+1 {
+				rows = append(rows, id)
+			}
+		}
+		return SplitKeysetPage(rows, limit, func(id string) string { return id })
+	}
+
+	seen := map[string]int{}
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		items, next := fetch(cursor, 2)
+		for _, id := range items {
+			seen[id]++
+		}
+		if page < len(inserts) {
+			table = append(table, inserts[page]...)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	for _, id := range original {
+		if seen[id] != 1 {
+			t.Fatalf("expected %s exactly once, got %d (seen %v)", id, seen[id], seen)
+		}
+	}
+	for id, count := range seen {
+		if count != 1 {
+			t.Fatalf("expected %s at most once, got %d", id, count)
+		}
+	}
+}
+
+func TestWherePreviousKeysetPageBuildsDescendingUpperBound(t *testing.T) {
+	base := goqu.Dialect(Dialect).From("aas_descriptor").Select("id").Order(goqu.C("id").Asc())
+
+	sql, _, err := WherePreviousKeysetPage(base, goqu.I("id"), "urn:c", 2).ToSQL()
+	if err != nil {
+		t.Fatalf("build previous page: %v", err)
+	}
+	if !strings.HasSuffix(sql, `WHERE ("id" < 'urn:c') ORDER BY "id" DESC LIMIT 2`) {
+		t.Fatalf("expected exclusive descending keyset condition, got %s", sql)
+	}
+}
+
+func TestPreviousKeysetCursorReturnsToPreviousPage(t *testing.T) {
+	table := []string{"urn:01", "urn:02", "urn:03", "urn:04", "urn:05", "urn:06", "urn:07"}
+	const limit = 3
+
+	// fetch and fetchPrevious evaluate the conditions of WhereKeysetCursor and
+	// WherePreviousKeysetPage on the sorted table.
+	fetch := func(cursor string) ([]string, string) {
+		rows := make([]string, 0, limit+1)
+		for _, id := range table {
+			if (cursor == "" || id >= cursor) && len(rows) < limit+1 {
+				rows = append(rows, id)
+			}
+		}
+		return SplitKeysetPage(rows, limit, func(id string) string { return id })
+	}
+	fetchPrevious := func(cursor string) string {
+		previous := ""
+		taken := 0
+		for i := len(table) - 1; i >= 0 && taken < limit; i-- {
+			if table[i] < cursor {
+				previous = table[i]
+				taken++
+			}
+		}
+		return previous
+	}
+
+	first, cursor := fetch("")
+	second, next := fetch(cursor)
+	third, _ := fetch(next)
+	if strings.Join(third, ",") != "urn:07" {
+		t.Fatalf("expected last page urn:07, got %v", third)
+	}
+
+	back, _ := fetch(fetchPrevious(next))
+	if strings.Join(back, ",") != strings.Join(second, ",") {
+		t.Fatalf("expected previous cursor to return %v, got %v", second, back)
+	}
+	back, _ = fetch(fetchPrevious(cursor))
+	if strings.Join(back, ",") != strings.Join(first, ",") {
+		t.Fatalf("expected previous cursor to return %v, got %v", first, back)
+	}
+	if previous := fetchPrevious(table[0]); previous != "" {
+		t.Fatalf("expected no previous cursor on the first page, got %q", previous)
+	}
+}
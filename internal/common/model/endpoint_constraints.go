@@ -0,0 +1,75 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package model
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxEndpointHrefLength is the length limit of protocolInformation.href in
+// the AAS Part 2 schema.
+const maxEndpointHrefLength = 2048
+
+// allowedEndpointHrefSchemes lists the URL schemes accepted for descriptor
+// endpoints. It covers the HTTP, WebSocket, MQTT and OPC UA endpoint protocols.
+var allowedEndpointHrefSchemes = map[string]struct{}{
+	"http":    {},
+	"https":   {},
+	"ws":      {},
+	"wss":     {},
+	"mqtt":    {},
+	"mqtts":   {},
+	"opc.tcp": {},
+}
+
+// assertEndpointHref rejects hrefs that are not absolute URLs with an allowed
+// scheme and a host, so malformed endpoints fail at registration instead of
+// when a client dereferences them.
+func assertEndpointHref(href string) error {
+	if href == "" {
+		return nil
+	}
+	if len(href) > maxEndpointHrefLength {
+		return errors.New("COMMON-ENDPOINTCONSTRAINTS-HREFLENGTH protocolInformation.href must not be longer than " + strconv.Itoa(maxEndpointHrefLength) + " characters")
+	}
+	if strings.ContainsAny(href, " \t\r\n") {
+		return errors.New("COMMON-ENDPOINTCONSTRAINTS-HREFWHITESPACE protocolInformation.href must not contain whitespace: " + strconv.Quote(href))
+	}
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return errors.New("COMMON-ENDPOINTCONSTRAINTS-HREFSYNTAX protocolInformation.href is not a valid URL: " + strconv.Quote(href))
+	}
+	if _, ok := allowedEndpointHrefSchemes[strings.ToLower(parsed.Scheme)]; !ok {
+		return errors.New("COMMON-ENDPOINTCONSTRAINTS-HREFSCHEME protocolInformation.href must be an absolute URL with one of the schemes http, https, ws, wss, mqtt, mqtts or opc.tcp: " + strconv.Quote(href))
+	}
+	if parsed.Host == "" {
+		return errors.New("COMMON-ENDPOINTCONSTRAINTS-HREFHOST protocolInformation.href must contain a host: " + strconv.Quote(href))
+	}
+	return nil
+}
@@ -108,6 +108,11 @@ type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error, result
 func DefaultErrorHandler(w http.ResponseWriter, _ *http.Request, err error, result *ImplResponse) {
 	var parsingErr *ParsingError
 	if ok := errors.As(err, &parsingErr); ok {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(parsingErr.Err, &maxBytesErr) {
+			_ = WriteErrorResponse(w, err, http.StatusRequestEntityTooLarge, "OPENAPI", "DefaultErrorHandler", "RequestBodyTooLarge")
+			return
+		}
 		_ = WriteErrorResponse(w, err, http.StatusBadRequest, "OPENAPI", "DefaultErrorHandler", "ParseRequest")
 		return
 	}
@@ -59,3 +59,13 @@ func TestDefaultErrorHandlerWritesStandardizedParsingError(t *testing.T) {
 		t.Fatalf("expected correlation ID and timestamp, got %#v", body[0])
 	}
 }
+
+func TestDefaultErrorHandlerMapsOversizedBodyToPayloadTooLarge(t *testing.T) {
+	recorder := httptest.NewRecorder()
+
+	DefaultErrorHandler(recorder, nil, &ParsingError{Err: &http.MaxBytesError{Limit: 16}}, nil)
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, recorder.Code)
+	}
+}
@@ -32,9 +32,16 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
 )
 
+// DefaultMaxFieldPathDepth is the maximum number of path segments a single
+// FieldIdentifier may contain before it is rejected. Every object or array hop
+// may add a join to the generated SQL, so unbounded paths are refused up front.
+// Services pass general.maxFieldPathDepth to each collector instead.
+const DefaultMaxFieldPathDepth = 32
+
 var smeIDShortPathArrayIndexPattern = regexp.MustCompile(`\[[^\]]*\]`)
 var smeIDShortPathTerminalIndexPattern = regexp.MustCompile(`(\[[0-9]+\])$`)
 var smeIDShortPathTerminalWildcardPattern = regexp.MustCompile(`(\[\])$`)
@@ -403,6 +410,40 @@ func smeRawIDShortPathFromField(fieldStr string) (string, bool) {
 	return path, true
 }
 
+// fieldPathDepth counts the path segments of a FieldIdentifier, including the
+// $sme idShortPath prefix. Each "." separated segment and each array selector
+// counts as one level.
+func fieldPathDepth(fieldStr string) int {
+	depth := 0
+	countSegments := func(path string) {
+		if strings.TrimSpace(path) == "" {
+			return
+		}
+		depth += strings.Count(path, ".") + 1
+		depth += strings.Count(path, "[")
+	}
+
+	prefix, rest, _ := strings.Cut(fieldStr, "#")
+	if strings.HasPrefix(prefix, "$sme") {
+		countSegments(strings.TrimPrefix(strings.TrimPrefix(prefix, "$sme"), "."))
+	}
+	countSegments(rest)
+	return depth
+}
+
+// validateFieldPathDepth rejects FieldIdentifiers that exceed maxDepth with a
+// 400 Bad Request error. A non-positive maxDepth falls back to
+// DefaultMaxFieldPathDepth.
+func validateFieldPathDepth(fieldStr string, maxDepth int) error {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxFieldPathDepth
+	}
+	if depth := fieldPathDepth(fieldStr); depth > maxDepth {
+		return common.NewErrBadRequest(fmt.Sprintf("GRAMMAR-FIELDPATH-TOODEEP field path %q has depth %d, maximum allowed is %d", fieldStr, depth, maxDepth))
+	}
+	return nil
+}
+
 func smePrefixIndexBindingsFromField(fieldStr string) []ArrayIndexBinding {
 	rawPath, ok := smeRawIDShortPathFromField(fieldStr)
 	if !ok {
@@ -828,6 +828,7 @@ type ResolvedFieldPathCollector struct {
 	joinConfig                   *JoinPlanConfig
 	inlineAliases                map[string]struct{}
 	fragmentBindingAliasRewrites map[string]string
+	maxFieldPathDepth            int
 }
 
 // NewResolvedFieldPathCollectorWithConfig creates a collector with the provided join config.
@@ -852,6 +853,36 @@ func (c *ResolvedFieldPathCollector) AllowInlineAliases(aliases ...string) {
 	}
 }
 
+// SetMaxFieldPathDepth limits the number of path segments a FieldIdentifier may
+// contain. Deeper fields are rejected with a 400 Bad Request before any SQL is
+// generated. A non-positive depth restores DefaultMaxFieldPathDepth.
+func (c *ResolvedFieldPathCollector) SetMaxFieldPathDepth(depth int) {
+	if c == nil {
+		return
+	}
+	c.maxFieldPathDepth = depth
+}
+
+func (c *ResolvedFieldPathCollector) checkFieldPathDepth(fieldStr string) error {
+	maxDepth := DefaultMaxFieldPathDepth
+	if c != nil && c.maxFieldPathDepth > 0 {
+		maxDepth = c.maxFieldPathDepth
+	}
+	return validateFieldPathDepth(fieldStr, maxDepth)
+}
+
+func (c *ResolvedFieldPathCollector) checkOperandFieldPathDepth(operands ...*Value) error {
+	for _, operand := range operands {
+		if operand == nil || operand.Field == nil {
+			continue
+		}
+		if err := c.checkFieldPathDepth(string(*operand.Field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetRootJoinKey configures the outer alias and column used to correlate
 // generated EXISTS expressions with the caller's dataset.
 func (c *ResolvedFieldPathCollector) SetRootJoinKey(alias string, column string) {
@@ -1634,6 +1665,9 @@ func (le *LogicalExpression) evaluateFragmentToExpression(collector *ResolvedFie
 	// NOTE: Fragment expressions are intentionally kept simple.
 	// A fragment resolves only to array index bindings (e.g. "specific_asset_id.position = 0").
 	// We do not register fragments in the ResolvedFieldPathCollector anymore.
+	if err := collector.checkFieldPathDepth(string(fragment)); err != nil {
+		return nil, nil, err
+	}
 	bindings, err := ResolveFragmentFieldToSQL(&fragment)
 	if err != nil {
 		return nil, nil, err
@@ -1672,6 +1706,9 @@ func handleBinaryOperationWithoutCollector(
 	if leftField != nil && rightField != nil {
 		return nil, nil, fieldToFieldErr
 	}
+	if err := (*ResolvedFieldPathCollector)(nil).checkOperandFieldPathDepth(leftField, rightField); err != nil {
+		return nil, nil, err
+	}
 
 	// Fast-path: both are values (no FieldIdentifiers involved).
 	if leftField == nil && rightField == nil {
@@ -1739,6 +1776,9 @@ func handleBinaryOperationWithCollector(
 	if leftField != nil && rightField != nil {
 		return nil, nil, fieldToFieldErr
 	}
+	if err := collector.checkOperandFieldPathDepth(leftField, rightField); err != nil {
+		return nil, nil, err
+	}
 
 	// Fast-path: both are values (no FieldIdentifiers involved).
 	if leftField == nil && rightField == nil {
@@ -2008,6 +2048,9 @@ func (le *LogicalExpression) EvaluateToExpressionWithNegatedFragments(
 	for i, f := range fragments {
 		// Preserve the optimization: wildcard fragments (no fixed bindings) should not
 		// contribute a redundant OR NOT(1=1) term.
+		if err := collector.checkFieldPathDepth(string(f)); err != nil {
+			return nil, nil, fmt.Errorf("error evaluating fragment at index %d: %w", i, err)
+		}
 		bindings, err := ResolveFragmentFieldToSQL(&f)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error evaluating fragment at index %d: %w", i, err)
@@ -0,0 +1,94 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package grammar
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+func deepSMEField(depth int) string {
+	segments := make([]string, depth)
+	for i := range segments {
+		segments[i] = "level"
+	}
+	return "$sme." + strings.Join(segments, ".") + "#value"
+}
+
+func TestEvaluateToExpression_RejectsExcessiveFieldPathDepth(t *testing.T) {
+	expr := LogicalExpression{
+		Eq: ComparisonItems{
+			field(deepSMEField(DefaultMaxFieldPathDepth + 1)),
+			strVal("100"),
+		},
+	}
+
+	collector := mustCollectorForRoot(t, "$sme")
+	whereExpr, resolved, err := expr.EvaluateToExpression(collector)
+	if err == nil {
+		t.Fatalf("expected excessive field path depth to be rejected")
+	}
+	if !common.IsErrBadRequest(err) {
+		t.Fatalf("expected 400 Bad Request error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "GRAMMAR-FIELDPATH-TOODEEP") {
+		t.Fatalf("expected GRAMMAR-FIELDPATH-TOODEEP error, got: %v", err)
+	}
+	if whereExpr != nil || resolved != nil {
+		t.Fatalf("expected no SQL expression or resolved paths, got %#v / %#v", whereExpr, resolved)
+	}
+}
+
+func TestEvaluateToExpression_CollectorMaxFieldPathDepth(t *testing.T) {
+	expr := LogicalExpression{
+		Eq: ComparisonItems{
+			field("$sme.a.b.c#value"),
+			strVal("100"),
+		},
+	}
+
+	collector := mustCollectorForRoot(t, "$sme")
+	if _, _, err := expr.EvaluateToExpression(collector); err != nil {
+		t.Fatalf("expected default depth limit to accept field, got: %v", err)
+	}
+
+	collector = mustCollectorForRoot(t, "$sme")
+	collector.SetMaxFieldPathDepth(3)
+	if _, _, err := expr.EvaluateToExpression(collector); !common.IsErrBadRequest(err) {
+		t.Fatalf("expected 400 Bad Request for depth 4 with limit 3, got: %v", err)
+	}
+}
+
+func TestEvaluateFragmentToExpression_RejectsExcessiveFieldPathDepth(t *testing.T) {
+	le := LogicalExpression{}
+	fragment := FragmentStringPattern(strings.TrimSuffix(deepSMEField(DefaultMaxFieldPathDepth+1), "#value"))
+
+	if _, _, err := le.evaluateFragmentToExpression(nil, fragment); !common.IsErrBadRequest(err) {
+		t.Fatalf("expected 400 Bad Request for deep fragment, got: %v", err)
+	}
+}
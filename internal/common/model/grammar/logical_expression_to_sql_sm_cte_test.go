@@ -315,3 +315,41 @@ func TestLogicalExpression_SM_SupplementalSemanticIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestLogicalExpression_SM_CombinedSubmodelAndElementConditions(t *testing.T) {
+	expr := LogicalExpression{
+		And: []LogicalExpression{
+			{
+				Eq: ComparisonItems{
+					field("$sm#idShort"),
+					strVal("Device1"),
+				},
+			},
+			{
+				Eq: ComparisonItems{
+					field("$sme.Status#value"),
+					strVal("Running"),
+				},
+			},
+		},
+	}
+
+	sql, _ := buildSMSQL(t, expr)
+	t.Logf("SQL: %s", sql)
+
+	if !strings.Contains(sql, `"submodel"."id_short" = 'Device1'`) {
+		t.Fatalf("expected submodel-level idShort condition, got: %s", sql)
+	}
+	if !strings.Contains(sql, `"submodel_element"."submodel_id" = "submodel"."id"`) {
+		t.Fatalf("expected element condition correlated to the same submodel, got: %s", sql)
+	}
+	if !strings.Contains(sql, `"submodel_element"."idshort_path" = 'Status'`) {
+		t.Fatalf("expected idshort_path constraint for element condition, got: %s", sql)
+	}
+	if !strings.Contains(sql, "'Running'") {
+		t.Fatalf("expected element value literal in SQL, got: %s", sql)
+	}
+	if !strings.Contains(sql, " AND EXISTS ") {
+		t.Fatalf("expected both conditions to be combined with AND, got: %s", sql)
+	}
+}
@@ -0,0 +1,69 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package grammar
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+// supportedQueryModifiers lists the top-level Query members that the query
+// endpoints evaluate. $select is accepted by the schema but not applied, so it
+// is not advertised.
+var supportedQueryModifiers = []string{"$condition", "$filters"}
+
+// NewQueryCapabilities describes the query endpoint at the given path for the
+// service description. Operators are the members of a logical expression as
+// accepted by LogicalExpression, so the list follows the grammar.
+//
+// Parameters:
+//   - endpoint: Path of the query endpoint, for example "/query/submodels"
+//
+// Returns:
+//   - *model.QueryCapabilities: Capabilities with sorted operator and modifier lists
+func NewQueryCapabilities(endpoint string) *model.QueryCapabilities {
+	return &model.QueryCapabilities{
+		Endpoint:  endpoint,
+		Operators: logicalExpressionOperators(),
+		Modifiers: append([]string(nil), supportedQueryModifiers...),
+	}
+}
+
+func logicalExpressionOperators() []string {
+	expressionType := reflect.TypeOf(LogicalExpression{})
+	operators := make([]string, 0, expressionType.NumField())
+	for i := 0; i < expressionType.NumField(); i++ {
+		name, _, _ := strings.Cut(expressionType.Field(i).Tag.Get("json"), ",")
+		if strings.HasPrefix(name, "$") {
+			operators = append(operators, name)
+		}
+	}
+	sort.Strings(operators)
+	return operators
+}
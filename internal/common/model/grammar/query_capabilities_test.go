@@ -0,0 +1,54 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package grammar
+
+import "testing"
+
+func TestNewQueryCapabilitiesListsEveryLogicalExpressionOperator(t *testing.T) {
+	capabilities := NewQueryCapabilities("/query/shells")
+
+	if capabilities.Endpoint != "/query/shells" {
+		t.Fatalf("expected endpoint /query/shells, got %q", capabilities.Endpoint)
+	}
+	want := []string{"$and", "$boolean", "$boolCast", "$contains", "$ends-with", "$eq", "$ge", "$gt", "$le", "$lt", "$match", "$ne", "$not", "$or", "$regex", "$starts-with"}
+	if len(capabilities.Operators) != len(want) {
+		t.Fatalf("expected %d operators, got %v", len(want), capabilities.Operators)
+	}
+	seen := make(map[string]bool, len(capabilities.Operators))
+	for _, operator := range capabilities.Operators {
+		seen[operator] = true
+	}
+	for _, operator := range want {
+		if !seen[operator] {
+			t.Fatalf("expected operator %q in %v", operator, capabilities.Operators)
+		}
+	}
+	for _, modifier := range capabilities.Modifiers {
+		if modifier == "$select" {
+			t.Fatal("expected $select not to be advertised because it is not applied")
+		}
+	}
+}
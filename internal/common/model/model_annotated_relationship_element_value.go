@@ -60,6 +60,36 @@ func (a AnnotatedRelationshipElementValue) MarshalJSON() ([]byte, error) {
 	return a.MarshalValueOnly()
 }
 
+// UnmarshalJSON implements custom JSON unmarshaling for AnnotatedRelationshipElementValue
+func (a *AnnotatedRelationshipElementValue) UnmarshalJSON(data []byte) error {
+	// Create a temporary struct with the same fields but Annotations as map[string]json.RawMessage
+	type Alias AnnotatedRelationshipElementValue
+	aux := &struct {
+		Annotations map[string]json.RawMessage `json:"annotations,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	// Annotations are data elements and are resolved like any other value
+	if aux.Annotations != nil {
+		a.Annotations = make(map[string]SubmodelElementValue, len(aux.Annotations))
+		for key, rawValue := range aux.Annotations {
+			value, err := UnmarshalSubmodelElementValue(rawValue)
+			if err != nil {
+				return err
+			}
+			a.Annotations[key] = value
+		}
+	}
+
+	return nil
+}
+
 // GetModelType returns the model type name for AnnotatedRelationshipElement
 func (a AnnotatedRelationshipElementValue) GetModelType() types.ModelType {
 	return types.ModelTypeAnnotatedRelationshipElement
@@ -528,7 +528,7 @@ func (obj AssetAdministrationShellDescriptor) ToJsonable() (map[string]any, erro
 		ret["submodelDescriptors"] = submodelDescriptors
 	}
 	if obj.CreatedAt != nil {
-		ret["createdAt"] = obj.CreatedAt.Format(time.RFC3339Nano)
+		ret["createdAt"] = obj.CreatedAt.UTC().Format(time.RFC3339Nano)
 	}
 	return ret, nil
 }
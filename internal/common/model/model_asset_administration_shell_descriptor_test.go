@@ -94,3 +94,21 @@ func TestAssetAdministrationShellDescriptorUnmarshalPermissiveStillRejectsEmptyS
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestAssertUniqueSubmodelDescriptorIDs(t *testing.T) {
+	endpoints := []Endpoint{{Interface: "SUBMODEL-3.0", ProtocolInformation: ProtocolInformation{Href: "https://repo.example/submodels/c20"}}}
+	descriptors := []SubmodelDescriptor{
+		{Id: "urn:sm:nameplate", Endpoints: endpoints},
+		{Id: "urn:sm:technical-data", Endpoints: endpoints},
+		{Id: "urn:sm:nameplate", Endpoints: endpoints},
+	}
+
+	err := AssertUniqueSubmodelDescriptorIDs(descriptors, false)
+	if err == nil || !strings.Contains(err.Error(), `COMMON-SMDESCCONSTRAINTS-DUPLICATEID submodelDescriptors[2] repeats the id "urn:sm:nameplate" of submodelDescriptors[0]`) {
+		t.Fatalf("expected duplicate submodel descriptor id error, got %v", err)
+	}
+
+	if err = AssertUniqueSubmodelDescriptorIDs(descriptors, true); err != nil {
+		t.Fatalf("expected duplicates to be accepted when allowed, got %v", err)
+	}
+}
@@ -37,6 +37,9 @@ package model
 // PagedResultPagingMetadata type of PagedResultPagingMetadata
 type PagedResultPagingMetadata struct {
 	Cursor string `json:"cursor,omitempty"`
+	// PrevCursor starts the page before the current one. Listings that
+	// support it leave it empty on the first page.
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 // AssertPagedResultPagingMetadataRequired checks if the required fields are not zero-ed
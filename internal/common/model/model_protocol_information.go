@@ -48,6 +48,9 @@ func AssertProtocolInformationRequired(obj ProtocolInformation) error {
 
 // AssertProtocolInformationConstraints checks if the values respects the defined constraints
 func AssertProtocolInformationConstraints(obj ProtocolInformation) error {
+	if err := assertEndpointHref(obj.Href); err != nil {
+		return err
+	}
 	for _, el := range obj.SecurityAttributes {
 		if err := AssertProtocolInformationSecurityAttributesConstraints(el); err != nil {
 			return err
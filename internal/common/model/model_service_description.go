@@ -37,6 +37,19 @@ package model
 // ServiceDescription - The Description object enables servers to present their capabilities to the clients, in particular which profiles they implement. At least one defined profile is required. Additional, proprietary attributes might be included. Nevertheless, the server must not expect that a regular client understands them.
 type ServiceDescription struct {
 	Profiles []string `json:"profiles,omitempty"`
+
+	// QueryCapabilities is a BaSyx-specific attribute that is present only while
+	// the service serves its query endpoint.
+	QueryCapabilities *QueryCapabilities `json:"queryCapabilities,omitempty"`
+}
+
+// QueryCapabilities advertises the query endpoint of a service together with the
+// query language operators and modifiers it evaluates, so clients can detect
+// support before sending a query.
+type QueryCapabilities struct {
+	Endpoint  string   `json:"endpoint"`
+	Operators []string `json:"operators"`
+	Modifiers []string `json:"modifiers"`
 }
 
 // AssertServiceDescriptionRequired checks if the required fields are not zero-ed
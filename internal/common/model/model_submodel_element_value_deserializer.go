@@ -115,29 +115,12 @@ func UnmarshalSubmodelElementValue(data []byte) (SubmodelElementValue, error) {
 	if _, hasFirst := raw["first"]; hasFirst {
 		if _, hasSecond := raw["second"]; hasSecond {
 			// RelationshipElementValue or AnnotatedRelationshipElementValue
-			if annotationsRaw, hasAnnotations := raw["annotations"]; hasAnnotations {
-				// Manually parse AnnotatedRelationshipElementValue to handle annotations recursively
+			if _, hasAnnotations := raw["annotations"]; hasAnnotations {
+				// AnnotatedRelationshipElementValue resolves its annotations recursively
 				var val AnnotatedRelationshipElementValue
-
-				// Parse first and second
-				firstBytes, _ := json.Marshal(raw["first"])
-				if err := json.Unmarshal(firstBytes, &val.First); err != nil {
-					return nil, fmt.Errorf("failed to unmarshal first: %w", err)
-				}
-
-				secondBytes, _ := json.Marshal(raw["second"])
-				if err := json.Unmarshal(secondBytes, &val.Second); err != nil {
-					return nil, fmt.Errorf("failed to unmarshal second: %w", err)
-				}
-
-				// Parse annotations as SubmodelElementCollectionValue
-				annotationsBytes, _ := json.Marshal(annotationsRaw)
-				annotations, err := parseSubmodelElementCollectionValue(annotationsBytes)
-				if err != nil {
-					return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
+				if err := json.Unmarshal(data, &val); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal annotated relationship element: %w", err)
 				}
-				val.Annotations = annotations.(SubmodelElementCollectionValue)
-
 				return val, nil
 			}
 			var val RelationshipElementValue
@@ -148,7 +131,7 @@ func UnmarshalSubmodelElementValue(data []byte) (SubmodelElementValue, error) {
 		}
 	}
 
-	if _, hasEntityType := raw["entityType"]; hasEntityType {
+	if _, hasEntityType := raw["entityType"]; hasEntityType || isEntityValueShape(raw) {
 		// EntityValue
 		var val EntityValue
 		if err := json.Unmarshal(data, &val); err != nil {
@@ -162,6 +145,36 @@ func UnmarshalSubmodelElementValue(data []byte) (SubmodelElementValue, error) {
 	return parseSubmodelElementCollectionValue(data)
 }
 
+// isEntityValueShape reports whether an object without entityType still has
+// the shape of an EntityValue. entityType is optional, so an Entity carrying
+// only statements or specificAssetIds would otherwise be read as a collection.
+// Only objects whose keys are all Entity value fields with matching JSON types
+// qualify, and at least statements or specificAssetIds must be present.
+func isEntityValueShape(raw map[string]any) bool {
+	hasEntityContent := false
+	for key, value := range raw {
+		switch key {
+		case "globalAssetId":
+			if _, ok := value.(string); !ok {
+				return false
+			}
+		case "specificAssetIds":
+			if _, ok := value.([]any); !ok {
+				return false
+			}
+			hasEntityContent = true
+		case "statements":
+			if _, ok := value.(map[string]any); !ok {
+				return false
+			}
+			hasEntityContent = true
+		default:
+			return false
+		}
+	}
+	return hasEntityContent
+}
+
 // parseSubmodelElementCollectionValue attempts to parse a map of SubmodelElementValues
 func parseSubmodelElementCollectionValue(data []byte) (SubmodelElementValue, error) {
 	var rawMap map[string]json.RawMessage
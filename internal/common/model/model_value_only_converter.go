@@ -254,7 +254,11 @@ func EntityToValueOnly(e *types.Entity) (EntityValue, error) {
 				"value": assetID.Value(),
 			}
 			if assetID.ExternalSubjectID() != nil {
-				assetIDMap["externalSubjectId"] = assetID.ExternalSubjectID()
+				externalSubjectID, err := jsonization.ToJsonable(assetID.ExternalSubjectID())
+				if err != nil {
+					return result, fmt.Errorf("failed to convert externalSubjectId of specific asset id '%s': %w", assetID.Name(), err)
+				}
+				assetIDMap["externalSubjectId"] = externalSubjectID
 			}
 			result.SpecificAssetIds = append(result.SpecificAssetIds, assetIDMap)
 		}
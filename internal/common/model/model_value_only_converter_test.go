@@ -0,0 +1,233 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+)
+
+func valueOnlyTestProperty(idShort string, value string) *types.Property {
+	property := types.NewProperty(types.DataTypeDefXSDString)
+	property.SetIDShort(&idShort)
+	property.SetValue(&value)
+	return property
+}
+
+func valueOnlyTestReference(value string) *types.Reference {
+	return types.NewReference(
+		types.ReferenceTypesExternalReference,
+		[]types.IKey{types.NewKey(types.KeyTypesGlobalReference, value)},
+	)
+}
+
+func valueOnlyTestSubmodel() *types.Submodel {
+	collectionIDShort := "Location"
+	collection := types.NewSubmodelElementCollection()
+	collection.SetIDShort(&collectionIDShort)
+	collection.SetValue([]types.ISubmodelElement{valueOnlyTestProperty("Hall", "7")})
+
+	specificAssetID := types.NewSpecificAssetID("serialNumber", "4711")
+	specificAssetID.SetExternalSubjectID(valueOnlyTestReference("urn:example:subject"))
+
+	entityIDShort := "Motor"
+	entityType := types.EntityTypeSelfManagedEntity
+	globalAssetID := "urn:example:asset:motor"
+	entity := types.NewEntity()
+	entity.SetIDShort(&entityIDShort)
+	entity.SetEntityType(&entityType)
+	entity.SetGlobalAssetID(&globalAssetID)
+	entity.SetSpecificAssetIDs([]types.ISpecificAssetID{specificAssetID})
+	entity.SetStatements([]types.ISubmodelElement{valueOnlyTestProperty("MaxRotation", "5000"), collection})
+
+	rangeIDShort := "Tolerance"
+	rangeMin := "1"
+	rangeMax := "3"
+	rangeElement := types.NewRange(types.DataTypeDefXSDInt)
+	rangeElement.SetIDShort(&rangeIDShort)
+	rangeElement.SetMin(&rangeMin)
+	rangeElement.SetMax(&rangeMax)
+
+	relationshipIDShort := "PowersPump"
+	relationship := types.NewAnnotatedRelationshipElement()
+	relationship.SetIDShort(&relationshipIDShort)
+	relationship.SetFirst(valueOnlyTestReference("urn:example:motor"))
+	relationship.SetSecond(valueOnlyTestReference("urn:example:pump"))
+	relationship.SetAnnotations([]types.IDataElement{valueOnlyTestProperty("AppliedRule", "ISO-1234"), rangeElement})
+
+	submodel := types.NewSubmodel("urn:example:submodel:value-only")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{entity, relationship})
+	return submodel
+}
+
+func TestSubmodelToValueOnlyShapesEntityAndAnnotatedRelationshipElement(t *testing.T) {
+	value, err := SubmodelToValueOnly(valueOnlyTestSubmodel())
+	if err != nil {
+		t.Fatalf("SubmodelToValueOnly returned error: %v", err)
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed to marshal value-only submodel: %v", err)
+	}
+
+	expected := `{
+		"Motor": {
+			"entityType": "SelfManagedEntity",
+			"globalAssetId": "urn:example:asset:motor",
+			"specificAssetIds": [{
+				"name": "serialNumber",
+				"value": "4711",
+				"externalSubjectId": {"type": "ExternalReference", "keys": [{"type": "GlobalReference", "value": "urn:example:subject"}]}
+			}],
+			"statements": {
+				"MaxRotation": "5000",
+				"Location": {"Hall": "7"}
+			}
+		},
+		"PowersPump": {
+			"first": {"type": "ExternalReference", "keys": [{"type": "GlobalReference", "value": "urn:example:motor"}]},
+			"second": {"type": "ExternalReference", "keys": [{"type": "GlobalReference", "value": "urn:example:pump"}]},
+			"annotations": {
+				"AppliedRule": "ISO-1234",
+				"Tolerance": {"min": "1", "max": "3"}
+			}
+		}
+	}`
+	assertJSONEqual(t, expected, string(payload))
+}
+
+func TestSubmodelValueUnmarshalRoundTripsEntityAndAnnotatedRelationshipElement(t *testing.T) {
+	value, err := SubmodelToValueOnly(valueOnlyTestSubmodel())
+	if err != nil {
+		t.Fatalf("SubmodelToValueOnly returned error: %v", err)
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed to marshal value-only submodel: %v", err)
+	}
+
+	var decoded SubmodelValue
+	if err = json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal value-only submodel: %v", err)
+	}
+
+	entity, ok := decoded["Motor"].(EntityValue)
+	if !ok {
+		t.Fatalf("expected Motor to decode as EntityValue, got %T", decoded["Motor"])
+	}
+	if entity.EntityType != "SelfManagedEntity" || entity.GlobalAssetID != "urn:example:asset:motor" {
+		t.Fatalf("unexpected entity value %#v", entity)
+	}
+	if _, ok = entity.Statements["Location"].(SubmodelElementCollectionValue); !ok {
+		t.Fatalf("expected Location statement to decode as collection, got %T", entity.Statements["Location"])
+	}
+
+	relationship, ok := decoded["PowersPump"].(AnnotatedRelationshipElementValue)
+	if !ok {
+		t.Fatalf("expected PowersPump to decode as AnnotatedRelationshipElementValue, got %T", decoded["PowersPump"])
+	}
+	if _, ok = relationship.Annotations["Tolerance"].(RangeValue); !ok {
+		t.Fatalf("expected Tolerance annotation to decode as RangeValue, got %T", relationship.Annotations["Tolerance"])
+	}
+
+	roundTripped, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("failed to marshal decoded value-only submodel: %v", err)
+	}
+	assertJSONEqual(t, string(payload), string(roundTripped))
+}
+
+func TestUnmarshalSubmodelElementValueDetectsEntityWithoutEntityType(t *testing.T) {
+	value, err := UnmarshalSubmodelElementValue([]byte(`{"statements":{"MaxRotation":"5000"}}`))
+	if err != nil {
+		t.Fatalf("UnmarshalSubmodelElementValue returned error: %v", err)
+	}
+	entity, ok := value.(EntityValue)
+	if !ok {
+		t.Fatalf("expected EntityValue, got %T", value)
+	}
+	if entity.Statements["MaxRotation"] != (PropertyValue{Value: "5000"}) {
+		t.Fatalf("unexpected statements %#v", entity.Statements)
+	}
+
+	value, err = UnmarshalSubmodelElementValue([]byte(`{"statements":{"MaxRotation":"5000"},"Hall":"7"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalSubmodelElementValue returned error: %v", err)
+	}
+	if _, ok = value.(SubmodelElementCollectionValue); !ok {
+		t.Fatalf("expected collection for mixed keys, got %T", value)
+	}
+}
+
+func assertJSONEqual(t *testing.T, expected string, actual string) {
+	t.Helper()
+
+	var expectedValue, actualValue any
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		t.Fatalf("failed to decode expected JSON: %v", err)
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		t.Fatalf("failed to decode actual JSON: %v", err)
+	}
+	if !reflect.DeepEqual(expectedValue, actualValue) {
+		t.Fatalf("JSON mismatch\nexpected: %s\nactual:   %s", expected, actual)
+	}
+}
+
+func TestValidateValueOnlyReferencesRejectsInvalidKeyTypes(t *testing.T) {
+	validKeys := []map[string]any{{"type": "GlobalReference", "value": "urn:example:target"}}
+	invalidKeys := []map[string]any{{"type": "NotAKeyType", "value": "urn:example:target"}}
+	validReference := map[string]any{"type": "ExternalReference", "keys": []any{validKeys[0]}}
+	invalidReference := map[string]any{"type": "ExternalReference", "keys": []any{invalidKeys[0]}}
+
+	tests := []struct {
+		name    string
+		value   SubmodelElementValue
+		wantErr bool
+	}{
+		{name: "valid reference element", value: ReferenceElementValue{Type: "ExternalReference", Keys: validKeys}},
+		{name: "empty reference element", value: ReferenceElementValue{}},
+		{name: "reference element", value: ReferenceElementValue{Type: "ExternalReference", Keys: invalidKeys}, wantErr: true},
+		{name: "relationship second", value: RelationshipElementValue{First: validReference, Second: invalidReference}, wantErr: true},
+		{name: "basic event observed", value: BasicEventElementValue{Observed: invalidReference}, wantErr: true},
+		{name: "nested in collection", value: SubmodelElementCollectionValue{"inner": ReferenceElementValue{Type: "ExternalReference", Keys: invalidKeys}}, wantErr: true},
+		{name: "nested in entity statement", value: EntityValue{Statements: map[string]SubmodelElementValue{"ref": RelationshipElementValue{First: invalidReference, Second: validReference}}}, wantErr: true},
+		{name: "nested in annotation list", value: SubmodelElementListValue{AnnotatedRelationshipElementValue{First: validReference, Second: validReference}, ReferenceElementValue{Type: "ExternalReference", Keys: invalidKeys}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateValueOnlyReferences(tt.value)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
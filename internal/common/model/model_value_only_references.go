@@ -0,0 +1,125 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+)
+
+// ValidateValueOnlyReferences checks every Reference carried by a Value-Only
+// payload against the metamodel, including reference and key type enums.
+// Value-Only writes store references as JSON without passing through the SDK
+// types, so invalid key types would otherwise reach the database unnoticed.
+// Nested collections, lists, entity statements and relationship annotations
+// are walked recursively; the returned error names the offending path.
+func ValidateValueOnlyReferences(value SubmodelElementValue) error {
+	return validateValueOnlyReferences("", value)
+}
+
+func validateValueOnlyReferences(path string, value SubmodelElementValue) error {
+	switch typed := value.(type) {
+	case ReferenceElementValue:
+		if len(typed.Keys) == 0 && typed.Type == "" {
+			return nil
+		}
+		reference := map[string]any{"type": typed.Type, "keys": keysAsJsonable(typed.Keys)}
+		if typed.ReferredSemanticId != nil {
+			reference["referredSemanticId"] = typed.ReferredSemanticId
+		}
+		return validateReferenceJsonable(path, reference)
+	case RelationshipElementValue:
+		if err := validateReferenceJsonable(joinValuePath(path, "first"), typed.First); err != nil {
+			return err
+		}
+		return validateReferenceJsonable(joinValuePath(path, "second"), typed.Second)
+	case AnnotatedRelationshipElementValue:
+		if err := validateReferenceJsonable(joinValuePath(path, "first"), typed.First); err != nil {
+			return err
+		}
+		if err := validateReferenceJsonable(joinValuePath(path, "second"), typed.Second); err != nil {
+			return err
+		}
+		for idShort, annotation := range typed.Annotations {
+			if err := validateValueOnlyReferences(joinValuePath(path, idShort), annotation); err != nil {
+				return err
+			}
+		}
+	case BasicEventElementValue:
+		return validateReferenceJsonable(joinValuePath(path, "observed"), typed.Observed)
+	case EntityValue:
+		for idShort, statement := range typed.Statements {
+			if err := validateValueOnlyReferences(joinValuePath(path, idShort), statement); err != nil {
+				return err
+			}
+		}
+	case SubmodelElementCollectionValue:
+		for idShort, element := range typed {
+			if err := validateValueOnlyReferences(joinValuePath(path, idShort), element); err != nil {
+				return err
+			}
+		}
+	case AmbiguousSubmodelElementValue:
+		list, err := typed.ConvertToSubmodelElementListValue()
+		if err != nil {
+			return nil
+		}
+		return validateValueOnlyReferences(path, list)
+	case SubmodelElementListValue:
+		for index, element := range typed {
+			if err := validateValueOnlyReferences(fmt.Sprintf("%s[%d]", path, index), element); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateReferenceJsonable(path string, reference map[string]any) error {
+	if reference == nil {
+		return nil
+	}
+	if _, err := jsonization.ReferenceFromJsonable(reference); err != nil {
+		return fmt.Errorf("invalid reference at '%s': %w", path, err)
+	}
+	return nil
+}
+
+func keysAsJsonable(keys []map[string]any) []any {
+	result := make([]any, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key)
+	}
+	return result
+}
+
+func joinValuePath(path string, segment string) string {
+	if path == "" {
+		return segment
+	}
+	return path + "." + segment
+}
@@ -0,0 +1,48 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package model
+
+import (
+	"errors"
+	"strconv"
+)
+
+// AssertUniqueSubmodelDescriptorIDs rejects shell descriptors whose submodel
+// descriptors share an id. Callers pass general.allowDuplicateSubmodelDescriptorIds
+// from the request configuration as allowDuplicates.
+func AssertUniqueSubmodelDescriptorIDs(descriptors []SubmodelDescriptor, allowDuplicates bool) error {
+	if allowDuplicates || len(descriptors) < 2 {
+		return nil
+	}
+	seen := make(map[string]int, len(descriptors))
+	for idx, descriptor := range descriptors {
+		if first, ok := seen[descriptor.Id]; ok {
+			return errors.New("COMMON-SMDESCCONSTRAINTS-DUPLICATEID submodelDescriptors[" + strconv.Itoa(idx) + "] repeats the id " + strconv.Quote(descriptor.Id) + " of submodelDescriptors[" + strconv.Itoa(first) + "]")
+		}
+		seen[descriptor.Id] = idx
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrOutboundAddressBlocked is returned by outbound HTTP clients for target
+// addresses they must not connect to.
+var ErrOutboundAddressBlocked = errors.New("COMMON-OUTBOUND-BLOCKEDADDR target address is not allowed")
+
+// alwaysBlockedOutboundNetworks are refused even when private networks are
+// allowed: "this" network, and the IPv6 instance metadata address of AWS.
+// IPv4 metadata addresses are link-local and covered by
+// OutboundAddressAllowed.
+var alwaysBlockedOutboundNetworks = mustParseCIDRs("0.0.0.0/8", "fd00:ec2::254/128")
+
+// privateOutboundNetworks adds shared address space to net.IP.IsPrivate.
+var privateOutboundNetworks = mustParseCIDRs("100.64.0.0/10")
+
+// NewOutboundHTTPClient returns a client for URLs chosen by API clients, such
+// as descriptor endpoint hrefs. The permitted function decides on the resolved
+// address at dial time, so DNS names cannot sidestep it. Proxies are disabled
+// because the dialer would only see the proxy address, and redirects are never
+// followed; the redirect response is returned instead.
+//
+// Parameters:
+//   - timeout: Timeout of one request, including the connection setup.
+//   - permitted: Decides whether a resolved address may be dialed.
+//
+// Returns:
+//   - *http.Client: Client refusing addresses rejected by permitted.
+func NewOutboundHTTPClient(timeout time.Duration, permitted func(net.IP) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !permitted(ip) {
+				return ErrOutboundAddressBlocked
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would make the dialer see the proxy address instead of the target.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// OutboundAddressAllowed refuses loopback, link-local, multicast and cloud
+// metadata addresses, and private networks (RFC 1918, RFC 4193 and shared
+// address space) unless allowPrivateNetworks is set.
+func OutboundAddressAllowed(ip net.IP, allowPrivateNetworks bool) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	if ipInNetworks(ip, alwaysBlockedOutboundNetworks) {
+		return false
+	}
+	if ip.IsPrivate() || ipInNetworks(ip, privateOutboundNetworks) {
+		return allowPrivateNetworks
+	}
+	return true
+}
+
+func ipInNetworks(ip net.IP, networks []*net.IPNet) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,60 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"net"
+	"testing"
+)
+
+func TestOutboundAddressAllowed(t *testing.T) {
+	tests := []struct {
+		ip           string
+		allowPrivate bool
+		want         bool
+	}{
+		{ip: "127.0.0.1", allowPrivate: true, want: false},
+		{ip: "::1", allowPrivate: true, want: false},
+		{ip: "169.254.169.254", allowPrivate: true, want: false},
+		{ip: "fe80::1", allowPrivate: true, want: false},
+		{ip: "fd00:ec2::254", allowPrivate: true, want: false},
+		{ip: "0.0.0.0", allowPrivate: true, want: false},
+		{ip: "::ffff:127.0.0.1", allowPrivate: true, want: false},
+		{ip: "10.1.2.3", allowPrivate: false, want: false},
+		{ip: "192.168.0.10", allowPrivate: false, want: false},
+		{ip: "100.64.0.1", allowPrivate: false, want: false},
+		{ip: "fd12::1", allowPrivate: false, want: false},
+		{ip: "10.1.2.3", allowPrivate: true, want: true},
+		{ip: "172.16.5.4", allowPrivate: true, want: true},
+		{ip: "93.184.216.34", allowPrivate: false, want: true},
+		{ip: "2606:4700::1", allowPrivate: false, want: true},
+	}
+	for _, tt := range tests {
+		if got := OutboundAddressAllowed(net.ParseIP(tt.ip), tt.allowPrivate); got != tt.want {
+			t.Errorf("%s (allowPrivate=%v): expected allowed=%v, got %v", tt.ip, tt.allowPrivate, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"fmt"
+)
+
+// PageLimitScope selects which configured default page size applies to a list request.
+type PageLimitScope int
+
+const (
+	// PageLimitScopeGeneral uses general.defaultPageLimit.
+	PageLimitScopeGeneral PageLimitScope = iota
+	// PageLimitScopeSubmodels uses general.defaultSubmodelPageLimit for submodel lists.
+	PageLimitScopeSubmodels
+	// PageLimitScopeSubmodelElements uses general.defaultSubmodelElementPageLimit for submodel element lists.
+	PageLimitScopeSubmodelElements
+)
+
+// ResolvePageLimit resolves the page size of a list request against the
+// request-scoped general.defaultPageLimit and general.maxPageLimit.
+//
+// A limit of zero means the client did not ask for a page size and yields the
+// configured default. Limits above the configured maximum are rejected rather
+// than clamped, so clients never receive a smaller page than they asked for
+// without noticing. Negative limits are returned unchanged for the caller's
+// own validation.
+//
+// Parameters:
+//   - ctx: Request context populated by ConfigMiddleware.
+//   - requested: Page size requested by the client.
+//
+// Returns:
+//   - int32: Page size to pass to the persistence layer.
+//   - error: A 400-classified error when the maximum is exceeded.
+func ResolvePageLimit(ctx context.Context, requested int32) (int32, error) {
+	return ResolvePageLimitFor(ctx, PageLimitScopeGeneral, requested)
+}
+
+// ResolvePageLimitFor behaves like ResolvePageLimit but takes the default page
+// size from the given endpoint scope. A scoped default of zero falls back to
+// general.defaultPageLimit.
+//
+// Parameters:
+//   - ctx: Request context populated by ConfigMiddleware.
+//   - scope: Endpoint family whose default page size applies.
+//   - requested: Page size requested by the client.
+//
+// Returns:
+//   - int32: Page size to pass to the persistence layer.
+//   - error: A 400-classified error when the maximum is exceeded.
+func ResolvePageLimitFor(ctx context.Context, scope PageLimitScope, requested int32) (int32, error) {
+	defaultLimit := DefaultConfig.GeneralDefaultPageLimit
+	maxLimit := DefaultConfig.GeneralMaxPageLimit
+	if cfg, ok := ConfigFromContext(ctx); ok && cfg != nil {
+		if cfg.General.DefaultPageLimit > 0 {
+			defaultLimit = cfg.General.DefaultPageLimit
+		}
+		if scoped := scopedDefaultPageLimit(cfg, scope); scoped > 0 {
+			defaultLimit = scoped
+		}
+		if cfg.General.MaxPageLimit >= 0 {
+			maxLimit = cfg.General.MaxPageLimit
+		}
+	}
+	if requested == 0 {
+		return int32(defaultLimit), nil
+	}
+	if maxLimit > 0 && int64(requested) > int64(maxLimit) {
+		return 0, NewErrBadRequest(fmt.Sprintf("COMMON-PAGELIMIT-EXCEEDED limit %d exceeds the configured maximum of %d", requested, maxLimit))
+	}
+	return requested, nil
+}
+
+func scopedDefaultPageLimit(cfg *Config, scope PageLimitScope) int {
+	switch scope {
+	case PageLimitScopeSubmodels:
+		return cfg.General.DefaultSubmodelPageLimit
+	case PageLimitScopeSubmodelElements:
+		return cfg.General.DefaultSubmodelElementPageLimit
+	default:
+		return 0
+	}
+}
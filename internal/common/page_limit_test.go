@@ -0,0 +1,107 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestResolvePageLimit(t *testing.T) {
+	configured := ContextWithConfig(context.Background(), &Config{General: GeneralConfig{DefaultPageLimit: 25, MaxPageLimit: 500}})
+	unlimited := ContextWithConfig(context.Background(), &Config{General: GeneralConfig{DefaultPageLimit: 25, MaxPageLimit: 0}})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requested int32
+		wantLimit int32
+		wantErr   bool
+	}{
+		{name: "configured default", ctx: configured, requested: 0, wantLimit: 25},
+		{name: "below max", ctx: configured, requested: 499, wantLimit: 499},
+		{name: "at max", ctx: configured, requested: 500, wantLimit: 500},
+		{name: "above max", ctx: configured, requested: 501, wantErr: true},
+		{name: "negative passes through", ctx: configured, requested: -1, wantLimit: -1},
+		{name: "max disabled", ctx: unlimited, requested: 1000000, wantLimit: 1000000},
+		{name: "fallback default", ctx: context.Background(), requested: 0, wantLimit: int32(DefaultConfig.GeneralDefaultPageLimit)},
+		{name: "fallback max", ctx: context.Background(), requested: int32(DefaultConfig.GeneralMaxPageLimit) + 1, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ResolvePageLimit(test.ctx, test.requested)
+			if test.wantErr {
+				if !IsErrBadRequest(err) || !strings.Contains(err.Error(), "COMMON-PAGELIMIT-EXCEEDED") {
+					t.Fatalf("expected COMMON-PAGELIMIT-EXCEEDED bad request, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.wantLimit {
+				t.Fatalf("expected limit %d, got %d", test.wantLimit, got)
+			}
+		})
+	}
+}
+
+func TestResolvePageLimitForUsesScopedDefaults(t *testing.T) {
+	scoped := ContextWithConfig(context.Background(), &Config{General: GeneralConfig{
+		DefaultPageLimit:                25,
+		DefaultSubmodelPageLimit:        10,
+		DefaultSubmodelElementPageLimit: 50,
+		MaxPageLimit:                    500,
+	}})
+	global := ContextWithConfig(context.Background(), &Config{General: GeneralConfig{DefaultPageLimit: 25, MaxPageLimit: 500}})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		scope     PageLimitScope
+		requested int32
+		wantLimit int32
+	}{
+		{name: "general", ctx: scoped, scope: PageLimitScopeGeneral, wantLimit: 25},
+		{name: "submodels", ctx: scoped, scope: PageLimitScopeSubmodels, wantLimit: 10},
+		{name: "submodel elements", ctx: scoped, scope: PageLimitScopeSubmodelElements, wantLimit: 50},
+		{name: "explicit limit wins", ctx: scoped, scope: PageLimitScopeSubmodels, requested: 7, wantLimit: 7},
+		{name: "submodels fall back to global", ctx: global, scope: PageLimitScopeSubmodels, wantLimit: 25},
+		{name: "submodel elements fall back to global", ctx: global, scope: PageLimitScopeSubmodelElements, wantLimit: 25},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ResolvePageLimitFor(test.ctx, test.scope, test.requested)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.wantLimit {
+				t.Fatalf("expected limit %d, got %d", test.wantLimit, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+const redactedQueryValue = "'***'"
+
+// QueryLogger receives generated SQL together with already redacted arguments.
+type QueryLogger func(query string, args []any)
+
+var (
+	queryLoggerMu sync.RWMutex
+	queryLogger   QueryLogger = defaultQueryLogger
+)
+
+func defaultQueryLogger(query string, args []any) {
+	log.Printf("🧾 [SQL] %s | args=%v", query, args)
+}
+
+// SetQueryLogger replaces the sink used by LogQuery and returns the previous one.
+// Passing nil restores the default logger that writes through the standard log package.
+func SetQueryLogger(logger QueryLogger) QueryLogger {
+	queryLoggerMu.Lock()
+	defer queryLoggerMu.Unlock()
+	previous := queryLogger
+	if logger == nil {
+		logger = defaultQueryLogger
+	}
+	queryLogger = logger
+	return previous
+}
+
+// QueryLoggingEnabled reports whether postgres.logQueries is set in the request configuration.
+func QueryLoggingEnabled(ctx context.Context) bool {
+	cfg, ok := ConfigFromContext(ctx)
+	return ok && cfg != nil && cfg.Postgres.LogQueries
+}
+
+// LogQuery forwards a generated SQL statement to the configured QueryLogger when
+// postgres.logQueries is enabled. String literals in the SQL text and all string
+// or binary arguments are masked so that identifiers, asset IDs and payloads do
+// not end up in the logs.
+//
+// Parameters:
+//   - ctx: Request context populated by ConfigMiddleware.
+//   - query: SQL text as produced by goqu's ToSQL.
+//   - args: Positional arguments belonging to query.
+func LogQuery(ctx context.Context, query string, args []any) {
+	if !QueryLoggingEnabled(ctx) {
+		return
+	}
+
+	queryLoggerMu.RLock()
+	logger := queryLogger
+	queryLoggerMu.RUnlock()
+
+	logger(RedactSQLLiterals(query), RedactQueryArgs(args))
+}
+
+// RedactQueryArgs masks string and binary arguments while keeping numeric,
+// boolean and nil values, which are useful for debugging and not sensitive.
+func RedactQueryArgs(args []any) []any {
+	if len(args) == 0 {
+		return nil
+	}
+	redacted := make([]any, len(args))
+	for i, arg := range args {
+		switch arg.(type) {
+		case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			redacted[i] = arg
+		default:
+			redacted[i] = fmt.Sprintf("<redacted %T>", arg)
+		}
+	}
+	return redacted
+}
+
+// RedactSQLLiterals replaces every single-quoted literal in query with '***'.
+// A doubled single quote inside a literal is an escaped quote, not its end.
+func RedactSQLLiterals(query string) string {
+	var out strings.Builder
+	out.Grow(len(query))
+
+	inLiteral := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if !inLiteral {
+			if c == '\'' {
+				inLiteral = true
+				out.WriteString(redactedQueryValue)
+				continue
+			}
+			out.WriteByte(c)
+			continue
+		}
+		if c != '\'' {
+			continue
+		}
+		if i+1 < len(query) && query[i+1] == '\'' {
+			i++
+			continue
+		}
+		inLiteral = false
+	}
+	return out.String()
+}
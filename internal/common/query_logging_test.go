@@ -0,0 +1,93 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func captureQueryLogs(t *testing.T) *[]string {
+	t.Helper()
+	captured := []string{}
+	previous := SetQueryLogger(func(query string, _ []any) {
+		captured = append(captured, query)
+	})
+	t.Cleanup(func() { SetQueryLogger(previous) })
+	return &captured
+}
+
+func TestLogQueryWritesToInjectedLoggerWhenEnabled(t *testing.T) {
+	var gotArgs []any
+	previous := SetQueryLogger(func(_ string, args []any) { gotArgs = args })
+	t.Cleanup(func() { SetQueryLogger(previous) })
+
+	cfg := &Config{}
+	cfg.Postgres.LogQueries = true
+	ctx := ContextWithConfig(context.Background(), cfg)
+
+	LogQuery(ctx, `SELECT 1 FROM "submodel" WHERE "id" = $1 AND "kind" = $2`, []any{"urn:secret", int64(1)})
+
+	if len(gotArgs) != 2 {
+		t.Fatalf("expected two logged args, got %v", gotArgs)
+	}
+	if gotArgs[0] == "urn:secret" {
+		t.Fatalf("expected string argument to be redacted, got %v", gotArgs[0])
+	}
+	if gotArgs[1] != int64(1) {
+		t.Fatalf("expected numeric argument to be kept, got %v", gotArgs[1])
+	}
+}
+
+func TestLogQueryIsSilentWhenDisabled(t *testing.T) {
+	captured := captureQueryLogs(t)
+
+	LogQuery(ContextWithConfig(context.Background(), &Config{}), "SELECT 1", nil)
+	LogQuery(context.Background(), "SELECT 1", nil)
+
+	if len(*captured) != 0 {
+		t.Fatalf("expected no query logs, got %v", *captured)
+	}
+}
+
+func TestRedactSQLLiterals(t *testing.T) {
+	tests := map[string]string{
+		`SELECT 1`: `SELECT 1`,
+		`SELECT * FROM "submodel" WHERE "id" = 'urn:secret'`: `SELECT * FROM "submodel" WHERE "id" = '***'`,
+		`WHERE a = 'it''s' AND b = 'x'`:                      `WHERE a = '***' AND b = '***'`,
+		`WHERE a IN ('one', 'two') LIMIT 10`:                 `WHERE a IN ('***', '***') LIMIT 10`,
+		`WHERE a = 'unterminated`:                            `WHERE a = '***'`,
+	}
+	for input, want := range tests {
+		if got := RedactSQLLiterals(input); got != want {
+			t.Fatalf("RedactSQLLiterals(%q) = %q, want %q", input, got, want)
+		}
+		if strings.Contains(RedactSQLLiterals(input), "secret") {
+			t.Fatalf("expected literal to be masked in %q", input)
+		}
+	}
+}
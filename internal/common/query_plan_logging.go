@@ -0,0 +1,125 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"sync"
+)
+
+// QueryPlanLogger receives a redacted SQL statement together with the lines
+// of its EXPLAIN output.
+type QueryPlanLogger func(query string, plan []string)
+
+// QueryExplainer is the subset of *sql.DB and *sql.Tx needed to run EXPLAIN.
+type QueryExplainer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+var (
+	queryPlanLoggerMu sync.RWMutex
+	queryPlanLogger   QueryPlanLogger = defaultQueryPlanLogger
+)
+
+func defaultQueryPlanLogger(query string, plan []string) {
+	log.Printf("🧾 [SQL PLAN] %s\n%s", query, strings.Join(plan, "\n"))
+}
+
+// SetQueryPlanLogger replaces the sink used by ExplainQuery and returns the previous one.
+// Passing nil restores the default logger that writes through the standard log package.
+func SetQueryPlanLogger(logger QueryPlanLogger) QueryPlanLogger {
+	queryPlanLoggerMu.Lock()
+	defer queryPlanLoggerMu.Unlock()
+	previous := queryPlanLogger
+	if logger == nil {
+		logger = defaultQueryPlanLogger
+	}
+	queryPlanLogger = logger
+	return previous
+}
+
+// QueryPlanLoggingEnabled reports whether postgres.explainQueries and
+// postgres.logQueries are both set in the request configuration.
+func QueryPlanLoggingEnabled(ctx context.Context) bool {
+	cfg, ok := ConfigFromContext(ctx)
+	return ok && cfg != nil && cfg.Postgres.LogQueries && cfg.Postgres.ExplainQueries
+}
+
+// ExplainQuery runs EXPLAIN for an assembled read query and forwards the plan
+// to the configured QueryPlanLogger when query plan logging is enabled.
+//
+// Plain EXPLAIN only plans the statement and never executes it. Literals are
+// masked in both the statement and the plan, because PostgreSQL prints bound
+// parameter values in filter conditions. Failures are
+// logged and otherwise ignored, so plan logging cannot break the request. It
+// must not be called inside a transaction, where a failing EXPLAIN would abort
+// the transaction.
+//
+// Parameters:
+//   - ctx: Request context populated by ConfigMiddleware.
+//   - db: Connection pool used to run EXPLAIN.
+//   - query: SQL text as produced by goqu's ToSQL.
+//   - args: Positional arguments belonging to query.
+func ExplainQuery(ctx context.Context, db QueryExplainer, query string, args []any) {
+	if db == nil || !QueryPlanLoggingEnabled(ctx) {
+		return
+	}
+
+	plan, err := explainQueryPlan(ctx, db, query, args)
+	if err != nil {
+		log.Printf("🧾 [SQL PLAN] EXPLAIN failed for %s: %v", RedactSQLLiterals(query), err)
+		return
+	}
+
+	queryPlanLoggerMu.RLock()
+	logger := queryPlanLogger
+	queryPlanLoggerMu.RUnlock()
+
+	logger(RedactSQLLiterals(query), plan)
+}
+
+func explainQueryPlan(ctx context.Context, db QueryExplainer, query string, args []any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "EXPLAIN "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	plan := []string{}
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			return nil, err
+		}
+		plan = append(plan, RedactSQLLiterals(line))
+	}
+	return plan, rows.Err()
+}
@@ -0,0 +1,115 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+func contextWithQueryPlanLogging(logQueries bool, explainQueries bool) context.Context {
+	cfg := &Config{}
+	cfg.Postgres.LogQueries = logQueries
+	cfg.Postgres.ExplainQueries = explainQueries
+	return ContextWithConfig(context.Background(), cfg)
+}
+
+func TestExplainQueryLogsRedactedPlanWhenEnabled(t *testing.T) {
+	var gotQuery string
+	var gotPlan []string
+	previous := SetQueryPlanLogger(func(query string, plan []string) {
+		gotQuery = query
+		gotPlan = plan
+	})
+	t.Cleanup(func() { SetQueryPlanLogger(previous) })
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+	mock.ExpectQuery(`^EXPLAIN SELECT "id" FROM "submodel" WHERE "id_short" = 'Secret'$`).
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).
+			AddRow("Seq Scan on submodel  (cost=0.00..1.01 rows=1 width=8)").
+			AddRow("  Filter: ((id_short)::text = 'Secret'::text)"))
+
+	ExplainQuery(contextWithQueryPlanLogging(true, true), db, `SELECT "id" FROM "submodel" WHERE "id_short" = 'Secret'`, nil)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+	if gotQuery != `SELECT "id" FROM "submodel" WHERE "id_short" = '***'` {
+		t.Fatalf("expected redacted query, got %q", gotQuery)
+	}
+	want := []string{"Seq Scan on submodel  (cost=0.00..1.01 rows=1 width=8)", "  Filter: ((id_short)::text = '***'::text)"}
+	if len(gotPlan) != len(want) || gotPlan[0] != want[0] || gotPlan[1] != want[1] {
+		t.Fatalf("expected plan %q, got %q", want, gotPlan)
+	}
+}
+
+func TestExplainQueryIsSkippedUnlessBothFlagsAreSet(t *testing.T) {
+	previous := SetQueryPlanLogger(func(string, []string) {
+		t.Fatal("expected no query plan to be logged")
+	})
+	t.Cleanup(func() { SetQueryPlanLogger(previous) })
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	ExplainQuery(context.Background(), db, "SELECT 1", nil)
+	ExplainQuery(contextWithQueryPlanLogging(true, false), db, "SELECT 1", nil)
+	ExplainQuery(contextWithQueryPlanLogging(false, true), db, "SELECT 1", nil)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expected no EXPLAIN statement, got %v", err)
+	}
+}
+
+func TestExplainQueryIgnoresExplainFailure(t *testing.T) {
+	previous := SetQueryPlanLogger(func(string, []string) {
+		t.Fatal("expected no query plan after EXPLAIN failure")
+	})
+	t.Cleanup(func() { SetQueryPlanLogger(previous) })
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+	mock.ExpectQuery(`^EXPLAIN SELECT 1$`).WillReturnError(errors.New("explain failed"))
+
+	ExplainQuery(contextWithQueryPlanLogging(true, true), db, "SELECT 1", nil)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// QueryMaxResultsBehaviorReject rejects query requests above general.queryMaxResults with 400.
+	QueryMaxResultsBehaviorReject = "reject"
+	// QueryMaxResultsBehaviorTruncate clamps query requests to general.queryMaxResults and signals it in a header.
+	QueryMaxResultsBehaviorTruncate = "truncate"
+
+	// QueryResultTruncatedHeader is set on query responses whose requested size was clamped.
+	QueryResultTruncatedHeader = "Warning"
+
+	defaultQueryPageLimit int32 = 100
+)
+
+// QueryResultLimit describes the hard result cap applied to query endpoints.
+type QueryResultLimit struct {
+	// MaxResults is the maximum number of items one query request may return.
+	MaxResults int32
+	// Behavior is either QueryMaxResultsBehaviorReject or QueryMaxResultsBehaviorTruncate.
+	Behavior string
+	// DefaultPageLimit is the page size used when the request has no limit.
+	DefaultPageLimit int32
+}
+
+// QueryResultLimitFromContext resolves the query result cap from request-scoped configuration.
+//
+// Loaded configurations always carry a positive general.queryMaxResults, as
+// validateGeneralConfig rejects anything else; the default cap only applies to
+// configurations that were never loaded, such as bare test contexts.
+//
+// Parameters:
+//   - ctx: Request context populated by ConfigMiddleware.
+//
+// Returns:
+//   - QueryResultLimit: Configured cap, or defaults when no configuration exists.
+func QueryResultLimitFromContext(ctx context.Context) QueryResultLimit {
+	limit := QueryResultLimit{
+		MaxResults:       int32(DefaultConfig.GeneralQueryMaxResults),
+		Behavior:         DefaultConfig.GeneralQueryMaxResultsBehavior,
+		DefaultPageLimit: defaultQueryPageLimit,
+	}
+	cfg, ok := ConfigFromContext(ctx)
+	if !ok || cfg == nil {
+		return limit
+	}
+	if cfg.General.QueryMaxResults > 0 {
+		limit.MaxResults = int32(cfg.General.QueryMaxResults)
+	}
+	if behavior := strings.ToLower(strings.TrimSpace(cfg.General.QueryMaxResultsBehavior)); behavior != "" {
+		limit.Behavior = behavior
+	}
+	switch {
+	case cfg.General.DefaultQueryPageLimit > 0:
+		limit.DefaultPageLimit = int32(cfg.General.DefaultQueryPageLimit)
+	case cfg.General.DefaultPageLimit > 0:
+		limit.DefaultPageLimit = int32(cfg.General.DefaultPageLimit)
+	}
+	return limit
+}
+
+// Apply resolves the effective page size for a query request.
+//
+// A non-positive requested limit means "no explicit page size". Zero falls
+// back to DefaultPageLimit, while negative values (pagination disabled) are
+// treated as unbounded and therefore always subject to the cap. A default
+// above the cap is clamped without being reported as truncation, because the
+// client never asked for the larger size.
+//
+// Parameters:
+//   - requested: Page size requested by the client.
+//
+// Returns:
+//   - int32: Page size to pass to the persistence layer.
+//   - bool: True when the requested size was truncated to MaxResults.
+//   - error: A 400-classified error when the cap is exceeded in reject mode.
+func (limit QueryResultLimit) Apply(requested int32) (int32, bool, error) {
+	effective := requested
+	if effective == 0 {
+		effective = limit.DefaultPageLimit
+		if effective <= 0 {
+			effective = defaultQueryPageLimit
+		}
+		if limit.MaxResults > 0 && effective > limit.MaxResults {
+			effective = limit.MaxResults
+		}
+	}
+	if effective > 0 && effective <= limit.MaxResults {
+		return effective, false, nil
+	}
+	if limit.Behavior == QueryMaxResultsBehaviorReject {
+		return 0, false, NewErrBadRequest(fmt.Sprintf("COMMON-QUERYLIMIT-EXCEEDED requested result size exceeds the configured maximum of %d", limit.MaxResults))
+	}
+	return limit.MaxResults, true, nil
+}
+
+// TruncationWarning returns the Warning header value announcing a truncated query result.
+func (limit QueryResultLimit) TruncationWarning() string {
+	return fmt.Sprintf("199 - \"query result truncated to %d items; continue with the returned cursor\"", limit.MaxResults)
+}
@@ -0,0 +1,98 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQueryResultLimitFromContextFallsBackToDefaults(t *testing.T) {
+	limit := QueryResultLimitFromContext(context.Background())
+	if limit.MaxResults != int32(DefaultConfig.GeneralQueryMaxResults) || limit.Behavior != DefaultConfig.GeneralQueryMaxResultsBehavior {
+		t.Fatalf("expected default query limit, got %+v", limit)
+	}
+}
+
+func TestQueryResultLimitApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		behavior      string
+		requested     int32
+		wantLimit     int32
+		wantTruncated bool
+		wantErr       bool
+	}{
+		{name: "within cap", behavior: QueryMaxResultsBehaviorReject, requested: 10, wantLimit: 10},
+		{name: "default page size", behavior: QueryMaxResultsBehaviorReject, requested: 0, wantLimit: 100},
+		{name: "reject above cap", behavior: QueryMaxResultsBehaviorReject, requested: 501, wantErr: true},
+		{name: "reject unbounded", behavior: QueryMaxResultsBehaviorReject, requested: -1, wantErr: true},
+		{name: "truncate above cap", behavior: QueryMaxResultsBehaviorTruncate, requested: 501, wantLimit: 500, wantTruncated: true},
+		{name: "truncate unbounded", behavior: QueryMaxResultsBehaviorTruncate, requested: -1, wantLimit: 500, wantTruncated: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limit := QueryResultLimit{MaxResults: 500, Behavior: test.behavior}
+			got, truncated, err := limit.Apply(test.requested)
+			if test.wantErr {
+				if !IsErrBadRequest(err) {
+					t.Fatalf("expected bad request error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.wantLimit || truncated != test.wantTruncated {
+				t.Fatalf("expected (%d,%t), got (%d,%t)", test.wantLimit, test.wantTruncated, got, truncated)
+			}
+		})
+	}
+}
+
+func TestQueryResultLimitUsesConfiguredDefaultPageLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		general GeneralConfig
+		want    int32
+	}{
+		{name: "query default", general: GeneralConfig{QueryMaxResults: 500, DefaultPageLimit: 25, DefaultQueryPageLimit: 40}, want: 40},
+		{name: "global default", general: GeneralConfig{QueryMaxResults: 500, DefaultPageLimit: 25}, want: 25},
+		{name: "clamped to cap", general: GeneralConfig{QueryMaxResults: 30, DefaultQueryPageLimit: 40, QueryMaxResultsBehavior: QueryMaxResultsBehaviorReject}, want: 30},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := ContextWithConfig(context.Background(), &Config{General: test.general})
+			got, truncated, err := QueryResultLimitFromContext(ctx).Apply(0)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want || truncated {
+				t.Fatalf("expected (%d,false), got (%d,%t)", test.want, got, truncated)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RateLimitScopeClient keeps one token bucket per client source IP.
+	RateLimitScopeClient = "client"
+	// RateLimitScopeGlobal shares one token bucket between all clients.
+	RateLimitScopeGlobal = "global"
+
+	rateLimitGlobalKey = "*"
+)
+
+// RateLimiter is a token-bucket limiter keyed by client.
+//
+// Every bucket holds up to burst tokens and refills at requestsPerSecond.
+// Each admitted request consumes one token. Buckets that have been idle long
+// enough to be full again are dropped, so per-client limiting does not grow
+// without bound.
+type RateLimiter struct {
+	requestsPerSecond float64
+	burst             float64
+	perClient         bool
+	now               func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a token-bucket limiter.
+//
+// Parameters:
+//   - requestsPerSecond: Sustained refill rate; must be positive.
+//   - burst: Bucket capacity; values below one are raised to one.
+//   - scope: RateLimitScopeClient or RateLimitScopeGlobal.
+//
+// Returns:
+//   - *RateLimiter: Limiter using the wall clock.
+func NewRateLimiter(requestsPerSecond int, burst int, scope string) *RateLimiter {
+	return newRateLimiterWithClock(requestsPerSecond, burst, scope, time.Now)
+}
+
+func newRateLimiterWithClock(requestsPerSecond int, burst int, scope string, now func() time.Time) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		requestsPerSecond: float64(requestsPerSecond),
+		burst:             float64(burst),
+		perClient:         !strings.EqualFold(strings.TrimSpace(scope), RateLimitScopeGlobal),
+		now:               now,
+		buckets:           map[string]*tokenBucket{},
+	}
+}
+
+// Allow consumes one token from the bucket of key.
+//
+// Parameters:
+//   - key: Client key; ignored when the limiter is global.
+//
+// Returns:
+//   - bool: True when the request may proceed.
+//   - time.Duration: Time until the next token is available when rejected.
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	if !l.perClient {
+		key = rateLimitGlobalKey
+	}
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	}
+	if elapsed := now.Sub(bucket.last).Seconds(); elapsed > 0 {
+		bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.requestsPerSecond)
+	}
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	wait := (1 - bucket.tokens) / l.requestsPerSecond
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// sweep drops buckets that have refilled completely. It runs at most once per
+// full refill period and must be called with l.mu held.
+func (l *RateLimiter) sweep(now time.Time) {
+	refill := time.Duration(l.burst / l.requestsPerSecond * float64(time.Second))
+	if now.Sub(l.lastSweep) < refill {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimitMiddleware rejects requests above the limiter's rate with a
+// standardized 429 response and a Retry-After header in whole seconds.
+// Clients are identified by RequestSourceIP, so forwarded headers are only
+// honored for trusted proxies. A nil limiter returns next unchanged.
+//
+// Parameters:
+//   - limiter: Token-bucket limiter to consult per request.
+//   - next: Handler that serves admitted requests.
+//
+// Returns:
+//   - http.Handler: next wrapped with the rate limit.
+func RateLimitMiddleware(limiter *RateLimiter, next http.Handler) http.Handler {
+	if limiter == nil || next == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed, wait := limiter.Allow(RequestSourceIP(r))
+		if allowed {
+			next.ServeHTTP(w, r)
+			return
+		}
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		_ = WriteErrorResponse(
+			w,
+			NewErrTooManyRequests("COMMON-RATELIMIT-EXCEEDED request rate limit exceeded"),
+			http.StatusTooManyRequests,
+			"HTTPServer",
+			"RateLimit",
+			"Exceeded",
+		)
+	})
+}
+
+// serverRateLimitMiddleware applies cfg.RateLimit to every request except the
+// /health and /readyz probes below cfg.ContextPath, so orchestrators keep
+// seeing the real probe result while clients are throttled. A disabled rate
+// limit returns next unchanged.
+func serverRateLimitMiddleware(cfg ServerConfig, next http.Handler) http.Handler {
+	if !cfg.RateLimit.Enabled || cfg.RateLimit.RequestsPerSecond <= 0 || next == nil {
+		return next
+	}
+	limiter := NewRateLimiter(cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst, cfg.RateLimit.Scope)
+	limited := RateLimitMiddleware(limiter, next)
+	probePaths := map[string]struct{}{
+		cfg.ContextPath + "/health": {},
+		cfg.ContextPath + "/readyz": {},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, probe := probePaths[r.URL.Path]; probe {
+			next.ServeHTTP(w, r)
+			return
+		}
+		limited.ServeHTTP(w, r)
+	})
+}
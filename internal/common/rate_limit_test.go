@@ -0,0 +1,194 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeRateLimitClock struct {
+	current time.Time
+}
+
+func (c *fakeRateLimitClock) now() time.Time {
+	return c.current
+}
+
+func serveRateLimited(handler http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.RemoteAddr = remoteAddr
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestRateLimitMiddlewareRejectsRequestsAboveRate(t *testing.T) {
+	clock := &fakeRateLimitClock{current: time.Unix(1700000000, 0)}
+	limiter := newRateLimiterWithClock(1, 3, RateLimitScopeClient, clock.now)
+	handler := RateLimitMiddleware(limiter, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for i := 0; i < 3; i++ {
+		if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusNoContent {
+			t.Fatalf("request %d within burst: expected 204, got %d", i, recorder.Code)
+		}
+	}
+
+	recorder := serveRateLimited(handler, "192.0.2.1:1234")
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 above burst, got %d", recorder.Code)
+	}
+	if got := recorder.Header().Get("Retry-After"); got != "1" {
+		t.Fatalf("expected Retry-After 1, got %q", got)
+	}
+	assertStandardizedRateLimitError(t, recorder)
+
+	// Buckets are per client, so another source IP is still admitted.
+	if recorder := serveRateLimited(handler, "192.0.2.2:1234"); recorder.Code != http.StatusNoContent {
+		t.Fatalf("expected other client to be admitted, got %d", recorder.Code)
+	}
+}
+
+func TestRateLimitMiddlewareRefillsOverTime(t *testing.T) {
+	clock := &fakeRateLimitClock{current: time.Unix(1700000000, 0)}
+	limiter := newRateLimiterWithClock(2, 2, RateLimitScopeClient, clock.now)
+	handler := RateLimitMiddleware(limiter, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serveRateLimited(handler, "192.0.2.1:1234")
+	serveRateLimited(handler, "192.0.2.1:1234")
+	if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected exhausted bucket to reject, got %d", recorder.Code)
+	}
+
+	clock.current = clock.current.Add(500 * time.Millisecond)
+	if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusNoContent {
+		t.Fatalf("expected one refilled token after 500ms, got %d", recorder.Code)
+	}
+	if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected refilled token to be consumed, got %d", recorder.Code)
+	}
+
+	clock.current = clock.current.Add(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusNoContent {
+			t.Fatalf("request %d after full refill: expected 204, got %d", i, recorder.Code)
+		}
+	}
+	if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected refill to be capped at burst, got %d", recorder.Code)
+	}
+}
+
+func TestRateLimiterGlobalScopeSharesBucket(t *testing.T) {
+	clock := &fakeRateLimitClock{current: time.Unix(1700000000, 0)}
+	limiter := newRateLimiterWithClock(1, 1, RateLimitScopeGlobal, clock.now)
+
+	if allowed, _ := limiter.Allow("192.0.2.1"); !allowed {
+		t.Fatal("expected first request to be admitted")
+	}
+	allowed, wait := limiter.Allow("192.0.2.2")
+	if allowed {
+		t.Fatal("expected global bucket to be shared between clients")
+	}
+	if wait != time.Second {
+		t.Fatalf("expected 1s until the next token, got %s", wait)
+	}
+}
+
+func TestRateLimiterRetryAfterRoundsUp(t *testing.T) {
+	clock := &fakeRateLimitClock{current: time.Unix(1700000000, 0)}
+	limiter := newRateLimiterWithClock(1, 1, RateLimitScopeGlobal, clock.now)
+	handler := RateLimitMiddleware(limiter, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serveRateLimited(handler, "192.0.2.1:1234")
+	clock.current = clock.current.Add(200 * time.Millisecond)
+	recorder := serveRateLimited(handler, "192.0.2.1:1234")
+	retryAfter, err := strconv.Atoi(recorder.Header().Get("Retry-After"))
+	if err != nil || retryAfter != 1 {
+		t.Fatalf("expected Retry-After of one whole second, got %q", recorder.Header().Get("Retry-After"))
+	}
+}
+
+func TestServerRateLimitMiddlewareDisabledByDefault(t *testing.T) {
+	handler := serverRateLimitMiddleware(ServerConfig{RateLimit: RateLimitConfig{RequestsPerSecond: 1, Burst: 1}}, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for i := 0; i < 5; i++ {
+		if recorder := serveRateLimited(handler, "192.0.2.1:1234"); recorder.Code != http.StatusNoContent {
+			t.Fatalf("expected disabled limiter to admit request %d, got %d", i, recorder.Code)
+		}
+	}
+}
+
+func TestNewConfiguredHTTPServerRateLimitsAllButProbes(t *testing.T) {
+	server := NewConfiguredHTTPServer(t.Context(), ServerConfig{
+		ContextPath: "/api",
+		RateLimit:   RateLimitConfig{Enabled: true, RequestsPerSecond: 1, Burst: 1, Scope: RateLimitScopeClient},
+	}, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if recorder := serveRateLimited(server.Handler, "192.0.2.1:1234"); recorder.Code != http.StatusNoContent {
+		t.Fatalf("expected first request to be admitted, got %d", recorder.Code)
+	}
+	recorder := serveRateLimited(server.Handler, "192.0.2.1:1234")
+	if recorder.Code != http.StatusTooManyRequests || recorder.Header().Get("Retry-After") == "" {
+		t.Fatalf("expected 429 with Retry-After, got %d %v", recorder.Code, recorder.Header())
+	}
+	for _, path := range []string{"/api/health", "/api/readyz"} {
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		request.RemoteAddr = "192.0.2.1:1234"
+		probe := httptest.NewRecorder()
+		server.Handler.ServeHTTP(probe, request)
+		if probe.Code != http.StatusNoContent {
+			t.Fatalf("expected %s to bypass the rate limit, got %d", path, probe.Code)
+		}
+	}
+}
+
+func assertStandardizedRateLimitError(t *testing.T, recorder *httptest.ResponseRecorder) {
+	t.Helper()
+
+	var body []ErrorHandler
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode error response: %v", err)
+	}
+	if len(body) != 1 || body[0].Code != "429" || !strings.Contains(body[0].Text, "COMMON-RATELIMIT-EXCEEDED") {
+		t.Fatalf("expected standardized 429 error, got %#v", body)
+	}
+}
@@ -0,0 +1,114 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestBodyLimitMiddleware caps request bodies at maxBodyBytes, or at
+// maxUploadBodyBytes on upload routes (see isUploadRoute). Requests that
+// announce a larger Content-Length are rejected with a standardized 413 before
+// the handler runs; all other bodies are wrapped with http.MaxBytesReader so
+// handlers that read past the limit fail with *http.MaxBytesError, which the
+// API error handlers map to 413.
+//
+// The upload exemption is decided by route, never by the client-supplied
+// Content-Type, so a multipart body sent to a JSON endpoint is still bound by
+// maxBodyBytes. A maxBodyBytes of zero or less disables the middleware and
+// returns next unchanged; a maxUploadBodyBytes of zero or less falls back to
+// maxBodyBytes.
+//
+// Parameters:
+//   - maxBodyBytes: Maximum accepted request body size in bytes.
+//   - maxUploadBodyBytes: Maximum accepted body size on upload routes.
+//   - next: Handler that serves accepted requests.
+//
+// Returns:
+//   - http.Handler: next wrapped with the body size limit.
+func RequestBodyLimitMiddleware(maxBodyBytes int64, maxUploadBodyBytes int64, next http.Handler) http.Handler {
+	if maxBodyBytes <= 0 || next == nil {
+		return next
+	}
+	if maxUploadBodyBytes <= 0 {
+		maxUploadBodyBytes = maxBodyBytes
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+		limit := maxBodyBytes
+		if isUploadRoute(r) {
+			limit = maxUploadBodyBytes
+		}
+		if r.ContentLength > limit {
+			_ = WriteErrorResponse(
+				w,
+				NewErrPayloadTooLarge(fmt.Sprintf("COMMON-BODYLIMIT-TOOLARGE request body exceeds configured maximum of %d bytes", limit)),
+				http.StatusRequestEntityTooLarge,
+				"HTTPServer",
+				"RequestBodyLimit",
+				"TooLarge",
+			)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isUploadRoute reports whether r targets one of the binary upload handlers:
+// submodel element attachments, asset thumbnails, the AAS environment and
+// submodel serialization uploads, and AASX package writes. It is the single
+// upload classification shared by RequestBodyLimitMiddleware and
+// JSONContentTypeMiddleware.
+func isUploadRoute(r *http.Request) bool {
+	if r.Method != http.MethodPost && r.Method != http.MethodPut {
+		return false
+	}
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case strings.HasSuffix(path, "/attachment"), strings.HasSuffix(path, "/asset-information/thumbnail"):
+		return r.Method == http.MethodPut
+	case strings.HasSuffix(path, "/upload"), strings.HasSuffix(path, "/packages"):
+		return r.Method == http.MethodPost
+	default:
+		// PUT /packages/{packageId} replaces an AASX package.
+		parent, _, found := cutLastPathSegment(path)
+		return found && r.Method == http.MethodPut && strings.HasSuffix(parent, "/packages")
+	}
+}
+
+func cutLastPathSegment(path string) (string, string, bool) {
+	index := strings.LastIndex(path, "/")
+	if index < 0 {
+		return "", path, false
+	}
+	return path[:index], path[index+1:], true
+}
@@ -0,0 +1,114 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func decodingHandler(t *testing.T) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+func jsonBodyOfSize(size int) string {
+	return `"` + strings.Repeat("a", size-2) + `"`
+}
+
+func TestRequestBodyLimitMiddleware(t *testing.T) {
+	const limit = 64
+	const uploadLimit = 256
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		contentType   string
+		unknownLength bool
+		want          int
+	}{
+		{name: "just under the limit", body: jsonBodyOfSize(limit - 1), contentType: "application/json", want: http.StatusCreated},
+		{name: "exactly the limit", body: jsonBodyOfSize(limit), contentType: "application/json", want: http.StatusCreated},
+		{name: "announced oversized body", body: jsonBodyOfSize(limit + 1), contentType: "application/json", want: http.StatusRequestEntityTooLarge},
+		{name: "streamed oversized body", body: jsonBodyOfSize(limit + 1), contentType: "application/json", unknownLength: true, want: http.StatusRequestEntityTooLarge},
+		{name: "multipart content type does not lift the limit on a JSON route", body: jsonBodyOfSize(limit * 2), contentType: "multipart/form-data; boundary=x", want: http.StatusRequestEntityTooLarge},
+		{name: "octet-stream content type does not lift the limit on a JSON route", body: jsonBodyOfSize(limit * 2), contentType: "application/octet-stream", unknownLength: true, want: http.StatusRequestEntityTooLarge},
+		{name: "attachment upload uses the upload limit", method: http.MethodPut, path: "/submodels/c20=/submodel-elements/doc/attachment", body: jsonBodyOfSize(limit * 2), contentType: "multipart/form-data; boundary=x", want: http.StatusCreated},
+		{name: "attachment upload above the upload limit", method: http.MethodPut, path: "/submodels/c20=/submodel-elements/doc/attachment", body: jsonBodyOfSize(uploadLimit + 1), contentType: "multipart/form-data; boundary=x", want: http.StatusRequestEntityTooLarge},
+		{name: "streamed package upload above the upload limit", method: http.MethodPut, path: "/packages/cGtn", body: jsonBodyOfSize(uploadLimit + 1), contentType: "multipart/form-data; boundary=x", unknownLength: true, want: http.StatusRequestEntityTooLarge},
+		{name: "environment upload uses the upload limit", path: "/upload", body: jsonBodyOfSize(limit * 2), contentType: "multipart/form-data; boundary=x", want: http.StatusCreated},
+		{name: "attachment read path is not an upload route", method: http.MethodPatch, path: "/submodels/c20=/submodel-elements/doc/attachment", body: jsonBodyOfSize(limit * 2), contentType: "application/json", want: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequestBodyLimitMiddleware(limit, uploadLimit, decodingHandler(t))
+			var body io.Reader = strings.NewReader(tt.body)
+			if tt.unknownLength {
+				body = io.MultiReader(body)
+			}
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			path := tt.path
+			if path == "" {
+				path = "/submodels"
+			}
+			request := httptest.NewRequest(method, path, body)
+			request.Header.Set("Content-Type", tt.contentType)
+			if tt.unknownLength {
+				request.ContentLength = -1
+			}
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.want {
+				t.Fatalf("expected status %d, got %d body=%s", tt.want, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -25,10 +25,19 @@
 
 package common
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 type authorizationHeaderContextKey struct{}
 type acceptHeaderContextKey struct{}
+type languageFilterContextKey struct{}
+type elementOrderContextKey struct{}
+type dryRunContextKey struct{}
+type elementDepthContextKey struct{}
+type idShortPathFilterContextKey struct{}
+type ifMatchHeaderContextKey struct{}
 
 // WithAuthorizationHeader stores the inbound Authorization header in context.
 func WithAuthorizationHeader(ctx context.Context, authorizationHeader string) context.Context {
@@ -71,3 +80,174 @@ func AcceptHeaderFromContext(ctx context.Context) string {
 
 	return value
 }
+
+// WithIfMatchHeader stores the inbound If-Match header in context. An empty
+// header leaves ctx unchanged so that writes stay unconditional.
+func WithIfMatchHeader(ctx context.Context, ifMatchHeader string) context.Context {
+	if strings.TrimSpace(ifMatchHeader) == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchHeaderContextKey{}, ifMatchHeader)
+}
+
+// IfMatchHeaderFromContext returns the previously stored If-Match header.
+func IfMatchHeaderFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	value, ok := ctx.Value(ifMatchHeaderContextKey{}).(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// ParseLanguageList splits a comma-separated list of language tags such as the
+// value of the `language` query parameter. Blank entries are dropped.
+func ParseLanguageList(raw string) []string {
+	languages := make([]string, 0)
+	for _, language := range strings.Split(raw, ",") {
+		if trimmed := strings.TrimSpace(language); trimmed != "" {
+			languages = append(languages, trimmed)
+		}
+	}
+	return languages
+}
+
+// WithLanguageFilter stores the languages requested for LangString values in
+// context. An empty list leaves ctx unchanged so that all languages are returned.
+func WithLanguageFilter(ctx context.Context, languages []string) context.Context {
+	if len(languages) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, languageFilterContextKey{}, languages)
+}
+
+// LanguageFilterFromContext returns the languages stored with WithLanguageFilter
+// or nil when the caller did not restrict the returned languages.
+func LanguageFilterFromContext(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+
+	value, ok := ctx.Value(languageFilterContextKey{}).([]string)
+	if !ok {
+		return nil
+	}
+
+	return value
+}
+
+// MatchesLanguageFilter reports whether the language tag of a LangString
+// matches one of the requested languages. Matching is case-insensitive and a
+// requested language also matches its regional variants, so "en" matches
+// "en-US" (RFC 4647 basic filtering).
+func MatchesLanguageFilter(language string, languages []string) bool {
+	for _, requested := range languages {
+		if strings.EqualFold(language, requested) {
+			return true
+		}
+		if len(language) > len(requested) && language[len(requested)] == '-' && strings.EqualFold(language[:len(requested)], requested) {
+			return true
+		}
+	}
+	return false
+}
+
+// Orderings accepted by the `orderBy` query parameter of element listings.
+const (
+	ElementOrderIDShort  = "idShort"
+	ElementOrderPosition = "position"
+)
+
+// ParseElementOrder validates the `orderBy` query parameter of element
+// listings. An empty value selects the default ElementOrderIDShort.
+func ParseElementOrder(raw string) (string, error) {
+	switch strings.TrimSpace(raw) {
+	case "", ElementOrderIDShort:
+		return ElementOrderIDShort, nil
+	case ElementOrderPosition:
+		return ElementOrderPosition, nil
+	default:
+		return "", NewErrBadRequest("COMMON-ORDERBY-INVALID orderBy must be idShort or position, got " + raw)
+	}
+}
+
+// WithElementOrder stores the ordering requested for an element listing in
+// context.
+func WithElementOrder(ctx context.Context, order string) context.Context {
+	return context.WithValue(ctx, elementOrderContextKey{}, order)
+}
+
+// ElementOrderFromContext returns the ordering stored with WithElementOrder or
+// ElementOrderIDShort when the caller did not request one.
+func ElementOrderFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ElementOrderIDShort
+	}
+
+	value, ok := ctx.Value(elementOrderContextKey{}).(string)
+	if !ok || value == "" {
+		return ElementOrderIDShort
+	}
+
+	return value
+}
+
+// WithDryRun marks a write request as validate-only. Handlers run every check
+// of the write but persist nothing.
+func WithDryRun(ctx context.Context, dryRun bool) context.Context {
+	if !dryRun {
+		return ctx
+	}
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// IsDryRun reports whether the request was marked with WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	value, ok := ctx.Value(dryRunContextKey{}).(bool)
+	return ok && value
+}
+
+// WithElementDepth stores the number of child levels requested with the
+// `depth` query parameter of element reads. Depth 0 keeps the element only.
+func WithElementDepth(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, elementDepthContextKey{}, depth)
+}
+
+// ElementDepthFromContext returns the depth stored with WithElementDepth and
+// whether the caller requested one.
+func ElementDepthFromContext(ctx context.Context) (int, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+
+	value, ok := ctx.Value(elementDepthContextKey{}).(int)
+	return value, ok
+}
+
+// WithIDShortPathFilter restricts a Submodel representation to the subtree at
+// idShortPath. An empty path leaves ctx unchanged.
+func WithIDShortPathFilter(ctx context.Context, idShortPath string) context.Context {
+	if idShortPath == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, idShortPathFilterContextKey{}, idShortPath)
+}
+
+// IDShortPathFilterFromContext returns the path stored with
+// WithIDShortPathFilter or "" when the whole Submodel was requested.
+func IDShortPathFilterFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	value, _ := ctx.Value(idShortPathFilterContextKey{}).(string)
+	return value
+}
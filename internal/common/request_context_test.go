@@ -0,0 +1,100 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestLanguageFilterRoundTrip(t *testing.T) {
+	if languages := LanguageFilterFromContext(context.Background()); languages != nil {
+		t.Fatalf("expected no language filter, got %v", languages)
+	}
+
+	ctx := WithLanguageFilter(context.Background(), ParseLanguageList(" de ,,en "))
+	if languages := LanguageFilterFromContext(ctx); !reflect.DeepEqual(languages, []string{"de", "en"}) {
+		t.Fatalf("expected [de en], got %v", languages)
+	}
+
+	if languages := LanguageFilterFromContext(WithLanguageFilter(context.Background(), ParseLanguageList(","))); languages != nil {
+		t.Fatalf("expected blank list to disable filtering, got %v", languages)
+	}
+}
+
+func TestMatchesLanguageFilter(t *testing.T) {
+	tests := []struct {
+		language string
+		want     bool
+	}{
+		{language: "en", want: true},
+		{language: "EN", want: true},
+		{language: "en-US", want: true},
+		{language: "de", want: true},
+		{language: "eng", want: false},
+		{language: "fr", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchesLanguageFilter(tt.language, []string{"de", "en"}); got != tt.want {
+			t.Fatalf("MatchesLanguageFilter(%q) = %v, want %v", tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestParseElementOrder(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "", want: ElementOrderIDShort},
+		{raw: "idShort", want: ElementOrderIDShort},
+		{raw: "position", want: ElementOrderPosition},
+		{raw: "value", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseElementOrder(tt.raw)
+		if tt.wantErr {
+			if err == nil || !IsErrBadRequest(err) {
+				t.Fatalf("ParseElementOrder(%q) expected bad request, got %v", tt.raw, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Fatalf("ParseElementOrder(%q) = %q, %v; want %q", tt.raw, got, err, tt.want)
+		}
+	}
+
+	if order := ElementOrderFromContext(context.Background()); order != ElementOrderIDShort {
+		t.Fatalf("expected default order %q, got %q", ElementOrderIDShort, order)
+	}
+	if order := ElementOrderFromContext(WithElementOrder(context.Background(), ElementOrderPosition)); order != ElementOrderPosition {
+		t.Fatalf("expected stored order %q, got %q", ElementOrderPosition, order)
+	}
+}
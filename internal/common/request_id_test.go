@@ -0,0 +1,120 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func captureSlogRecords(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buffer, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buffer
+}
+
+func serveWithRequestID(t *testing.T, inboundRequestID string) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+
+	logs := captureSlogRecords(t)
+	handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Logger(r.Context(), "TestComponent").Info("handled")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	if inboundRequestID != "" {
+		request.Header.Set(RequestIDHeader, inboundRequestID)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	var record map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("failed to decode log record %q: %v", logs.String(), err)
+	}
+	return recorder, record
+}
+
+func TestRequestIDMiddlewareGeneratesIDForLogsAndResponse(t *testing.T) {
+	recorder, record := serveWithRequestID(t, "")
+
+	requestID := recorder.Header().Get(RequestIDHeader)
+	if len(requestID) != 32 {
+		t.Fatalf("expected generated 32 character request ID, got %q", requestID)
+	}
+	if record["request_id"] != requestID {
+		t.Fatalf("expected log record request_id %q, got %#v", requestID, record)
+	}
+	if record["component"] != "TestComponent" {
+		t.Fatalf("expected log record component TestComponent, got %#v", record)
+	}
+}
+
+func TestRequestIDMiddlewarePropagatesInboundID(t *testing.T) {
+	recorder, record := serveWithRequestID(t, "client-trace.42")
+
+	if got := recorder.Header().Get(RequestIDHeader); got != "client-trace.42" {
+		t.Fatalf("expected echoed request ID client-trace.42, got %q", got)
+	}
+	if record["request_id"] != "client-trace.42" {
+		t.Fatalf("expected log record request_id client-trace.42, got %#v", record)
+	}
+}
+
+func TestRequestIDMiddlewareReplacesMalformedInboundID(t *testing.T) {
+	for _, inbound := range []string{"bad id\nwith newline", strings.Repeat("a", maxRequestIDLength+1)} {
+		recorder, record := serveWithRequestID(t, inbound)
+
+		requestID := recorder.Header().Get(RequestIDHeader)
+		if requestID == inbound || len(requestID) != 32 {
+			t.Fatalf("expected malformed request ID to be replaced, got %q", requestID)
+		}
+		if record["request_id"] != requestID {
+			t.Fatalf("expected log record request_id %q, got %#v", requestID, record)
+		}
+	}
+}
+
+func TestLoggerOmitsRequestIDOutsideRequests(t *testing.T) {
+	logs := captureSlogRecords(t)
+
+	Logger(context.Background(), "TestComponent").Info("startup")
+
+	if strings.Contains(logs.String(), "request_id") {
+		t.Fatalf("expected no request_id outside requests, got %s", logs.String())
+	}
+}
@@ -0,0 +1,162 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RequestTimeoutMiddleware bounds the time a request may spend in next.
+// The request context is canceled when the timeout elapses, so database calls
+// that use r.Context() are aborted. If next has not written a response by then,
+// the client gets a standardized 504 right away and later writes of next are
+// discarded. A response that has already started is left to finish. A timeout
+// of zero or less disables the budget and returns next unchanged.
+//
+// Parameters:
+//   - timeout: Maximum time a request may take before it is answered with 504.
+//   - next: Handler that serves the request.
+//
+// Returns:
+//   - http.Handler: next wrapped with the time budget.
+func RequestTimeoutMiddleware(timeout time.Duration, next http.Handler) http.Handler {
+	if timeout <= 0 || next == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		tw := &timeoutResponseWriter{w: w, header: make(http.Header)}
+		done := make(chan struct{})
+		panicked := make(chan any, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicked <- p
+				}
+			}()
+			next.ServeHTTP(tw, r.WithContext(ctx))
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case p := <-panicked:
+			panic(p)
+		case <-ctx.Done():
+			if !tw.timeout() {
+				// The response has already started; let it finish.
+				select {
+				case <-done:
+				case p := <-panicked:
+					panic(p)
+				}
+				return
+			}
+			_ = WriteErrorResponse(
+				w,
+				NewErrGatewayTimeout(fmt.Sprintf("COMMON-REQUESTTIMEOUT-EXCEEDED request exceeded %s", timeout)),
+				http.StatusGatewayTimeout,
+				"HTTPServer",
+				"RequestTimeout",
+				"Exceeded",
+			)
+		}
+	})
+}
+
+// timeoutResponseWriter forwards writes of a handler running in its own
+// goroutine until the request times out. Headers are staged in a private map
+// so a timed-out handler cannot touch the headers of the 504 response.
+type timeoutResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	mu          sync.Mutex
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutResponseWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutResponseWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeaderLocked(status)
+}
+
+func (tw *timeoutResponseWriter) writeHeaderLocked(status int) {
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	dst := tw.w.Header()
+	for key, values := range tw.header {
+		dst[key] = values
+	}
+	tw.w.WriteHeader(status)
+}
+
+func (tw *timeoutResponseWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	tw.writeHeaderLocked(http.StatusOK)
+	return tw.w.Write(p)
+}
+
+// Flush forwards to the underlying writer so streamed responses keep working.
+func (tw *timeoutResponseWriter) Flush() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return
+	}
+	if flusher, ok := tw.w.(http.Flusher); ok {
+		tw.writeHeaderLocked(http.StatusOK)
+		flusher.Flush()
+	}
+}
+
+// timeout marks the writer as timed out and reports whether the 504 response
+// may still be written, which is the case while no header was sent.
+func (tw *timeoutResponseWriter) timeout() bool {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.wroteHeader {
+		return false
+	}
+	tw.timedOut = true
+	return true
+}
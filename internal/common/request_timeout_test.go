@@ -0,0 +1,131 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestTimeoutMiddlewareAnswersSlowHandlerWith504(t *testing.T) {
+	handlerErr := make(chan error, 1)
+	handler := RequestTimeoutMiddleware(20*time.Millisecond, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			handlerErr <- r.Context().Err()
+		case <-time.After(5 * time.Second):
+			handlerErr <- nil
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	started := time.Now()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/submodels", nil))
+	elapsed := time.Since(started)
+
+	if recorder.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected 504, got %d", recorder.Code)
+	}
+	if elapsed > time.Second {
+		t.Fatalf("expected a timely answer, took %s", elapsed)
+	}
+	if !strings.Contains(recorder.Body.String(), "COMMON-REQUESTTIMEOUT-EXCEEDED") {
+		t.Fatalf("expected timeout error code, got %s", recorder.Body.String())
+	}
+	select {
+	case err := <-handlerErr:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected handler context to be canceled by the deadline, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected handler to observe the context cancellation")
+	}
+}
+
+func TestRequestTimeoutMiddlewarePassesFastResponses(t *testing.T) {
+	handler := RequestTimeoutMiddleware(time.Second, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/submodels", nil))
+
+	if recorder.Code != http.StatusCreated || recorder.Body.String() != `{}` {
+		t.Fatalf("expected handler response, got %d %q", recorder.Code, recorder.Body.String())
+	}
+	if recorder.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("expected handler headers, got %v", recorder.Header())
+	}
+}
+
+func TestRequestTimeoutMiddlewareLetsStartedResponseFinish(t *testing.T) {
+	handler := RequestTimeoutMiddleware(20*time.Millisecond, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("first"))
+		<-r.Context().Done()
+		_, _ = w.Write([]byte(" second"))
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/attachment", nil))
+
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "first second" {
+		t.Fatalf("expected started response to complete, got %d %q", recorder.Code, recorder.Body.String())
+	}
+}
+
+func TestRequestTimeoutMiddlewareDisabled(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	RequestTimeoutMiddleware(0, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Context().Deadline(); ok {
+			t.Fatal("expected no deadline when the timeout is disabled")
+		}
+	})).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestLoadConfigRequestTimeout(t *testing.T) {
+	t.Setenv("SERVER_REQUEST_TIMEOUT_SECONDS", "7")
+	cfg, err := LoadConfig("", QUIET)
+	if err != nil {
+		t.Fatalf("unexpected config load error: %v", err)
+	}
+	if cfg.Server.RequestTimeoutSeconds != 7 {
+		t.Fatalf("expected request timeout 7, got %d", cfg.Server.RequestTimeoutSeconds)
+	}
+
+	t.Setenv("SERVER_REQUEST_TIMEOUT_SECONDS", "-1")
+	if _, err := LoadConfig("", QUIET); err == nil || !strings.Contains(err.Error(), "CONFIG-SERVER-REQUESTTIMEOUT") {
+		t.Fatalf("expected CONFIG-SERVER-REQUESTTIMEOUT for a negative timeout, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ResponseSizeWarningHeader carries the soft limit warning on large responses.
+const ResponseSizeWarningHeader = "Warning"
+
+// ResponseSizeWarningMiddleware adds a Warning header to JSON responses whose
+// body exceeds softLimitBytes, so clients that request huge pages notice and
+// can ask for smaller ones. The response itself is not changed.
+//
+// Headers must be sent before the body, so up to softLimitBytes of a JSON body
+// are held back until the limit is either crossed or the handler returns; the
+// rest is streamed. A Content-Length set by the handler is used directly.
+// Responses of other media types, such as attachments and AASX packages, are
+// passed through. A softLimitBytes of zero or less disables the middleware
+// and returns next unchanged.
+//
+// Parameters:
+//   - softLimitBytes: Response body size above which the warning is added.
+//   - next: Handler that serves the request.
+//
+// Returns:
+//   - http.Handler: next wrapped with the response size warning.
+func ResponseSizeWarningMiddleware(softLimitBytes int64, next http.Handler) http.Handler {
+	if softLimitBytes <= 0 || next == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writer := &responseSizeWarningWriter{ResponseWriter: w, softLimitBytes: softLimitBytes}
+		next.ServeHTTP(writer, r)
+		writer.finish()
+	})
+}
+
+// ResponseSizeWarning returns the Warning header value for a response above
+// the soft limit.
+func ResponseSizeWarning(softLimitBytes int64) string {
+	return fmt.Sprintf("199 - \"response exceeds the soft limit of %d bytes; request a smaller page with the limit parameter\"", softLimitBytes)
+}
+
+type responseSizeWarningWriter struct {
+	http.ResponseWriter
+	softLimitBytes int64
+	status         int
+	buffering      bool
+	committed      bool
+	buffer         bytes.Buffer
+}
+
+func (w *responseSizeWarningWriter) WriteHeader(status int) {
+	if w.status != 0 || w.committed {
+		return
+	}
+	w.status = status
+	if !isJSONMediaType(w.Header().Get("Content-Type")) || status == http.StatusNoContent || status == http.StatusNotModified {
+		w.commit(false)
+		return
+	}
+	if length, err := strconv.ParseInt(w.Header().Get("Content-Length"), 10, 64); err == nil {
+		w.commit(length > w.softLimitBytes)
+		return
+	}
+	w.buffering = true
+}
+
+func (w *responseSizeWarningWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.buffering {
+		return w.ResponseWriter.Write(data)
+	}
+	if int64(w.buffer.Len()+len(data)) <= w.softLimitBytes {
+		return w.buffer.Write(data)
+	}
+	if err := w.commit(true); err != nil {
+		return 0, err
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush sends the held back part of the body. A body that is flushed before
+// it crossed the limit is not marked any more.
+func (w *responseSizeWarningWriter) Flush() {
+	if w.status != 0 && !w.committed {
+		_ = w.commit(false)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the wrapped writer to http.ResponseController.
+func (w *responseSizeWarningWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseSizeWarningWriter) finish() {
+	if w.status != 0 && !w.committed {
+		_ = w.commit(false)
+	}
+}
+
+func (w *responseSizeWarningWriter) commit(exceeded bool) error {
+	w.committed = true
+	w.buffering = false
+	if exceeded {
+		w.Header().Add(ResponseSizeWarningHeader, ResponseSizeWarning(w.softLimitBytes))
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.buffer.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
@@ -0,0 +1,134 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+// pagedItemsHandler answers with as many items as the limit query parameter asks for.
+func pagedItemsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		items := make([]string, 0, limit)
+		for index := range limit {
+			items = append(items, fmt.Sprintf("urn:example:submodel:%04d", index))
+		}
+		status := http.StatusOK
+		_ = model.EncodeJSONResponse(map[string]any{"result": items}, &status, w)
+	})
+}
+
+func TestResponseSizeWarningOnLargePage(t *testing.T) {
+	server := NewConfiguredHTTPServer(t.Context(), ServerConfig{Port: 8084, ResponseSizeWarningBytes: 1024}, pagedItemsHandler())
+
+	tests := []struct {
+		name        string
+		limit       int
+		wantWarning bool
+	}{
+		{name: "small page", limit: 5},
+		{name: "large page", limit: 500, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/submodels?limit="+strconv.Itoa(tt.limit), nil))
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d", recorder.Code)
+			}
+			warning := recorder.Header().Get(ResponseSizeWarningHeader)
+			if tt.wantWarning && warning != ResponseSizeWarning(1024) {
+				t.Fatalf("expected size warning, got %q", warning)
+			}
+			if !tt.wantWarning && warning != "" {
+				t.Fatalf("expected no warning, got %q", warning)
+			}
+
+			var body struct {
+				Result []string `json:"result"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("response body is not intact: %v", err)
+			}
+			if len(body.Result) != tt.limit {
+				t.Fatalf("expected %d items, got %d", tt.limit, len(body.Result))
+			}
+		})
+	}
+}
+
+func TestResponseSizeWarningSkipsNonJSONAndUsesContentLength(t *testing.T) {
+	large := strings.Repeat("x", 2048)
+
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantWarning bool
+	}{
+		{
+			name: "attachment",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, _ = w.Write([]byte(large))
+			},
+		},
+		{
+			name: "declared content length",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Length", strconv.Itoa(len(large)+2))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`"` + large + `"`))
+			},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ResponseSizeWarningMiddleware(1024, tt.handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if got := recorder.Header().Get(ResponseSizeWarningHeader) != ""; got != tt.wantWarning {
+				t.Fatalf("expected warning %v, got header %q", tt.wantWarning, recorder.Header().Get(ResponseSizeWarningHeader))
+			}
+			if !strings.Contains(recorder.Body.String(), large) {
+				t.Fatal("expected the body to be passed through unchanged")
+			}
+		})
+	}
+}
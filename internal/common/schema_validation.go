@@ -0,0 +1,461 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"go.yaml.in/yaml/v3"
+)
+
+// part1SchemaVersion selects the embedded Part 1 schema document used for
+// strict schema validation.
+const part1SchemaVersion = "V3.2.0"
+
+// utf16SurrogatePairs is the alternative the IDTA schemas use to admit
+// characters outside the Basic Multilingual Plane. ECMAScript regular
+// expressions match UTF-16 code units; Go matches code points, so the whole
+// alternative is replaced by the equivalent code point range.
+const utf16SurrogatePairs = `|\ud800[\udc00-\udfff]|[\ud801-\udbfe][\udc00-\udfff]|\udbff[\udc00-\udfff]`
+
+var unicodeEscape = regexp.MustCompile(`\\u([0-9a-fA-F]{4})`)
+
+// SchemaViolation is a single place where a request body does not conform to
+// the IDTA Part 1 JSON schema. Pointer is an RFC 6901 JSON pointer into the
+// request body; the empty pointer addresses the document root.
+type SchemaViolation struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (v SchemaViolation) String() string {
+	pointer := v.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return pointer + ": " + v.Message
+}
+
+type schemaNode struct {
+	ref        string
+	typ        string
+	enum       []string
+	pattern    *regexp.Regexp
+	minLength  int
+	maxLength  int
+	minItems   int
+	items      *schemaNode
+	properties map[string]*schemaNode
+	required   []string
+	allOf      []*schemaNode
+	oneOf      []*schemaNode
+}
+
+var (
+	part1SchemasOnce sync.Once
+	part1Schemas     map[string]*schemaNode
+	errPart1Schemas  error
+)
+
+// ValidatePart1Schema validates a raw JSON document against a named schema of
+// the embedded IDTA Part 1 metamodel schemas (e.g. "Submodel").
+//
+// The validator supports the keywords the IDTA schemas use: type, enum,
+// pattern, minLength, maxLength, minItems, items, properties, required, allOf,
+// oneOf and local $ref. Unknown properties are accepted, as in the schema.
+//
+// Returns:
+//   - []SchemaViolation: All violations found, ordered by pointer; empty when the document conforms
+//   - error: A 400 error when the body is not JSON, a 500 error when the schema cannot be loaded
+func ValidatePart1Schema(schemaName string, raw []byte) ([]SchemaViolation, error) {
+	part1SchemasOnce.Do(func() {
+		part1Schemas, errPart1Schemas = loadPart1Schemas()
+	})
+	if errPart1Schemas != nil {
+		return nil, NewInternalServerError("COMMON-SCHEMA-LOAD " + errPart1Schemas.Error())
+	}
+	root, ok := part1Schemas[schemaName]
+	if !ok {
+		return nil, NewInternalServerError("COMMON-SCHEMA-UNKNOWN unknown Part 1 schema " + schemaName)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, NewErrBadRequest("COMMON-SCHEMA-DECODE " + err.Error())
+	}
+
+	var violations []SchemaViolation
+	validateSchemaNode(root, document, "", &violations)
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Pointer < violations[j].Pointer
+	})
+	return violations, nil
+}
+
+// NewSchemaViolationResponse builds a 400 response carrying one standardized
+// error message per schema violation, each prefixed with its JSON pointer.
+func NewSchemaViolationResponse(violations []SchemaViolation, component, function string) model.ImplResponse {
+	timestamp := time.Now().Format(time.RFC3339)
+	correlationID := fmt.Sprintf("%s-400-%s-BadRequest-SchemaViolation", component, function)
+	messages := make([]model.Message, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, model.Message{
+			MessageType:   "Error",
+			Text:          component + "-" + strings.ToUpper(function) + "-SCHEMA " + violation.String(),
+			Code:          "400",
+			CorrelationID: correlationID,
+			Timestamp:     timestamp,
+		})
+	}
+	return model.Response(http.StatusBadRequest, messages)
+}
+
+func loadPart1Schemas() (map[string]*schemaNode, error) {
+	content, err := fs.ReadFile(part1SchemasFS, "swagger_part1_schemas/"+part1SchemaVersion+"/openapi.yaml")
+	if err != nil {
+		return nil, err
+	}
+	var document struct {
+		Components struct {
+			Schemas map[string]any `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]*schemaNode, len(document.Components.Schemas))
+	for name, raw := range document.Components.Schemas {
+		node, err := compileSchemaNode(raw)
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+		schemas[name] = node
+	}
+	for name, node := range schemas {
+		if err := resolveSchemaRefs(node, schemas); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+	}
+	return schemas, nil
+}
+
+func compileSchemaNode(raw any) (*schemaNode, error) {
+	definition, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected schema object, got %T", raw)
+	}
+	node := &schemaNode{minLength: -1, maxLength: -1, minItems: -1}
+	var err error
+	for keyword, value := range definition {
+		switch keyword {
+		case "$ref":
+			node.ref = fmt.Sprint(value)
+		case "type":
+			node.typ = fmt.Sprint(value)
+		case "enum":
+			values, _ := value.([]any)
+			for _, v := range values {
+				node.enum = append(node.enum, fmt.Sprint(v))
+			}
+		case "pattern":
+			node.pattern, err = compileSchemaPattern(fmt.Sprint(value))
+		case "minLength":
+			node.minLength, err = schemaInt(value)
+		case "maxLength":
+			node.maxLength, err = schemaInt(value)
+		case "minItems":
+			node.minItems, err = schemaInt(value)
+		case "items":
+			node.items, err = compileSchemaNode(value)
+		case "required":
+			values, _ := value.([]any)
+			for _, v := range values {
+				node.required = append(node.required, fmt.Sprint(v))
+			}
+		case "properties":
+			properties, _ := value.(map[string]any)
+			node.properties = make(map[string]*schemaNode, len(properties))
+			for name, property := range properties {
+				if node.properties[name], err = compileSchemaNode(property); err != nil {
+					return nil, fmt.Errorf("property %s: %w", name, err)
+				}
+			}
+		case "allOf", "oneOf":
+			values, _ := value.([]any)
+			children := make([]*schemaNode, 0, len(values))
+			for _, v := range values {
+				child, childErr := compileSchemaNode(v)
+				if childErr != nil {
+					return nil, fmt.Errorf("%s: %w", keyword, childErr)
+				}
+				children = append(children, child)
+			}
+			if keyword == "allOf" {
+				node.allOf = children
+			} else {
+				node.oneOf = children
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", keyword, err)
+		}
+	}
+	return node, nil
+}
+
+// compileSchemaPattern translates an ECMAScript pattern from the IDTA schemas
+// into RE2 syntax.
+func compileSchemaPattern(pattern string) (*regexp.Regexp, error) {
+	pattern = strings.ReplaceAll(pattern, utf16SurrogatePairs, `|[\x{10000}-\x{10FFFF}]`)
+	pattern = unicodeEscape.ReplaceAllString(pattern, `\x{$1}`)
+	return regexp.Compile(pattern)
+}
+
+func schemaInt(value any) (int, error) {
+	number, ok := value.(int)
+	if !ok {
+		return 0, fmt.Errorf("expected integer, got %T", value)
+	}
+	return number, nil
+}
+
+func resolveSchemaRefs(node *schemaNode, schemas map[string]*schemaNode) error {
+	if node == nil {
+		return nil
+	}
+	if node.ref != "" {
+		name := strings.TrimPrefix(node.ref, "#/components/schemas/")
+		if _, ok := schemas[name]; !ok {
+			return fmt.Errorf("unresolvable $ref %s", node.ref)
+		}
+		node.ref = name
+	}
+	children := append(append([]*schemaNode{node.items}, node.allOf...), node.oneOf...)
+	for _, property := range node.properties {
+		children = append(children, property)
+	}
+	for _, child := range children {
+		if err := resolveSchemaRefs(child, schemas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSchemaNode(node *schemaNode, value any, pointer string, violations *[]SchemaViolation) {
+	if node.ref != "" {
+		validateSchemaNode(part1Schemas[node.ref], value, pointer, violations)
+	}
+	if node.typ != "" && !matchesSchemaType(node.typ, value) {
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("expected %s, got %s", node.typ, schemaTypeName(value))})
+		return
+	}
+
+	switch typed := value.(type) {
+	case string:
+		validateSchemaString(node, typed, pointer, violations)
+	case []any:
+		if node.minItems >= 0 && len(typed) < node.minItems {
+			*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("array must contain at least %d item(s), got %d", node.minItems, len(typed))})
+		}
+		if node.items != nil {
+			for i, item := range typed {
+				validateSchemaNode(node.items, item, fmt.Sprintf("%s/%d", pointer, i), violations)
+			}
+		}
+	case map[string]any:
+		for _, name := range node.required {
+			if _, ok := typed[name]; !ok {
+				*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("missing required property %q", name)})
+			}
+		}
+		for name, property := range node.properties {
+			if propertyValue, ok := typed[name]; ok {
+				validateSchemaNode(property, propertyValue, pointer+"/"+escapeJSONPointerToken(name), violations)
+			}
+		}
+	}
+
+	for _, child := range node.allOf {
+		validateSchemaNode(child, value, pointer, violations)
+	}
+	if len(node.oneOf) > 0 {
+		validateSchemaOneOf(node.oneOf, value, pointer, violations)
+	}
+}
+
+func validateSchemaString(node *schemaNode, value string, pointer string, violations *[]SchemaViolation) {
+	if len(node.enum) > 0 {
+		allowed := false
+		for _, candidate := range node.enum {
+			if candidate == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("value %q is not one of the allowed values", value)})
+		}
+	}
+	length := utf8.RuneCountInString(value)
+	if node.minLength >= 0 && length < node.minLength {
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("string must be at least %d character(s) long, got %d", node.minLength, length)})
+	}
+	if node.maxLength >= 0 && length > node.maxLength {
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("string must be at most %d character(s) long, got %d", node.maxLength, length)})
+	}
+	if node.pattern != nil && !node.pattern.MatchString(value) {
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: fmt.Sprintf("value %q does not match pattern %s", value, node.pattern.String())})
+	}
+}
+
+// validateSchemaOneOf requires exactly one alternative to match. When none
+// matches, the violations of the alternative with the matching modelType (or
+// the fewest violations) are reported so that the caller sees the actual
+// defect instead of a bare "no match".
+func validateSchemaOneOf(alternatives []*schemaNode, value any, pointer string, violations *[]SchemaViolation) {
+	var best []SchemaViolation
+	bestFound := false
+	matches := 0
+	for _, alternative := range alternatives {
+		if rejectsModelType(alternative, value) {
+			continue
+		}
+		var candidate []SchemaViolation
+		validateSchemaNode(alternative, value, pointer, &candidate)
+		if len(candidate) == 0 {
+			matches++
+			continue
+		}
+		if !bestFound || len(candidate) < len(best) {
+			best = candidate
+			bestFound = true
+		}
+	}
+
+	switch {
+	case matches == 1:
+	case matches > 1:
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: "value matches more than one of the allowed schemas"})
+	case bestFound:
+		*violations = append(*violations, best...)
+	default:
+		*violations = append(*violations, SchemaViolation{Pointer: pointer, Message: "value does not match any of the allowed schemas; check modelType"})
+	}
+}
+
+// rejectsModelType reports whether the modelType constraints of a schema,
+// collected through $ref and allOf, reject the modelType of an object. It lets
+// oneOf skip non-matching alternatives without validating the whole subtree
+// once per alternative, which would grow exponentially with nesting depth.
+func rejectsModelType(node *schemaNode, value any) bool {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	modelType, ok := object["modelType"]
+	if !ok {
+		return false
+	}
+	var violations []SchemaViolation
+	collectModelTypeViolations(node, modelType, &violations)
+	return len(violations) > 0
+}
+
+func collectModelTypeViolations(node *schemaNode, modelType any, violations *[]SchemaViolation) {
+	if node.ref != "" {
+		collectModelTypeViolations(part1Schemas[node.ref], modelType, violations)
+	}
+	if property, ok := node.properties["modelType"]; ok {
+		validateSchemaNode(property, modelType, "", violations)
+	}
+	for _, child := range node.allOf {
+		collectModelTypeViolations(child, modelType, violations)
+	}
+}
+
+func matchesSchemaType(typ string, value any) bool {
+	switch typ {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		number, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := number.Int64()
+		return err == nil
+	}
+	return true
+}
+
+func schemaTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
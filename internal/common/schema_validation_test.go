@@ -0,0 +1,120 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+func TestValidatePart1SchemaReportsViolationsWithPointers(t *testing.T) {
+	const body = `{
+		"modelType": "Submodel",
+		"id": "urn:example:sm",
+		"idShort": "Sensors",
+		"administration": {"version": "01"},
+		"submodelElements": [
+			{"modelType": "Property", "idShort": "Temperature", "valueType": "xs:strin"},
+			{"modelType": "SubmodelElementCollection", "idShort": "Empty", "value": []},
+			{"modelType": "Property", "idShort": "a/b", "valueType": "xs:string", "value": "😀"},
+			{"modelType": "Unknown", "idShort": "Other"}
+		]
+	}`
+
+	violations, err := ValidatePart1Schema("Submodel", []byte(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]bool{
+		"/administration/version":       false,
+		"/submodelElements/0/valueType": false,
+		"/submodelElements/1/value":     false,
+		"/submodelElements/2/idShort":   false,
+		"/submodelElements/3":           false,
+	}
+	for _, violation := range violations {
+		if _, ok := want[violation.Pointer]; !ok {
+			t.Fatalf("unexpected violation %s", violation)
+		}
+		want[violation.Pointer] = true
+	}
+	for pointer, found := range want {
+		if !found {
+			t.Fatalf("expected violation at %s, got %v", pointer, violations)
+		}
+	}
+}
+
+func TestValidatePart1SchemaAcceptsConformingSubmodels(t *testing.T) {
+	const body = `{
+		"modelType": "Submodel",
+		"id": "urn:example:sm",
+		"idShort": "Nameplate",
+		"kind": "Instance",
+		"description": [{"language": "en", "text": "Name plate 🏷"}],
+		"semanticId": {"type": "ExternalReference", "keys": [{"type": "GlobalReference", "value": "urn:example:semantic"}]},
+		"submodelElements": [
+			{"modelType": "Property", "idShort": "Manufacturer", "valueType": "xs:string", "value": "ACME"},
+			{"modelType": "SubmodelElementList", "idShort": "Markings", "typeValueListElement": "SubmodelElementCollection", "value": [
+				{"modelType": "SubmodelElementCollection", "value": [{"modelType": "File", "idShort": "Logo", "contentType": "image/png"}]}
+			]}
+		]
+	}`
+
+	violations, err := ValidatePart1Schema("Submodel", []byte(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations, got %v", violations)
+	}
+}
+
+func TestValidatePart1SchemaRejectsMalformedJSON(t *testing.T) {
+	if _, err := ValidatePart1Schema("Submodel", []byte(`{`)); !IsErrBadRequest(err) {
+		t.Fatalf("expected bad request error, got %v", err)
+	}
+}
+
+func TestNewSchemaViolationResponseListsEveryViolation(t *testing.T) {
+	response := NewSchemaViolationResponse([]SchemaViolation{
+		{Pointer: "/idShort", Message: "too short"},
+		{Pointer: "", Message: "missing required property \"id\""},
+	}, "SMREPO", "PostSubmodel")
+
+	if response.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", response.Code)
+	}
+	messages, ok := response.Body.([]model.Message)
+	if !ok || len(messages) != 2 {
+		t.Fatalf("expected two messages, got %#v", response.Body)
+	}
+	if messages[0].Text != "SMREPO-POSTSUBMODEL-SCHEMA /idShort: too short" || messages[1].Text != `SMREPO-POSTSUBMODEL-SCHEMA /: missing required property "id"` {
+		t.Fatalf("unexpected message texts: %q, %q", messages[0].Text, messages[1].Text)
+	}
+}
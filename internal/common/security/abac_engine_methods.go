@@ -49,6 +49,9 @@ var mapMethodAndPatternToRightsData = []mapMethodAndPatternToRights{
 	// ABAC policy management
 	{"GET", "/security/abac/active-policy", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/security/abac/active-policy/rules", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"GET", "/security/abac/database-pool-stats", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"GET", "/security/abac/submodels/{submodelIdentifier}/list-indices", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"POST", "/security/abac/submodels/{submodelIdentifier}/list-indices/repair", []grammar.RightsEnum{grammar.RightsEnumUPDATE}},
 	{"GET", "/security/abac/policy-versions", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"POST", "/security/abac/policy-versions", []grammar.RightsEnum{grammar.RightsEnumCREATE}},
 	{"GET", "/security/abac/policy-versions/{versionID}", []grammar.RightsEnum{grammar.RightsEnumREAD}},
@@ -76,6 +79,7 @@ var mapMethodAndPatternToRightsData = []mapMethodAndPatternToRights{
 	// aas registry
 	{"GET", "/shell-descriptors/{aasIdentifier}", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"PUT", "/shell-descriptors/{aasIdentifier}", []grammar.RightsEnum{grammar.RightsEnumCREATE, grammar.RightsEnumUPDATE}},
+	{"PATCH", "/shell-descriptors/{aasIdentifier}", []grammar.RightsEnum{grammar.RightsEnumUPDATE}},
 	{"DELETE", "/shell-descriptors/{aasIdentifier}", []grammar.RightsEnum{grammar.RightsEnumDELETE}},
 	{"GET", "/shell-descriptors", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"POST", "/shell-descriptors", []grammar.RightsEnum{grammar.RightsEnumCREATE}},
@@ -134,8 +138,10 @@ var mapMethodAndPatternToRightsData = []mapMethodAndPatternToRights{
 	{"GET", "/submodels/{submodelIdentifier}/$history", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"POST", "/submodels/{submodelIdentifier}/submodel-elements", []grammar.RightsEnum{grammar.RightsEnumCREATE}},
+	{"DELETE", "/submodels/{submodelIdentifier}/submodel-elements", []grammar.RightsEnum{grammar.RightsEnumDELETE}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/$metadata", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/$value", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"PATCH", "/submodels/{submodelIdentifier}/submodel-elements/$value", []grammar.RightsEnum{grammar.RightsEnumUPDATE}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/$reference", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/$path", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}", []grammar.RightsEnum{grammar.RightsEnumREAD}},
@@ -149,6 +155,8 @@ var mapMethodAndPatternToRightsData = []mapMethodAndPatternToRights{
 	{"PATCH", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$value", []grammar.RightsEnum{grammar.RightsEnumUPDATE}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$reference", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$path", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$parent", []grammar.RightsEnum{grammar.RightsEnumREAD}},
+	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$ancestors", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"GET", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/attachment", []grammar.RightsEnum{grammar.RightsEnumREAD}},
 	{"PUT", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/attachment", []grammar.RightsEnum{grammar.RightsEnumCREATE, grammar.RightsEnumUPDATE}},
 	{"DELETE", "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/attachment", []grammar.RightsEnum{grammar.RightsEnumDELETE}},
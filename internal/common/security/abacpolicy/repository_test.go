@@ -297,6 +297,41 @@ func TestRegisterManagementRoutesServesActivePolicyEndpoints(t *testing.T) {
 	}
 }
 
+func TestDatabasePoolStatsRouteFollowsManagementAPIOptIn(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock setup failed: %v", err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	repo, err := NewRepository(db, "test-service", chi.NewRouter(), "")
+	if err != nil {
+		t.Fatalf("repository setup failed: %v", err)
+	}
+
+	disabledRouter := chi.NewRouter()
+	RegisterManagementRoutesIfEnabled(&common.Config{ABAC: common.ABACConfig{Enabled: true}}, disabledRouter, repo, "test-service")
+	response := httptest.NewRecorder()
+	disabledRouter.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/security/abac/database-pool-stats", nil))
+	if response.Code != http.StatusNotFound {
+		t.Fatalf("expected pool stats to stay unmounted without opt-in, got %d", response.Code)
+	}
+
+	enabledRouter := chi.NewRouter()
+	RegisterManagementRoutesIfEnabled(managementAPIEnabledConfig(), enabledRouter, repo, "test-service")
+	response = httptest.NewRecorder()
+	enabledRouter.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/security/abac/database-pool-stats", nil))
+	if response.Code != http.StatusOK {
+		t.Fatalf("expected pool stats status 200, got %d body=%s", response.Code, response.Body.String())
+	}
+	if !strings.Contains(response.Body.String(), `"name":"postgres"`) || !strings.Contains(response.Body.String(), `"waitDurationMillis"`) {
+		t.Fatalf("expected postgres pool stats, got %s", response.Body.String())
+	}
+}
+
 func TestManagementMutationRoutesAreHistoryExempt(t *testing.T) {
 	cfg := managementAPIEnabledConfig()
 	previous := history.ActiveConfig()
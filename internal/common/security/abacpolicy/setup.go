@@ -222,9 +222,13 @@ func importStartupFile(ctx context.Context, repo *Repository, modelPath string,
 //
 // Routes are mounted below /security/abac. Callers are
 // responsible for installing OIDC/ABAC middleware before this function is called
-// so the active policy protects the management API itself.
+// so the active policy protects the management API itself. The same protection
+// covers the connection-pool statistics of the repository database.
 func RegisterManagementRoutes(r chi.Router, repo *Repository) {
 	r.Get(managementActivePath, activePolicyHandler(repo))
+	r.Get(managementDatabasePoolStatsPath, common.DatabasePoolStatsHandler(map[string]*sql.DB{
+		managementDatabasePoolName: repo.db,
+	}))
 	r.Get(managementActiveRulesPath, activePolicyRulesHandler(repo))
 	r.Route(managementBasePath, func(policyRouter chi.Router) {
 		for _, route := range managementRoutes {
@@ -59,6 +59,9 @@ const (
 	managementBasePath        = managementRootPath + "/policy-versions"
 	managementActivePath      = managementRootPath + "/active-policy"
 	managementActiveRulesPath = managementActivePath + "/rules"
+
+	managementDatabasePoolStatsPath = managementRootPath + "/database-pool-stats"
+	managementDatabasePoolName      = "postgres"
 )
 
 // PolicyVersion describes one durable ABAC policy version.
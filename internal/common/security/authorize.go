@@ -50,6 +50,12 @@ type ABACSettings struct {
 	// DenyAsNotFoundPrefixes hides denied requests below sensitive route
 	// prefixes by returning 404 instead of 403.
 	DenyAsNotFoundPrefixes []string
+	// DebugFilterHeader exposes the applied query filter in the
+	// ABACFilterDebugHeader response header. Intended for development only.
+	DebugFilterHeader bool
+	// TrustInternalCalls lets requests whose context was marked with
+	// ContextForTrustedInternalCall pass without evaluation. Off by default.
+	TrustInternalCalls bool
 }
 
 // Resource represents the target object of an authorization request.
@@ -68,6 +74,9 @@ const (
 	filterKey ctxKey = "queryFilter"
 	// authorizationDecisionKey stores the ABAC decision made for the request.
 	authorizationDecisionKey ctxKey = "authorizationDecision"
+	// trustedInternalCallKey marks contexts created by in-process composition
+	// code that must not be re-evaluated by ABAC.
+	trustedInternalCallKey ctxKey = "trustedInternalCall"
 )
 
 // ResolveResource extracts a Resource from an HTTP request.
@@ -93,13 +102,15 @@ type AccessModelProvider interface {
 // ABACMiddleware returns an HTTP middleware handler that enforces attribute-based
 // authorization based on the provided ABACSettings.
 //
-// If ABAC is disabled, the next handler is executed without checks.
+// If ABAC is disabled, or TrustInternalCalls is set and the request context was
+// marked with ContextForTrustedInternalCall, the next handler is executed
+// without checks.
 // If enabled, Claims must be present in context or the request is rejected.
 // If the model denies access, a 403 Forbidden is returned.
 func ABACMiddleware(settings ABACSettings) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !settings.Enabled {
+			if !settings.Enabled || (settings.TrustInternalCalls && IsTrustedInternalCall(r.Context())) {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -162,6 +173,9 @@ func ABACMiddleware(settings ABACSettings) func(http.Handler) http.Handler {
 				})
 				if evaluation.QueryFilter != nil {
 					ctx = context.WithValue(ctx, filterKey, evaluation.QueryFilter)
+					if settings.DebugFilterHeader {
+						w.Header().Set(ABACFilterDebugHeader, DescribeQueryFilter(evaluation.QueryFilter))
+					}
 				}
 
 				next.ServeHTTP(w, r.WithContext(ctx))
@@ -277,6 +291,35 @@ func ContextWithoutQueryFilter(ctx context.Context) context.Context {
 	return context.WithValue(ctx, filterKey, (*QueryFilter)(nil))
 }
 
+// ContextForTrustedInternalCall marks ctx as a trusted service-to-service call
+// made by in-process composition code, such as the Digital Twin Registry
+// calling its embedded registry and discovery services. The ABAC query filter
+// of the originating request is dropped so that persistence does not inject
+// ABAC formulas again, and ABACMiddleware passes marked requests through.
+//
+// The marker lives only in the Go context and is never derived from request
+// headers, so external callers cannot set it. Query constraints merged with
+// MergeQueryFilter after marking are still applied. Unless abac.trustInternalCalls
+// is enabled in the configuration carried by ctx, ctx is returned unchanged.
+func ContextForTrustedInternalCall(ctx context.Context) context.Context {
+	cfg, ok := common.ConfigFromContext(ctx)
+	if !ok || cfg == nil || !cfg.ABAC.TrustInternalCalls {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, trustedInternalCallKey, true)
+	return context.WithValue(ctx, filterKey, (*QueryFilter)(nil))
+}
+
+// IsTrustedInternalCall reports whether ctx was created with
+// ContextForTrustedInternalCall.
+func IsTrustedInternalCall(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	trusted, _ := ctx.Value(trustedInternalCallKey).(bool)
+	return trusted
+}
+
 // ShouldEnforceFormula determines whether formula-based query constraints must
 // run for the current request context.
 //
@@ -28,6 +28,7 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -507,6 +508,93 @@ func TestShouldEnforceFormula_AppliesMergedQueryWhenABACDisabled(t *testing.T) {
 	}
 }
 
+func TestTrustedInternalCallSkipsABACWhileRequestContextEnforcesIt(t *testing.T) {
+	t.Parallel()
+
+	cfg := &common.Config{}
+	cfg.ABAC.Enabled = true
+	cfg.ABAC.TrustInternalCalls = true
+	queryExpr := boolExpression(false)
+	requestCtx := WithQueryFilter(common.ContextWithConfig(context.Background(), cfg), &QueryFilter{
+		Formula:         &queryExpr,
+		FormulasByRight: map[grammar.RightsEnum]grammar.LogicalExpression{grammar.RightsEnumREAD: queryExpr},
+	})
+	internalCtx := ContextForTrustedInternalCall(requestCtx)
+
+	shouldEnforce, err := ShouldEnforceFormula(requestCtx)
+	if err != nil || !shouldEnforce {
+		t.Fatalf("expected request context to enforce ABAC formula, got enforce=%v err=%v", shouldEnforce, err)
+	}
+	shouldEnforce, err = ShouldEnforceFormula(internalCtx)
+	if err != nil || shouldEnforce {
+		t.Fatalf("expected trusted internal context to skip ABAC formula, got enforce=%v err=%v", shouldEnforce, err)
+	}
+	if IsTrustedInternalCall(requestCtx) || !IsTrustedInternalCall(internalCtx) {
+		t.Fatal("expected only the internal context to carry the trusted marker")
+	}
+
+	handler := ABACMiddleware(ABACSettings{
+		Enabled:            true,
+		ModelProvider:      emptyModelProvider{},
+		TrustInternalCalls: true,
+	})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/description", nil)
+	req.Header.Set("X-Trusted-Internal-Call", "true")
+	req = req.WithContext(context.WithValue(common.ContextWithConfig(req.Context(), cfg), ClaimsKey, Claims{"sub": "tester"}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected request context to be evaluated by ABAC, got status %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req.WithContext(ContextForTrustedInternalCall(req.Context())))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected trusted internal call to bypass ABAC, got status %d", rec.Code)
+	}
+}
+
+func TestTrustedInternalCallMarkerHasNoEffectWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	cfg := &common.Config{}
+	cfg.ABAC.Enabled = true
+	queryExpr := boolExpression(false)
+	requestCtx := WithQueryFilter(common.ContextWithConfig(context.Background(), cfg), &QueryFilter{
+		Formula:         &queryExpr,
+		FormulasByRight: map[grammar.RightsEnum]grammar.LogicalExpression{grammar.RightsEnumREAD: queryExpr},
+	})
+
+	internalCtx := ContextForTrustedInternalCall(requestCtx)
+	if IsTrustedInternalCall(internalCtx) {
+		t.Fatal("expected no trusted marker while abac.trustInternalCalls is disabled")
+	}
+	shouldEnforce, err := ShouldEnforceFormula(internalCtx)
+	if err != nil || !shouldEnforce {
+		t.Fatalf("expected ABAC formula to stay enforced, got enforce=%v err=%v", shouldEnforce, err)
+	}
+
+	handler := ABACMiddleware(ABACSettings{
+		Enabled:       true,
+		ModelProvider: emptyModelProvider{},
+	})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// A marker set despite the disabled switch must not bypass the middleware either.
+	markedCtx := context.WithValue(context.Background(), trustedInternalCallKey, true)
+	req := httptest.NewRequest(http.MethodGet, "/description", nil)
+	req = req.WithContext(context.WithValue(markedCtx, ClaimsKey, Claims{"sub": "tester"}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected marked request to be evaluated by ABAC, got status %d", rec.Code)
+	}
+}
+
 func TestShouldEnforceFormula_InconsistentQueryFilterErrorDoesNotMentionABACEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -528,3 +616,163 @@ func TestShouldEnforceFormula_InconsistentQueryFilterErrorDoesNotMentionABACEnab
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestABACMiddleware_DatabasePoolStatsRequiresManagementRights(t *testing.T) {
+	tests := []struct {
+		name           string
+		denyAsNotFound []string
+		wantCode       int
+	}{
+		{name: "hidden below management prefix", denyAsNotFound: []string{"/security/abac"}, wantCode: http.StatusNotFound},
+		{name: "forbidden without prefix masking", wantCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := api.NewRouter()
+			model := &AccessModel{
+				apiRouter: router,
+				basePath:  "",
+			}
+
+			router.Use(ABACMiddleware(ABACSettings{
+				Enabled:                true,
+				Model:                  model,
+				DenyAsNotFoundPrefixes: tt.denyAsNotFound,
+			}))
+			router.Get("/security/abac/database-pool-stats", func(http.ResponseWriter, *http.Request) {
+				t.Fatal("pool stats handler must not be called without a matching rule")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/security/abac/database-pool-stats", nil)
+			ctx := context.WithValue(req.Context(), ClaimsKey, Claims{"sub": "tester", "scope": ""})
+			req = req.WithContext(ctx)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d", tt.wantCode, rec.Code)
+			}
+		})
+	}
+}
+
+func TestABACMiddleware_DebugFilterHeaderOnlyInDebugMode(t *testing.T) {
+	newRouter := func(debug bool) *api.Mux {
+		router := api.NewRouter()
+		model, err := ParseAccessModel([]byte(`{
+			"AllAccessPermissionRules": {
+				"rules": [
+					{
+						"ACL": {
+							"ATTRIBUTES": [{ "GLOBAL": "ANONYMOUS" }],
+							"RIGHTS": [ "READ" ],
+							"ACCESS": "ALLOW"
+						},
+						"OBJECTS": [{ "ROUTE": "/shell-descriptors" }],
+						"FORMULA": { "$eq": [{ "$field": "$aasdesc#idShort" }, { "$strVal": "visible" }] }
+					}
+				]
+			}
+		}`), router, "")
+		if err != nil {
+			t.Fatalf("ParseAccessModel() error = %v", err)
+		}
+		oidc := &OIDC{
+			verifiers: map[string]issuerVerifier{},
+			settings:  OIDCSettings{AllowAnonymous: true},
+		}
+		router.Use(oidc.Middleware, ABACMiddleware(ABACSettings{
+			Enabled:           true,
+			Model:             model,
+			DebugFilterHeader: debug,
+		}))
+		router.Get("/shell-descriptors", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		return router
+	}
+
+	debugRec := httptest.NewRecorder()
+	newRouter(true).ServeHTTP(debugRec, httptest.NewRequest(http.MethodGet, "/shell-descriptors", nil))
+	if debugRec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, debugRec.Code)
+	}
+	header := debugRec.Header().Get(ABACFilterDebugHeader)
+	if !strings.Contains(header, "$aasdesc#idShort") || !strings.Contains(header, `"$strVal":"***"`) {
+		t.Fatalf("expected debug header to describe the restrictive formula, got %q", header)
+	}
+	if strings.Contains(header, "visible") {
+		t.Fatalf("expected debug header to mask formula literals, got %q", header)
+	}
+
+	productionRec := httptest.NewRecorder()
+	newRouter(false).ServeHTTP(productionRec, httptest.NewRequest(http.MethodGet, "/shell-descriptors", nil))
+	if productionRec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, productionRec.Code)
+	}
+	if _, ok := productionRec.Header()[ABACFilterDebugHeader]; ok {
+		t.Fatalf("expected no %s header outside debug mode", ABACFilterDebugHeader)
+	}
+}
+
+func TestDescribeQueryFilterMasksLiteralsAndClaims(t *testing.T) {
+	var formula grammar.LogicalExpression
+	if err := json.Unmarshal([]byte(`{"$and":[
+		{"$eq":[{"$field":"$aasdesc#idShort"},{"$strVal":"Ä secret"}]},
+		{"$eq":[{"$field":"$aasdesc#assetKind"},{"$attribute":{"CLAIM":"tenant"}}]},
+		{"$gt":[{"$field":"$sm#idShort"},{"$numVal":42}]}
+	]}`), &formula); err != nil {
+		t.Fatalf("failed to parse formula: %v", err)
+	}
+
+	description := DescribeQueryFilter(&QueryFilter{Formula: &formula})
+	for _, leaked := range []string{"secret", "tenant", "42"} {
+		if strings.Contains(description, leaked) {
+			t.Fatalf("expected %q to be masked, got %q", leaked, description)
+		}
+	}
+	if !strings.Contains(description, "$aasdesc#assetKind") || !strings.Contains(description, `"$attribute":"***"`) {
+		t.Fatalf("expected fields to stay visible and attributes masked, got %q", description)
+	}
+	for _, r := range description {
+		if r < 0x20 || r >= 0x7f {
+			t.Fatalf("expected printable ASCII only, got %q", r)
+		}
+	}
+}
+
+func TestDescribeQueryFilterTruncatesOnStructuralBoundary(t *testing.T) {
+	branches := make([]string, 0, 500)
+	for i := 0; i < cap(branches); i++ {
+		branches = append(branches, `{"$eq":[{"$field":"$aasdesc#idShort"},{"$strVal":"x"}]}`)
+	}
+	var formula grammar.LogicalExpression
+	if err := json.Unmarshal([]byte(`{"$or":[`+strings.Join(branches, ",")+`]}`), &formula); err != nil {
+		t.Fatalf("failed to parse formula: %v", err)
+	}
+	filters := FragmentFilters{}
+	for i := 0; i < 300; i++ {
+		filters[grammar.FragmentStringPattern(fmt.Sprintf("$aasdesc#specificAssetIds[%d]", i))] = grammar.LogicalExpression{}
+	}
+
+	description := DescribeQueryFilter(&QueryFilter{Formula: &formula, Filters: filters})
+	if len(description) > maxQueryFilterDescriptionLength {
+		t.Fatalf("expected description of at most %d bytes, got %d", maxQueryFilterDescriptionLength, len(description))
+	}
+	var decoded struct {
+		Formula   any      `json:"formula"`
+		Fragments []string `json:"fragments"`
+		Truncated bool     `json:"truncated"`
+	}
+	if err := json.Unmarshal([]byte(description), &decoded); err != nil {
+		t.Fatalf("expected truncated description to stay valid JSON, got %q: %v", description, err)
+	}
+	if !decoded.Truncated || decoded.Formula != nil {
+		t.Fatalf("expected the formula to be dropped with a truncated marker, got %q", description)
+	}
+	if len(decoded.Fragments) == 0 || len(decoded.Fragments) == len(filters) {
+		t.Fatalf("expected only the leading fragments to be kept, got %d", len(decoded.Fragments))
+	}
+}
@@ -0,0 +1,151 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package auth
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// ABACFilterDebugHeader carries the description of the applied ABAC filter when
+// the debug header is enabled with abac.enableDebugFilterHeader.
+const ABACFilterDebugHeader = "X-ABAC-Filter"
+
+// maxQueryFilterDescriptionLength keeps the debug header below common proxy
+// header size limits.
+const maxQueryFilterDescriptionLength = 4096
+
+// maskedFormulaValue replaces literals and attribute references in the
+// described formula, matching the '***' used by the SQL literal redaction.
+const maskedFormulaValue = "***"
+
+// maskedFormulaKeys lists the operand keys whose values may carry request
+// data, such as resolved claim values, or configured literals.
+var maskedFormulaKeys = map[string]struct{}{
+	"$attribute":   {},
+	"$strVal":      {},
+	"$numVal":      {},
+	"$hexVal":      {},
+	"$dateTimeVal": {},
+	"$timeVal":     {},
+}
+
+type queryFilterDescription struct {
+	Formula   any      `json:"formula,omitempty"`
+	Fragments []string `json:"fragments,omitempty"`
+	Truncated bool     `json:"truncated,omitempty"`
+}
+
+// DescribeQueryFilter returns a single-line JSON description of qf that is safe
+// to send as a response header. It lists the structure of the active formula,
+// with literals and attributes masked, and the fragments that carry their own
+// filters. When the description does not fit into the header, the formula and
+// then trailing fragments are dropped and "truncated" is set, so the value
+// always stays valid JSON.
+func DescribeQueryFilter(qf *QueryFilter) string {
+	if qf == nil {
+		return ""
+	}
+
+	description := queryFilterDescription{}
+	if qf.Formula != nil {
+		formula, err := maskFormula(qf.Formula)
+		if err != nil {
+			return ""
+		}
+		description.Formula = formula
+	}
+	for fragment := range qf.Filters {
+		description.Fragments = append(description.Fragments, string(fragment))
+	}
+	sort.Strings(description.Fragments)
+
+	for {
+		raw, err := json.Marshal(description)
+		if err != nil {
+			return ""
+		}
+		value := sanitizeHeaderValue(string(raw))
+		if len(value) <= maxQueryFilterDescriptionLength {
+			return value
+		}
+		description.Truncated = true
+		switch {
+		case description.Formula != nil:
+			description.Formula = nil
+		case len(description.Fragments) > 0:
+			description.Fragments = description.Fragments[:len(description.Fragments)-1]
+		default:
+			return ""
+		}
+	}
+}
+
+// maskFormula converts formula into its generic JSON form and masks every
+// operand listed in maskedFormulaKeys. Field references and operators stay
+// visible so the shape of the filter can still be debugged.
+func maskFormula(formula any) (any, error) {
+	raw, err := json.Marshal(formula)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return maskFormulaNode(generic), nil
+}
+
+func maskFormulaNode(node any) any {
+	switch typed := node.(type) {
+	case map[string]any:
+		for key, value := range typed {
+			if _, ok := maskedFormulaKeys[key]; ok {
+				typed[key] = maskedFormulaValue
+				continue
+			}
+			typed[key] = maskFormulaNode(value)
+		}
+		return typed
+	case []any:
+		for i, value := range typed {
+			typed[i] = maskFormulaNode(value)
+		}
+		return typed
+	default:
+		return node
+	}
+}
+
+func sanitizeHeaderValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r >= 0x7f {
+			return '?'
+		}
+		return r
+	}, value)
+}
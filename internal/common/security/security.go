@@ -125,6 +125,8 @@ func SetupSecurityWithClaimsMiddleware(
 		EnableImplicitCasts:    cfg.General.EnableImplicitCasts,
 		Model:                  model,
 		DenyAsNotFoundPrefixes: abacDeniedAsNotFoundPrefixes(cfg.Server.ContextPath),
+		DebugFilterHeader:      cfg.ABAC.EnableDebugFilterHeader,
+		TrustInternalCalls:     cfg.ABAC.TrustInternalCalls,
 	}
 
 	applySecurityMiddleware(r, oidc.Middleware, ABACMiddleware(abacSettings), claimsMiddleware...)
@@ -156,6 +158,8 @@ func SetupSecurityWithAccessModelProvider(
 		EnableImplicitCasts:    cfg.General.EnableImplicitCasts,
 		ModelProvider:          provider,
 		DenyAsNotFoundPrefixes: abacDeniedAsNotFoundPrefixes(cfg.Server.ContextPath),
+		DebugFilterHeader:      cfg.ABAC.EnableDebugFilterHeader,
+		TrustInternalCalls:     cfg.ABAC.TrustInternalCalls,
 	}
 	applySecurityMiddleware(r, oidc.Middleware, ABACMiddleware(abacSettings), claimsMiddleware...)
 	return nil
@@ -0,0 +1,266 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+// Package smregistryclient resolves submodels that are stored in other
+// repositories. It asks the configured submodel registry for the descriptors
+// of a semanticId and dereferences their SUBMODEL endpoints.
+package smregistryclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+const (
+	queryPath = "/query/submodel-descriptors"
+
+	// submodelInterfacePrefix matches the SUBMODEL-3.x interfaces of descriptor endpoints.
+	submodelInterfacePrefix = "SUBMODEL-"
+
+	// maxErrorBodyBytes bounds how much of an error response is quoted in errors.
+	maxErrorBodyBytes = 512
+
+	// maxResponseBodyBytes bounds a decoded registry page or submodel.
+	maxResponseBodyBytes = 64 << 20
+
+	// maxRegistryPages bounds the cursor loop of ResolveBySemanticID, so a
+	// registry that keeps answering with new cursors cannot stall a request.
+	maxRegistryPages = 100
+)
+
+// SubmodelCandidate is a submodel that the registry lists for a semanticId.
+type SubmodelCandidate struct {
+	SubmodelID string
+	Endpoints  []model.Endpoint
+}
+
+// SubmodelHrefs returns the hrefs of the SUBMODEL interface endpoints in
+// descriptor order.
+func (c SubmodelCandidate) SubmodelHrefs() []string {
+	hrefs := make([]string, 0, len(c.Endpoints))
+	for _, endpoint := range c.Endpoints {
+		if strings.HasPrefix(endpoint.Interface, submodelInterfacePrefix) && endpoint.ProtocolInformation.Href != "" {
+			hrefs = append(hrefs, endpoint.ProtocolInformation.Href)
+		}
+	}
+	return hrefs
+}
+
+// Resolver looks up submodels by semanticId in one submodel registry.
+//
+// The registry URL is configured by the operator, but the SUBMODEL hrefs are
+// chosen by whoever registered a descriptor. They are therefore read with an
+// outbound client that refuses loopback, link-local and cloud metadata
+// addresses, and private networks unless allowPrivateNetworks is set.
+type Resolver struct {
+	registryURL    string
+	client         *http.Client
+	endpointClient *http.Client
+}
+
+// NewResolver creates a resolver for the registry of cfg. It does not check
+// cfg.Enabled; use NewResolverIfEnabled for the configuration-driven setup.
+func NewResolver(cfg common.SemanticIDResolutionConfig) *Resolver {
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	return &Resolver{
+		registryURL: strings.TrimRight(strings.TrimSpace(cfg.SubmodelRegistryURL), "/"),
+		client:      &http.Client{Timeout: timeout},
+		endpointClient: common.NewOutboundHTTPClient(timeout, func(ip net.IP) bool {
+			return common.OutboundAddressAllowed(ip, cfg.AllowPrivateNetworks)
+		}),
+	}
+}
+
+// NewResolverIfEnabled returns a resolver when general.semanticIdResolution
+// is enabled and nil otherwise.
+func NewResolverIfEnabled(cfg *common.Config) *Resolver {
+	if cfg == nil || !cfg.General.SemanticIDResolution.Enabled {
+		return nil
+	}
+	return NewResolver(cfg.General.SemanticIDResolution)
+}
+
+// ResolveBySemanticID returns every submodel descriptor of the registry whose
+// semanticId has a key with the given value, following the paging cursor
+// until the last page or at most maxRegistryPages pages.
+//
+// Parameters:
+//   - ctx: Context of the registry requests
+//   - semanticID: Key value of the semanticId to look for
+//
+// Returns:
+//   - []SubmodelCandidate: Submodel identifiers with their endpoints
+//   - error: 400 for an empty semanticId, 503 when the registry cannot be asked
+//     or keeps paging beyond maxRegistryPages
+func (r *Resolver) ResolveBySemanticID(ctx context.Context, semanticID string) ([]SubmodelCandidate, error) {
+	if strings.TrimSpace(semanticID) == "" {
+		return nil, common.NewErrBadRequest("SMREGCLIENT-RESOLVE-EMPTYSEMANTICID semanticId must not be empty")
+	}
+	body, err := json.Marshal(map[string]any{
+		"$condition": map[string]any{
+			"$eq": []any{
+				map[string]any{"$field": "$smdesc#semanticId.keys[].value"},
+				map[string]any{"$strVal": semanticID},
+			},
+		},
+	})
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREGCLIENT-RESOLVE-BUILDQUERY " + err.Error())
+	}
+
+	candidates := make([]SubmodelCandidate, 0)
+	cursor := ""
+	for range maxRegistryPages {
+		page, nextCursor, pageErr := r.queryPage(ctx, body, cursor)
+		if pageErr != nil {
+			return nil, pageErr
+		}
+		candidates = append(candidates, page...)
+		if nextCursor == "" || nextCursor == cursor {
+			return candidates, nil
+		}
+		cursor = nextCursor
+	}
+	return nil, common.NewErrServiceUnavailable(fmt.Sprintf("SMREGCLIENT-RESOLVE-TOOMANYPAGES submodel registry returned more than %d pages", maxRegistryPages))
+}
+
+func (r *Resolver) queryPage(ctx context.Context, body []byte, cursor string) ([]SubmodelCandidate, string, error) {
+	target := r.registryURL + queryPath
+	if cursor != "" {
+		target += "?cursor=" + url.QueryEscape(cursor)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, "", common.NewInternalServerError("SMREGCLIENT-RESOLVE-BUILDREQUEST " + err.Error())
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, "", common.NewErrServiceUnavailable("SMREGCLIENT-RESOLVE-REQUEST submodel registry not reachable: " + err.Error())
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		return nil, "", common.NewErrServiceUnavailable(fmt.Sprintf("SMREGCLIENT-RESOLVE-STATUS submodel registry answered %d: %s", response.StatusCode, readErrorBody(response.Body)))
+	}
+
+	var page struct {
+		PagingMetadata model.PagedResultPagingMetadata `json:"paging_metadata"`
+		Result         []struct {
+			ID        string           `json:"id"`
+			Endpoints []model.Endpoint `json:"endpoints"`
+		} `json:"result"`
+	}
+	if err = json.NewDecoder(io.LimitReader(response.Body, maxResponseBodyBytes)).Decode(&page); err != nil {
+		return nil, "", common.NewErrServiceUnavailable("SMREGCLIENT-RESOLVE-DECODE invalid submodel registry response: " + err.Error())
+	}
+
+	candidates := make([]SubmodelCandidate, 0, len(page.Result))
+	for _, descriptor := range page.Result {
+		candidates = append(candidates, SubmodelCandidate{SubmodelID: descriptor.ID, Endpoints: descriptor.Endpoints})
+	}
+	return candidates, page.PagingMetadata.Cursor, nil
+}
+
+// FetchSubmodel dereferences a candidate by reading the submodel from its
+// SUBMODEL endpoints. Endpoints are tried in order until one answers with the
+// submodel; the error of the last endpoint is returned otherwise.
+//
+// Parameters:
+//   - ctx: Context of the repository requests
+//   - candidate: Candidate returned by ResolveBySemanticID
+//
+// Returns:
+//   - types.ISubmodel: Submodel read from the first answering endpoint
+//   - error: 404 when the candidate has no SUBMODEL endpoint, 503 when no endpoint answered
+func (r *Resolver) FetchSubmodel(ctx context.Context, candidate SubmodelCandidate) (types.ISubmodel, error) {
+	hrefs := candidate.SubmodelHrefs()
+	if len(hrefs) == 0 {
+		return nil, common.NewErrNotFound("SMREGCLIENT-FETCH-NOENDPOINT no SUBMODEL endpoint for " + candidate.SubmodelID)
+	}
+	var lastErr error
+	for _, href := range hrefs {
+		submodel, err := r.fetchSubmodel(ctx, href)
+		if err == nil {
+			return submodel, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func (r *Resolver) fetchSubmodel(ctx context.Context, href string) (types.ISubmodel, error) {
+	target, err := url.Parse(href)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Hostname() == "" {
+		return nil, common.NewErrServiceUnavailable("SMREGCLIENT-FETCH-INVALIDHREF endpoint " + href + " is not an absolute http(s) URL")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, common.NewErrServiceUnavailable("SMREGCLIENT-FETCH-BUILDREQUEST invalid endpoint " + href + ": " + err.Error())
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := r.endpointClient.Do(request)
+	if err != nil {
+		return nil, common.NewErrServiceUnavailable("SMREGCLIENT-FETCH-REQUEST " + href + " not reachable: " + err.Error())
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		return nil, common.NewErrServiceUnavailable(fmt.Sprintf("SMREGCLIENT-FETCH-STATUS %s answered %d: %s", href, response.StatusCode, readErrorBody(response.Body)))
+	}
+
+	var jsonable any
+	if err = json.NewDecoder(io.LimitReader(response.Body, maxResponseBodyBytes)).Decode(&jsonable); err != nil {
+		return nil, common.NewErrServiceUnavailable("SMREGCLIENT-FETCH-DECODE invalid submodel from " + href + ": " + err.Error())
+	}
+	submodel, err := jsonization.SubmodelFromJsonable(jsonable)
+	if err != nil {
+		return nil, common.NewErrServiceUnavailable("SMREGCLIENT-FETCH-DESERIALIZE invalid submodel from " + href + ": " + err.Error())
+	}
+	return submodel, nil
+}
+
+func readErrorBody(body io.Reader) string {
+	content, _ := io.ReadAll(io.LimitReader(body, maxErrorBodyBytes))
+	return strings.TrimSpace(string(content))
+}
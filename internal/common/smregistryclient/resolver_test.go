@@ -0,0 +1,225 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package smregistryclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+const testSemanticID = "https://admin-shell.io/idta/nameplate/3/0/Nameplate"
+
+func newStubRegistry(t *testing.T, repositoryURL string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/query/submodel-descriptors" {
+			t.Errorf("unexpected registry request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var query map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			t.Errorf("invalid query body: %v", err)
+		}
+		encoded, _ := json.Marshal(query)
+		if !strings.Contains(string(encoded), `"$smdesc#semanticId.keys[].value"`) || !strings.Contains(string(encoded), testSemanticID) {
+			t.Errorf("query does not filter by semanticId: %s", encoded)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = fmt.Fprintf(w, `{"paging_metadata":{"cursor":"page-2"},"result":[{"id":"sm-1","endpoints":[{"interface":"SUBMODEL-3.0","protocolInformation":{"href":"%s/submodels/c20tMQ"}}]}]}`, repositoryURL)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"paging_metadata":{},"result":[{"id":"sm-2","endpoints":[{"interface":"AAS-3.0","protocolInformation":{"href":"%[1]s/shells/x"}},{"interface":"SUBMODEL-3.0","protocolInformation":{"href":"%[1]s/submodels/c20tMg"}}]}]}`, repositoryURL)
+	}))
+}
+
+func newTestResolver(registryURL string) *Resolver {
+	return NewResolver(common.SemanticIDResolutionConfig{Enabled: true, SubmodelRegistryURL: registryURL + "/", TimeoutSeconds: 5})
+}
+
+// newLoopbackTestResolver returns a resolver whose endpoint address guard is
+// relaxed so tests can read submodels from httptest servers on 127.0.0.1.
+func newLoopbackTestResolver(registryURL string) *Resolver {
+	resolver := newTestResolver(registryURL)
+	resolver.endpointClient = common.NewOutboundHTTPClient(5*time.Second, func(net.IP) bool { return true })
+	return resolver
+}
+
+func TestResolveBySemanticIDFollowsCursor(t *testing.T) {
+	registry := newStubRegistry(t, "http://repo.example")
+	defer registry.Close()
+
+	candidates, err := newTestResolver(registry.URL).ResolveBySemanticID(context.Background(), testSemanticID)
+	if err != nil {
+		t.Fatalf("ResolveBySemanticID returned error: %v", err)
+	}
+	if len(candidates) != 2 || candidates[0].SubmodelID != "sm-1" || candidates[1].SubmodelID != "sm-2" {
+		t.Fatalf("unexpected candidates: %+v", candidates)
+	}
+	hrefs := candidates[1].SubmodelHrefs()
+	if len(hrefs) != 1 || hrefs[0] != "http://repo.example/submodels/c20tMg" {
+		t.Fatalf("expected only the SUBMODEL endpoint, got %v", hrefs)
+	}
+}
+
+func TestResolveBySemanticIDRegistryErrorIsServiceUnavailable(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer registry.Close()
+
+	_, err := newTestResolver(registry.URL).ResolveBySemanticID(context.Background(), testSemanticID)
+	if !common.IsErrServiceUnavailable(err) || !strings.Contains(err.Error(), "SMREGCLIENT-RESOLVE-STATUS") {
+		t.Fatalf("expected 503 SMREGCLIENT-RESOLVE-STATUS, got %v", err)
+	}
+}
+
+func TestResolveBySemanticIDRejectsEmptySemanticID(t *testing.T) {
+	_, err := newTestResolver("http://registry.example").ResolveBySemanticID(context.Background(), " ")
+	if !common.IsErrBadRequest(err) {
+		t.Fatalf("expected 400, got %v", err)
+	}
+}
+
+func TestFetchSubmodelReadsSubmodelEndpoint(t *testing.T) {
+	repository := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/submodels/c20tMQ" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"modelType":"Submodel","id":"sm-1","idShort":"Nameplate"}`))
+	}))
+	defer repository.Close()
+	registry := newStubRegistry(t, repository.URL)
+	defer registry.Close()
+
+	resolver := newLoopbackTestResolver(registry.URL)
+	candidates, err := resolver.ResolveBySemanticID(context.Background(), testSemanticID)
+	if err != nil {
+		t.Fatalf("ResolveBySemanticID returned error: %v", err)
+	}
+	submodel, err := resolver.FetchSubmodel(context.Background(), candidates[0])
+	if err != nil {
+		t.Fatalf("FetchSubmodel returned error: %v", err)
+	}
+	if submodel.ID() != "sm-1" {
+		t.Fatalf("expected submodel sm-1, got %q", submodel.ID())
+	}
+
+	if _, err = resolver.FetchSubmodel(context.Background(), candidates[1]); !common.IsErrServiceUnavailable(err) {
+		t.Fatalf("expected 503 for missing submodel, got %v", err)
+	}
+}
+
+func TestFetchSubmodelRefusesInternalAndNonHTTPEndpoints(t *testing.T) {
+	var hits atomic.Int32
+	repository := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer repository.Close()
+
+	resolver := NewResolver(common.SemanticIDResolutionConfig{Enabled: true, SubmodelRegistryURL: "http://registry.example", TimeoutSeconds: 5, AllowPrivateNetworks: true})
+	for _, href := range []string{repository.URL + "/submodels/c20tMQ", "file:///etc/passwd", "gopher://repo.example/x", "/submodels/relative"} {
+		candidate := SubmodelCandidate{SubmodelID: "sm-1", Endpoints: []model.Endpoint{{
+			Interface:           "SUBMODEL-3.0",
+			ProtocolInformation: model.ProtocolInformation{Href: href},
+		}}}
+		if _, err := resolver.FetchSubmodel(context.Background(), candidate); !common.IsErrServiceUnavailable(err) {
+			t.Fatalf("expected 503 for %s, got %v", href, err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("expected no request to reach the loopback repository, got %d", hits.Load())
+	}
+}
+
+func TestFetchSubmodelBoundsResponseBody(t *testing.T) {
+	repository := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"modelType":"Submodel","id":"sm-1","idShort":"`))
+		padding := []byte(strings.Repeat("a", 1<<20))
+		for range maxResponseBodyBytes>>20 + 1 {
+			if _, err := w.Write(padding); err != nil {
+				return
+			}
+		}
+		_, _ = w.Write([]byte(`"}`))
+	}))
+	defer repository.Close()
+
+	candidate := SubmodelCandidate{SubmodelID: "sm-1", Endpoints: []model.Endpoint{{
+		Interface:           "SUBMODEL-3.0",
+		ProtocolInformation: model.ProtocolInformation{Href: repository.URL + "/submodels/c20tMQ"},
+	}}}
+	_, err := newLoopbackTestResolver("http://registry.example").FetchSubmodel(context.Background(), candidate)
+	if !common.IsErrServiceUnavailable(err) || !strings.Contains(err.Error(), "SMREGCLIENT-FETCH-DECODE") {
+		t.Fatalf("expected 503 SMREGCLIENT-FETCH-DECODE for an oversized body, got %v", err)
+	}
+}
+
+func TestResolveBySemanticIDStopsAfterMaxPages(t *testing.T) {
+	var pages atomic.Int32
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		page := pages.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"paging_metadata":{"cursor":"page-%d"},"result":[]}`, page+1)
+	}))
+	defer registry.Close()
+
+	_, err := newTestResolver(registry.URL).ResolveBySemanticID(context.Background(), testSemanticID)
+	if !common.IsErrServiceUnavailable(err) || !strings.Contains(err.Error(), "SMREGCLIENT-RESOLVE-TOOMANYPAGES") {
+		t.Fatalf("expected 503 SMREGCLIENT-RESOLVE-TOOMANYPAGES, got %v", err)
+	}
+	if pages.Load() != maxRegistryPages {
+		t.Fatalf("expected %d registry requests, got %d", maxRegistryPages, pages.Load())
+	}
+}
+
+func TestNewResolverIfEnabled(t *testing.T) {
+	cfg := &common.Config{}
+	if NewResolverIfEnabled(cfg) != nil {
+		t.Fatal("expected no resolver when semanticIdResolution is disabled")
+	}
+	cfg.General.SemanticIDResolution = common.SemanticIDResolutionConfig{Enabled: true, SubmodelRegistryURL: "http://registry.example", TimeoutSeconds: 1}
+	if NewResolverIfEnabled(cfg) == nil {
+		t.Fatal("expected resolver when semanticIdResolution is enabled")
+	}
+}
@@ -302,6 +302,44 @@ const abacManagementPathsYAML = `  /security/abac/active-policy:
           description: Hidden when the caller is not allowed to inspect ABAC policy management data
         '503':
           description: No active ABAC policy is available
+  /security/abac/database-pool-stats:
+    get:
+      tags:
+        - ABAC Policy Management
+      summary: Gets live connection-pool statistics of the service database
+      operationId: GetDatabasePoolStats
+      responses:
+        '200':
+          description: Connection-pool statistics per configured database
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    name:
+                      type: string
+                    maxOpenConnections:
+                      type: integer
+                    openConnections:
+                      type: integer
+                    inUse:
+                      type: integer
+                    idle:
+                      type: integer
+                    waitCount:
+                      type: integer
+                    waitDurationMillis:
+                      type: integer
+                    maxIdleClosed:
+                      type: integer
+                    maxIdleTimeClosed:
+                      type: integer
+                    maxLifetimeClosed:
+                      type: integer
+        '404':
+          description: Hidden when the caller is not allowed to inspect ABAC policy management data
   /security/abac/policy-versions:
     get:
       tags:
@@ -52,7 +52,11 @@ type ComposeTestMainOptions struct {
 
 	HealthURL     string
 	HealthTimeout time.Duration
-	WaitForReady  func() error
+	// ReadyURL points at a service's /readyz endpoint. When set and
+	// WaitForReady is nil, tests start only after the database schema is
+	// queryable instead of as soon as the process answers /health.
+	ReadyURL     string
+	WaitForReady func() error
 }
 
 func RunComposeTestMain(m *testing.M, options ComposeTestMainOptions) int {
@@ -184,6 +188,13 @@ func normalizeComposeTestMainOptions(options ComposeTestMainOptions) ComposeTest
 	if options.HealthURL != "" && options.HealthTimeout <= 0 {
 		options.HealthTimeout = 2 * time.Minute
 	}
+	if options.WaitForReady == nil && options.ReadyURL != "" {
+		readyURL := options.ReadyURL
+		readyTimeout := options.HealthTimeout
+		options.WaitForReady = func() error {
+			return WaitSchemaReadyURL(readyURL, readyTimeout)
+		}
+	}
 	return options
 }
 
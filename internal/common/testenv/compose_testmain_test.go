@@ -26,9 +26,13 @@
 package testenv
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -60,6 +64,40 @@ func TestNormalizeComposeTestMainOptionsAddsMissingDownCleanupArgs(t *testing.T)
 	require.Contains(t, options.DownArgs, "--remove-orphans")
 }
 
+func TestNormalizeComposeTestMainOptionsWaitsOnReadyURL(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/readyz", r.URL.Path)
+		// The first probe sees a service that is up but still waiting for its schema.
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := normalizeComposeTestMainOptions(ComposeTestMainOptions{
+		ReadyURL:      ReadyURL(server.URL + "/"),
+		HealthTimeout: 10 * time.Second,
+	})
+
+	require.NotNil(t, options.WaitForReady)
+	require.NoError(t, options.WaitForReady())
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestNormalizeComposeTestMainOptionsKeepsCustomWaitForReady(t *testing.T) {
+	called := false
+	options := normalizeComposeTestMainOptions(ComposeTestMainOptions{
+		ReadyURL:     "http://127.0.0.1:1/readyz",
+		WaitForReady: func() error { called = true; return nil },
+	})
+
+	require.NoError(t, options.WaitForReady())
+	require.True(t, called)
+}
+
 func TestNewComposeProjectNameIsUniqueAndValid(t *testing.T) {
 	first, err := NewComposeProjectName("Internal/AAS Registry Integration Tests")
 	require.NoError(t, err)
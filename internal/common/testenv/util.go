@@ -37,6 +37,7 @@ import (
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -85,6 +86,18 @@ func WaitHealthy(t testing.TB, url string, maxWait time.Duration) {
 	}
 }
 
+// ReadyURL returns the /readyz endpoint for a service base URL.
+func ReadyURL(baseURL string) string {
+	return strings.TrimRight(baseURL, "/") + "/readyz"
+}
+
+// WaitSchemaReadyURL polls a /readyz endpoint until the service reports that its
+// database schema is queryable. /health turns green before that point, so tests
+// that touch the database should wait on this instead.
+func WaitSchemaReadyURL(url string, maxWait time.Duration) error {
+	return WaitHealthyURL(url, maxWait)
+}
+
 // WaitHealthyURL polls the given URL until it returns HTTP 200 or the timeout is reached.
 // Returns a detailed timeout error containing the last received HTTP status or request error.
 func WaitHealthyURL(url string, maxWait time.Duration) error {
@@ -0,0 +1,86 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// TotalCountHeader carries the number of items matching the filters of a
+// listing when the client asked for it with `count=true`.
+const TotalCountHeader = "X-Total-Count"
+
+type totalCountContextKey struct{}
+
+// TotalCount receives the number of items matching the filters of a listing.
+// Controllers create it, services fill it.
+type TotalCount struct {
+	value int64
+	set   bool
+}
+
+// Set records the number of matching items.
+func (t *TotalCount) Set(value int64) {
+	t.value = value
+	t.set = true
+}
+
+// Value returns the recorded number and whether a listing recorded one.
+func (t *TotalCount) Value() (int64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	return t.value, t.set
+}
+
+// WithTotalCount asks listings to count all items matching their filters and
+// to record the result in total.
+func WithTotalCount(ctx context.Context, total *TotalCount) context.Context {
+	return context.WithValue(ctx, totalCountContextKey{}, total)
+}
+
+// TotalCountFromContext returns the receiver stored with WithTotalCount, or
+// nil when the client did not ask for a count.
+func TotalCountFromContext(ctx context.Context) *TotalCount {
+	if ctx == nil {
+		return nil
+	}
+
+	total, _ := ctx.Value(totalCountContextKey{}).(*TotalCount)
+	return total
+}
+
+// WriteTotalCountHeader sets TotalCountHeader when total holds a recorded
+// count. It must run before the response body is written.
+func WriteTotalCountHeader(w http.ResponseWriter, total *TotalCount) {
+	value, ok := total.Value()
+	if !ok {
+		return
+	}
+	w.Header().Set(TotalCountHeader, strconv.FormatInt(value, 10))
+}
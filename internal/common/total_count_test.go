@@ -0,0 +1,59 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package common
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteTotalCountHeader(t *testing.T) {
+	response := httptest.NewRecorder()
+	WriteTotalCountHeader(response, nil)
+	if response.Header().Get(TotalCountHeader) != "" {
+		t.Fatal("expected no header without a count request")
+	}
+
+	total := &TotalCount{}
+	ctx := WithTotalCount(context.Background(), total)
+	if TotalCountFromContext(ctx) != total {
+		t.Fatal("expected the stored receiver")
+	}
+	WriteTotalCountHeader(response, total)
+	if response.Header().Get(TotalCountHeader) != "" {
+		t.Fatal("expected no header before a count was recorded")
+	}
+
+	TotalCountFromContext(ctx).Set(0)
+	WriteTotalCountHeader(response, total)
+	if got := response.Header().Get(TotalCountHeader); got != "0" {
+		t.Fatalf("expected header 0, got %q", got)
+	}
+	if TotalCountFromContext(context.Background()) != nil {
+		t.Fatal("expected no receiver without WithTotalCount")
+	}
+}
@@ -31,7 +31,6 @@ package common
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"strings"
 	"time"
@@ -160,17 +159,3 @@ func Unmarshal(value []byte, v any) error {
 	}
 	return nil
 }
-
-// DebugEnabled checks whether descriptor debug logging is enabled in the
-// configuration stored in the provided context.
-
-func DebugEnabled(ctx context.Context) bool {
-	if ctx == nil {
-		return false
-	}
-	cfg, ok := ConfigFromContext(ctx)
-	if !ok || cfg == nil {
-		return false
-	}
-	return cfg.General.EnableDescriptorDebug
-}
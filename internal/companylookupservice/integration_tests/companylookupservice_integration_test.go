@@ -104,5 +104,6 @@ func TestMain(m *testing.M) {
 		ProjectName: runtime.ProjectName,
 		Env:         runtime.Env(),
 		HealthURL:   companyLookupBaseURL + "/health",
+		ReadyURL:    testenv.ReadyURL(companyLookupBaseURL),
 	}))
 }
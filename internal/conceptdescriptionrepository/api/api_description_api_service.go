@@ -45,6 +45,7 @@ import (
 // This service should implement the business logic for every endpoint for the DescriptionAPIAPI API.
 // Include any external packages or services that will be required by this service.
 type DescriptionAPIAPIService struct {
+	queryCapabilities *model.QueryCapabilities
 }
 
 // NewDescriptionAPIAPIService creates a default api service
@@ -52,6 +53,13 @@ func NewDescriptionAPIAPIService() *DescriptionAPIAPIService {
 	return &DescriptionAPIAPIService{}
 }
 
+// WithQueryCapabilities advertises the query endpoint in the self-description.
+// Services call it only while the query route is registered.
+func (s *DescriptionAPIAPIService) WithQueryCapabilities(capabilities *model.QueryCapabilities) *DescriptionAPIAPIService {
+	s.queryCapabilities = capabilities
+	return s
+}
+
 // GetSelfDescription - Returns the self-describing information of a network resource (ServiceDescription)
 func (s *DescriptionAPIAPIService) GetSelfDescription(_ context.Context) (model.ImplResponse, error) {
 	sd := model.ServiceDescription{
@@ -60,6 +68,7 @@ func (s *DescriptionAPIAPIService) GetSelfDescription(_ context.Context) (model.
 			"https://admin-shell.io/aas/API/3/2/ConceptDescriptionRepositoryServiceSpecification/SSP-002",
 			"https://basyx.org/aas/API/3/2/ConceptDescriptionRepositoryService/1.0",
 		},
+		QueryCapabilities: s.queryCapabilities,
 	}
 	return model.Response(http.StatusOK, sd), nil
 }
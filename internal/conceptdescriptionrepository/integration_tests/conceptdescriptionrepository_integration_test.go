@@ -363,6 +363,7 @@ func TestMain(m *testing.M) {
 		UpArgs:          []string{"up", "-d", "--build", "--remove-orphans"},
 		PreDownBeforeUp: true,
 		HealthURL:       conceptDescriptionRepositoryBaseURL + "/health",
+		ReadyURL:        testenv.ReadyURL(conceptDescriptionRepositoryBaseURL),
 		HealthTimeout:   150 * time.Second,
 	}))
 }
@@ -284,6 +284,7 @@ func (b *ConceptDescriptionBackend) checkConceptDescriptionVisibilityInTx(ctx co
 	if collectorErr != nil {
 		return false, false, common.NewInternalServerError("CDREPO-ABACCHKCD-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	query := goqu.From("concept_description").
 		Select(goqu.C("id")).
@@ -397,6 +398,7 @@ func (b *ConceptDescriptionBackend) GetConceptDescriptions(ctx context.Context,
 	if collectorErr != nil {
 		return nil, "", common.NewInternalServerError("CDREPO-GCDS-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	selectExpressions, selectErr := buildConceptDescriptionSelectExpressions(ctx, collector, true)
 	if selectErr != nil {
@@ -544,6 +546,7 @@ func (b *ConceptDescriptionBackend) GetConceptDescriptionByID(ctx context.Contex
 	if collectorErr != nil {
 		return nil, common.NewInternalServerError("CDREPO-GCDBYID-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	selectExpressions, selectErr := buildConceptDescriptionSelectExpressions(ctx, collector, true)
 	if selectErr != nil {
@@ -230,7 +230,7 @@ func (s *CustomDiscoveryService) PostAllAssetLinksByID(
 	}
 
 	aasID := string(decoded)
-	exists, existsErr := s.aasChecker.ExistsAASByID(ctx, aasID)
+	exists, existsErr := s.aasChecker.ExistsAASByID(auth.ContextForTrustedInternalCall(ctx), aasID)
 	if existsErr != nil {
 		log.Printf("🧭 [%s] Error PostAllAssetLinksById: existence check failed (aasId=%q): %v", customDiscoveryComponentName, aasID, existsErr)
 		return common.NewErrorResponse(
@@ -73,6 +73,9 @@ func (s *CustomRegistryService) GetAllAssetAdministrationShellDescriptors(
 	assetIds []string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointInterface string,
+	endpointProtocol string,
+	reachable *bool,
 ) (model.ImplResponse, error) {
 	createdAfter, _ := CreatedAfterFromContext(ctx)
 	if createdAfter != nil {
@@ -99,6 +102,9 @@ func (s *CustomRegistryService) GetAllAssetAdministrationShellDescriptors(
 			links,
 			createdFrom,
 			updatedFrom,
+			endpointInterface,
+			endpointProtocol,
+			reachable,
 		)
 	}
 
@@ -111,6 +117,9 @@ func (s *CustomRegistryService) GetAllAssetAdministrationShellDescriptors(
 		assetIds,
 		createdFrom,
 		updatedFrom,
+		endpointInterface,
+		endpointProtocol,
+		reachable,
 	)
 }
 
@@ -133,6 +142,9 @@ func (s *CustomRegistryService) getAllAssetAdministrationShellDescriptorsByAsset
 	links []model.AssetLink,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	endpointInterface string,
+	endpointProtocol string,
+	reachable *bool,
 ) (model.ImplResponse, error) {
 	if len(links) == 0 {
 		return emptyDescriptorPage(), nil
@@ -181,6 +193,9 @@ func (s *CustomRegistryService) getAllAssetAdministrationShellDescriptorsByAsset
 		nil,
 		createdFrom,
 		updatedFrom,
+		endpointInterface,
+		endpointProtocol,
+		reachable,
 	)
 	if descriptorErr != nil || descriptorResp.Code != http.StatusOK {
 		return descriptorResp, descriptorErr
@@ -341,32 +356,37 @@ func buildGlobalAssetIDQueryForField(field string, globalAssetIDs []string) gram
 	return grammar.Query{Condition: &condition}
 }
 
+// mergeAssetLinkLookupFilter derives the discovery lookup context for links.
+// The visibility constraints are computed from the caller's ABAC filter in ctx
+// and merged into a trusted internal context, so the embedded discovery service
+// does not apply the registry route's formulas a second time.
 func mergeAssetLinkLookupFilter(ctx context.Context, links []model.AssetLink) (context.Context, error) {
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
 		return ctx, enforceErr
 	}
 
+	lookupCtx := auth.ContextForTrustedInternalCall(ctx)
 	globalAssetIDs, specificAssetLinks := splitGlobalAssetIDLinks(links)
 	readUnrestricted := auth.HasUnrestrictedFormulaForRight(ctx, grammar.RightsEnumREAD)
 	if globalAssetIDDescriptorVisibilityRequired(ctx, globalAssetIDs, readUnrestricted) {
-		ctx = auth.MergeQueryFilter(ctx, buildBasicDiscoveryGlobalAssetIDDescriptorVisibilityQuery(ctx))
+		lookupCtx = auth.MergeQueryFilter(lookupCtx, buildBasicDiscoveryGlobalAssetIDDescriptorVisibilityQuery(ctx))
 	}
 
 	if !shouldEnforceFormula {
-		return ctx, nil
+		return lookupCtx, nil
 	}
 
 	assetLinkQuery := buildBasicDiscoveryAssetLinkQueryWithAccess(ctx, specificAssetLinks, readUnrestricted)
 	if assetLinkQuery.Condition == nil && len(assetLinkQuery.FilterConditions) == 0 {
-		return ctx, nil
+		return lookupCtx, nil
 	}
-	ctx = auth.MergeQueryFilter(ctx, assetLinkQuery)
+	lookupCtx = auth.MergeQueryFilter(lookupCtx, assetLinkQuery)
 	if len(globalAssetIDs) > 0 {
-		return ctx, nil
+		return lookupCtx, nil
 	}
 
-	return discoveryapiinternal.WithAssetLinksAlreadyConstrained(ctx), nil
+	return discoveryapiinternal.WithAssetLinksAlreadyConstrained(lookupCtx), nil
 }
 
 func globalAssetIDDescriptorVisibilityRequired(ctx context.Context, globalAssetIDs []string, readUnrestricted bool) bool {
@@ -504,6 +524,26 @@ func (s *CustomRegistryService) PutAssetAdministrationShellDescriptorById(
 	return baseResp, nil
 }
 
+// PatchAssetAdministrationShellDescriptorById executes default PATCH behavior.
+func (s *CustomRegistryService) PatchAssetAdministrationShellDescriptorById(
+	ctx context.Context,
+	aasIdentifier string,
+	patch map[string]any,
+) (model.ImplResponse, error) {
+	ctx = withDTRDescriptorWriteContext(ctx)
+
+	baseResp, baseErr := s.AssetAdministrationShellRegistryAPIAPIService.PatchAssetAdministrationShellDescriptorById(
+		ctx,
+		aasIdentifier,
+		patch,
+	)
+	if baseErr != nil || !is2xx(baseResp.Code) {
+		return baseResp, baseErr
+	}
+
+	return baseResp, nil
+}
+
 // PutSubmodelDescriptorByIdThroughSuperpath executes default PUT behavior for
 // submodel descriptors while deactivating strict body-id/path-id mismatch only
 // for Digital Twin Registry.
@@ -155,9 +155,45 @@ func TestMergeAssetLinkLookupFilterConstrainsSpecificOnlyAssetLinks(t *testing.T
 	}
 }
 
+func TestMergeAssetLinkLookupFilterUsesTrustedContextOnlyWhenEnabled(t *testing.T) {
+	t.Parallel()
+
+	links := []model.AssetLink{{Name: "customerPartId", Value: "customer-part"}}
+
+	lookupCtx, err := mergeAssetLinkLookupFilter(restrictedReadContext(), links)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if auth.IsTrustedInternalCall(lookupCtx) {
+		t.Fatalf("expected no trusted marker while abac.trustInternalCalls is disabled")
+	}
+
+	cfg := &common.Config{}
+	cfg.ABAC.Enabled = true
+	cfg.ABAC.TrustInternalCalls = true
+	lookupCtx, err = mergeAssetLinkLookupFilter(restrictedReadContextWithConfig(cfg), links)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !auth.IsTrustedInternalCall(lookupCtx) {
+		t.Fatalf("expected the discovery lookup to be marked as trusted internal call")
+	}
+	filter := auth.GetQueryFilter(lookupCtx)
+	if filter == nil || filter.Formula == nil {
+		t.Fatalf("expected the asset link constraints to be kept, got %#v", filter)
+	}
+	if filter.Formula.Boolean != nil {
+		t.Fatalf("expected the caller's registry formula to be dropped, got %#v", filter.Formula)
+	}
+}
+
 func restrictedReadContext() context.Context {
 	cfg := &common.Config{}
 	cfg.ABAC.Enabled = true
+	return restrictedReadContextWithConfig(cfg)
+}
+
+func restrictedReadContextWithConfig(cfg *common.Config) context.Context {
 	ctx := common.ContextWithConfig(context.Background(), cfg)
 	read := false
 	return auth.WithQueryFilter(ctx, &auth.QueryFilter{
@@ -68,6 +68,7 @@ func TestMain(m *testing.M) {
 		Env:         runtime.EnvWith("BASYX_IT_SECURITY_ENV=" + securityEnv),
 		UpArgs:      upArgs,
 		HealthURL:   BaseURL + "/health",
+		ReadyURL:    testenv.ReadyURL(BaseURL),
 	})
 	_ = os.RemoveAll(securityEnv)
 	os.Exit(code)
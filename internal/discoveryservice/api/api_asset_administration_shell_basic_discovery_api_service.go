@@ -22,6 +22,7 @@ import (
 	"github.com/FriedJannik/aas-go-sdk/jsonization"
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/discoveryservice/persistence"
 )
@@ -35,6 +36,7 @@ const (
 // Include any external packages or services that will be required by this service.
 type AssetAdministrationShellBasicDiscoveryAPIAPIService struct {
 	discoveryBackend persistencepostgresql.PostgreSQLDiscoveryDatabase
+	assetLinkEvents  history.EventPublisher
 }
 
 // NewAssetAdministrationShellBasicDiscoveryAPIAPIService creates a default api service
@@ -79,6 +81,12 @@ func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) SearchAllAssetAdmi
 	cursor string,
 	assetLink []model.AssetLink,
 ) (model.ImplResponse, error) {
+	limit, limitErr := common.ResolvePageLimit(ctx, limit)
+	if limitErr != nil {
+		return common.NewErrorResponse(
+			limitErr, http.StatusBadRequest, componentName, "SearchAllAssetAdministrationShellIdsByAssetLink", "LimitTooLarge",
+		), nil
+	}
 	assetLinksAlreadyConstrained := AssetLinksAlreadyConstrainedFromContext(ctx)
 	if len(assetLink) == 0 && !assetLinksAlreadyConstrained {
 		empty := model.GetAllAssetAdministrationShellIdsByAssetLink200Response{
@@ -233,6 +241,7 @@ func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) PostAllAssetLinksB
 		jsonableLinks = append(jsonableLinks, jsonableLink)
 	}
 
+	s.publishAssetLinkEvent(ctx, history.ChangeCreated, string(decodeDiscoveryIdentifier), jsonableLinks)
 	return model.Response(http.StatusCreated, jsonableLinks), nil
 }
 
@@ -291,6 +300,7 @@ func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) AddAllAssetLinksBy
 		jsonableLinks = append(jsonableLinks, jsonableLink)
 	}
 
+	s.publishAssetLinkEvent(ctx, history.ChangeUpdated, string(decodeDiscoveryIdentifier), jsonableLinks)
 	return model.Response(http.StatusCreated, jsonableLinks), nil
 }
 
@@ -324,7 +334,13 @@ func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) DeleteAllAssetLink
 		), nil
 	}
 
-	err := s.discoveryBackend.DeleteAllAssetLinks(ctx, string(decoded))
+	var removed []types.ISpecificAssetID
+	var err error
+	if s.assetLinkEvents != nil {
+		removed, err = s.discoveryBackend.DeleteAllAssetLinksReturning(ctx, string(decoded))
+	} else {
+		err = s.discoveryBackend.DeleteAllAssetLinks(ctx, string(decoded))
+	}
 	if err != nil {
 		switch {
 		case common.IsErrNotFound(err):
@@ -340,5 +356,14 @@ func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) DeleteAllAssetLink
 		}
 	}
 
+	if s.assetLinkEvents != nil {
+		removedLinks, convErr := jsonableAssetLinks(removed)
+		if convErr != nil {
+			log.Printf("🧭 [%s] Error DeleteAllAssetLinksById: failed to convert removed links for event (aasId=%q): %v", componentName, string(decoded), convErr)
+		} else {
+			s.publishAssetLinkEvent(ctx, history.ChangeDeleted, string(decoded), removedLinks)
+		}
+	}
+
 	return model.Response(http.StatusNoContent, nil), nil
 }
@@ -146,3 +146,25 @@ func TestSearchAllAssetAdministrationShellIdsByAssetLinkDoesNotShortCircuitWhenC
 		t.Fatalf("expected backend query to be executed, but expectations were not met: %v", err)
 	}
 }
+
+func TestSearchAllAssetAdministrationShellIdsByAssetLinkRejectsLimitAboveMaximum(t *testing.T) {
+	service := NewAssetAdministrationShellBasicDiscoveryAPIAPIService(persistencepostgresql.PostgreSQLDiscoveryDatabase{})
+	ctx := common.ContextWithConfig(context.Background(), &common.Config{General: common.GeneralConfig{DefaultPageLimit: 10, MaxPageLimit: 50}})
+
+	response, err := service.SearchAllAssetAdministrationShellIdsByAssetLink(ctx, 51, "", nil)
+	if err != nil {
+		t.Fatalf("expected response error body without returned error, got %v", err)
+	}
+	if response.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, response.Code)
+	}
+	errorBody := response.Body.([]common.ErrorHandler)
+	if len(errorBody) != 1 || !strings.Contains(errorBody[0].Text, "COMMON-PAGELIMIT-EXCEEDED") {
+		t.Fatalf("expected COMMON-PAGELIMIT-EXCEEDED, got %#v", response.Body)
+	}
+
+	response, err = service.SearchAllAssetAdministrationShellIdsByAssetLink(ctx, 50, "", nil)
+	if err != nil || response.Code != http.StatusOK {
+		t.Fatalf("expected limit at the maximum to be accepted, got status %d err %v", response.Code, err)
+	}
+}
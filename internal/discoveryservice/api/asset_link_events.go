@@ -0,0 +1,89 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
+)
+
+// AssetLinksEntityType is the history.ChangeEvent entity type of asset-link
+// change events.
+const AssetLinksEntityType = "AssetLinks"
+
+// WithAssetLinkEventPublisher enables change events for asset links. Events
+// are history.ChangeEvent values with EntityType AssetLinksEntityType, the
+// shell id as Identifier and the created, added or removed links in their JSON
+// form under the "assetLinks" key of Snapshot. They are published after the
+// database commit, so a failing publisher is logged but never rolls back or
+// fails the request. A nil publisher disables the events again.
+func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) WithAssetLinkEventPublisher(publisher history.EventPublisher) *AssetAdministrationShellBasicDiscoveryAPIAPIService {
+	s.assetLinkEvents = publisher
+	return s
+}
+
+func (s *AssetAdministrationShellBasicDiscoveryAPIAPIService) publishAssetLinkEvent(ctx context.Context, changeType string, aasID string, links []map[string]any) {
+	if s.assetLinkEvents == nil {
+		return
+	}
+	audit := history.FromContext(ctx)
+	event := history.ChangeEvent{
+		EntityType:    AssetLinksEntityType,
+		Identifier:    aasID,
+		ChangeType:    changeType,
+		Timestamp:     time.Now().UTC(),
+		Snapshot:      map[string]any{"assetLinks": links},
+		Deleted:       changeType == history.ChangeDeleted,
+		RequestID:     audit.RequestID,
+		CorrelationID: audit.CorrelationID,
+		ActorSubject:  audit.ActorSubject,
+		ActorIssuer:   audit.ActorIssuer,
+		ClientID:      audit.ClientID,
+		Operation:     audit.Operation,
+		Endpoint:      audit.Endpoint,
+		HTTPMethod:    audit.HTTPMethod,
+	}
+	if err := s.assetLinkEvents.Publish(ctx, event); err != nil {
+		log.Printf("🧭 [%s] Error publishing %s %s event (aasId=%q): %v", componentName, AssetLinksEntityType, changeType, aasID, err)
+	}
+}
+
+func jsonableAssetLinks(links []types.ISpecificAssetID) ([]map[string]any, error) {
+	jsonableLinks := make([]map[string]any, 0, len(links))
+	for _, link := range links {
+		jsonableLink, err := jsonization.ToJsonable(link)
+		if err != nil {
+			return nil, err
+		}
+		jsonableLinks = append(jsonableLinks, jsonableLink)
+	}
+	return jsonableLinks, nil
+}
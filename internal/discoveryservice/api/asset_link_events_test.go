@@ -0,0 +1,188 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
+	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/discoveryservice/persistence"
+)
+
+type fakeAssetLinkEventPublisher struct {
+	events []history.ChangeEvent
+	err    error
+}
+
+func (f *fakeAssetLinkEventPublisher) Publish(_ context.Context, event history.ChangeEvent) error {
+	f.events = append(f.events, event)
+	return f.err
+}
+
+func newEventTestService(t *testing.T, publisher history.EventPublisher) (*AssetAdministrationShellBasicDiscoveryAPIAPIService, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	backend, err := persistencepostgresql.NewPostgreSQLDiscoveryBackendFromDB(db)
+	if err != nil {
+		t.Fatalf("failed to create backend: %v", err)
+	}
+	return NewAssetAdministrationShellBasicDiscoveryAPIAPIService(*backend).WithAssetLinkEventPublisher(publisher), mock
+}
+
+func TestPostAllAssetLinksByIDPublishesCreatedEventAfterCommit(t *testing.T) {
+	publisher := &fakeAssetLinkEventPublisher{}
+	service, mock := newEventTestService(t, publisher)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "aas_identifier"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec(`DELETE FROM specific_asset_id`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO "specific_asset_id"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectExec(`INSERT INTO "specific_asset_id_payload"`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	response, err := service.PostAllAssetLinksByID(
+		context.Background(),
+		common.EncodeString("urn:aas:test:events"),
+		[]types.ISpecificAssetID{types.NewSpecificAssetID("serialNumber", "4711")},
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if response.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, response.Code)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expected create statements to be executed: %v", err)
+	}
+
+	if len(publisher.events) != 1 {
+		t.Fatalf("expected one event, got %#v", publisher.events)
+	}
+	event := publisher.events[0]
+	if event.EntityType != AssetLinksEntityType || event.ChangeType != history.ChangeCreated || event.Identifier != "urn:aas:test:events" || event.Deleted {
+		t.Fatalf("unexpected event header %#v", event)
+	}
+	links, _ := event.Snapshot["assetLinks"].([]map[string]any)
+	if len(links) != 1 || links[0]["name"] != "serialNumber" || links[0]["value"] != "4711" {
+		t.Fatalf("expected created link in payload, got %#v", event.Snapshot)
+	}
+	if event.Timestamp.IsZero() {
+		t.Fatalf("expected event time to be set")
+	}
+}
+
+func TestPostAllAssetLinksByIDDoesNotPublishWhenWriteFails(t *testing.T) {
+	publisher := &fakeAssetLinkEventPublisher{}
+	service, mock := newEventTestService(t, publisher)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "aas_identifier"`).WillReturnError(errors.New("connection lost"))
+	mock.ExpectRollback()
+
+	response, _ := service.PostAllAssetLinksByID(
+		context.Background(),
+		common.EncodeString("urn:aas:test:events"),
+		[]types.ISpecificAssetID{types.NewSpecificAssetID("serialNumber", "4711")},
+	)
+	if response.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, response.Code)
+	}
+	if len(publisher.events) != 0 {
+		t.Fatalf("expected no event for a failed write, got %#v", publisher.events)
+	}
+}
+
+func TestDeleteAllAssetLinksByIDPublishesRemovedLinks(t *testing.T) {
+	publisher := &fakeAssetLinkEventPublisher{err: errors.New("broker unavailable")}
+	service, mock := newEventTestService(t, publisher)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "aas_identifier"\."id" FROM "aas_identifier" WHERE .* FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`FROM "specific_asset_id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "value", "semantic_id_payload", "external_subject_id"}).
+			AddRow(int64(11), "serialNumber", "4711", nil, nil))
+	mock.ExpectQuery(`specific_asset_id_supplemental_semantic_id`).WillReturnRows(sqlmock.NewRows([]string{"specific_asset_id_id", "reference_id"}))
+	mock.ExpectQuery(`specific_asset_id_external_subject_id_reference`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(`DELETE FROM "aas_identifier"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ctx := common.ContextWithConfig(context.Background(), &common.Config{})
+	response, err := service.DeleteAllAssetLinksByID(ctx, common.EncodeString("urn:aas:test:events"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if response.Code != http.StatusNoContent {
+		t.Fatalf("expected a failing publisher not to fail the request, got status %d", response.Code)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expected delete statements to be executed: %v", err)
+	}
+
+	if len(publisher.events) != 1 {
+		t.Fatalf("expected one event, got %#v", publisher.events)
+	}
+	event := publisher.events[0]
+	if event.EntityType != AssetLinksEntityType || event.ChangeType != history.ChangeDeleted || event.Identifier != "urn:aas:test:events" || !event.Deleted {
+		t.Fatalf("unexpected event header %#v", event)
+	}
+	links, _ := event.Snapshot["assetLinks"].([]map[string]any)
+	if len(links) != 1 || links[0]["name"] != "serialNumber" {
+		t.Fatalf("expected removed link in payload, got %#v", event.Snapshot)
+	}
+}
+
+func TestDeleteAllAssetLinksByIDDoesNotPublishWhenShellIsMissing(t *testing.T) {
+	publisher := &fakeAssetLinkEventPublisher{}
+	service, mock := newEventTestService(t, publisher)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FOR UPDATE`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	response, err := service.DeleteAllAssetLinksByID(context.Background(), common.EncodeString("urn:aas:test:missing"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if response.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, response.Code)
+	}
+	if len(publisher.events) != 0 {
+		t.Fatalf("expected no event for a missing shell, got %#v", publisher.events)
+	}
+}
@@ -61,6 +61,7 @@ func TestMain(m *testing.M) {
 		ProjectName:   runtime.ProjectName,
 		Env:           runtime.Env(),
 		HealthURL:     discoveryBaseURL + "/health",
+		ReadyURL:      testenv.ReadyURL(discoveryBaseURL),
 		HealthTimeout: 2 * time.Minute,
 	}))
 }
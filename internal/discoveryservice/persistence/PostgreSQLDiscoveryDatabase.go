@@ -164,6 +164,55 @@ func (p *PostgreSQLDiscoveryDatabase) DeleteAllAssetLinks(ctx context.Context, a
 	return nil
 }
 
+// DeleteAllAssetLinksReturning deletes an AAS identifier like DeleteAllAssetLinks
+// and returns the asset links that were removed with it.
+//
+// The links are read without ABAC masking in the same transaction as the
+// delete, so the result is exactly what the commit removed. It is used when
+// change events need the removed links as payload.
+func (p *PostgreSQLDiscoveryDatabase) DeleteAllAssetLinksReturning(ctx context.Context, aasID string) ([]types.ISpecificAssetID, error) {
+	d := goqu.Dialect("postgres")
+	tAASIdentifier := goqu.T(common.TblAASIdentifier)
+	var removed []types.ISpecificAssetID
+	err := common.ExecuteInTransaction(p.db, "DISC-DELASSETLINKS-STARTTX", "DISC-DELASSETLINKS-COMMIT", func(tx *sql.Tx) error {
+		selectSQL, selectArgs, err := d.From(tAASIdentifier).
+			Select(tAASIdentifier.Col(common.ColID)).
+			Where(tAASIdentifier.Col("aasid").Eq(aasID)).
+			ForUpdate(goqu.Wait).
+			ToSQL()
+		if err != nil {
+			return common.NewInternalServerError("DISC-DELASSETLINKS-BUILDSELECT " + err.Error())
+		}
+		var aasRef int64
+		if err := tx.QueryRowContext(ctx, selectSQL, selectArgs...).Scan(&aasRef); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return common.NewErrNotFound(fmt.Sprintf("AAS identifier %s not found. See console for information.", aasID))
+			}
+			return common.NewInternalServerError("DISC-DELASSETLINKS-SELECT " + err.Error())
+		}
+
+		removed, err = descriptors.ReadSpecificAssetIDsByAASRef(auth.WithoutQueryFilter(ctx), tx, aasRef)
+		if err != nil {
+			return common.NewInternalServerError("DISC-DELASSETLINKS-READLINKS " + err.Error())
+		}
+
+		deleteSQL, deleteArgs, err := d.Delete(tAASIdentifier).
+			Where(tAASIdentifier.Col(common.ColID).Eq(aasRef)).
+			ToSQL()
+		if err != nil {
+			return common.NewInternalServerError("DISC-DELASSETLINKS-BUILDDELETE " + err.Error())
+		}
+		if _, err := tx.ExecContext(ctx, deleteSQL, deleteArgs...); err != nil {
+			return common.NewInternalServerError("DISC-DELASSETLINKS-DELETE " + err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
 // CreateAllAssetLinks creates or updates an AAS identifier with its associated asset links.
 //
 // This method performs an "upsert" operation: if the AAS identifier already exists,
@@ -259,13 +308,8 @@ func (p *PostgreSQLDiscoveryDatabase) SearchAASIDsByAssetLinks(
 			ad,
 			goqu.On(ad.Col(common.ColAASID).Eq(ai.Col("aasid"))),
 		).
-		Select(ai.Col("aasid")).
-		Where(
-			goqu.Or(
-				goqu.V(cursor).Eq(""),
-				ai.Col("aasid").Gte(cursor),
-			),
-		)
+		Select(ai.Col("aasid"))
+	ds = common.WhereKeysetCursor(ds, ai.Col("aasid"), cursor)
 
 	uniqueLinks := uniqueAssetLinks(links)
 	if len(uniqueLinks) > 0 {
@@ -296,6 +340,7 @@ func (p *PostgreSQLDiscoveryDatabase) SearchAASIDsByAssetLinks(
 		_, _ = fmt.Println("SearchAASIDsByAssetLinks: collector error:", err)
 		return nil, "", common.NewInternalServerError("Failed to build query filters. See server logs for details.")
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	ds, err = auth.AddFormulaQueryFromContext(ctx, ds, collector)
 	if err != nil {
@@ -304,23 +349,13 @@ func (p *PostgreSQLDiscoveryDatabase) SearchAASIDsByAssetLinks(
 	}
 
 	sqlStr, args, err := ds.ToSQL()
-	debugEnabled := common.DebugEnabled(ctx)
-	if debugEnabled {
-		_, _ = fmt.Println(sqlStr)
-	}
 	if err != nil {
 		_, _ = fmt.Println("SearchAASIDsByAssetLinks: sql build error:", err)
 		return nil, "", common.NewInternalServerError("Failed to query AAS IDs. See server logs for details.")
 	}
 
-	var rows *sql.Rows
-	if debugEnabled {
-		start := time.Now()
-		rows, err = p.db.QueryContext(ctx, sqlStr, args...)
-		_, _ = fmt.Printf("SearchAASIDsByAssetLinks query took %s\n", time.Since(start))
-	} else {
-		rows, err = p.db.QueryContext(ctx, sqlStr, args...)
-	}
+	common.LogQuery(ctx, sqlStr, args)
+	rows, err := p.db.QueryContext(ctx, sqlStr, args...)
 	if err != nil {
 		_, _ = fmt.Println("SearchAASIDsByAssetLinks: query error:", err)
 		return nil, "", common.NewInternalServerError("Failed to query AAS IDs. See server logs for details.")
@@ -345,13 +380,10 @@ func (p *PostgreSQLDiscoveryDatabase) SearchAASIDsByAssetLinks(
 		return nil, "", common.NewInternalServerError("Failed to iterate AAS IDs. See server logs for details.")
 	}
 
-	if len(buf) > int(limit) {
-		result := buf[:limit]
-		nextCursor := buf[limit]
-		return result, nextCursor, nil
-	}
-
-	return buf, "", nil
+	result, nextCursor := common.SplitKeysetPage(buf, limit, func(id string) string {
+		return id
+	})
+	return result, nextCursor, nil
 }
 
 func globalAssetIDLookupDataset(d goqu.DialectWrapper, value string) *goqu.SelectDataset {
@@ -81,6 +81,9 @@ func NewHTTPHandler(ctx context.Context, cfg *common.Config, openapiSpec fs.FS,
 	apiRouter.Use(history.AuditContextMiddleware(cfg))
 	for _, route := range dppRouter.OrderedRoutes() {
 		classifyDPPRoute(versioningGuard, route)
+		if common.IsOperationDisabled(cfg, route.Name, route.Method) {
+			continue
+		}
 		apiRouter.Method(route.Method, route.Pattern, route.HandlerFunc)
 	}
 
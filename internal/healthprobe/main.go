@@ -27,6 +27,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -145,22 +146,33 @@ func buildDefaultHealthURL() string {
 		port = defaultPort
 	}
 
+	scheme := "http"
+	if os.Getenv("SERVER_TLS_CERT_FILE") != "" || os.Getenv("BASYX_SERVER_TLS_CERT_FILE") != "" {
+		scheme = "https"
+	}
+
 	contextPath := os.Getenv("SERVER_CONTEXTPATH")
 	if contextPath == "" {
-		return fmt.Sprintf("http://127.0.0.1:%s/health", port)
+		return fmt.Sprintf("%s://127.0.0.1:%s/health", scheme, port)
 	}
 
-	return fmt.Sprintf("http://127.0.0.1:%s%s/health", port, contextPath)
+	return fmt.Sprintf("%s://127.0.0.1:%s%s/health", scheme, port, contextPath)
 }
 
 func runProbe(options probeOptions) error {
-	client := &http.Client{Timeout: options.timeout}
-
 	probeURL, err := parseAndValidateProbeURL(options.url)
 	if err != nil {
 		return err
 	}
 
+	client := &http.Client{Timeout: options.timeout}
+	if probeURL.Scheme == "https" {
+		// The service certificate names the public host, not the loopback
+		// address the probe connects to.
+		// #nosec G402 -- URL is constrained to localhost/loopback via parseAndValidateProbeURL
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12}}
+	}
+
 	// #nosec G704 -- URL is constrained to localhost/loopback via parseAndValidateProbeURL
 	response, err := client.Get(probeURL.String())
 	if err != nil {
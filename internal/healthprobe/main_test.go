@@ -94,6 +94,17 @@ func TestBuildDefaultHealthURL(t *testing.T) {
 	}
 }
 
+func TestBuildDefaultHealthURLUsesHTTPSWithTLSCertificate(t *testing.T) {
+	t.Setenv("SERVER_PORT", "8443")
+	t.Setenv("SERVER_CONTEXTPATH", "")
+	t.Setenv("SERVER_TLS_CERT_FILE", "/certs/server.crt")
+
+	url := buildDefaultHealthURL()
+	if url != "https://127.0.0.1:8443/health" {
+		t.Fatalf("unexpected url %q", url)
+	}
+}
+
 func TestBuildDefaultHealthURLWithContextPath(t *testing.T) {
 	t.Setenv("SERVER_PORT", "8089")
 	t.Setenv("SERVER_CONTEXTPATH", "/api")
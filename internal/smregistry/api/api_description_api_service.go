@@ -47,6 +47,7 @@ import (
 // This service should implement the business logic for every endpoint for the DescriptionAPIAPI API.
 // Include any external packages or services that will be required by this service.
 type DescriptionAPIAPIService struct {
+	queryCapabilities *model.QueryCapabilities
 }
 
 // NewDescriptionAPIAPIService creates a default api service
@@ -54,6 +55,13 @@ func NewDescriptionAPIAPIService() *DescriptionAPIAPIService {
 	return &DescriptionAPIAPIService{}
 }
 
+// WithQueryCapabilities advertises the query endpoint in the self-description.
+// Services call it only while the query route is registered.
+func (s *DescriptionAPIAPIService) WithQueryCapabilities(capabilities *model.QueryCapabilities) *DescriptionAPIAPIService {
+	s.queryCapabilities = capabilities
+	return s
+}
+
 // GetSelfDescription - Returns the self-describing information of a network resource (ServiceDescription)
 func (s *DescriptionAPIAPIService) GetSelfDescription(ctx context.Context) (model.ImplResponse, error) {
 	// TODO - update GetSelfDescription with the required logic for this service method.
@@ -75,5 +83,6 @@ func (s *DescriptionAPIAPIService) GetSelfDescription(ctx context.Context) (mode
 			"https://admin-shell.io/aas/API/3/2/SubmodelRegistryServiceSpecification/SSP-004",
 			"https://basyx.org/aas/API/3/2/SubmodelRegistryServiceSpecification/SSP-001",
 		},
+		QueryCapabilities: s.queryCapabilities,
 	}), nil
 }
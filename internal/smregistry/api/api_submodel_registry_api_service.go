@@ -71,6 +71,12 @@ func NewSubmodelRegistryAPIAPIService(databaseBackend smregistrypostgresql.Postg
 
 // GetAllSubmodelDescriptors - Returns all Submodel Descriptors
 func (s *SubmodelRegistryAPIAPIService) GetAllSubmodelDescriptors(ctx context.Context, limit int32, cursor string, createdFrom time.Time, updatedFrom time.Time) (model.ImplResponse, error) {
+	limit, limitErr := common.ResolvePageLimit(ctx, limit)
+	if limitErr != nil {
+		return common.NewErrorResponse(
+			limitErr, http.StatusBadRequest, componentName, "GetAllSubmodelDescriptors", "LimitTooLarge",
+		), nil
+	}
 	internalCursor, resp, err := decodeCursor(strings.TrimSpace(cursor), "GetAllSubmodelDescriptors")
 	if resp != nil || err != nil {
 		return *resp, err
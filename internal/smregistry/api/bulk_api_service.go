@@ -161,6 +161,7 @@ func (s *BulkService) GetResult(ctx context.Context, handleID string) model.Impl
 		"processedCount":  record.Result.ProcessedCount,
 		"successfulCount": record.Result.SuccessfulCount,
 		"failedCount":     record.Result.FailedCount,
+		"summary":         record.Result.Summary(),
 		"details":         record.Result.Failures,
 	})
 }
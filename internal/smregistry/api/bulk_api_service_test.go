@@ -145,6 +145,7 @@ func TestBulkServiceDeleteFailureResult(t *testing.T) {
 	require.EqualValues(t, 2, body["processedCount"])
 	require.EqualValues(t, 0, body["successfulCount"])
 	require.EqualValues(t, 2, body["failedCount"])
+	require.Equal(t, asyncbulk.ResultSummary{Total: 2, Succeeded: 0, Failed: 2}, body["summary"])
 	messages, ok := body["messages"].([]model.Message)
 	require.True(t, ok)
 	require.Len(t, messages, 1)
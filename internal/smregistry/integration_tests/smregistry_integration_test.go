@@ -301,6 +301,7 @@ func TestMain(m *testing.M) {
 		ProjectName:   runtime.ProjectName,
 		Env:           runtime.Env(),
 		HealthURL:     smRegistryBaseURL + "/health",
+		ReadyURL:      testenv.ReadyURL(smRegistryBaseURL),
 		HealthTimeout: 2 * time.Minute,
 	}))
 }
@@ -0,0 +1,68 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSelfDescriptionListsQueryCapabilitiesWhenQueryEndpointEnabled(t *testing.T) {
+	service := NewDescriptionAPIAPIService().WithQueryCapabilities(grammar.NewQueryCapabilities("/query/submodels"))
+
+	response, err := service.GetSelfDescription(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	body, err := json.Marshal(response.Body)
+	require.NoError(t, err)
+	var description struct {
+		Profiles          []string                 `json:"profiles"`
+		QueryCapabilities *model.QueryCapabilities `json:"queryCapabilities"`
+	}
+	require.NoError(t, json.Unmarshal(body, &description))
+	require.NotEmpty(t, description.Profiles)
+	require.NotNil(t, description.QueryCapabilities)
+	require.Equal(t, "/query/submodels", description.QueryCapabilities.Endpoint)
+	require.Contains(t, description.QueryCapabilities.Operators, "$eq")
+	require.Contains(t, description.QueryCapabilities.Operators, "$match")
+	require.Equal(t, []string{"$condition", "$filters"}, description.QueryCapabilities.Modifiers)
+}
+
+func TestGetSelfDescriptionOmitsQueryCapabilitiesWhenQueryEndpointDisabled(t *testing.T) {
+	response, err := NewDescriptionAPIAPIService().GetSelfDescription(context.Background())
+	require.NoError(t, err)
+
+	body, err := json.Marshal(response.Body)
+	require.NoError(t, err)
+	require.NotContains(t, string(body), "queryCapabilities")
+}
@@ -27,25 +27,368 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	aasx "github.com/aas-core-works/aas-package3-golang/v2"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+	openapi "github.com/eclipse-basyx/basyx-go-components/pkg/submodelrepositoryapi"
 )
 
-const errGenerateSerializationByIdsNotImplemented = "SMREPO-GENSERIALIZATIONBYIDS-NOTIMPLEMENTED"
+const (
+	serializationContentTypeAASXJSON    = "application/asset-administration-shell-package+json"
+	serializationContentTypeAASXJSONAlt = "application/aasx+json"
+	serializationAASXSpecURI            = "/aasx/json/content.json"
+	serializationAASXSupplementaryRoot  = "/aasx/files/"
+	serializationExportPageSize         = 100
+)
+
+// submodelExportSource is the part of the submodel backend read by the AASX export.
+type submodelExportSource interface {
+	GetSubmodels(ctx context.Context, limit int32, cursor string, submodelIdentifier string, semanticID string, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error)
+	GetSubmodelByID(ctx context.Context, submodelIdentifier string, level string, metadataOnly bool, includeBlobValue bool) (types.ISubmodel, error)
+	GetSubmodelElements(ctx context.Context, submodelID string, limit *int, cursor string, includeBlobValue bool, level string) ([]types.ISubmodelElement, string, error)
+	FileAttachmentExists(submodelID string, idShortPath string) (bool, error)
+	StreamFileAttachmentWithContext(ctx context.Context, submodelID string, idShortPath string, consume func(string, string, int64, io.Reader) error) error
+}
 
 // SerializationAPIAPIService is a service that implements the logic for the SerializationAPIAPIServicer.
-type SerializationAPIAPIService struct{}
+type SerializationAPIAPIService struct {
+	source submodelExportSource
+	target submodelImportTarget
+}
+
+// NewSerializationAPIAPIService creates a serialization service reading from and importing into the submodel backend.
+func NewSerializationAPIAPIService(submodelBackend persistencepostgresql.SubmodelDatabase) *SerializationAPIAPIService {
+	return &SerializationAPIAPIService{source: &submodelBackend, target: &submodelBackend}
+}
+
+// GenerateSerializationByIds streams the requested submodels as an AASX package.
+//
+// The package holds one JSON specification part with all selected submodels
+// and one supplementary part per stored File attachment. Submodels are read
+// page by page while the package is written to the response, so the export
+// is never buffered as a whole. Explicitly requested submodels are loaded
+// before the response starts so that unknown identifiers still yield 404.
+//
+// The repository holds neither shells nor concept descriptions, so aasIds
+// must be empty and includeConceptDescriptions has no effect.
+//
+// Parameters:
+//   - ctx: Request context carrying the Accept header and ABAC filters.
+//   - aasIds: Must be empty.
+//   - submodelIds: Base64URL-encoded submodel identifiers; empty selects all submodels.
+//   - includeConceptDescriptions: Ignored.
+//
+// Returns:
+//   - model.ImplResponse: A streaming AASX download or an error response.
+//   - error: Always nil; failures are mapped to the response.
+func (s *SerializationAPIAPIService) GenerateSerializationByIds(ctx context.Context, aasIds []string, submodelIds []string, _ bool) (model.ImplResponse, error) {
+	const operation = "GenerateSerializationByIds"
+
+	if len(aasIds) > 0 {
+		return newAPIErrorResponse(common.NewErrBadRequest("SMREPO-GENSERIALIZATION-AASIDS the submodel repository holds no asset administration shells"), http.StatusBadRequest, operation, "AASIdsNotSupported"), nil
+	}
+	if err := negotiateSerializationContentType(common.AcceptHeaderFromContext(ctx)); err != nil {
+		return newAPIErrorResponse(err, http.StatusNotAcceptable, operation, "NegotiateContentType"), nil
+	}
+
+	export := &submodelAASXExport{ctx: ctx, source: s.source}
+	if len(submodelIds) > 0 {
+		export.preloaded = make([]types.ISubmodel, 0, len(submodelIds))
+		for _, encoded := range submodelIds {
+			decoded, decodeErr := decodeSubmodelIdentifier(ctx, encoded)
+			if decodeErr != nil {
+				return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
+			}
+			submodel, loadErr := s.source.GetSubmodelByID(ctx, decoded, "deep", false, true)
+			if loadErr != nil {
+				return newSubmodelReadErrorResponse(loadErr, operation, "LoadSubmodel"), nil
+			}
+			export.preloaded = append(export.preloaded, submodel)
+		}
+	}
+
+	return model.Response(http.StatusOK, openapi.StreamingFileDownload{
+		ContentType: serializationContentTypeAASXJSON,
+		Filename:    "submodels.aasx",
+		WriteTo:     export.writeTo,
+	}), nil
+}
+
+func negotiateSerializationContentType(accept string) error {
+	if strings.TrimSpace(accept) == "" {
+		return nil
+	}
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(mediaRange, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "*/*", "application/*", serializationContentTypeAASXJSON, serializationContentTypeAASXJSONAlt:
+			return nil
+		}
+	}
+	return errors.New("SMREPO-GENSERIALIZATION-NOTACCEPTABLE only " + serializationContentTypeAASXJSON + " is supported")
+}
+
+// submodelAASXExport writes one AASX package. Attachments are collected while
+// the specification part is written and appended as supplementary parts after it.
+type submodelAASXExport struct {
+	ctx         context.Context
+	source      submodelExportSource
+	preloaded   []types.ISubmodel
+	attachments []submodelAASXAttachment
+	seen        map[string]struct{}
+}
+
+type submodelAASXAttachment struct {
+	submodelID  string
+	idShortPath string
+	uri         *url.URL
+}
+
+func (e *submodelAASXExport) writeTo(destination io.Writer) error {
+	pkg, err := aasx.NewPackaging().CreateWriter(destination)
+	if err != nil {
+		return common.NewInternalServerError("SMREPO-EXPORTAASX-CREATEPACKAGE " + err.Error())
+	}
+
+	specURI := &url.URL{Path: serializationAASXSpecURI}
+	specReader, specWriter := io.Pipe()
+	specDone := make(chan struct{})
+	go func() {
+		defer close(specDone)
+		_ = specWriter.CloseWithError(e.writeSpecification(specWriter))
+	}()
+	spec, err := pkg.PutPartFromStream(specURI, "application/json", specReader)
+	_ = specReader.Close()
+	<-specDone
+	if err != nil {
+		_ = pkg.Close()
+		return common.NewInternalServerError("SMREPO-EXPORTAASX-PUTSPECPART " + err.Error())
+	}
+	if err = pkg.MakeSpec(spec); err != nil {
+		_ = pkg.Close()
+		return common.NewInternalServerError("SMREPO-EXPORTAASX-MAKESPEC " + err.Error())
+	}
+
+	for _, attachment := range e.attachments {
+		streamErr := e.source.StreamFileAttachmentWithContext(e.ctx, attachment.submodelID, attachment.idShortPath, func(contentType string, _ string, _ int64, reader io.Reader) error {
+			if strings.TrimSpace(contentType) == "" {
+				contentType = "application/octet-stream"
+			}
+			part, putErr := pkg.PutPartFromStream(attachment.uri, contentType, reader)
+			if putErr != nil {
+				return putErr
+			}
+			return pkg.RelateSupplementaryToSpec(part, spec)
+		})
+		if common.IsErrNotFound(streamErr) || common.IsErrDenied(streamErr) {
+			// The attachment was removed after the specification part was
+			// written, or it is hidden from the caller.
+			// #nosec G706 -- identifiers come from the repository and are quoted.
+			log.Printf("[WARN] SMREPO-EXPORTAASX-SKIPATTACHMENT attachment of %q at %q is not readable", attachment.submodelID, attachment.idShortPath)
+			continue
+		}
+		if streamErr != nil {
+			_ = pkg.Close()
+			return common.NewInternalServerError("SMREPO-EXPORTAASX-PUTSUPPLPART " + streamErr.Error())
+		}
+	}
+
+	if err = pkg.Close(); err != nil {
+		return common.NewInternalServerError("SMREPO-EXPORTAASX-CLOSEPACKAGE " + err.Error())
+	}
+	return nil
+}
+
+// writeSpecification writes {"submodels":[...]} one submodel at a time.
+func (e *submodelAASXExport) writeSpecification(w io.Writer) error {
+	if _, err := io.WriteString(w, `{"submodels":[`); err != nil {
+		return err
+	}
+	first := true
+	err := e.eachSubmodel(func(submodel types.ISubmodel) error {
+		if err := e.collectAttachments(submodel); err != nil {
+			return err
+		}
+		jsonable, err := jsonization.ToJsonable(submodel)
+		if err != nil {
+			return common.NewInternalServerError("SMREPO-EXPORTAASX-TOJSONABLE " + err.Error())
+		}
+		payload, err := json.Marshal(jsonable)
+		if err != nil {
+			return common.NewInternalServerError("SMREPO-EXPORTAASX-MARSHAL " + err.Error())
+		}
+		if !first {
+			if _, err = io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(payload)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+func (e *submodelAASXExport) eachSubmodel(visit func(types.ISubmodel) error) error {
+	if e.preloaded != nil {
+		for _, submodel := range e.preloaded {
+			if err := visit(submodel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cursor := ""
+	for {
+		page, nextCursor, err := e.source.GetSubmodels(e.ctx, serializationExportPageSize, cursor, "", "", time.Time{}, time.Time{})
+		if err != nil {
+			return err
+		}
+		for _, submodel := range page {
+			elements, _, elementsErr := e.source.GetSubmodelElements(e.ctx, submodel.ID(), nil, "", true, "deep")
+			if common.IsErrNotFound(elementsErr) {
+				// Deleted between listing and loading its elements.
+				continue
+			}
+			if elementsErr != nil {
+				return elementsErr
+			}
+			submodel.SetSubmodelElements(elements)
+			if err = visit(submodel); err != nil {
+				return err
+			}
+		}
+		if strings.TrimSpace(nextCursor) == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// collectAttachments registers every File element of submodel whose
+// attachment is stored in the repository. Managed values already are package
+// part paths and are kept; legacy relative values are moved below
+// /aasx/files/ so that the model points at the embedded part.
+func (e *submodelAASXExport) collectAttachments(submodel types.ISubmodel) error {
+	var walkErr error
+	walkSubmodelExportFiles(submodel.SubmodelElements(), "", func(idShortPath string, file *types.File) bool {
+		value := strings.TrimSpace(*file.Value())
+		if strings.Contains(value, "://") {
+			return true
+		}
+		exists, err := e.source.FileAttachmentExists(submodel.ID(), idShortPath)
+		if err != nil && !common.IsErrNotFound(err) {
+			walkErr = err
+			return false
+		}
+		if !exists {
+			return true
+		}
+		target := value
+		if !strings.HasPrefix(target, serializationAASXSupplementaryRoot) {
+			target = fmt.Sprintf("%slegacy-%d/%s", serializationAASXSupplementaryRoot, len(e.attachments)+1, exportFileName(value))
+			file.SetValue(&target)
+		}
+		if e.seen == nil {
+			e.seen = map[string]struct{}{}
+		}
+		if _, duplicate := e.seen[target]; duplicate {
+			return true
+		}
+		e.seen[target] = struct{}{}
+		uri := &url.URL{Path: target}
+		if escaped := uri.EscapedPath(); escaped != target {
+			// The package writer expects the escaped form of the part name.
+			uri = &url.URL{Path: escaped}
+		}
+		e.attachments = append(e.attachments, submodelAASXAttachment{
+			submodelID:  submodel.ID(),
+			idShortPath: idShortPath,
+			uri:         uri,
+		})
+		return true
+	})
+	return walkErr
+}
+
+// walkSubmodelExportFiles calls visit for every File element with a value
+// until visit returns false.
+func walkSubmodelExportFiles(elements []types.ISubmodelElement, parentPath string, visit func(string, *types.File) bool) bool {
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		idShortPath := parentPath
+		if element.IDShort() != nil {
+			if idShortPath != "" {
+				idShortPath += "."
+			}
+			idShortPath += *element.IDShort()
+		}
+		if !walkSubmodelExportElement(element, idShortPath, visit) {
+			return false
+		}
+	}
+	return true
+}
 
-// NewSerializationAPIAPIService creates a default api service.
-func NewSerializationAPIAPIService() *SerializationAPIAPIService {
-	return &SerializationAPIAPIService{}
+func walkSubmodelExportElement(element types.ISubmodelElement, idShortPath string, visit func(string, *types.File) bool) bool {
+	switch typed := element.(type) {
+	case *types.File:
+		if typed.Value() != nil && strings.TrimSpace(*typed.Value()) != "" {
+			return visit(idShortPath, typed)
+		}
+	case *types.SubmodelElementCollection:
+		return walkSubmodelExportFiles(typed.Value(), idShortPath, visit)
+	case *types.SubmodelElementList:
+		for index, child := range typed.Value() {
+			if child != nil && !walkSubmodelExportElement(child, fmt.Sprintf("%s[%d]", idShortPath, index), visit) {
+				return false
+			}
+		}
+	case *types.Entity:
+		return walkSubmodelExportFiles(typed.Statements(), idShortPath, visit)
+	case *types.AnnotatedRelationshipElement:
+		annotations := make([]types.ISubmodelElement, 0, len(typed.Annotations()))
+		for _, annotation := range typed.Annotations() {
+			annotations = append(annotations, annotation)
+		}
+		return walkSubmodelExportFiles(annotations, idShortPath, visit)
+	}
+	return true
 }
 
-// GenerateSerializationByIds returns the requested serialization for the given identifiers.
-func (s *SerializationAPIAPIService) GenerateSerializationByIds(_ context.Context, _ []string, _ []string, _ bool) (model.ImplResponse, error) {
-	err := errors.New(errGenerateSerializationByIdsNotImplemented)
-	return common.NewErrorResponse(err, http.StatusNotImplemented, "SubmodelRepository", "GenerateSerializationByIds", ""), nil
+// exportFileName reduces a legacy file value to one package-safe path segment.
+func exportFileName(value string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, path.Base(strings.ReplaceAll(value, "\\", "/")))
+	if strings.Trim(name, "._") == "" {
+		return "file"
+	}
+	return name
 }
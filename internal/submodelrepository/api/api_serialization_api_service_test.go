@@ -26,28 +26,222 @@
 package api
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/FriedJannik/aas-go-sdk/types"
+	aasx "github.com/aas-core-works/aas-package3-golang/v2"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	openapi "github.com/eclipse-basyx/basyx-go-components/pkg/submodelrepositoryapi"
 	"github.com/stretchr/testify/require"
 )
 
-func TestGenerateSerializationByIdsReturnsNotImplemented(t *testing.T) {
+type exportAttachment struct {
+	contentType string
+	content     string
+}
+
+// fakeExportSource serves submodels one per page to exercise cursor paging.
+type fakeExportSource struct {
+	submodels   []types.ISubmodel
+	elements    map[string][]types.ISubmodelElement
+	attachments map[string]exportAttachment
+}
+
+func (f *fakeExportSource) GetSubmodels(_ context.Context, _ int32, cursor string, _ string, _ string, _ time.Time, _ time.Time) ([]types.ISubmodel, string, error) {
+	for index, submodel := range f.submodels {
+		if cursor != "" && submodel.ID() != cursor {
+			continue
+		}
+		next := ""
+		if index+1 < len(f.submodels) {
+			next = f.submodels[index+1].ID()
+		}
+		return []types.ISubmodel{types.NewSubmodel(submodel.ID())}, next, nil
+	}
+	return nil, "", nil
+}
+
+func (f *fakeExportSource) GetSubmodelByID(_ context.Context, id string, _ string, _ bool, _ bool) (types.ISubmodel, error) {
+	for _, submodel := range f.submodels {
+		if submodel.ID() == id {
+			loaded := types.NewSubmodel(id)
+			loaded.SetSubmodelElements(f.elements[id])
+			return loaded, nil
+		}
+	}
+	return nil, common.NewErrNotFound("SMREPO-TEST-NOTFOUND " + id)
+}
+
+func (f *fakeExportSource) GetSubmodelElements(_ context.Context, submodelID string, _ *int, _ string, _ bool, _ string) ([]types.ISubmodelElement, string, error) {
+	return f.elements[submodelID], "", nil
+}
+
+func (f *fakeExportSource) FileAttachmentExists(submodelID string, idShortPath string) (bool, error) {
+	_, ok := f.attachments[submodelID+"/"+idShortPath]
+	return ok, nil
+}
+
+func (f *fakeExportSource) StreamFileAttachmentWithContext(_ context.Context, submodelID string, idShortPath string, consume func(string, string, int64, io.Reader) error) error {
+	attachment, ok := f.attachments[submodelID+"/"+idShortPath]
+	if !ok {
+		return common.NewErrNotFound("SMREPO-TEST-NOATTACHMENT")
+	}
+	return consume(attachment.contentType, "", int64(len(attachment.content)), strings.NewReader(attachment.content))
+}
+
+func newExportFile(idShort string, value string) *types.File {
+	file := types.NewFile()
+	file.SetIDShort(&idShort)
+	file.SetValue(&value)
+	return file
+}
+
+func newFakeExportSource() *fakeExportSource {
+	collection := types.NewSubmodelElementCollection()
+	collectionIDShort := "Docs"
+	collection.SetIDShort(&collectionIDShort)
+	collection.SetValue([]types.ISubmodelElement{
+		newExportFile("Manual", "/aasx/files/token-1/manual.pdf"),
+		newExportFile("Website", "https://example.com/manual.pdf"),
+	})
+
+	return &fakeExportSource{
+		submodels: []types.ISubmodel{types.NewSubmodel("urn:example:sm:1"), types.NewSubmodel("urn:example:sm:2")},
+		elements: map[string][]types.ISubmodelElement{
+			"urn:example:sm:1": {collection},
+			"urn:example:sm:2": {newExportFile("Photo", "photo old.png"), newExportFile("Missing", "/aasx/files/token-2/gone.txt")},
+		},
+		attachments: map[string]exportAttachment{
+			"urn:example:sm:1/Docs.Manual": {contentType: "application/pdf", content: "%PDF-manual"},
+			"urn:example:sm:2/Photo":       {contentType: "image/png", content: "png-bytes"},
+		},
+	}
+}
+
+func exportPackage(t *testing.T, sut *SerializationAPIAPIService, ctx context.Context, submodelIDs []string) []byte {
+	t.Helper()
+
+	response, err := sut.GenerateSerializationByIds(ctx, nil, submodelIDs, true)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.Code)
+	download, ok := response.Body.(openapi.StreamingFileDownload)
+	require.True(t, ok, "expected streaming download, got %T", response.Body)
+	require.Equal(t, serializationContentTypeAASXJSON, download.ContentType)
+
+	var buffer bytes.Buffer
+	require.NoError(t, download.WriteTo(&buffer))
+	return buffer.Bytes()
+}
+
+func TestGenerateSerializationByIdsExportsRereadableAASX(t *testing.T) {
 	t.Parallel()
 
-	sut := NewSerializationAPIAPIService()
-	response, err := sut.GenerateSerializationByIds(contextWithABACDisabled(t), []string{"aas-1"}, []string{"sm-1"}, true)
+	sut := &SerializationAPIAPIService{source: newFakeExportSource()}
+	payload := exportPackage(t, sut, context.Background(), nil)
+
+	archive, err := zip.NewReader(bytes.NewReader(payload), int64(len(payload)))
+	require.NoError(t, err)
+	names := map[string]bool{}
+	for _, file := range archive.File {
+		names[file.Name] = true
+	}
+	require.True(t, names["[Content_Types].xml"], "missing content types part: %v", names)
+	require.True(t, names["_rels/.rels"], "missing package relationships: %v", names)
 
+	pkg, err := aasx.NewPackaging().OpenReadFromStream(bytes.NewReader(payload))
 	require.NoError(t, err)
-	require.Equal(t, http.StatusNotImplemented, response.Code)
+	defer func() {
+		_ = pkg.Close()
+	}()
+
+	specs, err := pkg.Specs()
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	require.Equal(t, serializationAASXSpecURI, specs[0].URI.Path)
+
+	specContent, err := specs[0].ReadAllBytes()
+	require.NoError(t, err)
+	var environment struct {
+		Submodels []map[string]any `json:"submodels"`
+	}
+	require.NoError(t, json.Unmarshal(specContent, &environment))
+	require.Len(t, environment.Submodels, 2)
+	require.Contains(t, string(specContent), `"value":"/aasx/files/token-1/manual.pdf"`)
+	require.Contains(t, string(specContent), `"value":"/aasx/files/legacy-2/photo_old.png"`)
+	require.Contains(t, string(specContent), `"value":"https://example.com/manual.pdf"`)
+
+	supplementaries, err := pkg.SupplementariesFor(specs[0])
+	require.NoError(t, err)
+	require.Len(t, supplementaries, 2)
+
+	manual, err := pkg.MustPart(&url.URL{Path: "/aasx/files/token-1/manual.pdf"})
+	require.NoError(t, err)
+	manualContent, err := manual.ReadAllBytes()
+	require.NoError(t, err)
+	require.Equal(t, "%PDF-manual", string(manualContent))
+	require.Equal(t, "application/pdf", manual.ContentType)
+
+	photo, err := pkg.MustPart(&url.URL{Path: "/aasx/files/legacy-2/photo_old.png"})
+	require.NoError(t, err)
+	photoContent, err := photo.ReadAllBytes()
+	require.NoError(t, err)
+	require.Equal(t, "png-bytes", string(photoContent))
+}
+
+func TestGenerateSerializationByIdsExportsSelectedSubmodels(t *testing.T) {
+	t.Parallel()
+
+	sut := &SerializationAPIAPIService{source: newFakeExportSource()}
+	payload := exportPackage(t, sut, context.Background(), []string{common.EncodeString("urn:example:sm:2")})
+
+	pkg, err := aasx.NewPackaging().OpenReadFromStream(bytes.NewReader(payload))
+	require.NoError(t, err)
+	defer func() {
+		_ = pkg.Close()
+	}()
+	specs, err := pkg.Specs()
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	specContent, err := specs[0].ReadAllBytes()
+	require.NoError(t, err)
+	require.Contains(t, string(specContent), "urn:example:sm:2")
+	require.NotContains(t, string(specContent), "urn:example:sm:1")
+}
+
+func TestGenerateSerializationByIdsRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		accept      string
+		aasIDs      []string
+		submodelIDs []string
+		wantCode    int
+	}{
+		{name: "aas identifiers", aasIDs: []string{"aas-1"}, wantCode: http.StatusBadRequest},
+		{name: "xml package", accept: "application/asset-administration-shell-package+xml", wantCode: http.StatusNotAcceptable},
+		{name: "malformed identifier", submodelIDs: []string{"%%%"}, wantCode: http.StatusBadRequest},
+		{name: "unknown submodel", submodelIDs: []string{common.EncodeString("urn:example:sm:unknown")}, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	handlers, ok := response.Body.([]common.ErrorHandler)
-	require.True(t, ok)
-	require.Len(t, handlers, 1)
-	require.Equal(t, "Error", handlers[0].MessageType)
-	require.Equal(t, errGenerateSerializationByIdsNotImplemented, handlers[0].Text)
-	require.Equal(t, "501", handlers[0].Code)
-	require.Equal(t, "SubmodelRepository-501-GenerateSerializationByIds-NotImplemented-", handlers[0].CorrelationID)
-	require.NotEmpty(t, handlers[0].Timestamp)
+			sut := &SerializationAPIAPIService{source: newFakeExportSource()}
+			ctx := common.WithAcceptHeader(context.Background(), tt.accept)
+			response, err := sut.GenerateSerializationByIds(ctx, tt.aasIDs, tt.submodelIDs, true)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCode, response.Code)
+		})
+	}
 }
@@ -0,0 +1,337 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/FriedJannik/aas-go-sdk/xmlization"
+	aasx "github.com/aas-core-works/aas-package3-golang/v2"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/asyncbulk"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+)
+
+// submodelImportTarget is the part of the submodel backend written by the AASX import.
+type submodelImportTarget interface {
+	ImportSubmodels(ctx context.Context, items []persistencepostgresql.SubmodelImportItem, atomic bool) ([]persistencepostgresql.SubmodelImportFailure, error)
+}
+
+// ImportAASXPackage stores the submodels of an uploaded AASX package together
+// with the attachments of their File elements.
+//
+// The package must hold exactly one JSON or XML specification part. Shells
+// and concept descriptions in it are ignored. A File element whose value
+// resolves to a supplementary part of the specification receives that part
+// as its attachment and thereby a new managed value; all other File values
+// are stored unchanged.
+//
+// general.aasxImportAtomic selects between an atomic import, where the first
+// failing submodel rolls back all others, and a best-effort import, where
+// failing submodels are skipped and reported.
+//
+// Parameters:
+//   - ctx: Request context carrying configuration and ABAC filters.
+//   - file: Seekable package content.
+//
+// Returns:
+//   - model.ImplResponse: HTTP 201 with the import summary, or per-submodel failures.
+//   - error: Always nil; failures are mapped to the response.
+func (s *SerializationAPIAPIService) ImportAASXPackage(ctx context.Context, file io.ReadSeeker) (model.ImplResponse, error) {
+	const operation = "ImportAASXPackage"
+
+	if file == nil {
+		return newAPIErrorResponse(common.NewErrBadRequest("SMREPO-IMPORTAASX-NOFILE package content is required"), http.StatusBadRequest, operation, "MissingFile"), nil
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return newAPIErrorResponse(common.NewInternalServerError("SMREPO-IMPORTAASX-SEEK "+err.Error()), http.StatusInternalServerError, operation, "SeekPackage"), nil
+	}
+
+	limits := common.AASXLimitsFromContext(ctx)
+	pkg, err := aasx.NewPackaging().OpenReadFromStream(file, limits.ReaderOptions()...)
+	if err != nil {
+		if errors.Is(err, aasx.ErrReaderLimitExceeded) {
+			return newAPIErrorResponse(common.NewErrPayloadTooLarge("SMREPO-IMPORTAASX-LIMIT "+err.Error()), http.StatusRequestEntityTooLarge, operation, "PackageTooLarge"), nil
+		}
+		return newAPIErrorResponse(common.NewErrBadRequest("SMREPO-IMPORTAASX-OPENPACKAGE "+err.Error()), http.StatusBadRequest, operation, "InvalidPackage"), nil
+	}
+	defer func() {
+		_ = pkg.Close()
+	}()
+
+	items, err := readSubmodelImportItems(pkg, limits.MaxPartExpandedSizeBytes)
+	if err != nil {
+		if errors.Is(err, aasx.ErrReaderLimitExceeded) || common.IsErrPayloadTooLarge(err) {
+			return newAPIErrorResponse(err, http.StatusRequestEntityTooLarge, operation, "PackageTooLarge"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "InvalidPackage"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "ReadPackage"), nil
+	}
+
+	atomic := submodelImportAtomic(ctx)
+	failures, err := s.target.ImportSubmodels(ctx, items, atomic)
+	if err != nil && len(failures) == 0 {
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "ImportSubmodels"), nil
+	}
+
+	result := submodelImportResult(items, failures, atomic)
+	status := http.StatusCreated
+	if len(failures) > 0 {
+		status = submodelImportResponseStatus(result.Failures)
+	}
+	return model.Response(status, map[string]any{
+		"messages":        asyncbulk.ToMessages(result.Failures),
+		"success":         result.Success,
+		"processedCount":  result.ProcessedCount,
+		"successfulCount": result.SuccessfulCount,
+		"failedCount":     result.FailedCount,
+		"summary":         result.Summary(),
+		"details":         result.Failures,
+	}), nil
+}
+
+func submodelImportAtomic(ctx context.Context) bool {
+	cfg, ok := common.ConfigFromContext(ctx)
+	if !ok || cfg == nil {
+		return common.DefaultConfig.GeneralAASXImportAtomic
+	}
+	return cfg.General.AASXImportAtomic
+}
+
+// submodelImportResult builds the per-submodel outcome of an import. Item
+// indexes refer to the submodels in package order.
+func submodelImportResult(items []persistencepostgresql.SubmodelImportItem, failures []persistencepostgresql.SubmodelImportFailure, atomic bool) asyncbulk.OperationResult {
+	identifiers := make([]string, 0, len(items))
+	for _, item := range items {
+		identifiers = append(identifiers, item.Submodel.ID())
+	}
+
+	itemFailures := make([]asyncbulk.ItemFailure, 0, len(failures))
+	for _, failure := range failures {
+		itemFailures = append(itemFailures, asyncbulk.ItemFailure{
+			Index:      failure.Index,
+			Identifier: failure.SubmodelID,
+			StatusCode: submodelImportFailureStatus(failure.Err),
+			Message:    failure.Err.Error(),
+		})
+	}
+	if atomic && len(itemFailures) > 0 {
+		itemFailures = asyncbulk.ExpandAtomicFailures(identifiers, itemFailures[0])
+	}
+
+	return asyncbulk.OperationResult{
+		Success:         len(itemFailures) == 0,
+		ProcessedCount:  len(identifiers),
+		SuccessfulCount: len(identifiers) - len(itemFailures),
+		FailedCount:     len(itemFailures),
+		Failures:        itemFailures,
+	}
+}
+
+func submodelImportFailureStatus(err error) int {
+	switch {
+	case common.IsErrConflict(err):
+		return http.StatusConflict
+	case common.IsErrDenied(err):
+		return http.StatusForbidden
+	case common.IsErrPayloadTooLarge(err):
+		return http.StatusRequestEntityTooLarge
+	case common.IsErrBadRequest(err) || common.IsErrNotFound(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// submodelImportResponseStatus returns the status shared by all failed items,
+// for example 409 when every failure is an identifier conflict, and 400 otherwise.
+func submodelImportResponseStatus(failures []asyncbulk.ItemFailure) int {
+	status := failures[0].StatusCode
+	for _, failure := range failures[1:] {
+		if failure.StatusCode != status {
+			return http.StatusBadRequest
+		}
+	}
+	return status
+}
+
+// readSubmodelImportItems parses the specification part of pkg and pairs the
+// File elements of every submodel with their supplementary parts.
+func readSubmodelImportItems(pkg *aasx.PackageRead, maxPartBytes uint64) ([]persistencepostgresql.SubmodelImportItem, error) {
+	specs, err := pkg.Specs()
+	if err != nil {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-READSPECS " + err.Error())
+	}
+	var spec *aasx.Part
+	for _, candidate := range specs {
+		if !isSubmodelImportJSONSpec(candidate) && !isSubmodelImportXMLSpec(candidate) {
+			continue
+		}
+		if spec != nil {
+			return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-MULTISPEC package holds more than one JSON or XML specification part")
+		}
+		spec = candidate
+	}
+	if spec == nil {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-NOSPEC package holds no JSON or XML specification part")
+	}
+
+	environment, err := readSubmodelImportEnvironment(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships, err := pkg.SupplementaryRelationships()
+	if err != nil {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-READSUPPL " + err.Error())
+	}
+	specPath := normalizeImportPartPath(spec.URI.Path)
+	supplementaries := map[string]*aasx.Part{}
+	for _, relationship := range relationships {
+		if normalizeImportPartPath(relationship.Spec.URI.Path) == specPath {
+			supplementaries[normalizeImportPartPath(relationship.Supplementary.URI.Path)] = relationship.Supplementary
+		}
+	}
+
+	items := make([]persistencepostgresql.SubmodelImportItem, 0, len(environment.Submodels()))
+	for _, submodel := range environment.Submodels() {
+		item := persistencepostgresql.SubmodelImportItem{Submodel: submodel}
+		walkSubmodelExportFiles(submodel.SubmodelElements(), "", func(idShortPath string, file *types.File) bool {
+			part, ok := supplementaries[resolveImportFileValue(*file.Value(), specPath)]
+			if !ok {
+				return true
+			}
+			item.Attachments = append(item.Attachments, persistencepostgresql.SubmodelImportAttachment{
+				IDShortPath: idShortPath,
+				FileName:    path.Base(part.URI.Path),
+				Open: func() (io.ReadCloser, error) {
+					return openImportPart(part, maxPartBytes)
+				},
+			})
+			return true
+		})
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func readSubmodelImportEnvironment(spec *aasx.Part) (types.IEnvironment, error) {
+	stream, err := spec.Stream()
+	if err != nil {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-OPENSPEC " + err.Error())
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	if isSubmodelImportJSONSpec(spec) {
+		var jsonable any
+		if err = json.NewDecoder(stream).Decode(&jsonable); err != nil {
+			if errors.Is(err, aasx.ErrReaderLimitExceeded) {
+				return nil, err
+			}
+			return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-DECODEJSON " + err.Error())
+		}
+		environment, parseErr := jsonization.EnvironmentFromJsonable(jsonable)
+		if parseErr != nil {
+			return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-PARSEJSON " + parseErr.Error())
+		}
+		return environment, nil
+	}
+
+	instance, err := xmlization.Unmarshal(xml.NewDecoder(stream))
+	if err != nil {
+		if errors.Is(err, aasx.ErrReaderLimitExceeded) {
+			return nil, err
+		}
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-PARSEXML " + err.Error())
+	}
+	environment, ok := instance.(types.IEnvironment)
+	if !ok {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-XMLNOTENV specification root is not an AAS environment")
+	}
+	return environment, nil
+}
+
+func isSubmodelImportJSONSpec(spec *aasx.Part) bool {
+	return strings.HasSuffix(strings.ToLower(spec.URI.Path), ".json") || strings.Contains(strings.ToLower(spec.ContentType), "json")
+}
+
+func isSubmodelImportXMLSpec(spec *aasx.Part) bool {
+	return strings.HasSuffix(strings.ToLower(spec.URI.Path), ".xml") || strings.Contains(strings.ToLower(spec.ContentType), "xml")
+}
+
+// resolveImportFileValue resolves a File value against the specification
+// part. Absolute URLs never refer to a package part and resolve to "".
+func resolveImportFileValue(value string, specPath string) string {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "://") {
+		return ""
+	}
+	reference, err := url.Parse(value)
+	if err != nil || reference.IsAbs() {
+		return ""
+	}
+	base := &url.URL{Path: specPath}
+	return normalizeImportPartPath(base.ResolveReference(reference).Path)
+}
+
+func normalizeImportPartPath(partPath string) string {
+	partPath = strings.ReplaceAll(strings.TrimSpace(partPath), "\\", "/")
+	if partPath == "" {
+		return ""
+	}
+	return path.Clean("/" + partPath)
+}
+
+type importPartStream struct {
+	io.Reader
+	io.Closer
+}
+
+func openImportPart(part *aasx.Part, maximum uint64) (io.ReadCloser, error) {
+	stream, err := part.Stream()
+	if err != nil {
+		return nil, common.NewErrBadRequest("SMREPO-IMPORTAASX-OPENPART " + err.Error())
+	}
+	return &importPartStream{
+		Reader: common.NewPayloadLimitReader(stream, maximum, "supplementary"),
+		Closer: stream,
+	}, nil
+}
@@ -0,0 +1,178 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/asyncbulk"
+	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeImportTarget stores imported submodels and reads their attachments.
+// Submodels listed in conflicts fail as already existing.
+type fakeImportTarget struct {
+	conflicts   map[string]bool
+	atomic      bool
+	submodels   []types.ISubmodel
+	attachments map[string]string
+}
+
+func (f *fakeImportTarget) ImportSubmodels(_ context.Context, items []persistencepostgresql.SubmodelImportItem, atomic bool) ([]persistencepostgresql.SubmodelImportFailure, error) {
+	f.atomic = atomic
+	f.attachments = map[string]string{}
+	var failures []persistencepostgresql.SubmodelImportFailure
+	for index, item := range items {
+		if f.conflicts[item.Submodel.ID()] {
+			err := common.NewErrConflict("SMREPO-TEST-CONFLICT " + item.Submodel.ID())
+			failures = append(failures, persistencepostgresql.SubmodelImportFailure{Index: index, SubmodelID: item.Submodel.ID(), Err: err})
+			if atomic {
+				f.submodels = nil
+				f.attachments = map[string]string{}
+				return failures, err
+			}
+			continue
+		}
+		for _, attachment := range item.Attachments {
+			reader, err := attachment.Open()
+			if err != nil {
+				return nil, err
+			}
+			content, err := io.ReadAll(reader)
+			_ = reader.Close()
+			if err != nil {
+				return nil, err
+			}
+			f.attachments[item.Submodel.ID()+"/"+attachment.IDShortPath] = string(content)
+		}
+		f.submodels = append(f.submodels, item.Submodel)
+	}
+	return failures, nil
+}
+
+func importContext(atomic bool) context.Context {
+	cfg := &common.Config{}
+	cfg.General.AASXImportAtomic = atomic
+	return common.ContextWithConfig(context.Background(), cfg)
+}
+
+func TestImportAASXPackageRoundTripsExport(t *testing.T) {
+	t.Parallel()
+
+	source := newFakeExportSource()
+	idShort := "Voltage"
+	value := "230"
+	property := types.NewProperty(types.DataTypeDefXSDInt)
+	property.SetIDShort(&idShort)
+	property.SetValue(&value)
+	source.elements["urn:example:sm:2"] = append(source.elements["urn:example:sm:2"], property)
+
+	payload := exportPackage(t, &SerializationAPIAPIService{source: source}, context.Background(), nil)
+
+	target := &fakeImportTarget{}
+	sut := &SerializationAPIAPIService{target: target}
+	response, err := sut.ImportAASXPackage(context.Background(), bytes.NewReader(payload))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body)
+	require.True(t, target.atomic, "imports are atomic by default")
+
+	// The export rewrote legacy File values in place, so the source elements
+	// hold exactly what the package describes.
+	require.Len(t, target.submodels, len(source.submodels))
+	for index, imported := range target.submodels {
+		expected := types.NewSubmodel(source.submodels[index].ID())
+		expected.SetSubmodelElements(source.elements[expected.ID()])
+		expectedJSON, jsonErr := jsonization.ToJsonable(expected)
+		require.NoError(t, jsonErr)
+		importedJSON, jsonErr := jsonization.ToJsonable(imported)
+		require.NoError(t, jsonErr)
+		require.Equal(t, expectedJSON, importedJSON)
+	}
+	require.Equal(t, map[string]string{
+		"urn:example:sm:1/Docs.Manual": "%PDF-manual",
+		"urn:example:sm:2/Photo":       "png-bytes",
+	}, target.attachments)
+}
+
+func TestImportAASXPackageReportsConflictsPerItem(t *testing.T) {
+	t.Parallel()
+
+	payload := exportPackage(t, &SerializationAPIAPIService{source: newFakeExportSource()}, context.Background(), nil)
+
+	tests := []struct {
+		name          string
+		atomic        bool
+		wantImported  int
+		wantSucceeded int
+		wantFailed    int
+	}{
+		{name: "best effort", atomic: false, wantImported: 1, wantSucceeded: 1, wantFailed: 1},
+		{name: "atomic", atomic: true, wantImported: 0, wantSucceeded: 0, wantFailed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := &fakeImportTarget{conflicts: map[string]bool{"urn:example:sm:1": true}}
+			sut := &SerializationAPIAPIService{target: target}
+			response, err := sut.ImportAASXPackage(importContext(tt.atomic), bytes.NewReader(payload))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusConflict, response.Code)
+			require.Len(t, target.submodels, tt.wantImported)
+
+			body, ok := response.Body.(map[string]any)
+			require.True(t, ok, "expected result map, got %T", response.Body)
+			require.Equal(t, asyncbulk.ResultSummary{Total: 2, Succeeded: tt.wantSucceeded, Failed: tt.wantFailed}, body["summary"])
+			details, ok := body["details"].([]asyncbulk.ItemFailure)
+			require.True(t, ok, "expected item failures, got %T", body["details"])
+			require.Len(t, details, tt.wantFailed)
+			require.Equal(t, "urn:example:sm:1", details[0].Identifier)
+			for _, detail := range details {
+				require.Equal(t, http.StatusConflict, detail.StatusCode)
+			}
+		})
+	}
+}
+
+func TestImportAASXPackageRejectsInvalidPackages(t *testing.T) {
+	t.Parallel()
+
+	target := &fakeImportTarget{}
+	sut := &SerializationAPIAPIService{target: target}
+	response, err := sut.ImportAASXPackage(context.Background(), bytes.NewReader([]byte("not a package")))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Empty(t, target.submodels)
+}
@@ -21,6 +21,8 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
 	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
+	submodelpath "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/path"
 	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
 	openapi "github.com/eclipse-basyx/basyx-go-components/pkg/submodelrepositoryapi"
 	"golang.org/x/sync/errgroup"
@@ -76,6 +79,20 @@ func newAPIErrorResponse(err error, status int, operation string, info string) g
 	return common.NewErrorResponse(err, status, componentName, operation, info)
 }
 
+// newSubmodelReadErrorResponse maps backend errors of submodel reads to a response.
+// A submodel hidden by ABAC is reported exactly like a missing one, so reads never
+// reveal whether an identifier exists; 403 stays reserved for denied writes.
+func newSubmodelReadErrorResponse(err error, operation string, step string) gen.ImplResponse {
+	switch {
+	case common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows) || common.IsErrDenied(err):
+		return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound")
+	case common.IsErrBadRequest(err):
+		return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest")
+	default:
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, step)
+	}
+}
+
 func newPostSubmodelElementByPathErrorResponse(err error) gen.ImplResponse {
 	const operation = "PostSubmodelElementByPathSubmodelRepo"
 
@@ -141,7 +158,10 @@ func buildLimitPtr(limit int32) *int {
 	return &parsedLimit
 }
 
-func mergeJSONObjects(base map[string]any, patch map[string]any) map[string]any {
+// MergeJSONObjects applies patch to base following RFC 7386: null values remove
+// the key, nested objects are merged recursively and any other value replaces
+// the stored one. Neither input is modified.
+func MergeJSONObjects(base map[string]any, patch map[string]any) map[string]any {
 	merged := make(map[string]any, len(base))
 	for key, value := range base {
 		merged[key] = value
@@ -157,7 +177,7 @@ func mergeJSONObjects(base map[string]any, patch map[string]any) map[string]any
 		baseMap, baseIsMap := baseValue.(map[string]any)
 		patchMap, patchIsMap := patchValue.(map[string]any)
 		if baseExists && baseIsMap && patchIsMap {
-			merged[key] = mergeJSONObjects(baseMap, patchMap)
+			merged[key] = MergeJSONObjects(baseMap, patchMap)
 			continue
 		}
 
@@ -167,8 +187,31 @@ func mergeJSONObjects(base map[string]any, patch map[string]any) map[string]any
 	return merged
 }
 
-func decodeSubmodelIdentifierOrAPIError(submodelIdentifier string, operation string) (string, gen.ImplResponse, bool) {
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+// MergeSubmodelMetadataPatch applies an RFC 7386 merge patch to the stored
+// submodel metadata: omitted fields are kept, explicit nulls remove the field
+// and nested objects such as administration are merged recursively. The
+// identifier and modelType are always taken from the stored submodel and
+// submodelElements are never part of a metadata patch.
+func MergeSubmodelMetadataPatch(existingJSON map[string]any, patchJSON map[string]any, submodelID string) (types.ISubmodel, error) {
+	mergedJSON := MergeJSONObjects(existingJSON, patchJSON)
+	mergedJSON["id"] = submodelID
+	mergedJSON["modelType"] = "Submodel"
+	delete(mergedJSON, "submodelElements")
+	return jsonization.SubmodelFromJsonable(mergedJSON)
+}
+
+// ValidateSubmodelMetadataPatchModelType rejects a metadata merge patch whose
+// modelType is set to anything other than "Submodel". Omitting modelType is
+// allowed because the stored value is kept.
+func ValidateSubmodelMetadataPatchModelType(patchJSON map[string]any) error {
+	if modelType, hasModelType := patchJSON["modelType"]; hasModelType && modelType != "Submodel" {
+		return errors.New("modelType for Submodel metadata must be 'Submodel'")
+	}
+	return nil
+}
+
+func decodeSubmodelIdentifierOrAPIError(ctx context.Context, submodelIdentifier string, operation string) (string, gen.ImplResponse, bool) {
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return "", newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), false
 	}
@@ -424,6 +467,46 @@ func toSubmodelElementMetadata(element types.ISubmodelElement) (map[string]any,
 	return jsonElement, nil
 }
 
+// submodelElementChildCountField carries the number of direct children of a
+// collection or list in its $metadata representation.
+const submodelElementChildCountField = "childCount"
+
+// submodelMaxDepthField carries the nesting depth of the deepest element in the
+// $metadata representation of a Submodel.
+const submodelMaxDepthField = "maxDepth"
+
+func hasCountableChildren(element types.ISubmodelElement) bool {
+	switch element.(type) {
+	case types.ISubmodelElementCollection, types.ISubmodelElementList:
+		return true
+	default:
+		return false
+	}
+}
+
+// applySubmodelElementChildCounts adds childCount to the metadata of every
+// collection or list in metadataByPath using one COUNT query. Nothing is added
+// when the backend withholds counts, for example under ABAC enforcement.
+func (s *SubmodelRepositoryAPIAPIService) applySubmodelElementChildCounts(ctx context.Context, submodelID string, metadataByPath map[string]map[string]any) error {
+	if len(metadataByPath) == 0 {
+		return nil
+	}
+	paths := make([]string, 0, len(metadataByPath))
+	for path := range metadataByPath {
+		paths = append(paths, path)
+	}
+	counts, err := s.submodelBackend.CountSubmodelElementChildren(ctx, submodelID, paths)
+	if err != nil {
+		return err
+	}
+	for path, metadata := range metadataByPath {
+		if count, ok := counts[path]; ok {
+			metadata[submodelElementChildCountField] = count
+		}
+	}
+	return nil
+}
+
 func sanitizeSubmodelElementMetadata(metadata map[string]any) {
 	modelType, _ := metadata["modelType"].(string)
 
@@ -433,8 +516,11 @@ func sanitizeSubmodelElementMetadata(metadata map[string]any) {
 	case "Range":
 		delete(metadata, "min")
 		delete(metadata, "max")
-	case "SubmodelElementCollection", "SubmodelElementList":
+	case "SubmodelElementCollection":
 		sanitizeSubmodelElementSliceField(metadata, "value")
+	case "SubmodelElementList":
+		sanitizeSubmodelElementSliceField(metadata, "value")
+		applySubmodelElementListMetadataDefaults(metadata)
 	case "Operation":
 		sanitizeOperationVariables(metadata, "inputVariables")
 		sanitizeOperationVariables(metadata, "outputVariables")
@@ -446,6 +532,15 @@ func sanitizeSubmodelElementMetadata(metadata map[string]any) {
 	}
 }
 
+// applySubmodelElementListMetadataDefaults makes the list attributes explicit so
+// clients can interpret a list without fetching its children. The metamodel
+// default for an omitted orderRelevant is true.
+func applySubmodelElementListMetadataDefaults(metadata map[string]any) {
+	if _, exists := metadata["orderRelevant"]; !exists {
+		metadata["orderRelevant"] = true
+	}
+}
+
 func sanitizeSubmodelElementSliceField(metadata map[string]any, field string) {
 	rawField, exists := metadata[field]
 	if !exists {
@@ -532,6 +627,13 @@ func getModelTypeLiteral(element types.ISubmodelElement) string {
 //   - cursor: Pagination cursor for continuing from previous results
 //   - level: Detail level for response (currently unused)
 //   - extent: Response extent specification for Blob values
+//   - kind: Optional modelling kind filter (Instance or Template)
+//   - hasElements: Optional element presence filter (true or false)
+//   - orderBy: Optional sort key (id, the default, or createdAt)
+//
+// A common.TotalCount in ctx receives the number of submodels matching the
+// filters across all pages. When cursor is set, the paging metadata also
+// carries the cursor of the previous page.
 //
 // Returns:
 //   - gen.ImplResponse: Response containing paginated submodel results
@@ -546,8 +648,15 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodels(
 	extent string,
 	createdFrom time.Time,
 	updatedFrom time.Time,
+	kind string,
+	hasElements string,
+	orderBy string,
 ) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodels"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
 	decodedCursor := ""
 	if cursor != "" {
@@ -573,39 +682,62 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodels(
 			return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "BadSemanticID"), nil
 		}
 	}
-
-	sms, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, limit, decodedCursor, idShort, decodedSemanticID, createdFrom, updatedFrom)
-	if err != nil {
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodels"), nil
+	kindFilter, kindErr := parseSubmodelKindFilter("SMREPO-GETALLSMS", kind)
+	if kindErr != nil {
+		return newAPIErrorResponse(kindErr, http.StatusBadRequest, operation, "InvalidKindParameter"), nil
 	}
-
-	eg, _ := errgroup.WithContext(ctx)
-	eg.SetLimit(8)
-
-	for index := range sms {
-		sm := sms[index]
-
-		eg.Go(func() error {
-			submodelElements, _, elementsErr := s.submodelBackend.GetSubmodelElements(ctx, sm.ID(), nil, "", normalizedExtent == extentWithBlobValue, level)
-			if elementsErr != nil {
-				return elementsErr
-			}
-
-			sm.SetSubmodelElements(submodelElements)
-			return nil
-		})
+	var hasElementsFilter *bool
+	if hasElements != "" {
+		parsedHasElements, parseErr := strconv.ParseBool(hasElements)
+		if parseErr != nil {
+			return newAPIErrorResponse(errors.New("SMREPO-GETALLSMS-BADHASELEMENTS hasElements must be true or false"), http.StatusBadRequest, operation, "InvalidHasElementsParameter"), nil
+		}
+		hasElementsFilter = &parsedHasElements
+	}
+	order := persistencepostgresql.SubmodelListOrderID
+	if orderBy != "" {
+		order = persistencepostgresql.SubmodelListOrder(orderBy)
+		if order != persistencepostgresql.SubmodelListOrderID && order != persistencepostgresql.SubmodelListOrderCreatedAt {
+			return newAPIErrorResponse(errors.New("SMREPO-GETALLSMS-BADORDERBY orderBy must be id or createdAt"), http.StatusBadRequest, operation, "InvalidOrderByParameter"), nil
+		}
 	}
 
-	if waitErr := eg.Wait(); waitErr != nil {
-		if common.IsErrNotFound(waitErr) || errors.Is(waitErr, sql.ErrNoRows) {
-			return newAPIErrorResponse(waitErr, http.StatusNotFound, operation, "SubmodelNotFound"), nil
+	listFailed := false
+	sms, nextCursor, err := loadSubmodelPage(limit, decodedCursor, func(pageLimit int32, pageCursor string) ([]types.ISubmodel, string, error) {
+		page, next, listErr := s.submodelBackend.GetSubmodelsByListFilters(ctx, pageLimit, pageCursor, idShort, decodedSemanticID, kindFilter, hasElementsFilter, order, createdFrom, updatedFrom)
+		listFailed = listErr != nil
+		return page, next, listErr
+	}, func(sm types.ISubmodel) error {
+		submodelElements, _, elementsErr := s.submodelBackend.GetSubmodelElements(ctx, sm.ID(), nil, "", normalizedExtent == extentWithBlobValue, level)
+		if elementsErr != nil {
+			return elementsErr
 		}
-		return newAPIErrorResponse(waitErr, http.StatusInternalServerError, operation, "GetSubmodelElements"), nil
+		sm.SetSubmodelElements(submodelElements)
+		return nil
+	})
+	if err != nil {
+		if listFailed {
+			return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodels"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElements"), nil
+	}
+	if total := common.TotalCountFromContext(ctx); total != nil {
+		count, countErr := s.submodelBackend.CountSubmodelsByListFilters(ctx, idShort, decodedSemanticID, kindFilter, hasElementsFilter, createdFrom, updatedFrom)
+		if countErr != nil {
+			return newAPIErrorResponse(countErr, http.StatusInternalServerError, operation, "CountSubmodels"), nil
+		}
+		total.Set(count)
+	}
+	prevCursor, err := s.submodelBackend.GetPreviousSubmodelCursor(ctx, limit, decodedCursor, idShort, decodedSemanticID, kindFilter, hasElementsFilter, order, createdFrom, updatedFrom)
+	if err != nil {
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetPreviousCursor"), nil
 	}
 
 	converted := make([]map[string]any, 0, len(sms))
 
+	languages := common.LanguageFilterFromContext(ctx)
 	for _, sm := range sms {
+		filterSubmodelLanguages(sm, languages)
 		jsonSubmodel, err := jsonization.ToJsonable(sm)
 		if err != nil {
 			return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "ToJsonable"), nil
@@ -619,9 +751,15 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodels(
 		encodedNextCursor = common.EncodeString(nextCursor)
 	}
 
+	encodedPrevCursor := ""
+	if prevCursor != "" {
+		encodedPrevCursor = common.EncodeString(prevCursor)
+	}
+
 	res := gen.GetSubmodelsResult{
 		PagingMetadata: gen.PagedResultPagingMetadata{
-			Cursor: encodedNextCursor,
+			Cursor:     encodedNextCursor,
+			PrevCursor: encodedPrevCursor,
 		},
 		Result: converted,
 	}
@@ -645,10 +783,11 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByID(
 	id string,
 	level string,
 	extent string,
+	fields string,
 ) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByID"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(id)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, id)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -660,24 +799,25 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByID(
 	if extentErr != nil {
 		return newAPIErrorResponse(extentErr, http.StatusBadRequest, operation, "InvalidExtentParameter"), nil
 	}
+	selection, fieldsErr := parseFieldSelection(fields, submodelFields)
+	if fieldsErr != nil {
+		return newAPIErrorResponse(fieldsErr, http.StatusBadRequest, operation, "InvalidFieldsParameter"), nil
+	}
 
-	sm, err := s.submodelBackend.GetSubmodelByID(ctx, string(decodedSubmodelIdentifier), level, false, normalizedExtent == extentWithBlobValue)
+	// Element trees are only loaded when the caller asked for them.
+	metadataOnly := !selection.includes("submodelElements")
+	sm, err := s.submodelBackend.GetSubmodelByID(ctx, string(decodedSubmodelIdentifier), level, metadataOnly, normalizedExtent == extentWithBlobValue)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
-		}
-		if common.IsErrNotFound(err) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
-		}
-		_, _ = fmt.Printf("[DEBUG] GetSubmodelByID: Error getting submodel '%s': %v\n", string(decodedSubmodelIdentifier), err)
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelByID"), nil
+		return newSubmodelReadErrorResponse(err, operation, "GetSubmodelByID"), nil
 	}
+	filterSubmodelLanguages(sm, common.LanguageFilterFromContext(ctx))
 	jsonSubmodel, err := jsonization.ToJsonable(sm)
 	if err != nil {
 		_, _ = fmt.Printf("[DEBUG] GetSubmodelByID: Error converting submodel '%s' to JSON: %v\n", string(decodedSubmodelIdentifier), err)
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "ToJsonable"), nil
 	}
 	deleteSubmodelElementsIfEmpty(jsonSubmodel)
+	selection.project(jsonSubmodel)
 	return gen.Response(200, jsonSubmodel), nil
 }
 
@@ -692,6 +832,10 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsRecentChanges(
 	cursor string,
 ) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelsRecentChanges"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
 	decodedCursor, decodeErr := common.DecodeString(cursor)
 	if decodeErr != nil {
@@ -710,7 +854,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsRecentChanges(
 		return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
 	}
 
-	submodels, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, normalizedLimit, decodedCursor, idShort, decodedSemanticID, createdFrom, updatedFrom)
+	submodels, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, normalizedLimit, decodedCursor, idShort, decodedSemanticID, nil, nil, persistencepostgresql.SubmodelListOrderID, createdFrom, updatedFrom)
 	if err != nil {
 		if common.IsErrBadRequest(err) {
 			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
@@ -780,7 +924,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIdAndDate(
 ) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByIdAndDate"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(id)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, id)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -821,7 +965,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSignedSubmodelByID(
 ) (gen.ImplResponse, error) {
 	const operation = "GetSignedSubmodelByID"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(id)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, id)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -847,7 +991,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSignedSubmodelByIDValueOnly(
 ) (gen.ImplResponse, error) {
 	const operation = "GetSignedSubmodelByIDValueOnly"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(id)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, id)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -882,7 +1026,7 @@ func (s *SubmodelRepositoryAPIAPIService) DeleteSubmodelByID(
 ) (gen.ImplResponse, error) {
 	const operation = "DeleteSubmodelByID"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(id)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, id)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -920,6 +1064,12 @@ func (s *SubmodelRepositoryAPIAPIService) PostSubmodel(
 ) (gen.ImplResponse, error) {
 	const operation = "PostSubmodel"
 
+	normalizeSubmodelBodyIdentifier(ctx, submodel)
+	if common.IsDryRun(ctx) {
+		err := s.submodelBackend.ValidateSubmodelCreate(ctx, submodel)
+		return newSubmodelDryRunResponse(err, operation, submodel.ID(), DryRunOutcomeCreate), nil
+	}
+
 	err := s.submodelBackend.CreateSubmodel(ctx, submodel)
 
 	if err != nil {
@@ -934,7 +1084,7 @@ func (s *SubmodelRepositoryAPIAPIService) PostSubmodel(
 			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "InvalidSubmodelData"), nil
 		}
 
-		_, _ = fmt.Println("Error creating submodel: " + err.Error())
+		common.Logger(ctx, "SMREPO").Error("PostSubmodel failed", "error", err)
 
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "CreateSubmodel"), nil
 	}
@@ -956,6 +1106,7 @@ func (s *SubmodelRepositoryAPIAPIService) PostSubmodel(
 //   - idShort: Short identifier for filtering
 //   - limit: Maximum number of results
 //   - cursor: Pagination cursor
+//   - kind: Optional modelling kind filter (Instance or Template)
 //
 // Returns:
 //   - gen.ImplResponse: Response with submodel metadata (when implemented)
@@ -965,8 +1116,13 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsMetadata(
 	semanticID string,
 	idShort string,
 	limit int32,
-	cursor string) (gen.ImplResponse, error) {
+	cursor string,
+	kind string) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelsMetadata"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
 	decodedCursor := ""
 	if cursor != "" {
@@ -986,7 +1142,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsMetadata(
 		}
 	}
 
-	submodels, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, limit, decodedCursor, idShort, decodedSemanticID, time.Time{}, time.Time{})
+	kindFilter, kindErr := parseSubmodelKindFilter("SMREPO-GETALLSMMETA", kind)
+	if kindErr != nil {
+		return newAPIErrorResponse(kindErr, http.StatusBadRequest, operation, "InvalidKindParameter"), nil
+	}
+
+	submodels, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, limit, decodedCursor, idShort, decodedSemanticID, kindFilter, nil, persistencepostgresql.SubmodelListOrderID, time.Time{}, time.Time{})
 	if err != nil {
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodels"), nil
 	}
@@ -1021,8 +1182,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsMetadata(
 // GetAllSubmodelsValueOnly - Returns all Submodels in their ValueOnly representation
 //
 //nolint:revive
-func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsValueOnly(ctx context.Context, semanticID string, idShort string, limit int32, cursor string, level string, extent string) (gen.ImplResponse, error) {
+func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsValueOnly(ctx context.Context, semanticID string, idShort string, limit int32, cursor string, level string, extent string, kind string) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelsValueOnly"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
 	decodedCursor := ""
 	if cursor != "" {
@@ -1050,7 +1215,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsValueOnly(ctx context.C
 		}
 	}
 
-	sms, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, limit, decodedCursor, idShort, decodedSemanticID, time.Time{}, time.Time{})
+	kindFilter, kindErr := parseSubmodelKindFilter("SMREPO-GETALLSMVALUE", kind)
+	if kindErr != nil {
+		return newAPIErrorResponse(kindErr, http.StatusBadRequest, operation, "InvalidKindParameter"), nil
+	}
+
+	sms, nextCursor, err := s.submodelBackend.GetSubmodelsByListFilters(ctx, limit, decodedCursor, idShort, decodedSemanticID, kindFilter, nil, persistencepostgresql.SubmodelListOrderID, time.Time{}, time.Time{})
 	if err != nil {
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodels"), nil
 	}
@@ -1107,9 +1277,13 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsValueOnly(ctx context.C
 // GetAllSubmodelsReference - Returns the References for all Submodels
 //
 //nolint:revive
-func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsReference(ctx context.Context, semanticID string, idShort string, limit int32, cursor string, level string) (gen.ImplResponse, error) {
+func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsReference(ctx context.Context, semanticID string, idShort string, limit int32, cursor string, level string, kind string) (gen.ImplResponse, error) {
 	_ = level
 	const operation = "GetAllSubmodelsReference"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
 	decodedCursor, decodeErr := common.DecodeString(cursor)
 	if decodeErr != nil {
@@ -1124,7 +1298,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsReference(ctx context.C
 		}
 	}
 
-	references, nextCursor, err := s.submodelBackend.GetSubmodelReferences(ctx, limit, decodedCursor, idShort, decodedSemanticID)
+	kindFilter, kindErr := parseSubmodelKindFilter("SMREPO-GETALLSMREF", kind)
+	if kindErr != nil {
+		return newAPIErrorResponse(kindErr, http.StatusBadRequest, operation, "InvalidKindParameter"), nil
+	}
+
+	references, nextCursor, err := s.submodelBackend.GetSubmodelReferences(ctx, limit, decodedCursor, idShort, decodedSemanticID, kindFilter)
 	if err != nil {
 		if common.IsErrBadRequest(err) {
 			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
@@ -1159,8 +1338,13 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsPath(
 	limit int32,
 	cursor string,
 	level string,
+	kind string,
 ) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelsPath"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 	if limit < 0 {
 		limitErr := common.NewErrBadRequest("SMREPO-GETALLSMPATH-BADLIMIT limit must be >= 0")
 		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "BadRequest"), nil
@@ -1188,6 +1372,11 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsPath(
 		}
 	}
 
+	kindFilter, kindErr := parseSubmodelKindFilter("SMREPO-GETALLSMPATH", kind)
+	if kindErr != nil {
+		return newAPIErrorResponse(kindErr, http.StatusBadRequest, operation, "InvalidKindParameter"), nil
+	}
+
 	cursorState := decodeAllSubmodelsPathCursorState(decodedCursor)
 	if cursorState.PathCursor != "" && cursorState.SubmodelCursor == "" {
 		badCursorErr := common.NewErrBadRequest("SMREPO-GETALLSMPATH-BADCURSOR path cursor requires submodel cursor")
@@ -1208,7 +1397,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsPath(
 	}
 
 	for len(resultPaths) < effectiveLimit {
-		references, nextSubmodelCursor, err := s.submodelBackend.GetSubmodelReferences(ctx, referencePageLimit, submodelCursor, idShort, decodedSemanticID)
+		references, nextSubmodelCursor, err := s.submodelBackend.GetSubmodelReferences(ctx, referencePageLimit, submodelCursor, idShort, decodedSemanticID, kindFilter)
 		if err != nil {
 			if common.IsErrBadRequest(err) {
 				return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
@@ -1314,15 +1503,25 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelsPath(
 func (s *SubmodelRepositoryAPIAPIService) PutSubmodelByID(ctx context.Context, submodelIdentifier string, submodel types.ISubmodel) (gen.ImplResponse, error) {
 	const operation = "PutSubmodelByID"
 
-	decodedIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
 
+	normalizeSubmodelBodyIdentifier(ctx, submodel)
 	if decodedIdentifier != submodel.ID() {
 		return newAPIErrorResponse(errors.New("submodel ID in path and body do not match"), http.StatusBadRequest, operation, "IdMismatch"), nil
 	}
 
+	if common.IsDryRun(ctx) {
+		isUpdate, err := s.submodelBackend.ValidateSubmodelPut(ctx, decodedIdentifier, submodel)
+		outcome := DryRunOutcomeCreate
+		if isUpdate {
+			outcome = DryRunOutcomeUpdate
+		}
+		return newSubmodelDryRunResponse(err, operation, decodedIdentifier, outcome), nil
+	}
+
 	isUpdate, err := s.submodelBackend.PutSubmodel(ctx, decodedIdentifier, submodel)
 	if err != nil {
 		if common.IsErrDenied(err) {
@@ -1360,7 +1559,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByID(ctx context.Context,
 	_ = level
 	const operation = "PatchSubmodelByID"
 
-	decodedIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1406,7 +1605,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByID(ctx context.Context,
 
 	patchJSON["id"] = decodedIdentifier
 
-	mergedJSON := mergeJSONObjects(existingJSON, patchJSON)
+	mergedJSON := MergeJSONObjects(existingJSON, patchJSON)
 
 	mergedSubmodel, mergedErr := jsonization.SubmodelFromJsonable(mergedJSON)
 	if mergedErr != nil {
@@ -1439,18 +1638,14 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByID(ctx context.Context,
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDMetadata(ctx context.Context, submodelIdentifier string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByIDMetadata"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
 
 	sm, err := s.submodelBackend.GetSubmodelByID(ctx, string(decodedSubmodelIdentifier), "", true, true)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
-		}
-
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelByID"), nil
+		return newSubmodelReadErrorResponse(err, operation, "GetSubmodelByID"), nil
 	}
 
 	jsonSubmodel, err := jsonization.ToJsonable(sm)
@@ -1460,6 +1655,14 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDMetadata(ctx context.Co
 
 	delete(jsonSubmodel, "submodelElements")
 
+	depth, hasDepth, depthErr := s.submodelBackend.GetSubmodelElementMaxDepth(ctx, decodedSubmodelIdentifier)
+	if depthErr != nil {
+		return newAPIErrorResponse(depthErr, http.StatusInternalServerError, operation, "GetSubmodelElementMaxDepth"), nil
+	}
+	if hasDepth {
+		jsonSubmodel[submodelMaxDepthField] = depth
+	}
+
 	return gen.Response(http.StatusOK, jsonSubmodel), nil
 }
 
@@ -1469,7 +1672,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDMetadata(ctx context.Co
 func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDMetadata(ctx context.Context, submodelIdentifier string, submodelMetadata gen.SubmodelMetadata) (gen.ImplResponse, error) {
 	const operation = "PatchSubmodelByIDMetadata"
 
-	decodedIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1485,10 +1688,9 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDMetadata(ctx context.
 	if rawPatchJSON, hasRawPatch := common.GetSubmodelMetadataPatch(ctx); hasRawPatch {
 		patchJSON = rawPatchJSON
 	}
-	if patchJSON["modelType"] != "Submodel" {
-		return newAPIErrorResponse(errors.New("modelType for Submodel metadata must be 'Submodel'"), http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
+	if modelTypeErr := ValidateSubmodelMetadataPatchModelType(patchJSON); modelTypeErr != nil {
+		return newAPIErrorResponse(modelTypeErr, http.StatusBadRequest, operation, "InvalidSubmodelMetadata"), nil
 	}
-	patchJSON["id"] = decodedIdentifier
 
 	existingSubmodel, getErr := s.submodelBackend.GetSubmodelByID(ctx, decodedIdentifier, "core", true, true)
 	if getErr != nil {
@@ -1503,9 +1705,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDMetadata(ctx context.
 		return newAPIErrorResponse(existingJSONErr, http.StatusInternalServerError, operation, "ToJsonableCurrentSubmodel"), nil
 	}
 
-	mergedJSON := mergeJSONObjects(existingJSON, patchJSON)
-	delete(mergedJSON, "submodelElements")
-	mergedSubmodel, mergedErr := jsonization.SubmodelFromJsonable(mergedJSON)
+	mergedSubmodel, mergedErr := MergeSubmodelMetadataPatch(existingJSON, patchJSON, decodedIdentifier)
 	if mergedErr != nil {
 		return newAPIErrorResponse(mergedErr, http.StatusBadRequest, operation, "InvalidPatchedSubmodel"), nil
 	}
@@ -1532,7 +1732,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDMetadata(ctx context.
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDValueOnly(ctx context.Context, submodelIdentifier string, level string, extent string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByIDValueOnly"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1547,10 +1747,15 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDValueOnly(ctx context.C
 
 	sm, err := s.submodelBackend.GetSubmodelByID(ctx, string(decodedSubmodelIdentifier), level, false, normalizedExtent == extentWithBlobValue)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
+		return newSubmodelReadErrorResponse(err, operation, "GetSubmodelByID"), nil
+	}
+	if idShortPath := common.IDShortPathFilterFromContext(ctx); idShortPath != "" {
+		if pathErr := restrictSubmodelToPath(sm, idShortPath); pathErr != nil {
+			if common.IsErrNotFound(pathErr) {
+				return newAPIErrorResponse(pathErr, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+			}
+			return newAPIErrorResponse(pathErr, http.StatusBadRequest, operation, "InvalidIdShortPath"), nil
 		}
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelByID"), nil
 	}
 	valueOnly, convErr := gen.SubmodelToValueOnly(sm)
 	if convErr != nil {
@@ -1567,7 +1772,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDValueOnly(ctx context
 	_ = level
 	const operation = "PatchSubmodelByIDValueOnly"
 
-	decodedIdentifier, err := common.DecodeString(submodelIdentifier)
+	decodedIdentifier, err := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if err != nil {
 		return newAPIErrorResponse(err, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1580,7 +1785,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDValueOnly(ctx context
 		if common.IsErrNotFound(err) {
 			return newAPIErrorResponse(err, http.StatusNotFound, operation, fmt.Sprintf("SubmodelOrSubmodelElementNotFound-%s", decodedIdentifier)), nil
 		}
-		_, _ = fmt.Println(err)
+		common.Logger(ctx, "SMREPO").Error("PatchSubmodelByIDValueOnly failed", "error", err)
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "InternalServerError"), nil
 	}
 	return gen.Response(http.StatusNoContent, nil), nil
@@ -1592,20 +1797,14 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelByIDValueOnly(ctx context
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDReference(ctx context.Context, submodelIdentifier string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByIDReference"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
 
 	reference, err := s.submodelBackend.GetSubmodelReference(ctx, decodedSubmodelIdentifier)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
-		}
-		if common.IsErrBadRequest(err) {
-			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
-		}
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelReference"), nil
+		return newSubmodelReadErrorResponse(err, operation, "GetSubmodelReference"), nil
 	}
 
 	jsonableRef, convErr := jsonization.ToJsonable(reference)
@@ -1622,7 +1821,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDReference(ctx context.C
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDPath(ctx context.Context, submodelIdentifier string, level string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelByIDPath"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1633,13 +1832,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDPath(ctx context.Contex
 
 	paths, err := s.submodelBackend.GetSubmodelElementPaths(ctx, decodedSubmodelIdentifier, level)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
-			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
-		}
-		if common.IsErrBadRequest(err) {
-			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
-		}
-		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElementPaths"), nil
+		return newSubmodelReadErrorResponse(err, operation, "GetSubmodelElementPaths"), nil
 	}
 
 	return gen.Response(http.StatusOK, paths), nil
@@ -1659,10 +1852,14 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelByIDPath(ctx context.Contex
 // Returns:
 //   - gen.ImplResponse: Response containing submodel elements
 //   - error: Error if the operation fails
-func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElements(ctx context.Context, submodelIdentifier string, limit int32, cursor string, level string, extent string) (gen.ImplResponse, error) {
+func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElements(ctx context.Context, submodelIdentifier string, limit int32, cursor string, level string, extent string, fields string) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelElements"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodelElements, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1689,8 +1886,14 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElements(ctx context.Con
 	if extentErr != nil {
 		return newAPIErrorResponse(extentErr, http.StatusBadRequest, operation, "InvalidExtentParameter"), nil
 	}
+	selection, fieldsErr := parseFieldSelection(fields, submodelElementFields)
+	if fieldsErr != nil {
+		return newAPIErrorResponse(fieldsErr, http.StatusBadRequest, operation, "InvalidFieldsParameter"), nil
+	}
 
-	elements, nextCursor, err := s.submodelBackend.GetSubmodelElements(ctx, string(decodedSubmodelIdentifier), limitPtr, decodedCursor, normalizedExtent == extentWithBlobValue, level)
+	// Blob bytes are never loaded when the value attribute is projected away.
+	includeBlobValue := normalizedExtent == extentWithBlobValue && selection.includes("value")
+	elements, nextCursor, err := s.submodelBackend.GetSubmodelElements(ctx, string(decodedSubmodelIdentifier), limitPtr, decodedCursor, includeBlobValue, level)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
 			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
@@ -1701,12 +1904,15 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElements(ctx context.Con
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElements"), nil
 	}
 
+	languages := common.LanguageFilterFromContext(ctx)
 	converted := make([]map[string]any, 0, len(elements))
 	for _, element := range elements {
+		filterElementLanguage(element, languages)
 		jsonSubmodelElement, convErr := jsonization.ToJsonable(element)
 		if convErr != nil {
 			return newAPIErrorResponse(convErr, http.StatusInternalServerError, operation, "ToJsonable"), nil
 		}
+		selection.project(jsonSubmodelElement)
 		converted = append(converted, jsonSubmodelElement)
 	}
 
@@ -1737,7 +1943,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElements(ctx context.Con
 func (s *SubmodelRepositoryAPIAPIService) PostSubmodelElementSubmodelRepo(ctx context.Context, submodelIdentifier string, submodelElement types.ISubmodelElement) (gen.ImplResponse, error) {
 	const operation = "PostSubmodelElementSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1770,10 +1976,13 @@ func (s *SubmodelRepositoryAPIAPIService) PostSubmodelElementSubmodelRepo(ctx co
 //
 //nolint:revive
 func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsMetadataSubmodelRepo(ctx context.Context, submodelIdentifier string, limit int32, cursor string) (gen.ImplResponse, error) {
-	_ = ctx
 	const operation = "GetAllSubmodelElementsMetadataSubmodelRepo"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodelElements, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1795,13 +2004,20 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsMetadataSubmodel
 	}
 
 	metadataResult := make([]map[string]any, 0, len(elements))
+	countableMetadata := make(map[string]map[string]any)
 	for _, element := range elements {
 		metadata, conversionErr := toSubmodelElementMetadata(element)
 		if conversionErr != nil {
 			return newAPIErrorResponse(conversionErr, http.StatusInternalServerError, operation, "ToSubmodelElementMetadata"), nil
 		}
+		if hasCountableChildren(element) && element.IDShort() != nil {
+			countableMetadata[*element.IDShort()] = metadata
+		}
 		metadataResult = append(metadataResult, metadata)
 	}
+	if countErr := s.applySubmodelElementChildCounts(ctx, decodedSubmodelIdentifier, countableMetadata); countErr != nil {
+		return newAPIErrorResponse(countErr, http.StatusInternalServerError, operation, "CountSubmodelElementChildren"), nil
+	}
 
 	res := submodelElementMetadataPageResult{
 		PagingMetadata: gen.PagedResultPagingMetadata{Cursor: common.EncodeString(nextCursor)},
@@ -1816,8 +2032,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsMetadataSubmodel
 //nolint:revive
 func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsValueOnlySubmodelRepo(ctx context.Context, submodelIdentifier string, limit int32, cursor string, level string, extent string) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelElementsValueOnlySubmodelRepo"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodelElements, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1894,8 +2114,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsValueOnlySubmode
 func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsReferenceSubmodelRepo(ctx context.Context, submodelIdentifier string, limit int32, cursor string, level string) (gen.ImplResponse, error) {
 	_ = level
 	const operation = "GetAllSubmodelElementsReferenceSubmodelRepo"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodelElements, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1938,8 +2162,12 @@ func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsReferenceSubmode
 //nolint:revive
 func (s *SubmodelRepositoryAPIAPIService) GetAllSubmodelElementsPathSubmodelRepo(ctx context.Context, submodelIdentifier string, limit int32, cursor string, level string) (gen.ImplResponse, error) {
 	const operation = "GetAllSubmodelElementsPathSubmodelRepo"
+	limit, limitErr := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodelElements, limit)
+	if limitErr != nil {
+		return newAPIErrorResponse(limitErr, http.StatusBadRequest, operation, "LimitTooLarge"), nil
+	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -1986,11 +2214,15 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathSubmodelRepo(c
 		return newAPIErrorResponse(extentErr, http.StatusBadRequest, operation, "InvalidExtentParameter"), nil
 	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
 
+	depth, hasDepth := common.ElementDepthFromContext(ctx)
+	if hasDepth {
+		level = "deep"
+	}
 	element, err := s.submodelBackend.GetSubmodelElement(ctx, decodedSubmodelIdentifier, idShortPath, normalizedExtent == extentWithBlobValue, level)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
@@ -2001,6 +2233,10 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathSubmodelRepo(c
 		}
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElement"), nil
 	}
+	if hasDepth {
+		limitElementDepth(element, depth)
+	}
+	filterElementLanguage(element, common.LanguageFilterFromContext(ctx))
 	converted, convErr := jsonization.ToJsonable(element)
 	if convErr != nil {
 		return newAPIErrorResponse(convErr, http.StatusInternalServerError, operation, "ToJsonable"), nil
@@ -2025,7 +2261,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathSubmodelRepo(c
 func (s *SubmodelRepositoryAPIAPIService) PutSubmodelElementByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, submodelElement types.ISubmodelElement, _ /*level*/ string) (gen.ImplResponse, error) {
 	const operation = "PutSubmodelElementByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2074,7 +2310,7 @@ func (s *SubmodelRepositoryAPIAPIService) PutSubmodelElementByPathSubmodelRepo(c
 func (s *SubmodelRepositoryAPIAPIService) PostSubmodelElementByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, submodelElement types.ISubmodelElement) (gen.ImplResponse, error) {
 	const operation = "PostSubmodelElementByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2095,18 +2331,21 @@ func (s *SubmodelRepositoryAPIAPIService) PostSubmodelElementByPathSubmodelRepo(
 // DeleteSubmodelElementByPathSubmodelRepo deletes a submodel element at a specified path within the submodel elements hierarchy.
 // The method removes the element and all its children from the specified path.
 //
+// An If-Match header stored in ctx makes the deletion conditional on the
+// element's current entity tag.
+//
 // Parameters:
-//   - ctx: Request context (currently unused)
+//   - ctx: Request context carrying the optional If-Match header
 //   - submodelIdentifier: Base64-encoded identifier of the parent submodel
 //   - idShortPath: Path to the submodel element to delete
 //
 // Returns:
-//   - gen.ImplResponse: Response indicating successful deletion (HTTP 204)
+//   - gen.ImplResponse: Response indicating successful deletion (HTTP 204) or HTTP 412 for a stale If-Match
 //   - error: Error if the deletion fails
 func (s *SubmodelRepositoryAPIAPIService) DeleteSubmodelElementByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
 	const operation = "DeleteSubmodelElementByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2116,6 +2355,9 @@ func (s *SubmodelRepositoryAPIAPIService) DeleteSubmodelElementByPathSubmodelRep
 		if common.IsErrDenied(err) {
 			return newAPIErrorResponse(err, http.StatusForbidden, operation, "Denied"), nil
 		}
+		if common.IsErrPreconditionFailed(err) {
+			return newAPIErrorResponse(err, http.StatusPreconditionFailed, operation, "PreconditionFailed"), nil
+		}
 		if common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows) {
 			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
 		}
@@ -2128,13 +2370,57 @@ func (s *SubmodelRepositoryAPIAPIService) DeleteSubmodelElementByPathSubmodelRep
 	return gen.Response(http.StatusNoContent, nil), nil
 }
 
+// DeleteSubmodelElementsByPathSubmodelRepo deletes several submodel elements of one submodel in a
+// single transaction. Every path refers to the element tree before the deletion, so deleting
+// "list[1]" and "list[3]" removes the second and fourth entry. Each affected SubmodelElementList
+// is renumbered once after all deletions.
+//
+// Parameters:
+//   - ctx: Request context
+//   - submodelIdentifier: Base64-encoded identifier of the parent submodel
+//   - idShortPaths: Paths of the submodel elements to delete
+//
+// Returns:
+//   - gen.ImplResponse: Response indicating successful deletion (HTTP 204)
+//   - error: Error if the deletion fails
+func (s *SubmodelRepositoryAPIAPIService) DeleteSubmodelElementsByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPaths []string) (gen.ImplResponse, error) {
+	const operation = "DeleteSubmodelElementsByPathSubmodelRepo"
+
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
+	if decodeErr != nil {
+		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
+	}
+
+	for _, idShortPath := range idShortPaths {
+		if strings.TrimSpace(idShortPath) == "" {
+			return newAPIErrorResponse(common.NewErrBadRequest("SMREPO-DELSMESBPATHS-EMPTYPATH idShortPath must not be empty"), http.StatusBadRequest, operation, "EmptyIdShortPath"), nil
+		}
+	}
+
+	err := s.submodelBackend.DeleteSubmodelElementsByPaths(ctx, decodedSubmodelIdentifier, idShortPaths)
+	if err != nil {
+		if common.IsErrDenied(err) {
+			return newAPIErrorResponse(err, http.StatusForbidden, operation, "Denied"), nil
+		}
+		if common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "DeleteSubmodelElementsByPaths"), nil
+	}
+
+	return gen.Response(http.StatusNoContent, nil), nil
+}
+
 // PatchSubmodelElementByPathSubmodelRepo - Updates an existing SubmodelElement
 //
 //nolint:revive
 func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, submodelElement types.ISubmodelElement, level string) (gen.ImplResponse, error) {
 	const operation = "PatchSubmodelElementByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2162,7 +2448,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathSubmodelRepo
 		return newAPIErrorResponse(patchJSONErr, http.StatusBadRequest, operation, "InvalidSubmodelElementData"), nil
 	}
 
-	mergedJSON := mergeJSONObjects(existingJSON, patchJSON)
+	mergedJSON := MergeJSONObjects(existingJSON, patchJSON)
 	if _, hasValuePatch := patchJSON["value"]; !hasValuePatch {
 		delete(mergedJSON, "value")
 	}
@@ -2196,7 +2482,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathSubmodelRepo
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathMetadataSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelElementByPathMetadataSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2216,6 +2502,11 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathMetadataSubmod
 	if conversionErr != nil {
 		return newAPIErrorResponse(conversionErr, http.StatusInternalServerError, operation, "ToSubmodelElementMetadata"), nil
 	}
+	if hasCountableChildren(element) {
+		if countErr := s.applySubmodelElementChildCounts(ctx, decodedSubmodelIdentifier, map[string]map[string]any{idShortPath: metadata}); countErr != nil {
+			return newAPIErrorResponse(countErr, http.StatusInternalServerError, operation, "CountSubmodelElementChildren"), nil
+		}
+	}
 
 	return gen.Response(http.StatusOK, metadata), nil
 }
@@ -2226,7 +2517,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathMetadataSubmod
 func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathMetadataSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, submodelElementMetadata gen.SubmodelElementMetadata) (gen.ImplResponse, error) {
 	const operation = "PatchSubmodelElementByPathMetadataSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2259,7 +2550,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathMetadataSubm
 		}
 	}
 
-	mergedJSON := mergeJSONObjects(existingJSON, patchJSON)
+	mergedJSON := MergeJSONObjects(existingJSON, patchJSON)
 	mergedElement, mergedErr := jsonization.SubmodelElementFromJsonable(mergedJSON)
 	if mergedErr != nil {
 		return newAPIErrorResponse(mergedErr, http.StatusBadRequest, operation, "InvalidPatchedSubmodelElement"), nil
@@ -2290,7 +2581,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathMetadataSubm
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathValueOnlySubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, level string, extent string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelElementByPathValueOnlySubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2302,6 +2593,10 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathValueOnlySubmo
 		return newAPIErrorResponse(extentErr, http.StatusBadRequest, operation, "InvalidExtentParameter"), nil
 	}
 
+	depth, hasDepth := common.ElementDepthFromContext(ctx)
+	if hasDepth {
+		level = "deep"
+	}
 	element, err := s.submodelBackend.GetSubmodelElement(ctx, string(decodedSubmodelIdentifier), idShortPath, normalizedExtent == extentWithBlobValue, level)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
@@ -2312,6 +2607,10 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathValueOnlySubmo
 		}
 		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElement"), nil
 	}
+	if hasDepth {
+		limitElementDepth(element, depth)
+	}
+	filterElementLanguage(element, common.LanguageFilterFromContext(ctx))
 	valueOnly, convErr := gen.SubmodelElementToValueOnly(element)
 	if convErr != nil {
 		return newAPIErrorResponse(convErr, http.StatusInternalServerError, operation, "SubmodelElementToValueOnly"), nil
@@ -2332,7 +2631,7 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathValueOnlySub
 	_ = level
 	const operation = "PatchSubmodelElementByPathValueOnlySubmodelRepo"
 
-	decodedIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2351,13 +2650,105 @@ func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementByPathValueOnlySub
 	return gen.Response(http.StatusNoContent, nil), nil
 }
 
+// PatchSubmodelElementsValueOnlySubmodelRepo updates the values of several submodel elements of one
+// submodel in one transaction. The body maps idShortPaths to value-only representations.
+//
+// Without atomic, failing paths are skipped and reported while all other paths are committed. With
+// atomic, the first failing path rolls back every update.
+//
+// Returns:
+//   - gen.ImplResponse: HTTP 204 when every path was updated, otherwise HTTP 400 with per-path results
+//   - error: Always nil; failures are encoded in the response
+func (s *SubmodelRepositoryAPIAPIService) PatchSubmodelElementsValueOnlySubmodelRepo(ctx context.Context, submodelIdentifier string, values gen.SubmodelValue, atomic bool) (gen.ImplResponse, error) {
+	const operation = "PatchSubmodelElementsValueOnlySubmodelRepo"
+
+	decodedIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
+	if decodeErr != nil {
+		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
+	}
+	if len(values) == 0 {
+		return newAPIErrorResponse(common.NewErrBadRequest("SMREPO-PATCHSMEVALUES-EMPTYBODY request body must map at least one idShortPath to a value"), http.StatusBadRequest, operation, "EmptyBody"), nil
+	}
+
+	failures, err := s.submodelBackend.UpdateSubmodelElementValues(ctx, decodedIdentifier, values, atomic)
+	if err != nil && len(failures) == 0 {
+		if common.IsErrNotFound(err) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "UpdateSubmodelElementValues"), nil
+	}
+	if len(failures) == 0 {
+		return gen.Response(http.StatusNoContent, nil), nil
+	}
+
+	result := submodelElementValuesResult(values, failures, atomic)
+	return gen.Response(http.StatusBadRequest, map[string]any{
+		"messages":        asyncbulk.ToMessages(result.Failures),
+		"success":         false,
+		"processedCount":  result.ProcessedCount,
+		"successfulCount": result.SuccessfulCount,
+		"failedCount":     result.FailedCount,
+		"summary":         result.Summary(),
+		"details":         result.Failures,
+	}), nil
+}
+
+// submodelElementValuesResult builds the per-path outcome of a bulk value update. Item indexes
+// refer to the idShortPaths in lexical order, the order in which they were applied.
+func submodelElementValuesResult(values gen.SubmodelValue, failures []persistencepostgresql.SubmodelElementValueFailure, atomic bool) asyncbulk.OperationResult {
+	paths := make([]string, 0, len(values))
+	for path := range values {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	indexes := make(map[string]int, len(paths))
+	for index, path := range paths {
+		indexes[path] = index
+	}
+
+	itemFailures := make([]asyncbulk.ItemFailure, 0, len(failures))
+	for _, failure := range failures {
+		itemFailures = append(itemFailures, asyncbulk.ItemFailure{
+			Index:      indexes[failure.IDShortPath],
+			Identifier: failure.IDShortPath,
+			StatusCode: submodelElementValueFailureStatus(failure.Err),
+			Message:    failure.Err.Error(),
+		})
+	}
+	if atomic {
+		itemFailures = asyncbulk.ExpandAtomicFailures(paths, itemFailures[0])
+	}
+
+	return asyncbulk.OperationResult{
+		Success:         false,
+		ProcessedCount:  len(paths),
+		SuccessfulCount: len(paths) - len(itemFailures),
+		FailedCount:     len(itemFailures),
+		Failures:        itemFailures,
+	}
+}
+
+func submodelElementValueFailureStatus(err error) int {
+	switch {
+	case common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case common.IsErrBadRequest(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // GetSubmodelElementByPathReferenceSubmodelRepo - Returns the Referee of a specific submodel element from the Submodel at a specified path
 //
 //nolint:revive
 func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathReferenceSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
 	const operation = "GetSubmodelElementByPathReferenceSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2425,6 +2816,105 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathReferenceSubmo
 	return gen.Response(http.StatusOK, jsonableRef), nil
 }
 
+// GetSubmodelElementParentSubmodelRepo returns the element that contains the submodel element at idShortPath.
+// Top-level elements are contained directly in the Submodel, so its reference is returned instead.
+func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementParentSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, level string, extent string) (gen.ImplResponse, error) {
+	const operation = "GetSubmodelElementParentSubmodelRepo"
+
+	if !isLevelValid(level) {
+		return newAPIErrorResponse(errors.New("invalid level parameter"), http.StatusBadRequest, operation, "InvalidLevelParameter"), nil
+	}
+	normalizedExtent, extentErr := normalizeExtent(extent)
+	if extentErr != nil {
+		return newAPIErrorResponse(extentErr, http.StatusBadRequest, operation, "InvalidExtentParameter"), nil
+	}
+
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
+	if decodeErr != nil {
+		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
+	}
+
+	parentPath, pathErr := submodelpath.ParentIDShortPath(idShortPath)
+	if pathErr != nil {
+		badRequest := common.NewErrBadRequest("SMREPO-GETSMEPARENT-INVALIDPATH " + pathErr.Error())
+		return newAPIErrorResponse(badRequest, http.StatusBadRequest, operation, "InvalidIdShortPath"), nil
+	}
+
+	// The element itself must be visible, otherwise its parent would leak its existence.
+	if _, err := s.submodelBackend.GetSubmodelElement(ctx, decodedSubmodelIdentifier, idShortPath, false, "core"); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElement"), nil
+	}
+
+	if parentPath == "" {
+		reference, err := s.submodelBackend.GetSubmodelReference(ctx, decodedSubmodelIdentifier)
+		if err != nil {
+			return newSubmodelReadErrorResponse(err, operation, "GetSubmodelReference"), nil
+		}
+		jsonableRef, convErr := jsonization.ToJsonable(reference)
+		if convErr != nil {
+			return newAPIErrorResponse(convErr, http.StatusInternalServerError, operation, "ToJsonable"), nil
+		}
+		return gen.Response(http.StatusOK, jsonableRef), nil
+	}
+
+	parent, err := s.submodelBackend.GetSubmodelElement(ctx, decodedSubmodelIdentifier, parentPath, normalizedExtent == extentWithBlobValue, level)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElement"), nil
+	}
+	filterElementLanguage(parent, common.LanguageFilterFromContext(ctx))
+	converted, convErr := jsonization.ToJsonable(parent)
+	if convErr != nil {
+		return newAPIErrorResponse(convErr, http.StatusInternalServerError, operation, "ToJsonable"), nil
+	}
+
+	return gen.Response(http.StatusOK, converted), nil
+}
+
+// GetSubmodelElementAncestorsSubmodelRepo returns the elements that contain the submodel element at idShortPath,
+// ordered from the top-level element down to the direct parent. Each entry carries idShort, modelType and
+// idShortPath. A top-level element returns an empty list.
+func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementAncestorsSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
+	const operation = "GetSubmodelElementAncestorsSubmodelRepo"
+
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
+	if decodeErr != nil {
+		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
+	}
+
+	// The element itself must be visible, otherwise its ancestors would leak its existence.
+	if _, err := s.submodelBackend.GetSubmodelElement(ctx, decodedSubmodelIdentifier, idShortPath, false, "core"); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElement"), nil
+	}
+
+	ancestors, err := s.submodelBackend.GetSubmodelElementAncestors(ctx, decodedSubmodelIdentifier, idShortPath)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || common.IsErrNotFound(err) {
+			return newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelElementNotFound"), nil
+		}
+		if common.IsErrBadRequest(err) {
+			return newAPIErrorResponse(err, http.StatusBadRequest, operation, "BadRequest"), nil
+		}
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "GetSubmodelElementAncestors"), nil
+	}
+
+	return gen.Response(http.StatusOK, ancestors), nil
+}
+
 // GetSubmodelElementByPathPathSubmodelRepo - Returns a specific submodel element from the Submodel at a specified path in the Path notation
 //
 //nolint:revive
@@ -2435,7 +2925,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathPathSubmodelRe
 		return newAPIErrorResponse(errors.New("invalid level parameter"), http.StatusBadRequest, operation, "InvalidLevelParameter"), nil
 	}
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2460,7 +2950,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetSubmodelElementByPathPathSubmodelRe
 func (s *SubmodelRepositoryAPIAPIService) GetFileByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
 	const operation = "GetFileByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2513,7 +3003,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetFileByPathSubmodelRepo(ctx context.
 func (s *SubmodelRepositoryAPIAPIService) PutFileByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string, fileName string, file io.Reader) (gen.ImplResponse, error) {
 	const operation = "PutFileByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2574,7 +3064,7 @@ func (s *SubmodelRepositoryAPIAPIService) PutFileByPathSubmodelRepo(ctx context.
 func (s *SubmodelRepositoryAPIAPIService) DeleteFileByPathSubmodelRepo(ctx context.Context, submodelIdentifier string, idShortPath string) (gen.ImplResponse, error) {
 	const operation = "DeleteFileByPathSubmodelRepo"
 
-	decodedSubmodelIdentifier, decodeErr := common.DecodeString(submodelIdentifier)
+	decodedSubmodelIdentifier, decodeErr := decodeSubmodelIdentifier(ctx, submodelIdentifier)
 	if decodeErr != nil {
 		return newAPIErrorResponse(decodeErr, http.StatusBadRequest, operation, "MalformedSubmodelIdentifier"), nil
 	}
@@ -2606,7 +3096,7 @@ func (s *SubmodelRepositoryAPIAPIService) InvokeOperationSubmodelRepo(ctx contex
 		return s.InvokeOperationAsync(ctx, submodelIdentifier, idShortPath, operationRequest)
 	}
 
-	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(submodelIdentifier, operation)
+	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(ctx, submodelIdentifier, operation)
 	if !ok {
 		return response, nil
 	}
@@ -2666,7 +3156,7 @@ func (s *SubmodelRepositoryAPIAPIService) InvokeOperationValueOnly(ctx context.C
 func (s *SubmodelRepositoryAPIAPIService) InvokeOperationAsync(ctx context.Context, submodelIdentifier string, idShortPath string, operationRequest gen.OperationRequest) (gen.ImplResponse, error) {
 	const operation = "InvokeOperationAsync"
 
-	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(submodelIdentifier, operation)
+	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(ctx, submodelIdentifier, operation)
 	if !ok {
 		return response, nil
 	}
@@ -2763,7 +3253,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetOperationAsyncStatus(ctx context.Co
 	_ = ctx
 	const operation = "GetOperationAsyncStatus"
 
-	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(submodelIdentifier, operation)
+	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(ctx, submodelIdentifier, operation)
 	if !ok {
 		return response, nil
 	}
@@ -2797,7 +3287,7 @@ func (s *SubmodelRepositoryAPIAPIService) GetOperationAsyncResult(ctx context.Co
 	_ = ctx
 	const operation = "GetOperationAsyncResult"
 
-	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(submodelIdentifier, operation)
+	decodedSubmodelIdentifier, response, ok := decodeSubmodelIdentifierOrAPIError(ctx, submodelIdentifier, operation)
 	if !ok {
 		return response, nil
 	}
@@ -2858,46 +3348,34 @@ func (s *SubmodelRepositoryAPIAPIService) QuerySubmodels(
 ) (gen.ImplResponse, error) {
 	querySelectionCtx := auth.MergeQueryFilter(ctx, query)
 
-	sms, nextCursor, err := s.submodelBackend.GetSubmodels(querySelectionCtx, limit, cursor, "", "", time.Time{}, time.Time{})
+	listFailed := false
+	sms, nextCursor, err := loadSubmodelPage(limit, cursor, func(pageLimit int32, pageCursor string) ([]types.ISubmodel, string, error) {
+		page, next, listErr := s.submodelBackend.GetSubmodels(querySelectionCtx, pageLimit, pageCursor, "", "", time.Time{}, time.Time{})
+		listFailed = listErr != nil
+		return page, next, listErr
+	}, func(sm types.ISubmodel) error {
+		submodelElements, _, elementsErr := s.submodelBackend.GetSubmodelElements(querySelectionCtx, sm.ID(), nil, "", true, "")
+		if elementsErr != nil {
+			return elementsErr
+		}
+		sm.SetSubmodelElements(submodelElements)
+		return nil
+	})
 	if err != nil {
 		switch {
-		case common.IsErrBadRequest(err):
+		case listFailed && common.IsErrBadRequest(err):
 			return common.NewErrorResponse(
 				err, http.StatusBadRequest, "SMREPO", "QuerySubmodels", "BadRequest",
 			), nil
-		default:
+		case listFailed:
 			return common.NewErrorResponse(
 				err, http.StatusInternalServerError, "SMREPO", "QuerySubmodels", "InternalServerError",
 			), err
-		}
-	}
-
-	eg, _ := errgroup.WithContext(ctx)
-	eg.SetLimit(8)
-
-	for index := range sms {
-		sm := sms[index]
-		eg.Go(func() error {
-			elementQueryCtx := auth.MergeQueryFilter(ctx, query)
-			submodelElements, _, elementsErr := s.submodelBackend.GetSubmodelElements(elementQueryCtx, sm.ID(), nil, "", true, "")
-			if elementsErr != nil {
-				return elementsErr
-			}
-
-			sm.SetSubmodelElements(submodelElements)
-			return nil
-		})
-	}
-
-	if waitErr := eg.Wait(); waitErr != nil {
-		if common.IsErrNotFound(waitErr) || errors.Is(waitErr, sql.ErrNoRows) {
+		default:
 			return common.NewErrorResponse(
-				waitErr, http.StatusNotFound, "SMREPO", "QuerySubmodels", "SubmodelNotFound",
-			), nil
+				err, http.StatusInternalServerError, "SMREPO", "QuerySubmodels", "GetSubmodelElements",
+			), err
 		}
-		return common.NewErrorResponse(
-			waitErr, http.StatusInternalServerError, "SMREPO", "QuerySubmodels", "GetSubmodelElements",
-		), waitErr
 	}
 
 	converted := make([]map[string]any, 0, len(sms))
@@ -28,14 +28,22 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/asyncbulk"
 	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
+	auth "github.com/eclipse-basyx/basyx-go-components/internal/common/security"
 	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
 	openapi "github.com/eclipse-basyx/basyx-go-components/pkg/submodelrepositoryapi"
 	"github.com/stretchr/testify/require"
@@ -135,6 +143,68 @@ func TestGetSubmodelByIDPathRejectsInvalidLevel(t *testing.T) {
 	require.Equal(t, 400, response.Code)
 }
 
+func TestGetAllSubmodelsRejectsInvalidKind(t *testing.T) {
+	t.Parallel()
+
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+
+	response, err := sut.GetAllSubmodels(contextWithABACDisabled(t), "", "", 10, "", "deep", "", time.Time{}, time.Time{}, "Instances", "", "")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+}
+
+func TestGetAllSubmodelsListVariantsRejectInvalidKind(t *testing.T) {
+	t.Parallel()
+
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+	ctx := contextWithABACDisabled(t)
+
+	response, err := sut.GetAllSubmodelsMetadata(ctx, "", "", 10, "", "Instances")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "SMREPO-GETALLSMMETA-BADKIND")
+
+	response, err = sut.GetAllSubmodelsReference(ctx, "", "", 10, "", "", "Instances")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "SMREPO-GETALLSMREF-BADKIND")
+}
+
+func TestGetAllSubmodelsRejectsUnknownOrderBy(t *testing.T) {
+	t.Parallel()
+
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+
+	response, err := sut.GetAllSubmodels(contextWithABACDisabled(t), "", "", 10, "", "deep", "", time.Time{}, time.Time{}, "", "", "updatedAt")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "SMREPO-GETALLSMS-BADORDERBY")
+}
+
+func TestGetAllSubmodelsRejectsLimitAboveConfiguredMaximum(t *testing.T) {
+	t.Parallel()
+
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+	ctx := common.ContextWithConfig(context.Background(), &common.Config{General: common.GeneralConfig{DefaultPageLimit: 10, MaxPageLimit: 50}})
+
+	// The invalid kind is checked after the limit, so a 400 without the limit
+	// code shows the boundary value was accepted.
+	response, err := sut.GetAllSubmodels(ctx, "", "", 50, "", "deep", "", time.Time{}, time.Time{}, "Instances", "", "")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.NotContains(t, fmt.Sprint(response.Body), "COMMON-PAGELIMIT-EXCEEDED")
+
+	response, err = sut.GetAllSubmodels(ctx, "", "", 51, "", "deep", "", time.Time{}, time.Time{}, "Instances", "", "")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "COMMON-PAGELIMIT-EXCEEDED")
+
+	response, err = sut.GetAllSubmodelElements(ctx, common.EncodeString("urn:example:sm"), 51, "", "deep", "", "")
+	require.NoError(t, err)
+	require.Equal(t, 400, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "COMMON-PAGELIMIT-EXCEEDED")
+}
+
 func TestInvokeOperationValueOnlyReturnsBadRequest(t *testing.T) {
 	t.Parallel()
 
@@ -154,6 +224,57 @@ func TestPostSubmodelElementByPathSubmodelRepoMapsDeniedToForbidden(t *testing.T
 	require.Equal(t, http.StatusForbidden, response.Code)
 }
 
+func TestSubmodelReadErrorResponseHidesDeniedSubmodels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "missing", err: common.NewErrNotFound("urn:example:sm:missing"), want: http.StatusNotFound},
+		{name: "denied", err: common.NewErrDenied("SMREPO-GETSM-ABACDENIED submodel is not accessible"), want: http.StatusNotFound},
+		{name: "bad request", err: common.NewErrBadRequest("SMREPO-GETSM-BADREQ invalid"), want: http.StatusBadRequest},
+		{name: "internal", err: fmt.Errorf("connection reset"), want: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			response := newSubmodelReadErrorResponse(tt.err, "GetSubmodelByID", "GetSubmodelByID")
+			require.Equal(t, tt.want, response.Code)
+		})
+	}
+}
+
+func TestGetSubmodelByIDReturnsNotFoundForSubmodelHiddenByABAC(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	backend, err := persistencepostgresql.NewSubmodelDatabaseFromDB(db, nil, "strict")
+	require.NoError(t, err)
+	sut := NewSubmodelRepositoryAPIAPIService(*backend)
+
+	// The submodel exists, but the read rule evaluates to FALSE for it.
+	hidden := false
+	expr := grammar.LogicalExpression{Boolean: &hidden}
+	ctx := auth.WithQueryFilter(contextWithABACDisabled(t), &auth.QueryFilter{Formula: &expr})
+
+	mock.ExpectQuery(`SELECT .*FROM .*submodel.*FALSE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	response, err := sut.GetSubmodelByID(ctx, common.EncodeString("urn:example:sm:hidden"), "deep", "", "idShort")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, response.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetOperationAsyncStatusReturnsRedirectWithLocation(t *testing.T) {
 	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
 
@@ -178,3 +299,397 @@ func TestGetOperationAsyncStatusReturnsRedirectWithLocation(t *testing.T) {
 	require.True(t, ok)
 	require.True(t, strings.Contains(redirect.Location, "/operation-results/"))
 }
+
+func TestMergeSubmodelMetadataPatchOnlyUpdatesDescription(t *testing.T) {
+	t.Parallel()
+
+	existingJSON := map[string]any{
+		"modelType": "Submodel",
+		"id":        "urn:example:sm:merge",
+		"idShort":   "merge",
+		"category":  "PARAMETER",
+		"kind":      "Instance",
+		"administration": map[string]any{
+			"version":  "1",
+			"revision": "2",
+		},
+		"description": []any{
+			map[string]any{"language": "en", "text": "old"},
+		},
+	}
+	patchJSON := map[string]any{
+		"description": []any{
+			map[string]any{"language": "en", "text": "new"},
+		},
+	}
+
+	merged, err := MergeSubmodelMetadataPatch(existingJSON, patchJSON, "urn:example:sm:merge")
+	require.NoError(t, err)
+
+	require.Equal(t, "urn:example:sm:merge", merged.ID())
+	require.Equal(t, "new", merged.Description()[0].Text())
+	require.NotNil(t, merged.Category())
+	require.Equal(t, "PARAMETER", *merged.Category())
+	require.NotNil(t, merged.Kind())
+	require.Equal(t, types.ModellingKindInstance, *merged.Kind())
+	require.NotNil(t, merged.Administration())
+	require.Equal(t, "1", *merged.Administration().Version())
+	require.Equal(t, "2", *merged.Administration().Revision())
+}
+
+func TestMergeSubmodelMetadataPatchNullClearsFieldAndMergesNestedObjects(t *testing.T) {
+	t.Parallel()
+
+	existingJSON := map[string]any{
+		"modelType": "Submodel",
+		"id":        "urn:example:sm:merge-null",
+		"category":  "PARAMETER",
+		"administration": map[string]any{
+			"version":  "1",
+			"revision": "2",
+		},
+	}
+	patchJSON := map[string]any{
+		"category":       nil,
+		"administration": map[string]any{"revision": "3"},
+	}
+
+	merged, err := MergeSubmodelMetadataPatch(existingJSON, patchJSON, "urn:example:sm:merge-null")
+	require.NoError(t, err)
+
+	require.Nil(t, merged.Category())
+	require.Equal(t, "1", *merged.Administration().Version())
+	require.Equal(t, "3", *merged.Administration().Revision())
+}
+
+func TestApplySubmodelElementChildCountsReportsCollectionChildren(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	backend, err := persistencepostgresql.NewSubmodelDatabaseFromDB(db, nil, "strict")
+	require.NoError(t, err)
+	sut := NewSubmodelRepositoryAPIAPIService(*backend)
+
+	mock.ExpectQuery(`SELECT "parent"."idshort_path", COUNT\("child"."id"\)`).
+		WithArgs("urn:example:sm:count", "sensors").
+		WillReturnRows(sqlmock.NewRows([]string{"idshort_path", "count"}).AddRow("sensors", 3))
+
+	metadata := map[string]any{"modelType": "SubmodelElementCollection", "idShort": "sensors"}
+	require.NoError(t, sut.applySubmodelElementChildCounts(contextWithABACDisabled(t), "urn:example:sm:count", map[string]map[string]any{"sensors": metadata}))
+
+	require.Equal(t, 3, metadata[submodelElementChildCountField])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHasCountableChildrenOnlyForCollectionsAndLists(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, hasCountableChildren(types.NewSubmodelElementCollection()))
+	require.True(t, hasCountableChildren(types.NewSubmodelElementList(types.AASSubmodelElementsProperty)))
+	require.False(t, hasCountableChildren(types.NewProperty(types.DataTypeDefXSDString)))
+}
+
+func TestSubmodelElementListMetadataReportsListAttributes(t *testing.T) {
+	t.Parallel()
+
+	idShort := "temperatures"
+	child := types.NewProperty(types.DataTypeDefXSDInt)
+	valueType := types.DataTypeDefXSDInt
+	orderRelevant := false
+	list := types.NewSubmodelElementList(types.AASSubmodelElementsProperty)
+	list.SetIDShort(&idShort)
+	list.SetValueTypeListElement(&valueType)
+	list.SetOrderRelevant(&orderRelevant)
+	list.SetValue([]types.ISubmodelElement{child})
+
+	metadata, err := toSubmodelElementMetadata(list)
+	require.NoError(t, err)
+	require.Equal(t, false, metadata["orderRelevant"])
+	require.Equal(t, "Property", metadata["typeValueListElement"])
+	require.Equal(t, "xs:int", metadata["valueTypeListElement"])
+
+	list.SetOrderRelevant(nil)
+	metadata, err = toSubmodelElementMetadata(list)
+	require.NoError(t, err)
+	require.Equal(t, true, metadata["orderRelevant"])
+	require.Equal(t, "Property", metadata["typeValueListElement"])
+}
+
+func TestFieldSelectionOmitsUnrequestedAttributes(t *testing.T) {
+	t.Parallel()
+
+	idShort := "payload"
+	blob := types.NewBlob()
+	blob.SetIDShort(&idShort)
+	blob.SetValue([]byte("heavy"))
+	blob.SetSemanticID(types.NewReference(types.ReferenceTypesExternalReference, []types.IKey{types.NewKey(types.KeyTypesGlobalReference, "urn:example:semantic")}))
+	blob.SetDescription([]types.ILangStringTextType{types.NewLangStringTextType("en", "large blob")})
+
+	jsonable, err := jsonization.ToJsonable(blob)
+	require.NoError(t, err)
+
+	selection, err := parseFieldSelection("idShort, modelType", submodelElementFields)
+	require.NoError(t, err)
+	require.False(t, selection.includes("value"))
+	require.False(t, selection.includes("submodelElements"))
+
+	selection.project(jsonable)
+	require.Equal(t, map[string]any{"idShort": "payload", "modelType": "Blob"}, jsonable)
+}
+
+func TestParseFieldSelectionRejectsEmptyEntries(t *testing.T) {
+	t.Parallel()
+
+	selection, err := parseFieldSelection("", submodelElementFields)
+	require.NoError(t, err)
+	require.Nil(t, selection)
+	require.True(t, selection.includes("value"))
+
+	_, err = parseFieldSelection("idShort,,modelType", submodelElementFields)
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+}
+
+func TestParseFieldSelectionRejectsUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseFieldSelection("idShort,idshort", submodelFields)
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+	require.Contains(t, err.Error(), `SMREPO-PARSEFIELDS-UNKNOWNFIELD fields contains the unknown attribute "idshort"`)
+
+	_, err = parseFieldSelection("submodelElements", submodelElementFields)
+	require.Error(t, err)
+
+	selection, err := parseFieldSelection("inoutputVariables,valueType", submodelElementFields)
+	require.NoError(t, err)
+	require.True(t, selection.includes("valueType"))
+}
+
+func TestGetSubmodelByIDRejectsInvalidFields(t *testing.T) {
+	t.Parallel()
+
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+
+	response, err := sut.GetSubmodelByID(contextWithABACDisabled(t), common.EncodeString("sm-1"), "deep", "", "idShort,")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+
+	response, err = sut.GetSubmodelByID(contextWithABACDisabled(t), common.EncodeString("sm-1"), "deep", "", "idShort,nonsense")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Contains(t, fmt.Sprint(response.Body), "SMREPO-PARSEFIELDS-UNKNOWNFIELD")
+}
+
+func TestTrailingSpaceIdentifierMatchesOnlyWhenTrimmingEnabled(t *testing.T) {
+	t.Parallel()
+
+	encodedWithSpace := common.EncodeString("urn:example:sm:trim ")
+	cfg := &common.Config{}
+
+	decoded, err := decodeSubmodelIdentifier(common.ContextWithConfig(t.Context(), cfg), encodedWithSpace)
+	require.NoError(t, err)
+	require.NotEqual(t, "urn:example:sm:trim", decoded)
+
+	submodel := types.NewSubmodel("urn:example:sm:trim ")
+	sut := NewSubmodelRepositoryAPIAPIService(persistencepostgresql.SubmodelDatabase{})
+	response, err := sut.PutSubmodelByID(common.ContextWithConfig(t.Context(), cfg), common.EncodeString("urn:example:sm:trim"), submodel)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+
+	trimCfg := &common.Config{}
+	trimCfg.General.TrimIdentifierWhitespace = true
+	trimCtx := common.ContextWithConfig(t.Context(), trimCfg)
+
+	decoded, err = decodeSubmodelIdentifier(trimCtx, encodedWithSpace)
+	require.NoError(t, err)
+	require.Equal(t, "urn:example:sm:trim", decoded)
+
+	normalizeSubmodelBodyIdentifier(trimCtx, submodel)
+	require.Equal(t, "urn:example:sm:trim", submodel.ID())
+}
+
+func TestFilterElementLanguageKeepsOnlyRequestedLanguages(t *testing.T) {
+	t.Parallel()
+
+	allLanguages := func() []types.ILangStringTextType {
+		return []types.ILangStringTextType{
+			types.NewLangStringTextType("en", "Title"),
+			types.NewLangStringTextType("de", "Titel"),
+			types.NewLangStringTextType("fr", "Titre"),
+		}
+	}
+	idShort := "title"
+	mlp := types.NewMultiLanguageProperty()
+	mlp.SetIDShort(&idShort)
+	mlp.SetValue(allLanguages())
+	mlp.SetDescription(allLanguages())
+	mlp.SetDisplayName([]types.ILangStringNameType{
+		types.NewLangStringNameType("en", "Title"),
+		types.NewLangStringNameType("fr", "Titre"),
+	})
+	collectionIDShort := "texts"
+	collection := types.NewSubmodelElementCollection()
+	collection.SetIDShort(&collectionIDShort)
+	collection.SetValue([]types.ISubmodelElement{mlp})
+
+	ctx := common.WithLanguageFilter(context.Background(), common.ParseLanguageList("de, en"))
+	filterElementLanguage(collection, common.LanguageFilterFromContext(ctx))
+
+	textLanguages := func(values []types.ILangStringTextType) []string {
+		languages := make([]string, 0, len(values))
+		for _, value := range values {
+			languages = append(languages, value.Language())
+		}
+		return languages
+	}
+	require.Equal(t, []string{"en", "de"}, textLanguages(mlp.Value()))
+	require.Equal(t, []string{"en", "de"}, textLanguages(mlp.Description()))
+	require.Len(t, mlp.DisplayName(), 1)
+	require.Equal(t, "en", mlp.DisplayName()[0].Language())
+}
+
+func TestLimitElementDepthKeepsRequestedLevels(t *testing.T) {
+	t.Parallel()
+
+	newTree := func() types.ISubmodelElementCollection {
+		leafIDShort := "leaf"
+		leaf := types.NewProperty(types.DataTypeDefXSDString)
+		leaf.SetIDShort(&leafIDShort)
+		childIDShort := "child"
+		child := types.NewSubmodelElementCollection()
+		child.SetIDShort(&childIDShort)
+		child.SetValue([]types.ISubmodelElement{leaf})
+		siblingIDShort := "sibling"
+		sibling := types.NewProperty(types.DataTypeDefXSDString)
+		sibling.SetIDShort(&siblingIDShort)
+		rootIDShort := "root"
+		root := types.NewSubmodelElementCollection()
+		root.SetIDShort(&rootIDShort)
+		root.SetValue([]types.ISubmodelElement{child, sibling})
+		return root
+	}
+
+	shell := newTree()
+	limitElementDepth(shell, 0)
+	require.Empty(t, shell.Value())
+
+	immediate := newTree()
+	limitElementDepth(immediate, 1)
+	require.Len(t, immediate.Value(), 2)
+	child, ok := immediate.Value()[0].(types.ISubmodelElementCollection)
+	require.True(t, ok)
+	require.Equal(t, "child", *child.IDShort())
+	require.Empty(t, child.Value())
+
+	full := newTree()
+	limitElementDepth(full, 2)
+	require.Len(t, full.Value()[0].(types.ISubmodelElementCollection).Value(), 1)
+}
+
+func TestFilterSubmodelLanguagesWithoutFilterKeepsAllLanguages(t *testing.T) {
+	t.Parallel()
+
+	mlp := types.NewMultiLanguageProperty()
+	mlp.SetValue([]types.ILangStringTextType{
+		types.NewLangStringTextType("en", "Title"),
+		types.NewLangStringTextType("fr", "Titre"),
+	})
+	submodel := types.NewSubmodel("sm-1")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{mlp})
+
+	filterSubmodelLanguages(submodel, common.LanguageFilterFromContext(context.Background()))
+	require.Len(t, mlp.Value(), 2)
+
+	filterSubmodelLanguages(submodel, []string{"de"})
+	require.Nil(t, mlp.Value())
+}
+
+func TestSubmodelElementValuesResultReportsPerPathFailures(t *testing.T) {
+	values := gen.SubmodelValue{
+		"b": gen.PropertyValue{Value: "2"},
+		"a": gen.PropertyValue{Value: "1"},
+		"c": gen.PropertyValue{Value: "3"},
+	}
+	failures := []persistencepostgresql.SubmodelElementValueFailure{
+		{IDShortPath: "b", Err: common.NewErrNotFound("SMREPO-UPDSMEVALONLY-NOTFOUND b")},
+	}
+
+	partial := submodelElementValuesResult(values, failures, false)
+	require.Equal(t, 3, partial.ProcessedCount)
+	require.Equal(t, 2, partial.SuccessfulCount)
+	require.Len(t, partial.Failures, 1)
+	require.Equal(t, 1, partial.Failures[0].Index)
+	require.Equal(t, http.StatusNotFound, partial.Failures[0].StatusCode)
+
+	atomic := submodelElementValuesResult(values, failures, true)
+	require.Equal(t, 0, atomic.SuccessfulCount)
+	require.Equal(t, 3, atomic.FailedCount)
+}
+
+func TestListEndpointsUseScopedDefaultPageLimit(t *testing.T) {
+	t.Parallel()
+
+	cfg := &common.Config{General: common.GeneralConfig{
+		DefaultPageLimit:                25,
+		DefaultSubmodelPageLimit:        7,
+		DefaultSubmodelElementPageLimit: 12,
+		MaxPageLimit:                    100,
+	}}
+
+	tests := []struct {
+		name      string
+		setup     func(sqlmock.Sqlmock)
+		wantLimit string
+		call      func(context.Context, *SubmodelRepositoryAPIAPIService) (gen.ImplResponse, error)
+	}{
+		{
+			name:      "submodels",
+			wantLimit: `LIMIT 8`,
+			call: func(ctx context.Context, sut *SubmodelRepositoryAPIAPIService) (gen.ImplResponse, error) {
+				return sut.GetAllSubmodels(ctx, "", "", 0, "", "deep", "", time.Time{}, time.Time{}, "", "", "")
+			},
+		},
+		{
+			name: "submodel elements",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			wantLimit: `LIMIT 13`,
+			call: func(ctx context.Context, sut *SubmodelRepositoryAPIAPIService) (gen.ImplResponse, error) {
+				return sut.GetAllSubmodelElements(ctx, common.EncodeString("urn:example:sm"), 0, "", "deep", "", "")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			backend, err := persistencepostgresql.NewSubmodelDatabaseFromDB(db, nil, "strict")
+			require.NoError(t, err)
+			sut := NewSubmodelRepositoryAPIAPIService(*backend)
+
+			if tt.setup != nil {
+				tt.setup(mock)
+			}
+			mock.ExpectQuery(tt.wantLimit).
+				WillReturnError(errors.New("query stopped"))
+
+			_, _ = tt.call(common.ContextWithConfig(context.Background(), cfg), sut)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -26,11 +26,14 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/stringification"
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	submodelpath "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/path"
@@ -135,6 +138,27 @@ func resolveModelReferencePathKeys(
 	return keyTypes, keyValues, nil
 }
 
+// decodeSubmodelIdentifier decodes a base64url identifier path parameter and
+// applies the configured identifier whitespace policy.
+func decodeSubmodelIdentifier(ctx context.Context, encoded string) (string, error) {
+	decoded, err := common.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	return common.NormalizeIdentifierFromContext(ctx, decoded), nil
+}
+
+// normalizeSubmodelBodyIdentifier applies the identifier whitespace policy to
+// the id of a submodel request body.
+func normalizeSubmodelBodyIdentifier(ctx context.Context, submodel types.ISubmodel) {
+	if submodel == nil {
+		return
+	}
+	if normalized := common.NormalizeIdentifierFromContext(ctx, submodel.ID()); normalized != submodel.ID() {
+		submodel.SetID(normalized)
+	}
+}
+
 func isLevelValid(level string) bool {
 	return level == "core" || level == "" || level == "deep"
 }
@@ -151,6 +175,19 @@ func validateLevel(level string) error {
 	return common.NewErrBadRequest("SMREPO-VALIDLEVEL-BADVALUE invalid level parameter")
 }
 
+// parseSubmodelKindFilter turns the optional kind query parameter into a
+// list filter. An empty value disables the filter.
+func parseSubmodelKindFilter(codePrefix string, kind string) (*types.ModellingKind, error) {
+	if kind == "" {
+		return nil, nil
+	}
+	parsedKind, ok := stringification.ModellingKindFromString(kind)
+	if !ok {
+		return nil, common.NewErrBadRequest(codePrefix + "-BADKIND kind must be Instance or Template")
+	}
+	return &parsedKind, nil
+}
+
 func normalizeExtent(extent string) (string, error) {
 	if extent == "" || extent == extentWithoutBlobValue {
 		return extentWithoutBlobValue, nil
@@ -161,6 +198,69 @@ func normalizeExtent(extent string) (string, error) {
 	return "", common.NewErrBadRequest("SMREPO-NORMEXT-BADVALUE invalid extent parameter")
 }
 
+// fieldSelection holds the top-level attributes requested via ?fields=.
+// A nil selection means the full representation is returned.
+type fieldSelection map[string]struct{}
+
+// submodelFields lists the top-level attributes of a Submodel.
+var submodelFields = fieldSelection{
+	"modelType": {}, "id": {}, "idShort": {}, "category": {}, "displayName": {}, "description": {},
+	"administration": {}, "kind": {}, "semanticId": {}, "supplementalSemanticIds": {}, "qualifiers": {},
+	"extensions": {}, "embeddedDataSpecifications": {}, "submodelElements": {},
+}
+
+// submodelElementFields lists the top-level attributes of every submodel
+// element type, so one selection applies to a mixed element list.
+var submodelElementFields = fieldSelection{
+	"modelType": {}, "idShort": {}, "category": {}, "displayName": {}, "description": {},
+	"semanticId": {}, "supplementalSemanticIds": {}, "qualifiers": {}, "extensions": {}, "embeddedDataSpecifications": {},
+	"value": {}, "valueType": {}, "valueId": {}, "min": {}, "max": {}, "contentType": {},
+	"orderRelevant": {}, "semanticIdListElement": {}, "typeValueListElement": {}, "valueTypeListElement": {},
+	"first": {}, "second": {}, "annotations": {}, "statements": {}, "entityType": {}, "globalAssetId": {}, "specificAssetIds": {},
+	"observed": {}, "direction": {}, "state": {}, "messageTopic": {}, "messageBroker": {}, "lastUpdate": {}, "minInterval": {}, "maxInterval": {},
+	"inputVariables": {}, "outputVariables": {}, "inoutputVariables": {},
+}
+
+func parseFieldSelection(fields string, allowed fieldSelection) (fieldSelection, error) {
+	if fields == "" {
+		return nil, nil
+	}
+
+	selection := fieldSelection{}
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return nil, common.NewErrBadRequest("SMREPO-PARSEFIELDS-EMPTYFIELD fields must be a comma-separated list of attribute names")
+		}
+		if _, ok := allowed[field]; !ok {
+			return nil, common.NewErrBadRequest(fmt.Sprintf("SMREPO-PARSEFIELDS-UNKNOWNFIELD fields contains the unknown attribute %q", field))
+		}
+		selection[field] = struct{}{}
+	}
+	return selection, nil
+}
+
+// includes reports whether the attribute is part of the response.
+func (f fieldSelection) includes(field string) bool {
+	if f == nil {
+		return true
+	}
+	_, ok := f[field]
+	return ok
+}
+
+// project removes every top-level attribute that was not requested.
+func (f fieldSelection) project(jsonable map[string]any) {
+	if f == nil {
+		return
+	}
+	for key := range jsonable {
+		if _, ok := f[key]; !ok {
+			delete(jsonable, key)
+		}
+	}
+}
+
 func stripBlobValuesFromHistoricalSubmodel(submodel types.ISubmodel) {
 	if submodel == nil {
 		return
@@ -215,6 +315,128 @@ func stripBlobValuesFromOperationVariables(variables []types.IOperationVariable)
 	}
 }
 
+// filterSubmodelLanguages narrows displayName, description and
+// MultiLanguageProperty values to the languages requested via ?language=.
+// A nil language list leaves the submodel unchanged. Lists without a matching
+// language are dropped instead of being serialized as empty arrays.
+func filterSubmodelLanguages(submodel types.ISubmodel, languages []string) {
+	if submodel == nil || len(languages) == 0 {
+		return
+	}
+	submodel.SetDisplayName(filterLangStringNameTypes(submodel.DisplayName(), languages))
+	submodel.SetDescription(filterLangStringTextTypes(submodel.Description(), languages))
+	filterElementLanguages(submodel.SubmodelElements(), languages)
+}
+
+func filterElementLanguages(elements []types.ISubmodelElement, languages []string) {
+	for _, element := range elements {
+		filterElementLanguage(element, languages)
+	}
+}
+
+func filterElementLanguage(element types.ISubmodelElement, languages []string) {
+	if element == nil || len(languages) == 0 {
+		return
+	}
+	element.SetDisplayName(filterLangStringNameTypes(element.DisplayName(), languages))
+	element.SetDescription(filterLangStringTextTypes(element.Description(), languages))
+
+	switch typedElement := element.(type) {
+	case types.IMultiLanguageProperty:
+		typedElement.SetValue(filterLangStringTextTypes(typedElement.Value(), languages))
+	case types.ISubmodelElementList:
+		filterElementLanguages(typedElement.Value(), languages)
+	case types.ISubmodelElementCollection:
+		filterElementLanguages(typedElement.Value(), languages)
+	case types.IEntity:
+		filterElementLanguages(typedElement.Statements(), languages)
+	case types.IAnnotatedRelationshipElement:
+		for _, annotation := range typedElement.Annotations() {
+			filterElementLanguage(annotation, languages)
+		}
+	case types.IOperation:
+		for _, variables := range [][]types.IOperationVariable{typedElement.InputVariables(), typedElement.OutputVariables(), typedElement.InoutputVariables()} {
+			for _, variable := range variables {
+				if variable != nil {
+					filterElementLanguage(variable.Value(), languages)
+				}
+			}
+		}
+	}
+}
+
+// limitElementDepth removes the children of element that lie more than depth
+// levels below it, as requested by the `depth` query parameter. Depth 0 keeps
+// the element itself without children.
+func limitElementDepth(element types.ISubmodelElement, depth int) {
+	switch typedElement := element.(type) {
+	case types.ISubmodelElementList:
+		if depth == 0 {
+			typedElement.SetValue(nil)
+			return
+		}
+		for _, child := range typedElement.Value() {
+			limitElementDepth(child, depth-1)
+		}
+	case types.ISubmodelElementCollection:
+		if depth == 0 {
+			typedElement.SetValue(nil)
+			return
+		}
+		for _, child := range typedElement.Value() {
+			limitElementDepth(child, depth-1)
+		}
+	case types.IEntity:
+		if depth == 0 {
+			typedElement.SetStatements(nil)
+			return
+		}
+		for _, child := range typedElement.Statements() {
+			limitElementDepth(child, depth-1)
+		}
+	case types.IAnnotatedRelationshipElement:
+		if depth == 0 {
+			typedElement.SetAnnotations(nil)
+			return
+		}
+		for _, annotation := range typedElement.Annotations() {
+			limitElementDepth(annotation, depth-1)
+		}
+	}
+}
+
+func filterLangStringNameTypes(values []types.ILangStringNameType, languages []string) []types.ILangStringNameType {
+	if values == nil {
+		return nil
+	}
+	filtered := make([]types.ILangStringNameType, 0, len(values))
+	for _, value := range values {
+		if value != nil && common.MatchesLanguageFilter(value.Language(), languages) {
+			filtered = append(filtered, value)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+func filterLangStringTextTypes(values []types.ILangStringTextType, languages []string) []types.ILangStringTextType {
+	if values == nil {
+		return nil
+	}
+	filtered := make([]types.ILangStringTextType, 0, len(values))
+	for _, value := range values {
+		if value != nil && common.MatchesLanguageFilter(value.Language(), languages) {
+			filtered = append(filtered, value)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
 func pruneSubmodelToCore(submodel types.ISubmodel) {
 	if submodel == nil {
 		return
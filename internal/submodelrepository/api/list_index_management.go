@@ -0,0 +1,114 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelelements "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/submodelElements"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// ListIndexValidationPath reports inconsistent SubmodelElementList indices
+	// of one Submodel on the management surface.
+	ListIndexValidationPath = "/security/abac/submodels/{submodelIdentifier}/list-indices"
+	// ListIndexRepairPath renumbers inconsistent SubmodelElementList indices
+	// of one Submodel on the management surface.
+	ListIndexRepairPath = ListIndexValidationPath + "/repair"
+)
+
+// ListIndexMaintainer validates and repairs stored SubmodelElementList
+// positions. It is implemented by the PostgreSQL Submodel backend.
+type ListIndexMaintainer interface {
+	ValidateListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error)
+	RepairListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error)
+}
+
+// ListIndexReport is the JSON body returned by the list index management
+// routes.
+type ListIndexReport struct {
+	SubmodelID string                                `json:"submodelId"`
+	Violations []submodelelements.ListIndexViolation `json:"violations"`
+	Repaired   bool                                  `json:"repaired"`
+}
+
+// RegisterListIndexManagementRoutes mounts the SubmodelElementList index
+// validation and repair routes. The handlers perform no authorization of their
+// own; callers must mount them behind the management API protection.
+func RegisterListIndexManagementRoutes(r chi.Router, maintainer ListIndexMaintainer) {
+	r.Get(ListIndexValidationPath, listIndexHandler(maintainer.ValidateListIndices, false))
+	r.Post(ListIndexRepairPath, listIndexHandler(maintainer.RepairListIndices, true))
+}
+
+func listIndexHandler(run func(context.Context, string) ([]submodelelements.ListIndexViolation, error), repair bool) http.HandlerFunc {
+	operation := "ValidateListIndices"
+	if repair {
+		operation = "RepairListIndices"
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		submodelID, err := decodeSubmodelIdentifier(r.Context(), chi.URLParam(r, "submodelIdentifier"))
+		if err != nil {
+			_ = common.WriteErrorResponse(w, err, http.StatusBadRequest, "SMREPO", operation, "MalformedSubmodelIdentifier")
+			return
+		}
+
+		violations, err := run(r.Context(), submodelID)
+		if err != nil {
+			writeListIndexError(w, err, operation)
+			return
+		}
+
+		payload, err := json.Marshal(ListIndexReport{
+			SubmodelID: submodelID,
+			Violations: violations,
+			Repaired:   repair && len(violations) > 0,
+		})
+		if err != nil {
+			_ = common.WriteErrorResponse(w, fmt.Errorf("SMREPO-LISTIDX-MARSHAL %w", err), http.StatusInternalServerError, "SMREPO", operation, "MarshalResponse")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err = w.Write(payload); err != nil {
+			log.Printf("SMREPO-LISTIDX-WRITE response write failed: %v", err)
+		}
+	}
+}
+
+func writeListIndexError(w http.ResponseWriter, err error, operation string) {
+	status := http.StatusInternalServerError
+	if common.IsErrNotFound(err) {
+		status = http.StatusNotFound
+	}
+	_ = common.WriteErrorResponse(w, err, status, "SMREPO", operation, "Execute")
+}
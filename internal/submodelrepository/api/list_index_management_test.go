@@ -0,0 +1,109 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelelements "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/submodelElements"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type listIndexRequestKey struct{}
+
+type fakeListIndexMaintainer struct {
+	violations []submodelelements.ListIndexViolation
+	err        error
+	validated  string
+	repaired   string
+	ctx        context.Context
+}
+
+func (f *fakeListIndexMaintainer) ValidateListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error) {
+	f.validated = submodelID
+	f.ctx = ctx
+	return f.violations, f.err
+}
+
+func (f *fakeListIndexMaintainer) RepairListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error) {
+	f.repaired = submodelID
+	f.ctx = ctx
+	return f.violations, f.err
+}
+
+func TestListIndexManagementRoutes(t *testing.T) {
+	encodedID := base64.RawURLEncoding.EncodeToString([]byte("urn:sm:1"))
+	gap := []submodelelements.ListIndexViolation{{ListPath: "Gapped", Positions: []int{0, 2}, MismatchedPaths: []string{"Gapped[2]"}}}
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		maintainer   *fakeListIndexMaintainer
+		wantStatus   int
+		wantRepaired bool
+	}{
+		{name: "validate reports gap", method: http.MethodGet, path: "/security/abac/submodels/" + encodedID + "/list-indices", maintainer: &fakeListIndexMaintainer{violations: gap}, wantStatus: http.StatusOK},
+		{name: "repair reports repaired gap", method: http.MethodPost, path: "/security/abac/submodels/" + encodedID + "/list-indices/repair", maintainer: &fakeListIndexMaintainer{violations: gap}, wantStatus: http.StatusOK, wantRepaired: true},
+		{name: "unknown submodel", method: http.MethodGet, path: "/security/abac/submodels/" + encodedID + "/list-indices", maintainer: &fakeListIndexMaintainer{err: common.NewErrNotFound("SMREPO-VALIDATELISTIDX-GETSMID-NOTFOUND")}, wantStatus: http.StatusNotFound},
+		{name: "malformed identifier", method: http.MethodGet, path: "/security/abac/submodels/%25%25/list-indices", maintainer: &fakeListIndexMaintainer{}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := chi.NewRouter()
+			RegisterListIndexManagementRoutes(router, tt.maintainer)
+			response := httptest.NewRecorder()
+
+			requestCtx := context.WithValue(t.Context(), listIndexRequestKey{}, tt.name)
+			router.ServeHTTP(response, httptest.NewRequestWithContext(requestCtx, tt.method, tt.path, nil))
+
+			require.Equal(t, tt.wantStatus, response.Code, response.Body.String())
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var report ListIndexReport
+			require.NoError(t, json.NewDecoder(strings.NewReader(response.Body.String())).Decode(&report))
+			require.Equal(t, "urn:sm:1", report.SubmodelID)
+			require.Equal(t, gap, report.Violations)
+			require.Equal(t, tt.wantRepaired, report.Repaired)
+			require.Equal(t, tt.name, tt.maintainer.ctx.Value(listIndexRequestKey{}))
+			if tt.method == http.MethodPost {
+				require.Equal(t, "urn:sm:1", tt.maintainer.repaired)
+			} else {
+				require.Equal(t, "urn:sm:1", tt.maintainer.validated)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+// Outcomes reported by a successful dry run.
+const (
+	DryRunOutcomeCreate = "create"
+	DryRunOutcomeUpdate = "update"
+)
+
+// SubmodelDryRunReport is the 200 response of a Submodel write sent with
+// `?dryRun=true`. Nothing is persisted; Outcome tells what the write would
+// have done and Messages carry the errors it would have returned.
+type SubmodelDryRunReport struct {
+	DryRun     bool          `json:"dryRun"`
+	Valid      bool          `json:"valid"`
+	SubmodelID string        `json:"submodelId"`
+	Outcome    string        `json:"outcome,omitempty"`
+	Messages   []gen.Message `json:"messages,omitempty"`
+}
+
+// newSubmodelDryRunResponse turns the result of a dry run into a report.
+// Validation, conflict and authorization failures make the report invalid;
+// internal errors are returned as regular error responses because they say
+// nothing about the Submodel itself.
+func newSubmodelDryRunResponse(err error, operation string, submodelID string, outcome string) gen.ImplResponse {
+	if err == nil {
+		return gen.Response(http.StatusOK, SubmodelDryRunReport{
+			DryRun:     true,
+			Valid:      true,
+			SubmodelID: submodelID,
+			Outcome:    outcome,
+		})
+	}
+
+	var response gen.ImplResponse
+	switch {
+	case common.IsErrDenied(err):
+		response = newAPIErrorResponse(err, http.StatusForbidden, operation, "Denied")
+	case common.IsErrConflict(err):
+		response = newAPIErrorResponse(err, http.StatusConflict, operation, "Conflict")
+	case common.IsErrBadRequest(err):
+		response = newAPIErrorResponse(err, http.StatusBadRequest, operation, "InvalidSubmodelData")
+	case common.IsErrNotFound(err):
+		response = newAPIErrorResponse(err, http.StatusNotFound, operation, "SubmodelNotFound")
+	default:
+		return newAPIErrorResponse(err, http.StatusInternalServerError, operation, "DryRun")
+	}
+
+	messages, _ := response.Body.([]gen.Message)
+	return gen.Response(http.StatusOK, SubmodelDryRunReport{
+		DryRun:     true,
+		Valid:      false,
+		SubmodelID: submodelID,
+		Messages:   messages,
+	})
+}
@@ -0,0 +1,129 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	persistencepostgresql "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostSubmodelDryRunReportsValidSubmodelWithoutCommit(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	backend, err := persistencepostgresql.NewSubmodelDatabaseFromDB(db, nil, "strict")
+	require.NoError(t, err)
+	sut := NewSubmodelRepositoryAPIAPIService(*backend)
+
+	submodel := types.NewSubmodel("urn:example:sm:dry-run")
+	idShort := "dryRun"
+	submodel.SetIDShort(&idShort)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO .*submodel.*RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	response, err := sut.PostSubmodel(common.WithDryRun(contextWithABACDisabled(t), true), submodel)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	report, ok := response.Body.(SubmodelDryRunReport)
+	require.True(t, ok)
+	require.True(t, report.DryRun)
+	require.True(t, report.Valid)
+	require.Equal(t, "urn:example:sm:dry-run", report.SubmodelID)
+	require.Equal(t, DryRunOutcomeCreate, report.Outcome)
+	require.Empty(t, report.Messages)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostSubmodelDryRunReportsInvalidSubmodel(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	backend, err := persistencepostgresql.NewSubmodelDatabaseFromDB(db, nil, "strict")
+	require.NoError(t, err)
+	sut := NewSubmodelRepositoryAPIAPIService(*backend)
+
+	first := types.NewProperty(types.DataTypeDefXSDString)
+	second := types.NewProperty(types.DataTypeDefXSDString)
+	duplicate := "temperature"
+	first.SetIDShort(&duplicate)
+	second.SetIDShort(&duplicate)
+	submodel := types.NewSubmodel("urn:example:sm:dry-run-invalid")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{first, second})
+
+	response, err := sut.PostSubmodel(common.WithDryRun(contextWithABACDisabled(t), true), submodel)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	report, ok := response.Body.(SubmodelDryRunReport)
+	require.True(t, ok)
+	require.False(t, report.Valid)
+	require.Empty(t, report.Outcome)
+	require.Len(t, report.Messages, 1)
+	require.Equal(t, "400", report.Messages[0].Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewSubmodelDryRunResponseKeepsInternalErrors(t *testing.T) {
+	t.Parallel()
+
+	response := newSubmodelDryRunResponse(common.NewInternalServerError("boom"), "PostSubmodel", "sm", DryRunOutcomeCreate)
+	require.Equal(t, http.StatusInternalServerError, response.Code)
+
+	response = newSubmodelDryRunResponse(common.NewErrDenied("no"), "PutSubmodelByID", "sm", DryRunOutcomeUpdate)
+	require.Equal(t, http.StatusOK, response.Code)
+	report, ok := response.Body.(SubmodelDryRunReport)
+	require.True(t, ok)
+	require.False(t, report.Valid)
+	require.Equal(t, "403", report.Messages[0].Code)
+
+	response = newSubmodelDryRunResponse(errors.New("unexpected"), "PutSubmodelByID", "sm", DryRunOutcomeUpdate)
+	require.Equal(t, http.StatusInternalServerError, response.Code)
+}
@@ -0,0 +1,131 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func metadataSemanticID(value string) types.IReference {
+	return types.NewReference(types.ReferenceTypesExternalReference, []types.IKey{types.NewKey(types.KeyTypesGlobalReference, value)})
+}
+
+func metadataChildren(t *testing.T, metadata map[string]any, field string) []map[string]any {
+	t.Helper()
+
+	rawChildren, ok := metadata[field].([]any)
+	require.True(t, ok, "expected %s to hold nested metadata", field)
+	children := make([]map[string]any, 0, len(rawChildren))
+	for _, rawChild := range rawChildren {
+		child, ok := rawChild.(map[string]any)
+		require.True(t, ok)
+		children = append(children, child)
+	}
+	return children
+}
+
+func requireElementMetadata(t *testing.T, metadata map[string]any, modelType string, semanticID string) {
+	t.Helper()
+
+	require.Equal(t, modelType, metadata["modelType"])
+	semantic, ok := metadata["semanticId"].(map[string]any)
+	require.True(t, ok, "expected semanticId on %s metadata", modelType)
+	keys, ok := semantic["keys"].([]any)
+	require.True(t, ok)
+	require.Len(t, keys, 1)
+	require.Equal(t, semanticID, keys[0].(map[string]any)["value"])
+}
+
+func TestSubmodelElementMetadataCoversNestedChildren(t *testing.T) {
+	t.Parallel()
+
+	withIdentity := func(element types.ISubmodelElement, idShort string) {
+		element.SetIDShort(&idShort)
+		element.SetSemanticID(metadataSemanticID("urn:example:semantic:" + idShort))
+	}
+
+	reading := "21.5"
+	temperature := types.NewProperty(types.DataTypeDefXSDDouble)
+	temperature.SetValue(&reading)
+
+	list := types.NewSubmodelElementList(types.AASSubmodelElementsProperty)
+	withIdentity(list, "Temperatures")
+	list.SetSemanticIDListElement(metadataSemanticID("urn:example:semantic:Temperature"))
+	temperature.SetSemanticID(metadataSemanticID("urn:example:semantic:Temperature"))
+	list.SetValue([]types.ISubmodelElement{temperature})
+
+	minVal, maxVal := "0", "10"
+	tolerance := types.NewRange(types.DataTypeDefXSDInt)
+	withIdentity(tolerance, "Tolerance")
+	tolerance.SetMin(&minVal)
+	tolerance.SetMax(&maxVal)
+	entity := types.NewEntity()
+	withIdentity(entity, "Bearing")
+	entityType := types.EntityTypeCoManagedEntity
+	entity.SetEntityType(&entityType)
+	entity.SetStatements([]types.ISubmodelElement{tolerance})
+
+	speed := types.NewProperty(types.DataTypeDefXSDInt)
+	withIdentity(speed, "Speed")
+	operation := types.NewOperation()
+	withIdentity(operation, "SetSpeed")
+	operation.SetInputVariables([]types.IOperationVariable{types.NewOperationVariable(speed)})
+
+	collection := types.NewSubmodelElementCollection()
+	withIdentity(collection, "Motor")
+	collection.SetValue([]types.ISubmodelElement{list, entity, operation})
+
+	metadata, err := toSubmodelElementMetadata(collection)
+	require.NoError(t, err)
+	requireElementMetadata(t, metadata, "SubmodelElementCollection", "urn:example:semantic:Motor")
+
+	children := metadataChildren(t, metadata, "value")
+	require.Len(t, children, 3)
+	requireElementMetadata(t, children[0], "SubmodelElementList", "urn:example:semantic:Temperatures")
+	requireElementMetadata(t, children[1], "Entity", "urn:example:semantic:Bearing")
+	requireElementMetadata(t, children[2], "Operation", "urn:example:semantic:SetSpeed")
+
+	listChildren := metadataChildren(t, children[0], "value")
+	require.Len(t, listChildren, 1)
+	requireElementMetadata(t, listChildren[0], "Property", "urn:example:semantic:Temperature")
+	require.NotContains(t, listChildren[0], "value")
+	require.Equal(t, "xs:double", listChildren[0]["valueType"])
+
+	statements := metadataChildren(t, children[1], "statements")
+	require.Len(t, statements, 1)
+	requireElementMetadata(t, statements[0], "Range", "urn:example:semantic:Tolerance")
+	require.NotContains(t, statements[0], "min")
+	require.NotContains(t, statements[0], "max")
+
+	variables := metadataChildren(t, children[2], "inputVariables")
+	require.Len(t, variables, 1)
+	variableValue, ok := variables[0]["value"].(map[string]any)
+	require.True(t, ok)
+	requireElementMetadata(t, variableValue, "Property", "urn:example:semantic:Speed")
+}
@@ -0,0 +1,104 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"golang.org/x/sync/errgroup"
+)
+
+// submodelPageFetcher reads up to limit submodels starting at cursor and
+// returns the identifier of the first submodel after the page.
+type submodelPageFetcher func(limit int32, cursor string) ([]types.ISubmodel, string, error)
+
+// loadSubmodelPage reads a page of submodels and loads the elements of every
+// member. Listing and element loading are separate reads, so a submodel can
+// vanish in between: deleted concurrently, or no longer visible under the
+// caller's ABAC rules. Such members are dropped and the page is refilled from
+// the next cursor. The page therefore keeps the size the cursor accounts for,
+// and the returned cursor still names the first submodel that was not
+// returned.
+func loadSubmodelPage(limit int32, cursor string, fetch submodelPageFetcher, loadElements func(types.ISubmodel) error) ([]types.ISubmodel, string, error) {
+	batch, nextCursor, err := fetch(limit, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize := len(batch)
+
+	page := make([]types.ISubmodel, 0, pageSize)
+	for {
+		loaded, loadErr := loadSubmodelBatch(batch, loadElements)
+		if loadErr != nil {
+			return nil, "", loadErr
+		}
+		page = append(page, loaded...)
+
+		missing := pageSize - len(page)
+		if missing <= 0 || nextCursor == "" {
+			return page, nextCursor, nil
+		}
+		batch, nextCursor, err = fetch(int32(missing), nextCursor) // #nosec G115 -- missing is bounded by the page size
+		if err != nil {
+			return nil, "", err
+		}
+		if len(batch) == 0 {
+			return page, nextCursor, nil
+		}
+	}
+}
+
+// loadSubmodelBatch loads the elements of all submodels concurrently and
+// returns the submodels that still exist, in their original order.
+func loadSubmodelBatch(batch []types.ISubmodel, loadElements func(types.ISubmodel) error) ([]types.ISubmodel, error) {
+	vanished := make([]bool, len(batch))
+	var eg errgroup.Group
+	eg.SetLimit(8)
+	for index := range batch {
+		eg.Go(func() error {
+			err := loadElements(batch[index])
+			if common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows) {
+				vanished[index] = true
+				return nil
+			}
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	loaded := make([]types.ISubmodel, 0, len(batch))
+	for index, sm := range batch {
+		if !vanished[index] {
+			loaded = append(loaded, sm)
+		}
+	}
+	return loaded, nil
+}
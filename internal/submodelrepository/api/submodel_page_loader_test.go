@@ -0,0 +1,182 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSubmodelPages pages over sorted identifiers the way the persistence
+// layer does: the cursor is the first identifier of the next page.
+func fakeSubmodelPages(ids []string, fetchLimits *[]int32) submodelPageFetcher {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	return func(limit int32, cursor string) ([]types.ISubmodel, string, error) {
+		*fetchLimits = append(*fetchLimits, limit)
+		start := sort.SearchStrings(sorted, cursor)
+		page := make([]types.ISubmodel, 0, limit)
+		for index := start; index < len(sorted); index++ {
+			if len(page) == int(limit) {
+				return page, sorted[index], nil
+			}
+			page = append(page, types.NewSubmodel(sorted[index]))
+		}
+		return page, "", nil
+	}
+}
+
+// hidingElementLoader fails like GetSubmodelElements does for submodels that
+// are not visible to the caller or were deleted after the listing.
+func hidingElementLoader(hidden ...string) func(types.ISubmodel) error {
+	var mu sync.Mutex
+	hiddenSet := make(map[string]bool, len(hidden))
+	for _, id := range hidden {
+		hiddenSet[id] = true
+	}
+	return func(sm types.ISubmodel) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if hiddenSet[sm.ID()] {
+			return common.NewErrNotFound(sm.ID())
+		}
+		return nil
+	}
+}
+
+func submodelIDs(submodels []types.ISubmodel) []string {
+	ids := make([]string, 0, len(submodels))
+	for _, sm := range submodels {
+		ids = append(ids, sm.ID())
+	}
+	return ids
+}
+
+func TestLoadSubmodelPageRefillsHiddenSubmodels(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{"sm-a", "sm-b", "sm-c", "sm-d", "sm-e", "sm-f", "sm-g", "sm-h"}
+	tests := []struct {
+		name       string
+		cursor     string
+		hidden     []string
+		wantIDs    []string
+		wantCursor string
+		wantLimits []int32
+	}{
+		{
+			name:       "nothing hidden",
+			wantIDs:    []string{"sm-a", "sm-b", "sm-c"},
+			wantCursor: "sm-d",
+			wantLimits: []int32{3},
+		},
+		{
+			name:       "hidden members are refilled",
+			hidden:     []string{"sm-b", "sm-c"},
+			wantIDs:    []string{"sm-a", "sm-d", "sm-e"},
+			wantCursor: "sm-f",
+			wantLimits: []int32{3, 2},
+		},
+		{
+			name:       "refill skips hidden submodels too",
+			hidden:     []string{"sm-c", "sm-d"},
+			wantIDs:    []string{"sm-a", "sm-b", "sm-e"},
+			wantCursor: "sm-f",
+			wantLimits: []int32{3, 1, 1},
+		},
+		{
+			name:       "continuation from cursor",
+			cursor:     "sm-d",
+			hidden:     []string{"sm-e"},
+			wantIDs:    []string{"sm-d", "sm-f", "sm-g"},
+			wantCursor: "sm-h",
+			wantLimits: []int32{3, 1},
+		},
+		{
+			name:       "last page shrinks and ends the listing",
+			cursor:     "sm-f",
+			hidden:     []string{"sm-g"},
+			wantIDs:    []string{"sm-f", "sm-h"},
+			wantCursor: "",
+			wantLimits: []int32{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var limits []int32
+			page, nextCursor, err := loadSubmodelPage(3, tt.cursor, fakeSubmodelPages(ids, &limits), hidingElementLoader(tt.hidden...))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantIDs, submodelIDs(page))
+			require.Equal(t, tt.wantCursor, nextCursor)
+			require.Equal(t, tt.wantLimits, limits)
+		})
+	}
+}
+
+func TestLoadSubmodelPageWalksAllVisibleSubmodelsExactlyOnce(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{"sm-01", "sm-02", "sm-03", "sm-04", "sm-05", "sm-06", "sm-07", "sm-08", "sm-09", "sm-10"}
+	var limits []int32
+	fetch := fakeSubmodelPages(ids, &limits)
+	load := hidingElementLoader("sm-02", "sm-03", "sm-07")
+
+	var seen []string
+	cursor := ""
+	for {
+		page, nextCursor, err := loadSubmodelPage(2, cursor, fetch, load)
+		require.NoError(t, err)
+		if nextCursor != "" {
+			require.Len(t, page, 2, "every page before the last must be full")
+		}
+		seen = append(seen, submodelIDs(page)...)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+	require.Equal(t, []string{"sm-01", "sm-04", "sm-05", "sm-06", "sm-08", "sm-09", "sm-10"}, seen)
+}
+
+func TestLoadSubmodelPagePropagatesLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	var limits []int32
+	loadErr := errors.New("connection reset")
+	_, _, err := loadSubmodelPage(3, "", fakeSubmodelPages([]string{"sm-a", "sm-b"}, &limits), func(types.ISubmodel) error {
+		return loadErr
+	})
+	require.ErrorIs(t, err, loadErr)
+}
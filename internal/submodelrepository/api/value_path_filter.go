@@ -0,0 +1,91 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"strconv"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelpath "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/path"
+)
+
+// restrictSubmodelToPath removes every element of submodel that is neither on
+// nor below idShortPath, so that the Value-Only serialization of the Submodel
+// only carries the requested subtree. Ancestors keep their idShort nesting;
+// a list on the path keeps only the addressed item.
+func restrictSubmodelToPath(submodel types.ISubmodel, idShortPath string) error {
+	segments, err := submodelpath.ParseIDShortPathSegments(idShortPath)
+	if err != nil {
+		return common.NewErrBadRequest("SMREPO-VALUEPATH-INVALID " + err.Error())
+	}
+	if segments[0].IsIndex {
+		return common.NewErrBadRequest("SMREPO-VALUEPATH-INVALID idShortPath must start with an idShort")
+	}
+
+	children := submodel.SubmodelElements()
+	setChildren := submodel.SetSubmodelElements
+	for i, segment := range segments {
+		match := findPathSegment(children, segment)
+		if match == nil {
+			return common.NewErrNotFound("SMREPO-VALUEPATH-NOTFOUND no element at idShortPath " + idShortPath)
+		}
+		setChildren([]types.ISubmodelElement{match})
+
+		if i == len(segments)-1 {
+			break
+		}
+		switch element := match.(type) {
+		case types.ISubmodelElementCollection:
+			children, setChildren = element.Value(), element.SetValue
+		case types.ISubmodelElementList:
+			children, setChildren = element.Value(), element.SetValue
+		case types.IEntity:
+			children, setChildren = element.Statements(), element.SetStatements
+		default:
+			return common.NewErrNotFound("SMREPO-VALUEPATH-NOTFOUND no element at idShortPath " + idShortPath)
+		}
+	}
+
+	return nil
+}
+
+func findPathSegment(children []types.ISubmodelElement, segment submodelpath.Segment) types.ISubmodelElement {
+	if segment.IsIndex {
+		index, err := strconv.Atoi(segment.Value)
+		if err != nil || index < 0 || index >= len(children) {
+			return nil
+		}
+		return children[index]
+	}
+
+	for _, child := range children {
+		if idShort := child.IDShort(); idShort != nil && *idShort == segment.Value {
+			return child
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func valuePathProperty(idShort string, value string) types.ISubmodelElement {
+	property := types.NewProperty(types.DataTypeDefXSDString)
+	if idShort != "" {
+		property.SetIDShort(&idShort)
+	}
+	property.SetValue(&value)
+	return property
+}
+
+func valuePathSubmodel() types.ISubmodel {
+	nameplateIDShort := "nameplate"
+	nameplate := types.NewSubmodelElementCollection()
+	nameplate.SetIDShort(&nameplateIDShort)
+	nameplate.SetValue([]types.ISubmodelElement{
+		valuePathProperty("serial", "SN-1"),
+		valuePathProperty("manufacturer", "ACME"),
+	})
+
+	readingsIDShort := "readings"
+	readings := types.NewSubmodelElementList(types.AASSubmodelElementsProperty)
+	readings.SetIDShort(&readingsIDShort)
+	readings.SetValue([]types.ISubmodelElement{
+		valuePathProperty("", "1"),
+		valuePathProperty("", "2"),
+	})
+
+	submodel := types.NewSubmodel("urn:example:sm:value-path")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{
+		nameplate,
+		readings,
+		valuePathProperty("temperature", "21"),
+	})
+	return submodel
+}
+
+func TestRestrictSubmodelToPathReturnsOnlyRequestedSubtreeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		path     string
+		wantJSON string
+	}{
+		{name: "top-level element", path: "temperature", wantJSON: `{"temperature":"21"}`},
+		{name: "nested element", path: "nameplate.serial", wantJSON: `{"nameplate":{"serial":"SN-1"}}`},
+		{name: "whole collection", path: "nameplate", wantJSON: `{"nameplate":{"serial":"SN-1","manufacturer":"ACME"}}`},
+		{name: "list item", path: "readings[1]", wantJSON: `{"readings":["2"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			submodel := valuePathSubmodel()
+			require.NoError(t, restrictSubmodelToPath(submodel, tt.path))
+
+			valueOnly, err := gen.SubmodelToValueOnly(submodel)
+			require.NoError(t, err)
+			payload, err := valueOnly.MarshalValueOnly()
+			require.NoError(t, err)
+			require.JSONEq(t, tt.wantJSON, string(payload))
+		})
+	}
+}
+
+func TestRestrictSubmodelToPathRejectsUnknownOrInvalidPaths(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, common.IsErrNotFound(restrictSubmodelToPath(valuePathSubmodel(), "nameplate.missing")))
+	require.True(t, common.IsErrNotFound(restrictSubmodelToPath(valuePathSubmodel(), "readings[5]")))
+	require.True(t, common.IsErrNotFound(restrictSubmodelToPath(valuePathSubmodel(), "temperature.child")))
+	require.True(t, common.IsErrBadRequest(restrictSubmodelToPath(valuePathSubmodel(), "[0]")))
+	require.True(t, common.IsErrBadRequest(restrictSubmodelToPath(valuePathSubmodel(), "nameplate..serial")))
+}
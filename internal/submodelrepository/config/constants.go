@@ -27,12 +27,6 @@
 package config
 
 const (
-	// DefaultPageLimit is the default number of items returned per page when pagination limit is not specified.
-	DefaultPageLimit int32 = 100
-
-	// MaxPageLimit is the maximum allowed number of items per page for pagination.
-	MaxPageLimit int32 = 1000
-
 	// WorkerPoolSize is the default number of concurrent workers for parallel processing operations.
 	WorkerPoolSize = 10
 
@@ -305,6 +305,58 @@ func requireSubmodelBlobValueState(t *testing.T, submodel map[string]any, idShor
 	requireElementBlobValueState(t, findSubmodelElementInList(t, rawElements, idShort), contentType, expectedValue, expectValue)
 }
 
+func TestSubmodelRepositoryBlobValueRoundTripsBinaryContent(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	submodelID := fmt.Sprintf("urn:basyx:integration:blob-binary-%d", time.Now().UnixNano())
+	submodelIDEncoded := base64.RawURLEncoding.EncodeToString([]byte(submodelID))
+	createdValue := []byte{0x00, 0xff, 0x10, 0x80, 0x7f, 0x0a, 0xfe}
+	// "abcd" is itself valid base64 and must not be decoded a second time on read.
+	patchedValue := []byte("abcd")
+
+	payload := map[string]any{
+		"id":        submodelID,
+		"modelType": "Submodel",
+		"submodelElements": []any{
+			map[string]any{
+				"idShort":     "BinaryBlob",
+				"modelType":   "Blob",
+				"contentType": "application/octet-stream",
+				"value":       base64.StdEncoding.EncodeToString(createdValue),
+			},
+		},
+	}
+	statusCode, body, err := requestJSON(http.MethodPost, baseURL+"/submodels", payload)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+	t.Cleanup(func() {
+		_, _, _ = requestJSON(http.MethodDelete, baseURL+"/submodels/"+submodelIDEncoded, nil)
+	})
+
+	elementURL := baseURL + "/submodels/" + submodelIDEncoded + "/submodel-elements/BinaryBlob"
+	requireBlobBytes := func(expected []byte) {
+		t.Helper()
+		status, responseBody, requestErr := requestJSON(http.MethodGet, elementURL+"?extent=withBlobValue", nil)
+		require.NoError(t, requestErr)
+		require.Equal(t, http.StatusOK, status, "response=%s", string(responseBody))
+		encoded, ok := decodeMap(t, responseBody)["value"].(string)
+		require.True(t, ok, "response=%s", string(responseBody))
+		decoded, decodeErr := base64.StdEncoding.DecodeString(encoded)
+		require.NoError(t, decodeErr, "blob value must be valid base64: %q", encoded)
+		require.Equal(t, expected, decoded)
+	}
+
+	requireBlobBytes(createdValue)
+
+	statusCode, body, err = requestJSON(http.MethodPatch, elementURL+"/$value", map[string]any{
+		"contentType": "application/octet-stream",
+		"value":       base64.StdEncoding.EncodeToString(patchedValue),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, statusCode, "response=%s", string(body))
+
+	requireBlobBytes(patchedValue)
+}
+
 func requireElementBlobValueState(t *testing.T, element map[string]any, contentType string, expectedValue string, expectValue bool) {
 	t.Helper()
 	require.Equal(t, "Blob", element["modelType"])
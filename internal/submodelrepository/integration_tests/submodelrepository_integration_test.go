@@ -40,6 +40,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -1040,6 +1041,74 @@ func TestContractSubmodelRepository(t *testing.T) {
 		assert.False(t, hasSemanticID, "semanticId should be removed when patched with null")
 	})
 
+	t.Run("PatchSubmodelByIDMetadataMergesPartialPayloadWithoutIDAndModelType", func(t *testing.T) {
+		submodelID := fmt.Sprintf("https://example.com/ids/sm/contract-patch-metadata-merge-%d", time.Now().UnixNano())
+		submodelIDShort := fmt.Sprintf("contractPatchMetadataMerge%d", time.Now().UnixNano())
+
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":             submodelID,
+			"idShort":        submodelIDShort,
+			"category":       "PARAMETER",
+			"kind":           "Instance",
+			"modelType":      "Submodel",
+			"administration": map[string]any{"version": "1", "revision": "0"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		encodedSubmodelID := common.EncodeString(submodelID)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedSubmodelID), nil)
+		})
+
+		patchPayload := map[string]any{
+			"description": []map[string]any{
+				{"language": "en", "text": "only the description changes"},
+			},
+		}
+		statusCode, body, err = requestJSON(http.MethodPatch, fmt.Sprintf("%s/submodels/%s/$metadata", baseURL, encodedSubmodelID), patchPayload)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, statusCode, "response=%s", string(body))
+
+		statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/$metadata", baseURL, encodedSubmodelID), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var metadata map[string]any
+		require.NoError(t, json.Unmarshal(body, &metadata), "response=%s", string(body))
+		assert.Equal(t, "PARAMETER", metadata["category"])
+		assert.Equal(t, "Instance", metadata["kind"])
+		assert.Equal(t, submodelIDShort, metadata["idShort"])
+		assert.Equal(t, map[string]any{"version": "1", "revision": "0"}, metadata["administration"])
+		require.Len(t, metadata["description"], 1, "response=%s", string(body))
+	})
+
+	t.Run("GetSubmodelElementMetadataReportsCollectionChildCount", func(t *testing.T) {
+		submodelID := fmt.Sprintf("https://example.com/ids/sm/contract-child-count-%d", time.Now().UnixNano())
+		submodelIDShort := fmt.Sprintf("contractChildCount%d", time.Now().UnixNano())
+		encodedSubmodelID := createSubmodel(t, submodelID, submodelIDShort)
+
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels/%s/submodel-elements", baseURL, encodedSubmodelID), map[string]any{
+			"idShort":   "Sensors",
+			"modelType": "SubmodelElementCollection",
+			"value": []map[string]any{
+				{"idShort": "First", "modelType": "Property", "valueType": "xs:string", "value": "1"},
+				{"idShort": "Second", "modelType": "Property", "valueType": "xs:string", "value": "2"},
+				{"idShort": "Third", "modelType": "Property", "valueType": "xs:string", "value": "3"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/submodel-elements/Sensors/$metadata", baseURL, encodedSubmodelID), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var metadata map[string]any
+		require.NoError(t, json.Unmarshal(body, &metadata), "response=%s", string(body))
+		assert.EqualValues(t, 3, metadata["childCount"], "response=%s", string(body))
+	})
+
 	t.Run("PostSubmodelElementReturnsCreatedPayload", func(t *testing.T) {
 		submodelID := fmt.Sprintf("https://example.com/ids/sm/contract-post-element-%d", time.Now().UnixNano())
 		submodelIDShort := fmt.Sprintf("contractPostElement%d", time.Now().UnixNano())
@@ -1547,6 +1616,56 @@ func TestPathNotationEndpoints(t *testing.T) {
 		assert.NotContains(t, paths, "MainCollection.NestedList[0]")
 	})
 
+	t.Run("GetSubmodelByIDPathDeepAddressesElementsInsideListItems", func(t *testing.T) {
+		nestedSubmodelID := fmt.Sprintf("https://example.com/ids/sm/path-list-items-%d", time.Now().UnixNano())
+		nestedSubmodelIDEncoded := common.EncodeString(nestedSubmodelID)
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":        nestedSubmodelID,
+			"idShort":   "PathListItems",
+			"modelType": "Submodel",
+			"submodelElements": []map[string]any{
+				{
+					"idShort":   "foo",
+					"modelType": "SubmodelElementCollection",
+					"value": []map[string]any{
+						{
+							"idShort":              "bar",
+							"modelType":            "SubmodelElementList",
+							"typeValueListElement": "SubmodelElementCollection",
+							"value": []map[string]any{
+								{
+									"modelType": "SubmodelElementCollection",
+									"value": []map[string]any{
+										{"idShort": "baz", "modelType": "Property", "valueType": "xs:string", "value": "first"},
+									},
+								},
+								{
+									"modelType": "SubmodelElementCollection",
+									"value": []map[string]any{
+										{"idShort": "baz", "modelType": "Property", "valueType": "xs:string", "value": "second"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, nestedSubmodelIDEncoded), nil)
+		})
+
+		statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/$path?level=deep", baseURL, nestedSubmodelIDEncoded), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var paths []string
+		require.NoError(t, json.Unmarshal(body, &paths), "response=%s", string(body))
+		assert.ElementsMatch(t, []string{"foo", "foo.bar", "foo.bar[0]", "foo.bar[0].baz", "foo.bar[1]", "foo.bar[1].baz"}, paths)
+	})
+
 	t.Run("GetAllSubmodelsPathReturnsPathItems", func(t *testing.T) {
 		statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/$path?level=deep&limit=500", baseURL), nil)
 		require.NoError(t, err)
@@ -2423,6 +2542,526 @@ func TestPutSubmodelElementByPathCreatesWhenMissing(t *testing.T) {
 	assert.Empty(t, headers.Get("Location"))
 }
 
+func TestGetAllSubmodelsFiltersByKind(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	suffix := time.Now().UnixNano()
+	idShort := fmt.Sprintf("KindFilter%d", suffix)
+	instanceID := fmt.Sprintf("https://example.com/ids/sm/kind-filter-instance-%d", suffix)
+	templateID := fmt.Sprintf("https://example.com/ids/sm/kind-filter-template-%d", suffix)
+
+	for id, kind := range map[string]string{instanceID: "Instance", templateID: "Template"} {
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":        id,
+			"idShort":   idShort,
+			"kind":      kind,
+			"modelType": "Submodel",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		encodedID := common.EncodeString(id)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+		})
+	}
+
+	for kind, expectedID := range map[string]string{"Instance": instanceID, "Template": templateID} {
+		statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?idShort=%s&kind=%s", baseURL, idShort, kind), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var response struct {
+			Result []map[string]any `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(body, &response), "response=%s", string(body))
+		require.Len(t, response.Result, 1, "response=%s", string(body))
+		assert.Equal(t, expectedID, response.Result[0]["id"])
+		assert.Equal(t, kind, response.Result[0]["kind"])
+	}
+
+	statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?kind=Instances", baseURL), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode, "response=%s", string(body))
+}
+
+func TestGetAllSubmodelsFiltersByElementPresence(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	suffix := time.Now().UnixNano()
+	idShort := fmt.Sprintf("HasElementsFilter%d", suffix)
+	emptyID := fmt.Sprintf("https://example.com/ids/sm/has-elements-empty-%d", suffix)
+	populatedID := fmt.Sprintf("https://example.com/ids/sm/has-elements-populated-%d", suffix)
+
+	payloads := map[string]map[string]any{
+		emptyID: {"id": emptyID, "idShort": idShort, "modelType": "Submodel"},
+		populatedID: {
+			"id":        populatedID,
+			"idShort":   idShort,
+			"modelType": "Submodel",
+			"submodelElements": []map[string]any{
+				{"idShort": "Temperature", "modelType": "Property", "valueType": "xs:int", "value": "21"},
+			},
+		},
+	}
+	for id, payload := range payloads {
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), payload)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		encodedID := common.EncodeString(id)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+		})
+	}
+
+	for hasElements, expectedID := range map[string]string{"true": populatedID, "false": emptyID} {
+		statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?idShort=%s&hasElements=%s", baseURL, idShort, hasElements), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var response struct {
+			Result []map[string]any `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(body, &response), "response=%s", string(body))
+		require.Len(t, response.Result, 1, "hasElements=%s response=%s", hasElements, string(body))
+		assert.Equal(t, expectedID, response.Result[0]["id"])
+	}
+
+	statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?hasElements=maybe", baseURL), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode, "response=%s", string(body))
+}
+
+func TestGetAllSubmodelsOrdersByCreation(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	suffix := time.Now().UnixNano()
+	idShort := fmt.Sprintf("CreationOrder%d", suffix)
+
+	// Identifiers sort opposite to insertion order, so the default listing and
+	// the creation-order listing must differ.
+	insertedIDs := make([]string, 0, 5)
+	for index := 5; index > 0; index-- {
+		id := fmt.Sprintf("https://example.com/ids/sm/creation-order-%d-%d", suffix, index)
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":        id,
+			"idShort":   idShort,
+			"modelType": "Submodel",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+		insertedIDs = append(insertedIDs, id)
+
+		encodedID := common.EncodeString(id)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+		})
+	}
+
+	listIDs := func(query string) []string {
+		ids := make([]string, 0, len(insertedIDs))
+		pageCursor := ""
+		for pageCount := 0; ; pageCount++ {
+			require.Less(t, pageCount, 10, "pagination did not terminate")
+			requestURL := fmt.Sprintf("%s/submodels?idShort=%s&limit=2%s", baseURL, idShort, query)
+			if pageCursor != "" {
+				requestURL += "&cursor=" + pageCursor
+			}
+			statusCode, body, err := requestJSON(http.MethodGet, requestURL, nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+			var pageResponse struct {
+				PagingMetadata struct {
+					Cursor string `json:"cursor"`
+				} `json:"paging_metadata"`
+				Result []map[string]any `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(body, &pageResponse), "response=%s", string(body))
+			for _, item := range pageResponse.Result {
+				ids = append(ids, fmt.Sprint(item["id"]))
+			}
+			if pageResponse.PagingMetadata.Cursor == "" {
+				return ids
+			}
+			pageCursor = pageResponse.PagingMetadata.Cursor
+		}
+	}
+
+	assert.Equal(t, insertedIDs, listIDs("&orderBy=createdAt"))
+
+	sortedIDs := append([]string(nil), insertedIDs...)
+	sort.Strings(sortedIDs)
+	assert.Equal(t, sortedIDs, listIDs(""))
+	assert.Equal(t, sortedIDs, listIDs("&orderBy=id"))
+
+	statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?orderBy=updatedAt", baseURL), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode, "response=%s", string(body))
+}
+
+func TestGetAllSubmodelsCreationOrderSurvivesPut(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	suffix := time.Now().UnixNano()
+	idShort := fmt.Sprintf("CreationOrderPut%d", suffix)
+	firstID := fmt.Sprintf("https://example.com/ids/sm/creation-order-put-%d-a", suffix)
+	secondID := fmt.Sprintf("https://example.com/ids/sm/creation-order-put-%d-b", suffix)
+
+	for _, id := range []string{firstID, secondID} {
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":        id,
+			"idShort":   idShort,
+			"modelType": "Submodel",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		encodedID := common.EncodeString(id)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+		})
+	}
+
+	statusCode, body, err := requestJSON(http.MethodPut, fmt.Sprintf("%s/submodels/%s", baseURL, common.EncodeString(firstID)), map[string]any{
+		"id":        firstID,
+		"idShort":   idShort,
+		"modelType": "Submodel",
+		"submodelElements": []map[string]any{
+			{"idShort": "Replaced", "modelType": "Property", "valueType": "xs:string", "value": "yes"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, statusCode, "response=%s", string(body))
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?idShort=%s&orderBy=createdAt", baseURL, idShort), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+	var pageResponse struct {
+		Result []map[string]any `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(body, &pageResponse), "response=%s", string(body))
+	ids := make([]string, 0, len(pageResponse.Result))
+	for _, item := range pageResponse.Result {
+		ids = append(ids, fmt.Sprint(item["id"]))
+	}
+	assert.Equal(t, []string{firstID, secondID}, ids)
+}
+
+func TestPutSubmodelReplacesAllElements(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	submodelID := fmt.Sprintf("https://example.com/ids/sm/put-replace-%d", time.Now().UnixNano())
+	submodelEndpoint := fmt.Sprintf("%s/submodels/%s", baseURL, common.EncodeString(submodelID))
+
+	property := func(idShort string, value string) map[string]any {
+		return map[string]any{"idShort": idShort, "modelType": "Property", "valueType": "xs:string", "value": value}
+	}
+	submodel := func(elements ...map[string]any) map[string]any {
+		return map[string]any{"id": submodelID, "idShort": "PutReplace", "modelType": "Submodel", "submodelElements": elements}
+	}
+
+	statusCode, body, err := requestJSON(http.MethodPost, baseURL+"/submodels", submodel(
+		property("Kept", "old"),
+		property("Removed", "gone"),
+		map[string]any{"idShort": "RemovedCollection", "modelType": "SubmodelElementCollection", "value": []any{property("Child", "gone")}},
+	))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+	t.Cleanup(func() {
+		_, _, _ = requestJSON(http.MethodDelete, submodelEndpoint, nil)
+	})
+
+	statusCode, body, err = requestJSON(http.MethodPut, submodelEndpoint, submodel(property("Kept", "new")))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, statusCode, "response=%s", string(body))
+
+	statusCode, body, err = requestJSON(http.MethodGet, submodelEndpoint, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	var stored struct {
+		SubmodelElements []map[string]any `json:"submodelElements"`
+	}
+	require.NoError(t, json.Unmarshal(body, &stored), "response=%s", string(body))
+	require.Len(t, stored.SubmodelElements, 1, "response=%s", string(body))
+	assert.Equal(t, "Kept", stored.SubmodelElements[0]["idShort"])
+	assert.Equal(t, "new", stored.SubmodelElements[0]["value"])
+
+	for _, removedPath := range []string{"Removed", "RemovedCollection", "RemovedCollection.Child"} {
+		statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodel-elements/%s", submodelEndpoint, url.PathEscape(removedPath)), nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, statusCode, "path=%s response=%s", removedPath, string(body))
+	}
+}
+
+func TestSubmodelReadsProjectRequestedFields(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	submodelID := fmt.Sprintf("https://example.com/ids/sm/fields-projection-%d", time.Now().UnixNano())
+	encodedID := common.EncodeString(submodelID)
+
+	statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+		"id":        submodelID,
+		"idShort":   "FieldsProjection",
+		"modelType": "Submodel",
+		"semanticId": map[string]any{
+			"type": "ExternalReference",
+			"keys": []map[string]any{{"type": "GlobalReference", "value": "urn:example:fields-projection"}},
+		},
+		"submodelElements": []map[string]any{
+			{
+				"idShort":     "Payload",
+				"modelType":   "Blob",
+				"contentType": "application/octet-stream",
+				"value":       "aGVhdnk=",
+				"description": []map[string]any{{"language": "en", "text": "large blob"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+	t.Cleanup(func() {
+		_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+	})
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s?fields=idShort,modelType", baseURL, encodedID), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	var submodel map[string]any
+	require.NoError(t, json.Unmarshal(body, &submodel), "response=%s", string(body))
+	assert.Equal(t, map[string]any{"idShort": "FieldsProjection", "modelType": "Submodel"}, submodel)
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/submodel-elements?fields=idShort,modelType&extent=withBlobValue", baseURL, encodedID), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	var elements struct {
+		Result []map[string]any `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(body, &elements), "response=%s", string(body))
+	require.Len(t, elements.Result, 1, "response=%s", string(body))
+	assert.Equal(t, map[string]any{"idShort": "Payload", "modelType": "Blob"}, elements.Result[0])
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s?fields=idShort,,modelType", baseURL, encodedID), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode, "response=%s", string(body))
+}
+
+func TestGetAllSubmodelsIsSortedByIdentifier(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	suffix := time.Now().UnixNano()
+	idShort := fmt.Sprintf("OrderedList%d", suffix)
+	identifiers := []string{
+		fmt.Sprintf("urn:example:sm:order-%d:c", suffix),
+		fmt.Sprintf("urn:example:sm:order-%d:a", suffix),
+		fmt.Sprintf("urn:example:sm:order-%d:b", suffix),
+	}
+
+	for _, id := range identifiers {
+		statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+			"id":        id,
+			"idShort":   idShort,
+			"modelType": "Submodel",
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+		encodedID := common.EncodeString(id)
+		t.Cleanup(func() {
+			_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+		})
+	}
+
+	expected := []string{identifiers[1], identifiers[2], identifiers[0]}
+
+	var listed []string
+	cursor := ""
+	for page := 0; page < len(identifiers)+1; page++ {
+		target := fmt.Sprintf("%s/submodels?idShort=%s&limit=1", baseURL, idShort)
+		if cursor != "" {
+			target += "&cursor=" + cursor
+		}
+		statusCode, body, err := requestJSON(http.MethodGet, target, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+		var response struct {
+			PagingMetadata struct {
+				Cursor string `json:"cursor"`
+			} `json:"paging_metadata"`
+			Result []map[string]any `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(body, &response), "response=%s", string(body))
+		for _, submodel := range response.Result {
+			listed = append(listed, fmt.Sprint(submodel["id"]))
+		}
+		cursor = response.PagingMetadata.Cursor
+		if cursor == "" {
+			break
+		}
+	}
+	assert.Equal(t, expected, listed)
+
+	statusCode, body, err := requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels?idShort=%s", baseURL, idShort), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	var unpaged struct {
+		Result []map[string]any `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(body, &unpaged), "response=%s", string(body))
+	require.Len(t, unpaged.Result, len(expected), "response=%s", string(body))
+	for index, submodel := range unpaged.Result {
+		assert.Equal(t, expected[index], submodel["id"])
+	}
+}
+
+func TestSubmodelMetadataReportsMaxElementDepth(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	property := func(idShort string) map[string]any {
+		return map[string]any{"idShort": idShort, "modelType": "Property", "valueType": "xs:string", "value": idShort}
+	}
+
+	tests := []struct {
+		name      string
+		elements  []map[string]any
+		wantDepth float64
+	}{
+		{name: "empty", elements: []map[string]any{}, wantDepth: 0},
+		{
+			name: "nested",
+			elements: []map[string]any{
+				property("Flat"),
+				{
+					"idShort":   "Outer",
+					"modelType": "SubmodelElementCollection",
+					"value": []map[string]any{
+						{
+							"idShort":   "Inner",
+							"modelType": "SubmodelElementCollection",
+							"value":     []map[string]any{property("Leaf")},
+						},
+					},
+				},
+				{
+					"idShort":              "Items",
+					"modelType":            "SubmodelElementList",
+					"typeValueListElement": "Property",
+					"valueTypeListElement": "xs:string",
+					"value":                []map[string]any{{"modelType": "Property", "valueType": "xs:string", "value": "item"}},
+				},
+			},
+			wantDepth: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submodelID := fmt.Sprintf("https://example.com/ids/sm/max-depth-%s-%d", tt.name, time.Now().UnixNano())
+			encodedID := common.EncodeString(submodelID)
+
+			statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+				"id":               submodelID,
+				"idShort":          "MaxDepth",
+				"modelType":        "Submodel",
+				"submodelElements": tt.elements,
+			})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+			t.Cleanup(func() {
+				_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+			})
+
+			statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/$metadata", baseURL, encodedID), nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+			var metadata map[string]any
+			require.NoError(t, json.Unmarshal(body, &metadata), "response=%s", string(body))
+			assert.Equal(t, tt.wantDepth, metadata["maxDepth"], "response=%s", string(body))
+		})
+	}
+}
+
+func TestMultiLanguagePropertyReadsFilterByLanguage(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	submodelID := fmt.Sprintf("https://example.com/ids/sm/language-filter-%d", time.Now().UnixNano())
+	encodedID := common.EncodeString(submodelID)
+	langStrings := []map[string]any{
+		{"language": "en", "text": "Title"},
+		{"language": "de", "text": "Titel"},
+		{"language": "fr", "text": "Titre"},
+	}
+
+	statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels", baseURL), map[string]any{
+		"id":        submodelID,
+		"idShort":   "LanguageFilter",
+		"modelType": "Submodel",
+		"submodelElements": []map[string]any{
+			{
+				"idShort":     "Title",
+				"modelType":   "MultiLanguageProperty",
+				"value":       langStrings,
+				"description": langStrings,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+	t.Cleanup(func() {
+		_, _, _ = requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s", baseURL, encodedID), nil)
+	})
+
+	languagesOf := func(values []any) []string {
+		languages := make([]string, 0, len(values))
+		for _, value := range values {
+			languages = append(languages, value.(map[string]any)["language"].(string))
+		}
+		return languages
+	}
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/submodel-elements/Title?language=de,en", baseURL, encodedID), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	var element map[string]any
+	require.NoError(t, json.Unmarshal(body, &element), "response=%s", string(body))
+	assert.ElementsMatch(t, []string{"de", "en"}, languagesOf(element["value"].([]any)))
+	assert.ElementsMatch(t, []string{"de", "en"}, languagesOf(element["description"].([]any)))
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s/submodel-elements/Title", baseURL, encodedID), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+	element = nil
+	require.NoError(t, json.Unmarshal(body, &element), "response=%s", string(body))
+	assert.ElementsMatch(t, []string{"en", "de", "fr"}, languagesOf(element["value"].([]any)))
+}
+
+func TestAddedFileElementIsImmediatelyReadable(t *testing.T) {
+	baseURL := submodelRepositoryBaseURL
+	submodelID := fmt.Sprintf("urn:basyx:integration:file-read-after-write-%d", time.Now().UnixNano())
+	submodelIDEncoded := common.EncodeString(submodelID)
+
+	createSubmodelForLargeObjectCleanupTest(t, baseURL, submodelID, "FileReadAfterWrite", []any{
+		map[string]any{"idShort": "Existing", "modelType": "Property", "valueType": "xs:string", "value": "x"},
+	})
+	t.Cleanup(func() { deleteSubmodelForLargeObjectCleanupTest(t, baseURL, submodelIDEncoded) })
+
+	statusCode, body, err := requestJSON(http.MethodPost, fmt.Sprintf("%s/submodels/%s/submodel-elements", baseURL, submodelIDEncoded), fileElementForLargeObjectCleanupTest("AddedFile"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, statusCode, "response=%s", string(body))
+
+	assert.Equal(t, "file:///AddedFile", getFileElementValue(t, fmt.Sprintf("%s/submodels/%s/submodel-elements/AddedFile", baseURL, submodelIDEncoded)))
+
+	statusCode, body, err = requestJSON(http.MethodGet, fmt.Sprintf("%s/submodels/%s", baseURL, submodelIDEncoded), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode, "response=%s", string(body))
+
+	var submodel struct {
+		SubmodelElements []map[string]any `json:"submodelElements"`
+	}
+	require.NoError(t, json.Unmarshal(body, &submodel), "response=%s", string(body))
+	modelTypes := make(map[string]any, len(submodel.SubmodelElements))
+	for _, element := range submodel.SubmodelElements {
+		idShort, _ := element["idShort"].(string)
+		modelTypes[idShort] = element["modelType"]
+	}
+	assert.Equal(t, "File", modelTypes["AddedFile"], "response=%s", string(body))
+}
+
 func TestStandaloneStartupRejectsUnsupportedAASRegistryToggle(t *testing.T) {
 	if os.Getenv("BASYX_EXTERNAL_COMPOSE") == "1" {
 		t.Skip("requires bundled integration docker compose setup")
@@ -2599,6 +3238,7 @@ func TestMain(m *testing.M) {
 		Env:             runtime.Env(),
 		PreDownBeforeUp: true,
 		HealthURL:       submodelRepositoryBaseURL + "/health",
+		ReadyURL:        testenv.ReadyURL(submodelRepositoryBaseURL),
 		HealthTimeout:   150 * time.Second,
 	}))
 }
@@ -0,0 +1,117 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubmodelElementPagingSurvivesDeletes pages through the top-level elements
+// and deletes the cursor element and an earlier element between pages. Every
+// element that still exists must be returned exactly once.
+func TestSubmodelElementPagingSurvivesDeletes(t *testing.T) {
+	for _, orderBy := range []string{"idShort", "position"} {
+		t.Run(orderBy, func(t *testing.T) {
+			submodelID := fmt.Sprintf("urn:basyx:integration:paging-deletes-%s-%d", orderBy, time.Now().UnixNano())
+			encodedID := common.EncodeString(submodelID)
+			idShorts := []string{"A", "B", "C", "D", "E", "F", "G"}
+			elements := make([]map[string]any, 0, len(idShorts))
+			for _, idShort := range idShorts {
+				elements = append(elements, map[string]any{
+					"idShort":   idShort,
+					"modelType": "Property",
+					"valueType": "xs:string",
+					"value":     idShort,
+				})
+			}
+			status, body, err := requestJSON(http.MethodPost, submodelRepositoryBaseURL+"/submodels", map[string]any{
+				"id":               submodelID,
+				"idShort":          "PagingDeletes",
+				"modelType":        "Submodel",
+				"submodelElements": elements,
+			})
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, status, "response=%s", string(body))
+			t.Cleanup(func() {
+				_, _, _ = requestJSON(http.MethodDelete, submodelRepositoryBaseURL+"/submodels/"+encodedID, nil)
+			})
+
+			seen := map[string]int{}
+			deleted := map[string]bool{}
+			cursor := ""
+			for page := 0; page < len(idShorts); page++ {
+				endpoint := fmt.Sprintf("%s/submodels/%s/submodel-elements?limit=2&orderBy=%s", submodelRepositoryBaseURL, encodedID, orderBy)
+				if cursor != "" {
+					endpoint += "&cursor=" + url.QueryEscape(cursor)
+				}
+				status, body, err := requestJSON(http.MethodGet, endpoint, nil)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+
+				var response struct {
+					PagingMetadata map[string]any   `json:"paging_metadata"`
+					Result         []map[string]any `json:"result"`
+				}
+				require.NoError(t, json.Unmarshal(body, &response), "response=%s", string(body))
+				for _, element := range response.Result {
+					seen[element["idShort"].(string)]++
+				}
+
+				if page == 0 {
+					// Delete the first element and the cursor element before requesting the next page.
+					for _, idShort := range []string{"A", "B"} {
+						status, body, err := requestJSON(http.MethodDelete, fmt.Sprintf("%s/submodels/%s/submodel-elements/%s", submodelRepositoryBaseURL, encodedID, idShort), nil)
+						require.NoError(t, err)
+						require.Equal(t, http.StatusNoContent, status, "response=%s", string(body))
+						deleted[idShort] = true
+					}
+				}
+
+				next, _ := response.PagingMetadata["cursor"].(string)
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+
+			for _, idShort := range idShorts {
+				if deleted[idShort] {
+					continue
+				}
+				require.Equal(t, 1, seen[idShort], "element %s must be returned exactly once, seen=%v", idShort, seen)
+			}
+		})
+	}
+}
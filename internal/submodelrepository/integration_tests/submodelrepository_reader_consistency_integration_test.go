@@ -0,0 +1,197 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+//nolint:all
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readerConsistencyFixtures are the submodels every read path must return identically.
+var readerConsistencyFixtures = []string{
+	"bodies/post/postFullSM.json",
+	"bodies/post/postSubmodelWithAnnotatedRelationshipElement.json",
+	"bodies/post/postSubmodelWithBasicEventElement.json",
+	"bodies/post/postSubmodelWithCapability.json",
+	"bodies/post/postSubmodelWithEntity.json",
+	"bodies/post/postSubmodelWithFile.json",
+	"bodies/post/postSubmodelWithOperation.json",
+	"bodies/post/postSubmodelWithRangeElement.json",
+	"bodies/post/postSubmodelWithReferenceElement.json",
+	"bodies/post/postSubmodelWithRelationshipElement.json",
+}
+
+// TestSubmodelRepositoryReadersAgree asserts that the single-submodel read,
+// the list read, the query read and the element list read all return the
+// same submodel content for a battery of submodels.
+func TestSubmodelRepositoryReadersAgree(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	fixtures := make([]map[string]any, 0, len(readerConsistencyFixtures)+1)
+	for _, path := range readerConsistencyFixtures {
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var fixture map[string]any
+		require.NoError(t, json.Unmarshal(raw, &fixture))
+		fixtures = append(fixtures, fixture)
+	}
+	fixtures = append(fixtures, readerConsistencyInlineFixture())
+
+	for index, fixture := range fixtures {
+		fixture["id"] = fmt.Sprintf("urn:basyx:integration:reader-consistency-%d-%d", suffix, index)
+		submodelID := fixture["id"].(string)
+
+		t.Run(fmt.Sprintf("%d-%v", index, fixture["idShort"]), func(t *testing.T) {
+			status, body, err := requestJSON(http.MethodPost, submodelRepositoryBaseURL+"/submodels", fixture)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, status, "response=%s", string(body))
+			encodedID := base64.RawURLEncoding.EncodeToString([]byte(submodelID))
+			t.Cleanup(func() {
+				_, _, _ = requestJSON(http.MethodDelete, submodelRepositoryBaseURL+"/submodels/"+encodedID, nil)
+			})
+
+			requireSubmodelReadersAgree(t, submodelID, fixture["idShort"])
+		})
+	}
+}
+
+func requireSubmodelReadersAgree(t *testing.T, submodelID string, idShort any) {
+	t.Helper()
+	encodedID := base64.RawURLEncoding.EncodeToString([]byte(submodelID))
+
+	status, body, err := requestJSON(http.MethodGet, submodelRepositoryBaseURL+"/submodels/"+encodedID+"?extent=withBlobValue", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+	byID := decodeMap(t, body)
+
+	listURL := submodelRepositoryBaseURL + "/submodels?limit=100&extent=withBlobValue"
+	if value, ok := idShort.(string); ok && value != "" {
+		listURL += "&idShort=" + url.QueryEscape(value)
+	}
+	status, body, err = requestJSON(http.MethodGet, listURL, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+	fromList := findSubmodelByID(t, decodeMap(t, body), submodelID)
+
+	query := map[string]any{
+		"$condition": map[string]any{
+			"$eq": []any{
+				map[string]any{"$field": "$sm#id"},
+				map[string]any{"$strVal": submodelID},
+			},
+		},
+	}
+	status, body, err = requestJSON(http.MethodPost, submodelRepositoryBaseURL+"/query/submodels", query)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+	fromQuery := findSubmodelByID(t, decodeMap(t, body), submodelID)
+
+	status, body, err = requestJSON(http.MethodGet, submodelRepositoryBaseURL+"/submodels/"+encodedID+"/submodel-elements?limit=1000&extent=withBlobValue", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status, "response=%s", string(body))
+	elements, _ := decodeMap(t, body)["result"].([]any)
+
+	require.Equal(t, byID, fromList, "GET /submodels/{id} and GET /submodels disagree")
+	require.Equal(t, byID, fromQuery, "GET /submodels/{id} and POST /query/submodels disagree")
+	if byIDElements, hasElements := byID["submodelElements"]; hasElements {
+		require.Equal(t, byIDElements, elements, "GET /submodels/{id} and GET /submodel-elements disagree")
+	} else {
+		require.Empty(t, elements)
+	}
+}
+
+func findSubmodelByID(t *testing.T, page map[string]any, submodelID string) map[string]any {
+	t.Helper()
+	result, _ := page["result"].([]any)
+	for _, item := range result {
+		submodel, _ := item.(map[string]any)
+		if submodel["id"] == submodelID {
+			return submodel
+		}
+	}
+	require.FailNow(t, "submodel missing from page", "id=%s page=%v", submodelID, page)
+	return nil
+}
+
+// readerConsistencyInlineFixture covers the fields that historically diverged
+// between read paths: multi-key semantic IDs, multi-language values, ranges
+// and nested collections and lists.
+func readerConsistencyInlineFixture() map[string]any {
+	return map[string]any{
+		"modelType": "Submodel",
+		"idShort":   "ReaderConsistencyInline",
+		"semanticId": map[string]any{
+			"type": "ModelReference",
+			"keys": []any{
+				map[string]any{"type": "Submodel", "value": "urn:z:submodel"},
+				map[string]any{"type": "SubmodelElementCollection", "value": "Middle"},
+				map[string]any{"type": "Property", "value": "A"},
+			},
+		},
+		"submodelElements": []any{
+			map[string]any{
+				"modelType": "MultiLanguageProperty",
+				"idShort":   "Label",
+				"value": []any{
+					map[string]any{"language": "en", "text": "Label"},
+					map[string]any{"language": "de", "text": "Beschriftung"},
+				},
+			},
+			map[string]any{
+				"modelType": "Range",
+				"idShort":   "Interval",
+				"valueType": "xs:int",
+				"min":       "0",
+				"max":       "10",
+			},
+			map[string]any{
+				"modelType": "SubmodelElementCollection",
+				"idShort":   "Outer",
+				"value": []any{
+					map[string]any{
+						"modelType":            "SubmodelElementList",
+						"idShort":              "Items",
+						"typeValueListElement": "Property",
+						"valueTypeListElement": "xs:string",
+						"orderRelevant":        true,
+						"value": []any{
+							map[string]any{"modelType": "Property", "valueType": "xs:string", "value": "first"},
+							map[string]any{"modelType": "Property", "valueType": "xs:string", "value": "second"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
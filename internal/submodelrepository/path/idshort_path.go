@@ -144,3 +144,13 @@ func BuildIDShortPathFromSegments(segments []Segment) string {
 
 	return builder.String()
 }
+
+// ParentIDShortPath returns the idShort path of the element containing idShortPath.
+// Top-level elements have no parent element, which is reported as an empty path.
+func ParentIDShortPath(idShortPath string) (string, error) {
+	segments, err := ParseIDShortPathSegments(idShortPath)
+	if err != nil {
+		return "", err
+	}
+	return BuildIDShortPathFromSegments(segments[:len(segments)-1]), nil
+}
@@ -98,3 +98,27 @@ func TestBuildIDShortPathFromSegments(t *testing.T) {
 		t.Fatalf("unexpected path: %s", path)
 	}
 }
+
+func TestParentIDShortPath(t *testing.T) {
+	tests := map[string]string{
+		"Motor":                               "",
+		"MechanicalParts.Motor":               "MechanicalParts",
+		"MechanicalParts.statements[0]":       "MechanicalParts.statements",
+		"MechanicalParts.statements[0].Motor": "MechanicalParts.statements[0]",
+		"MechanicalParts.statements[0][1]":    "MechanicalParts.statements[0]",
+	}
+
+	for idShortPath, want := range tests {
+		got, err := ParentIDShortPath(idShortPath)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", idShortPath, err)
+		}
+		if got != want {
+			t.Fatalf("expected parent of %q to be %q, got %q", idShortPath, want, got)
+		}
+	}
+
+	if _, err := ParentIDShortPath(""); !errors.Is(err, ErrEmptyPath) {
+		t.Fatalf("expected ErrEmptyPath, got %v", err)
+	}
+}
@@ -205,18 +205,108 @@ func ApplySubmodelSemanticIDFilter(selectDS *goqu.SelectDataset, semanticID stri
 	return selectDS.Where(goqu.Func("EXISTS", semanticIDFilterDS))
 }
 
+// ApplySubmodelKindFilter restricts a submodel dataset to one modelling kind.
+// Submodels stored without a kind count as Instance, the AAS default.
+func ApplySubmodelKindFilter(selectDS *goqu.SelectDataset, kind *types.ModellingKind) *goqu.SelectDataset {
+	if kind == nil {
+		return selectDS
+	}
+
+	kindColumn := goqu.I("submodel.kind")
+	if *kind == types.ModellingKindInstance {
+		return selectDS.Where(goqu.Or(kindColumn.Eq(int(*kind)), kindColumn.IsNull()))
+	}
+	return selectDS.Where(kindColumn.Eq(int(*kind)))
+}
+
+// ApplySubmodelHasElementsFilter restricts a submodel dataset to submodels
+// with (true) or without (false) any stored submodel element.
+func ApplySubmodelHasElementsFilter(selectDS *goqu.SelectDataset, hasElements *bool) *goqu.SelectDataset {
+	if hasElements == nil {
+		return selectDS
+	}
+
+	elementsDS := goqu.From(goqu.T("submodel_element").As("sme_presence")).
+		Select(goqu.V(1)).
+		Where(goqu.I("sme_presence.submodel_id").Eq(goqu.I("submodel.id")))
+	if *hasElements {
+		return selectDS.Where(goqu.Func("EXISTS", elementsDS))
+	}
+	return selectDS.Where(goqu.Func("NOT EXISTS", elementsDS))
+}
+
+// ApplySubmodelCreationOrder sorts a submodel dataset by the time each
+// submodel was first stored. The row id breaks ties between submodels created
+// in the same transaction. A cursor names the first submodel of the page, so
+// the page continues at its (db_created_at, id) key.
+func ApplySubmodelCreationOrder(selectDS *goqu.SelectDataset, cursor *string) *goqu.SelectDataset {
+	createdAt := goqu.I("submodel.db_created_at")
+	rowID := goqu.I("submodel.id")
+	selectDS = selectDS.
+		SelectAppend(createdAt.As("sort_created_at"), rowID.As("sort_id")).
+		Order(createdAt.Asc(), rowID.Asc())
+	if cursor == nil || *cursor == "" {
+		return selectDS
+	}
+
+	dialect := goqu.Dialect(common.Dialect)
+	cursorKeyDS := dialect.From(goqu.T("submodel").As("s2")).
+		Select(goqu.I("s2.db_created_at"), goqu.I("s2.id")).
+		Where(goqu.Ex{"s2.submodel_identifier": *cursor})
+	return selectDS.Where(goqu.L("(?, ?) >= ?", createdAt, rowID, cursorKeyDS))
+}
+
+// SelectPreviousSubmodelPage turns a filtered submodel dataset into the
+// identifiers of the page before cursor, nearest to the cursor first. The
+// last row is the cursor of that page. orderByCreation selects the keyset of
+// ApplySubmodelCreationOrder instead of the identifier.
+func SelectPreviousSubmodelPage(selectDS *goqu.SelectDataset, cursor string, limit int32, orderByCreation bool) *goqu.SelectDataset {
+	identifier := goqu.I("submodel.submodel_identifier")
+	selectDS = selectDS.Select(identifier).ClearLimit()
+	if !orderByCreation {
+		return common.WherePreviousKeysetPage(selectDS, identifier, cursor, limit)
+	}
+
+	createdAt := goqu.I("submodel.db_created_at")
+	rowID := goqu.I("submodel.id")
+	cursorKeyDS := goqu.Dialect(common.Dialect).From(goqu.T("submodel").As("s2")).
+		Select(goqu.I("s2.db_created_at"), goqu.I("s2.id")).
+		Where(goqu.Ex{"s2.submodel_identifier": cursor})
+	selectDS = selectDS.
+		Where(goqu.L("(?, ?) < ?", createdAt, rowID, cursorKeyDS)).
+		Order(createdAt.Desc(), rowID.Desc())
+	if limit > 0 {
+		selectDS = selectDS.Limit(uint(limit)) // #nosec G115 -- limit is positive
+	}
+	return selectDS
+}
+
 // BuildSubmodelListSQL builds the final SQL for a masked submodel list query.
 func BuildSubmodelListSQL(selectDS *goqu.SelectDataset, dataAlias string, maskedExpressions []exp.Expression) (string, []any, error) {
-	return BuildSubmodelListSQLWithSupplementalOwnerID(selectDS, dataAlias, maskedExpressions, false)
+	return BuildSubmodelListSQLWithSupplementalOwnerID(selectDS, dataAlias, maskedExpressions, false, false)
+}
+
+// BuildSubmodelCountSQL builds a query counting every row of a filtered
+// submodel list dataset. It keeps the filters but drops the projections,
+// order and page limit of the dataset.
+func BuildSubmodelCountSQL(selectDS *goqu.SelectDataset) (string, []any, error) {
+	dialect := goqu.Dialect(common.Dialect)
+	countedDS := selectDS.Select(goqu.I("submodel.id")).ClearOrder().ClearLimit()
+	return dialect.From(countedDS.As("submodel_count_data")).
+		Select(goqu.COUNT("*")).
+		ToSQL()
 }
 
 // BuildSubmodelListSQLWithSupplementalOwnerID builds the final SQL and
 // optionally exposes the database ID needed to reconstruct filtered references.
+// orderByCreation keeps the order of a dataset passed through
+// ApplySubmodelCreationOrder instead of sorting by identifier.
 func BuildSubmodelListSQLWithSupplementalOwnerID(
 	selectDS *goqu.SelectDataset,
 	dataAlias string,
 	maskedExpressions []exp.Expression,
 	includeSupplementalOwnerID bool,
+	orderByCreation bool,
 ) (string, []any, error) {
 	dialect := goqu.Dialect(common.Dialect)
 	projections := []interface{}{
@@ -237,9 +327,14 @@ func BuildSubmodelListSQLWithSupplementalOwnerID(
 		projections = append(projections, goqu.I(dataAlias+".supplemental_owner_id"))
 	}
 
+	order := []exp.OrderedExpression{goqu.I(dataAlias + ".sort_submodel_identifier").Asc()}
+	if orderByCreation {
+		order = []exp.OrderedExpression{goqu.I(dataAlias + ".sort_created_at").Asc(), goqu.I(dataAlias + ".sort_id").Asc()}
+	}
+
 	return dialect.From(selectDS.As(dataAlias)).
 		Select(projections...).
-		Order(goqu.I(dataAlias + ".sort_submodel_identifier").Asc()).
+		Order(order...).
 		ToSQL()
 }
 
@@ -254,6 +349,53 @@ func BuildSubmodelCursorExistsSQL(cursor string) (string, []any, error) {
 		ToSQL()
 }
 
+// BuildSubmodelElementChildCountSQL builds a query returning the number of
+// direct children for each of the given element paths of one submodel. Paths
+// without children are returned with a count of zero; unknown paths are omitted.
+func BuildSubmodelElementChildCountSQL(submodelID string, idShortPaths []string) (string, []any, error) {
+	dialect := goqu.Dialect(common.Dialect)
+	return dialect.
+		From(goqu.T("submodel_element").As("parent")).
+		InnerJoin(goqu.T("submodel").As("sm"), goqu.On(goqu.I("sm.id").Eq(goqu.I("parent.submodel_id")))).
+		LeftJoin(goqu.T("submodel_element").As("child"), goqu.On(goqu.I("child.parent_sme_id").Eq(goqu.I("parent.id")))).
+		Select(goqu.I("parent.idshort_path"), goqu.COUNT(goqu.I("child.id"))).
+		Where(
+			goqu.I("sm.submodel_identifier").Eq(submodelID),
+			goqu.I("parent.idshort_path").In(idShortPaths),
+		).
+		GroupBy(goqu.I("parent.idshort_path")).
+		Prepared(true).
+		ToSQL()
+}
+
+// BuildSubmodelElementMaxDepthSQL builds a recursive query returning the depth
+// of the deepest element of one submodel. Top-level elements have depth 1 and
+// a submodel without elements yields 0.
+func BuildSubmodelElementMaxDepthSQL(submodelID string) (string, []any, error) {
+	const depthCTE = "sme_depth"
+	dialect := goqu.Dialect(common.Dialect)
+
+	roots := dialect.
+		From(goqu.T("submodel_element").As("root")).
+		InnerJoin(goqu.T("submodel").As("sm"), goqu.On(goqu.I("sm.id").Eq(goqu.I("root.submodel_id")))).
+		Select(goqu.I("root.id"), goqu.L("1")).
+		Where(
+			goqu.I("sm.submodel_identifier").Eq(submodelID),
+			goqu.I("root.parent_sme_id").IsNull(),
+		)
+	children := dialect.
+		From(goqu.T("submodel_element").As("child")).
+		InnerJoin(goqu.T(depthCTE).As("parent"), goqu.On(goqu.I("child.parent_sme_id").Eq(goqu.I("parent.id")))).
+		Select(goqu.I("child.id"), goqu.L("? + 1", goqu.I("parent.depth")))
+
+	return dialect.
+		From(goqu.T(depthCTE)).
+		WithRecursive(depthCTE+"(id, depth)", roots.UnionAll(children)).
+		Select(goqu.COALESCE(goqu.MAX(goqu.I(depthCTE+".depth")), 0)).
+		Prepared(true).
+		ToSQL()
+}
+
 // SelectVisibleSubmodelDataset builds a submodel visibility check dataset.
 func SelectVisibleSubmodelDataset(submodelID string) *goqu.SelectDataset {
 	dialect := goqu.Dialect(common.Dialect)
@@ -452,20 +594,26 @@ func buildSubmodelSemanticIDSelectExpression(dialect *goqu.DialectWrapper) exp.A
 		Select(
 			keyTypeSelectExpression.As("type"),
 			goqu.I("ssrk.value").As("value"),
+			goqu.I("ssrk.position").As("position"),
 		).
-		Where(goqu.I("ssrk.reference_id").Eq(goqu.I("ssr.id"))).
-		Order(goqu.I("ssrk.position").Asc())
+		Where(goqu.I("ssrk.reference_id").Eq(goqu.I("ssr.id")))
 
+	// Key order is significant for AAS references, so the aggregate is ordered
+	// by the stored insertion position instead of relying on subquery order.
 	aggregatedKeyValuesSelectDS := dialect.
 		From(orderedKeyValuesSelectDS.As("ordered_key_values")).
 		Select(
 			goqu.COALESCE(
 				goqu.Func(
 					"jsonb_agg",
-					goqu.Func(
-						"jsonb_build_object",
-						goqu.V("type"), goqu.I("ordered_key_values.type"),
-						goqu.V("value"), goqu.I("ordered_key_values.value"),
+					goqu.L(
+						"? ORDER BY ?",
+						goqu.Func(
+							"jsonb_build_object",
+							goqu.V("type"), goqu.I("ordered_key_values.type"),
+							goqu.V("value"), goqu.I("ordered_key_values.value"),
+						),
+						goqu.I("ordered_key_values.position"),
 					),
 				),
 				goqu.L("'[]'::jsonb"),
@@ -0,0 +1,167 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package queries
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+func TestBuildSubmodelSemanticIDSelectExpressionAggregatesKeysByPosition(t *testing.T) {
+	dialect := goqu.Dialect(common.Dialect)
+	query, _, err := dialect.From(goqu.T("submodel")).
+		Select(buildSubmodelSemanticIDSelectExpression(&dialect)).
+		ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL returned error: %v", err)
+	}
+	if !strings.Contains(query, `ORDER BY "ordered_key_values"."position"`) {
+		t.Fatalf("expected key aggregate to be ordered by position, got: %s", query)
+	}
+}
+
+func TestBuildInsertSubmodelSemanticIDReferenceKeysSQLKeepsKeyOrder(t *testing.T) {
+	first := types.NewKey(types.KeyTypesSubmodel, "urn:z:submodel")
+	second := types.NewKey(types.KeyTypesGlobalReference, "urn:a:global")
+	semanticID := types.NewReference(types.ReferenceTypesExternalReference, []types.IKey{first, second})
+
+	query, _, err := BuildInsertSubmodelSemanticIDReferenceKeysSQL(1, semanticID)
+	if err != nil {
+		t.Fatalf("BuildInsertSubmodelSemanticIDReferenceKeysSQL returned error: %v", err)
+	}
+	firstIndex := strings.Index(query, "(0, 1, ")
+	secondIndex := strings.Index(query, "(1, 1, ")
+	if firstIndex < 0 || secondIndex < 0 || firstIndex > secondIndex {
+		t.Fatalf("expected keys to be inserted with ascending positions in input order, got: %s", query)
+	}
+	if !strings.Contains(query, "'urn:z:submodel'") || strings.Index(query, "'urn:z:submodel'") > strings.Index(query, "'urn:a:global'") {
+		t.Fatalf("expected insertion order to follow key order, got: %s", query)
+	}
+}
+
+func TestBuildSubmodelElementChildCountSQLCountsDirectChildrenPerPath(t *testing.T) {
+	query, args, err := BuildSubmodelElementChildCountSQL("urn:example:sm", []string{"collection", "list"})
+	if err != nil {
+		t.Fatalf("BuildSubmodelElementChildCountSQL returned error: %v", err)
+	}
+	for _, fragment := range []string{
+		`COUNT("child"."id")`,
+		`LEFT JOIN "submodel_element" AS "child" ON ("child"."parent_sme_id" = "parent"."id")`,
+		`GROUP BY "parent"."idshort_path"`,
+	} {
+		if !strings.Contains(query, fragment) {
+			t.Fatalf("expected query to contain %s, got: %s", fragment, query)
+		}
+	}
+	if len(args) != 3 {
+		t.Fatalf("expected submodel identifier and two paths as arguments, got %v", args)
+	}
+}
+
+func TestBuildSubmodelListSQLOrdersBySubmodelIdentifier(t *testing.T) {
+	limit := int32(2)
+	cursor := "urn:example:sm:b"
+	selectDS, err := SelectSubmodelDataset(nil, nil, &limit, &cursor, time.Time{}, time.Time{}, nil)
+	if err != nil {
+		t.Fatalf("SelectSubmodelDataset returned error: %v", err)
+	}
+
+	query, _, err := BuildSubmodelListSQL(selectDS, "submodel_list_data", []exp.Expression{
+		goqu.I("submodel_list_data.c1"),
+		goqu.I("submodel_list_data.raw_semantic_id_payload"),
+	})
+	if err != nil {
+		t.Fatalf("BuildSubmodelListSQL returned error: %v", err)
+	}
+	for _, fragment := range []string{
+		`ORDER BY "submodel"."submodel_identifier" ASC`,
+		`ORDER BY "submodel_list_data"."sort_submodel_identifier" ASC`,
+	} {
+		if !strings.Contains(query, fragment) {
+			t.Fatalf("expected query to contain %s, got: %s", fragment, query)
+		}
+	}
+	if strings.Contains(query, `ORDER BY "submodel"."id"`) {
+		t.Fatalf("expected list order to be independent of the database row id, got: %s", query)
+	}
+}
+
+func TestApplySubmodelCreationOrderPagesOverCreationKey(t *testing.T) {
+	limit := int32(2)
+	cursor := "urn:example:sm:b"
+	selectDS, err := SelectSubmodelDataset(nil, nil, &limit, nil, time.Time{}, time.Time{}, nil)
+	if err != nil {
+		t.Fatalf("SelectSubmodelDataset returned error: %v", err)
+	}
+	selectDS = ApplySubmodelCreationOrder(selectDS, &cursor)
+
+	query, _, err := BuildSubmodelListSQLWithSupplementalOwnerID(selectDS, "submodel_list_data", []exp.Expression{
+		goqu.I("submodel_list_data.c1"),
+		goqu.I("submodel_list_data.raw_semantic_id_payload"),
+	}, false, true)
+	if err != nil {
+		t.Fatalf("BuildSubmodelListSQLWithSupplementalOwnerID returned error: %v", err)
+	}
+	for _, fragment := range []string{
+		`("submodel"."db_created_at", "submodel"."id") >= (SELECT "s2"."db_created_at", "s2"."id" FROM "submodel" AS "s2" WHERE ("s2"."submodel_identifier" = 'urn:example:sm:b'))`,
+		`ORDER BY "submodel"."db_created_at" ASC, "submodel"."id" ASC LIMIT 3`,
+		`ORDER BY "submodel_list_data"."sort_created_at" ASC, "submodel_list_data"."sort_id" ASC`,
+	} {
+		if !strings.Contains(query, fragment) {
+			t.Fatalf("expected query to contain %s, got: %s", fragment, query)
+		}
+	}
+	if strings.Contains(query, `"submodel"."submodel_identifier" >=`) {
+		t.Fatalf("expected creation order to ignore the identifier keyset, got: %s", query)
+	}
+}
+
+func TestBuildSubmodelElementMaxDepthSQLWalksParentChain(t *testing.T) {
+	query, args, err := BuildSubmodelElementMaxDepthSQL("urn:example:sm")
+	if err != nil {
+		t.Fatalf("BuildSubmodelElementMaxDepthSQL returned error: %v", err)
+	}
+	for _, fragment := range []string{
+		`WITH RECURSIVE sme_depth(id, depth) AS (SELECT "root"."id", 1`,
+		`("root"."parent_sme_id" IS NULL)`,
+		`"parent"."depth" + 1`,
+		`INNER JOIN "sme_depth" AS "parent" ON ("child"."parent_sme_id" = "parent"."id")`,
+		`SELECT COALESCE(MAX("sme_depth"."depth"), $2) FROM "sme_depth"`,
+	} {
+		if !strings.Contains(query, fragment) {
+			t.Fatalf("expected query to contain %s, got: %s", fragment, query)
+		}
+	}
+	if len(args) != 2 || args[0] != "urn:example:sm" {
+		t.Fatalf("expected submodel identifier and empty-depth fallback as arguments, got %v", args)
+	}
+}
@@ -189,7 +189,7 @@ func (p PostgreSQLAnnotatedRelationshipElementHandler) Update(submodelID string,
 // Parameters:
 //   - submodelID: The ID of the parent submodel
 //   - idShortOrPath: The idShort or path identifying the element to update
-//   - valueOnly: The new value to set (must be of type gen.AnnotatedRelationshipElementValue)
+//   - valueOnly: The new value to set (gen.AnnotatedRelationshipElementValue or gen.RelationshipElementValue)
 //
 // Returns:
 //   - error: An error if the update operation fails
@@ -200,7 +200,7 @@ func (p PostgreSQLAnnotatedRelationshipElementHandler) UpdateValueOnly(submodelI
 	}
 
 	// Update 'first' and 'second' references for AnnotatedRelationshipElement
-	if areValue, ok := valueOnly.(gen.AnnotatedRelationshipElementValue); ok {
+	if areValue, ok := annotatedRelationshipElementValueOf(valueOnly); ok {
 		dialect := goqu.Dialect("postgres")
 		smDbID, err := persistenceutils.GetSubmodelDatabaseID(tx, submodelID)
 		if err != nil {
@@ -271,6 +271,20 @@ func (p PostgreSQLAnnotatedRelationshipElementHandler) UpdateValueOnly(submodelI
 	return nil
 }
 
+// annotatedRelationshipElementValueOf accepts a RelationshipElementValue as an
+// annotated relationship value without annotations. Value-only payloads omit
+// empty annotations, which makes both element types share the same shape.
+func annotatedRelationshipElementValueOf(valueOnly gen.SubmodelElementValue) (gen.AnnotatedRelationshipElementValue, bool) {
+	switch value := valueOnly.(type) {
+	case gen.AnnotatedRelationshipElementValue:
+		return value, true
+	case gen.RelationshipElementValue:
+		return gen.AnnotatedRelationshipElementValue{First: value.First, Second: value.Second}, true
+	default:
+		return gen.AnnotatedRelationshipElementValue{}, false
+	}
+}
+
 // Delete removes an AnnotatedRelationshipElement identified by its idShort or path from the database.
 // This method delegates the deletion operation to the decorated CRUD handler which handles
 // the cascading deletion of all related data and child elements.
@@ -31,6 +31,7 @@ package submodelelements
 
 import (
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
@@ -156,7 +157,12 @@ func (p PostgreSQLBlobHandler) UpdateValueOnly(submodelID string, idShortOrPath
 			return common.NewErrBadRequest("valueOnly is not of type BlobValue")
 		}
 
-		bytea := []byte(fileValueOnly.Value)
+		// A Blob value in Value-Only format is a base64 string, which is
+		// indistinguishable from a File value until it is decoded here.
+		bytea, decodeErr := base64.StdEncoding.DecodeString(fileValueOnly.Value)
+		if decodeErr != nil {
+			return common.NewErrBadRequest("SMREPO-BLOBVALUEONLY-DECODE blob value is not valid base64: " + decodeErr.Error())
+		}
 
 		blobValueOnly = gen.BlobValue{
 			ContentType: fileValueOnly.ContentType,
@@ -200,7 +206,7 @@ func (p PostgreSQLBlobHandler) UpdateValueOnly(submodelID string, idShortOrPath
 	}
 
 	updateQuery, updateArgs, err := dialect.Update("blob_element").
-		Set(goqu.Record{"content_type": blobValueOnly.ContentType, "value": []byte(blobValueOnly.Value)}).
+		Set(goqu.Record{"content_type": blobValueOnly.ContentType, "value": encodeBlobValue(blobValueOnly.Value)}).
 		Where(goqu.C("id").Eq(elementID)).
 		ToSQL()
 	if err != nil {
@@ -249,19 +255,24 @@ func (p PostgreSQLBlobHandler) GetInsertQueryPart(_ *sql.Tx, id int, element typ
 		contentType = *blob.ContentType()
 	}
 
-	// encode value to base64
-	encoded := common.Encode(blob.Value())
-
 	return &InsertQueryPart{
 		TableName: "blob_element",
 		Record: goqu.Record{
 			"id":           id,
 			"content_type": contentType,
-			"value":        encoded,
+			"value":        encodeBlobValue(blob.Value()),
 		},
 	}, nil
 }
 
+// encodeBlobValue returns the storage representation of a Blob value. Every
+// write path stores the base64url-encoded bytes so the reader can decode them
+// unambiguously; storing raw bytes on some paths would let binary content that
+// happens to be valid base64 be decoded a second time on read.
+func encodeBlobValue(value []byte) string {
+	return common.Encode(value)
+}
+
 func isBlobSizeExceeded(blob *types.Blob) bool {
 	return len(blob.Value()) > smrepoconfig.MaxBlobSizeBytes
 }
@@ -283,7 +294,7 @@ func buildUpdateBlobRecordObject(isPut bool, blob *types.Blob) goqu.Record {
 
 	value := blob.Value()
 	if isPut || len(value) > 0 {
-		updateRecord["value"] = value
+		updateRecord["value"] = encodeBlobValue(value)
 	}
 	return updateRecord
 }
@@ -31,6 +31,7 @@ import (
 	"fmt"
 
 	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/stringification"
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
@@ -201,7 +202,8 @@ func ensureEntityStatementParentLinks(tx *sql.Tx, entityElementID int, rootSmeID
 }
 
 // UpdateValueOnly updates only the value of an existing Entity submodel element identified by its idShort or path.
-// It updates the entity type, global asset ID, and specific asset IDs based on the provided value.
+// It updates the entity type, global asset ID, and specific asset IDs based on the provided value
+// and applies the statement values to the nested elements.
 //
 // Parameters:
 //   - submodelID: The ID of the parent submodel
@@ -253,26 +255,13 @@ func (p PostgreSQLEntityHandler) UpdateValueOnly(submodelID string, idShortOrPat
 		return err
 	}
 
-	var specificAssetIDs string
-	if entityValueOnly.SpecificAssetIds != nil {
-		json := jsoniter.ConfigCompatibleWithStandardLibrary
-		specificAssetIDsBytes, err := json.Marshal(entityValueOnly.SpecificAssetIds)
-		if err != nil {
-			return err
-		}
-		specificAssetIDs = string(specificAssetIDsBytes)
-	} else {
-		specificAssetIDs = "[]"
+	updateRecord, err := buildEntityValueOnlyUpdateRecord(entityValueOnly)
+	if err != nil {
+		return err
 	}
 
 	updateQuery, args, err := dialect.Update("entity_element").
-		Set(
-			goqu.Record{
-				"entity_type":        entityValueOnly.EntityType,
-				"global_asset_id":    entityValueOnly.GlobalAssetID,
-				"specific_asset_ids": specificAssetIDs,
-			},
-		).
+		Set(updateRecord).
 		Where(goqu.C("id").Eq(elementID)).
 		ToSQL()
 	if err != nil {
@@ -345,6 +334,40 @@ func (p PostgreSQLEntityHandler) GetInsertQueryPart(_ *sql.Tx, id int, element t
 	}, nil
 }
 
+// buildEntityValueOnlyUpdateRecord maps an EntityValue onto entity_element columns.
+// entityType is stored as the metamodel enum and is left untouched when the
+// value omits it; globalAssetId and specificAssetIds are replaced.
+func buildEntityValueOnlyUpdateRecord(entityValueOnly gen.EntityValue) (goqu.Record, error) {
+	updateRecord := goqu.Record{}
+
+	if entityValueOnly.EntityType != "" {
+		entityType, ok := stringification.EntityTypeFromString(entityValueOnly.EntityType)
+		if !ok {
+			return nil, common.NewErrBadRequest("SMREPO-UPDENTITYVALONLY-ENTITYTYPE Unknown entityType " + entityValueOnly.EntityType)
+		}
+		updateRecord["entity_type"] = entityType
+	}
+
+	if entityValueOnly.GlobalAssetID != "" {
+		updateRecord["global_asset_id"] = entityValueOnly.GlobalAssetID
+	} else {
+		updateRecord["global_asset_id"] = nil
+	}
+
+	specificAssetIDs := "[]"
+	if entityValueOnly.SpecificAssetIds != nil {
+		json := jsoniter.ConfigCompatibleWithStandardLibrary
+		specificAssetIDsBytes, err := json.Marshal(entityValueOnly.SpecificAssetIds)
+		if err != nil {
+			return nil, common.NewErrBadRequest("SMREPO-UPDENTITYVALONLY-SAAMARSHAL Failed to marshal specificAssetIds: " + err.Error())
+		}
+		specificAssetIDs = string(specificAssetIDsBytes)
+	}
+	updateRecord["specific_asset_ids"] = specificAssetIDs
+
+	return updateRecord, nil
+}
+
 func buildUpdateEntityRecordObject(isPut bool, entity *types.Entity) (goqu.Record, error) {
 	updateRecord := goqu.Record{}
 
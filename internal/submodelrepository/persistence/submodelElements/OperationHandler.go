@@ -190,59 +190,17 @@ func (p PostgreSQLOperationHandler) GetInsertQueryPart(_ *sql.Tx, id int, elemen
 
 	json := jsoniter.ConfigCompatibleWithStandardLibrary
 
-	var inputVars, outputVars, inoutputVars string
-	if operation.InputVariables() != nil {
-		var jsonables []map[string]any
-		for _, v := range operation.InputVariables() {
-			jsonable, err := jsonization.ToJsonable(v)
-			if err != nil {
-				return nil, err
-			}
-			jsonables = append(jsonables, jsonable)
-		}
-		inputVarBytes, err := json.Marshal(jsonables)
-		if err != nil {
-			return nil, err
-		}
-		inputVars = string(inputVarBytes)
-	} else {
-		inputVars = "[]"
+	inputVars, err := marshalOperationVariables(operation.InputVariables(), json)
+	if err != nil {
+		return nil, err
 	}
-
-	if operation.OutputVariables() != nil {
-		var jsonables []map[string]any
-		for _, v := range operation.OutputVariables() {
-			jsonable, err := jsonization.ToJsonable(v)
-			if err != nil {
-				return nil, err
-			}
-			jsonables = append(jsonables, jsonable)
-		}
-		outputVarBytes, err := json.Marshal(jsonables)
-		if err != nil {
-			return nil, err
-		}
-		outputVars = string(outputVarBytes)
-	} else {
-		outputVars = "[]"
+	outputVars, err := marshalOperationVariables(operation.OutputVariables(), json)
+	if err != nil {
+		return nil, err
 	}
-
-	if operation.InoutputVariables() != nil {
-		var jsonables []map[string]any
-		for _, v := range operation.InoutputVariables() {
-			jsonable, err := jsonization.ToJsonable(v)
-			if err != nil {
-				return nil, err
-			}
-			jsonables = append(jsonables, jsonable)
-		}
-		inoutputVarBytes, err := json.Marshal(jsonables)
-		if err != nil {
-			return nil, err
-		}
-		inoutputVars = string(inoutputVarBytes)
-	} else {
-		inoutputVars = "[]"
+	inoutputVars, err := marshalOperationVariables(operation.InoutputVariables(), json)
+	if err != nil {
+		return nil, err
 	}
 
 	return &InsertQueryPart{
@@ -259,70 +217,42 @@ func (p PostgreSQLOperationHandler) GetInsertQueryPart(_ *sql.Tx, id int, elemen
 func buildUpdateOperationRecordObject(isPut bool, operation *types.Operation, json jsoniter.API) (goqu.Record, error) {
 	updateRecord := goqu.Record{}
 
-	if isPut || operation.InputVariables() != nil {
-		var inputVars string
-		if operation.InputVariables() != nil {
-			var jsonables []map[string]any
-			for _, v := range operation.InputVariables() {
-				jsonable, err := jsonization.ToJsonable(v)
-				if err != nil {
-					return nil, err
-				}
-				jsonables = append(jsonables, jsonable)
-			}
-			inputVarBytes, err := json.Marshal(jsonables)
-			if err != nil {
-				return nil, err
-			}
-			inputVars = string(inputVarBytes)
-		} else {
-			inputVars = "[]"
-		}
-		updateRecord["input_variables"] = inputVars
+	variableColumns := []struct {
+		column    string
+		variables []types.IOperationVariable
+	}{
+		{column: "input_variables", variables: operation.InputVariables()},
+		{column: "output_variables", variables: operation.OutputVariables()},
+		{column: "inoutput_variables", variables: operation.InoutputVariables()},
 	}
-
-	if isPut || operation.OutputVariables() != nil {
-		var outputVars string
-		if operation.OutputVariables() != nil {
-			var jsonables []map[string]any
-			for _, v := range operation.OutputVariables() {
-				jsonable, err := jsonization.ToJsonable(v)
-				if err != nil {
-					return nil, err
-				}
-				jsonables = append(jsonables, jsonable)
-			}
-			outputVarBytes, err := json.Marshal(jsonables)
-			if err != nil {
-				return nil, err
-			}
-			outputVars = string(outputVarBytes)
-		} else {
-			outputVars = "[]"
+	for _, vc := range variableColumns {
+		if !isPut && vc.variables == nil {
+			continue
 		}
-		updateRecord["output_variables"] = outputVars
+		serialized, err := marshalOperationVariables(vc.variables, json)
+		if err != nil {
+			return nil, err
+		}
+		updateRecord[vc.column] = serialized
 	}
+	return updateRecord, nil
+}
 
-	if isPut || operation.InoutputVariables() != nil {
-		var inoutputVars string
-		if operation.InoutputVariables() != nil {
-			var jsonables []map[string]any
-			for _, v := range operation.InoutputVariables() {
-				jsonable, err := jsonization.ToJsonable(v)
-				if err != nil {
-					return nil, err
-				}
-				jsonables = append(jsonables, jsonable)
-			}
-			inoutputVarBytes, err := json.Marshal(jsonables)
-			if err != nil {
-				return nil, err
-			}
-			inoutputVars = string(inoutputVarBytes)
-		} else {
-			inoutputVars = "[]"
+// marshalOperationVariables serializes operation variables, including their
+// SubmodelElement values, into the JSON array stored in operation_element.
+// Missing or empty variable lists are stored as an empty array, never as JSON null.
+func marshalOperationVariables(variables []types.IOperationVariable, json jsoniter.API) (string, error) {
+	jsonables := make([]map[string]any, 0, len(variables))
+	for _, variable := range variables {
+		jsonable, err := jsonization.ToJsonable(variable)
+		if err != nil {
+			return "", err
 		}
-		updateRecord["inoutput_variables"] = inoutputVars
+		jsonables = append(jsonables, jsonable)
 	}
-	return updateRecord, nil
+	serialized, err := json.Marshal(jsonables)
+	if err != nil {
+		return "", err
+	}
+	return string(serialized), nil
 }
@@ -178,9 +178,6 @@ func (p PostgreSQLRangeHandler) UpdateValueOnly(submodelID string, idShortOrPath
 		return err
 	}
 
-	// Determine column names based on value type
-	minCol, maxCol := getRangeColumnNames(valueType)
-
 	// Build subquery to get the submodel element ID
 	var elementID int
 	idQuery, args, err := dialect.From("submodel_element").
@@ -197,20 +194,8 @@ func (p PostgreSQLRangeHandler) UpdateValueOnly(submodelID string, idShortOrPath
 		return err
 	}
 
-	// Build update record with all columns, setting unused ones to NULL
-	updateRecord := goqu.Record{
-		"min_text":     nil,
-		"max_text":     nil,
-		"min_num":      nil,
-		"max_num":      nil,
-		"min_time":     nil,
-		"max_time":     nil,
-		"min_datetime": nil,
-		"max_datetime": nil,
-	}
-	// Set the appropriate columns based on value type
-	updateRecord[minCol] = rangeValue.Min
-	updateRecord[maxCol] = rangeValue.Max
+	// Write min/max into the columns matching the value type and clear all others
+	updateRecord := buildRangeValueOnlyUpdateRecord(valueType, rangeValue)
 
 	// Build and execute update query
 	updateQuery, updateArgs, err := dialect.Update("range_element").
@@ -269,29 +254,46 @@ func (p PostgreSQLRangeHandler) GetInsertQueryPart(_ *sql.Tx, id int, element ty
 	}
 	typedValue := MapRangeValueByType(rangeElem.ValueType(), minVal, maxVal)
 
+	record := typedRangeRecord(typedValue)
+	record["id"] = id
+	record["value_type"] = rangeElem.ValueType()
+
 	return &InsertQueryPart{
 		TableName: "range_element",
-		Record: goqu.Record{
-			"id":           id,
-			"value_type":   rangeElem.ValueType(),
-			"min_text":     typedValue.MinText,
-			"max_text":     typedValue.MaxText,
-			"min_num":      typedValue.MinNumeric,
-			"max_num":      typedValue.MaxNumeric,
-			"min_time":     typedValue.MinTime,
-			"max_time":     typedValue.MaxTime,
-			"min_date":     typedValue.MinDate,
-			"max_date":     typedValue.MaxDate,
-			"min_datetime": typedValue.MinDateTime,
-			"max_datetime": typedValue.MaxDateTime,
-		},
+		Record:    record,
 	}, nil
 }
 
-// getRangeColumnNames returns the appropriate column names for min and max values
-// based on the XML Schema datatype of the Range element.
-func getRangeColumnNames(valueType types.DataTypeDefXSD) (minCol, maxCol string) {
-	return GetRangeColumnNames(valueType)
+// typedRangeRecord returns a range_element record covering every typed min/max
+// column. Only the columns matching the value type are non-NULL, so a rewrite
+// never leaves a stale bound behind in a column of a previous value type.
+func typedRangeRecord(typedValue TypedRangeValue) goqu.Record {
+	return goqu.Record{
+		"min_text":     typedValue.MinText,
+		"max_text":     typedValue.MaxText,
+		"min_num":      typedValue.MinNumeric,
+		"max_num":      typedValue.MaxNumeric,
+		"min_time":     typedValue.MinTime,
+		"max_time":     typedValue.MaxTime,
+		"min_date":     typedValue.MinDate,
+		"max_date":     typedValue.MaxDate,
+		"min_datetime": typedValue.MinDateTime,
+		"max_datetime": typedValue.MaxDateTime,
+	}
+}
+
+// buildRangeValueOnlyUpdateRecord maps a value-only Range update onto the typed
+// columns of the stored value type. Missing bounds are written as NULL.
+func buildRangeValueOnlyUpdateRecord(valueType types.DataTypeDefXSD, rangeValue gen.RangeValue) goqu.Record {
+	minVal := ""
+	if rangeValue.Min != nil {
+		minVal = *rangeValue.Min
+	}
+	maxVal := ""
+	if rangeValue.Max != nil {
+		maxVal = *rangeValue.Max
+	}
+	return typedRangeRecord(MapRangeValueByType(valueType, minVal, maxVal))
 }
 
 func buildUpdateRangeRecordObject(rangeElem *types.Range, isPut bool) goqu.Record {
@@ -308,16 +310,9 @@ func buildUpdateRangeRecordObject(rangeElem *types.Range, isPut bool) goqu.Recor
 			panic("Both 'Min' and 'Max' values must be provided for Range element in PUT operation")
 		}
 		typedValue := MapRangeValueByType(rangeElem.ValueType(), *rangeElem.Min(), *rangeElem.Max())
-		updateRecord["min_text"] = typedValue.MinText
-		updateRecord["max_text"] = typedValue.MaxText
-		updateRecord["min_num"] = typedValue.MinNumeric
-		updateRecord["max_num"] = typedValue.MaxNumeric
-		updateRecord["min_time"] = typedValue.MinTime
-		updateRecord["max_time"] = typedValue.MaxTime
-		updateRecord["min_date"] = typedValue.MinDate
-		updateRecord["max_date"] = typedValue.MaxDate
-		updateRecord["min_datetime"] = typedValue.MinDateTime
-		updateRecord["max_datetime"] = typedValue.MaxDateTime
+		for column, value := range typedRangeRecord(typedValue) {
+			updateRecord[column] = value
+		}
 	} else { //nolint:all - elseif: can replace 'else {if cond {}}' with 'else if cond {}' -> this would make the code less readable and has differing semantics
 		// PATCH: Only update if minVal/max are provided
 		minVal := ""
@@ -587,6 +587,10 @@ func (p *PostgreSQLSMECrudHandler) UpdateIdShortPaths(tx *sql.Tx, submodelID str
 		return oldPath, nil
 	}
 
+	if err = validateIDShortPathSegment(newIDShort, "SMREPO-UPDPATH-IDSHORTDELIMITER"); err != nil {
+		return "", err
+	}
+
 	// Compute the new path by replacing the last segment of oldPath
 	newPath := computeNewPath(oldPath, newIDShort)
 
@@ -0,0 +1,131 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func annotatedRelationshipTestRows(linkAnnotationsToParent bool) []loadedSMERow {
+	relationshipValue := json.RawMessage(`{"first":null,"second":null}`)
+	rows := []loadedSMERow{{
+		row: model.SubmodelElementRow{
+			DbID:        sql.NullInt64{Int64: 1, Valid: true},
+			IDShort:     sql.NullString{String: "rel", Valid: true},
+			IDShortPath: "rel",
+			ModelType:   int64(types.ModelTypeAnnotatedRelationshipElement),
+			Value:       &relationshipValue,
+		},
+		semanticPayload: []byte(sharedSemanticIDPayload),
+		semanticVisible: true,
+		valueVisible:    true,
+	}}
+	for i, idShort := range []string{"note", "author"} {
+		value := json.RawMessage(fmt.Sprintf(`{"value_type":%d,"value":"%s"}`, types.DataTypeDefXSDString, idShort))
+		rows = append(rows, loadedSMERow{
+			row: model.SubmodelElementRow{
+				DbID:        sql.NullInt64{Int64: int64(i + 2), Valid: true},
+				ParentID:    sql.NullInt64{Int64: 1, Valid: linkAnnotationsToParent},
+				IDShort:     sql.NullString{String: idShort, Valid: true},
+				IDShortPath: "rel." + idShort,
+				Position:    i,
+				ModelType:   int64(types.ModelTypeProperty),
+				Value:       &value,
+			},
+			semanticPayload: []byte(sharedSemanticIDPayload),
+			semanticVisible: true,
+			valueVisible:    true,
+		})
+	}
+	return rows
+}
+
+func requireRelationshipAnnotations(t *testing.T, element types.ISubmodelElement) {
+	t.Helper()
+
+	relationship, ok := element.(types.IAnnotatedRelationshipElement)
+	require.True(t, ok)
+	annotations := relationship.Annotations()
+	require.Len(t, annotations, 2)
+	require.Equal(t, "note", *annotations[0].IDShort())
+	require.Equal(t, "author", *annotations[1].IDShort())
+}
+
+func newAnnotatedRelationshipTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+// The standard listing loader attaches annotations the same way it attaches collection children.
+func TestBuildSubmodelElementForestAttachesRelationshipAnnotations(t *testing.T) {
+	t.Parallel()
+
+	db := newAnnotatedRelationshipTestDB(t)
+	elements, err := buildSubmodelElementForestFromRows(db, annotatedRelationshipTestRows(true))
+	require.NoError(t, err)
+	require.Len(t, elements, 1)
+	requireRelationshipAnnotations(t, elements[1])
+}
+
+// The path loader attaches annotations both through parent ids and through the idShortPath fallback.
+func TestBuildSubmodelElementTreeAttachesRelationshipAnnotations(t *testing.T) {
+	t.Parallel()
+
+	for _, linked := range []bool{true, false} {
+		t.Run(fmt.Sprintf("parentLinked=%t", linked), func(t *testing.T) {
+			t.Parallel()
+
+			db := newAnnotatedRelationshipTestDB(t)
+			element, err := buildSubmodelElementTreeFromRows(db, annotatedRelationshipTestRows(linked), "urn:example:submodel", "rel")
+			require.NoError(t, err)
+			requireRelationshipAnnotations(t, element)
+		})
+	}
+}
+
+func TestIsDirectChildPath(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isDirectChildPath("rel", "rel.note"))
+	require.True(t, isDirectChildPath("list", "list[0]"))
+	require.False(t, isDirectChildPath("rel", "rel.note.nested"))
+	require.False(t, isDirectChildPath("rel", "relation.note"))
+}
@@ -0,0 +1,116 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newStringProperty(idShort string) types.ISubmodelElement {
+	property := types.NewProperty(types.DataTypeDefXSDString)
+	property.SetIDShort(&idShort)
+	return property
+}
+
+func TestInsertSubmodelElementsRejectsIdShortWithPathDelimiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		element  func() types.ISubmodelElement
+		wantPath string
+	}{
+		{
+			name:     "top-level dot",
+			element:  func() types.ISubmodelElement { return newStringProperty("foo.bar") },
+			wantPath: "'foo.bar'",
+		},
+		{
+			name: "nested bracket",
+			element: func() types.ISubmodelElement {
+				idShort := "outer"
+				collection := types.NewSubmodelElementCollection()
+				collection.SetIDShort(&idShort)
+				collection.SetValue([]types.ISubmodelElement{newStringProperty("in[0]")})
+				return collection
+			},
+			wantPath: "'outer.in[0]'",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectBegin()
+			tx, err := db.Begin()
+			require.NoError(t, err)
+			mock.ExpectRollback()
+
+			_, err = InsertSubmodelElementsForSubmodelDatabaseID(db, 7, []types.ISubmodelElement{tc.element()}, tx, &BatchInsertContext{})
+			require.Error(t, err)
+			require.True(t, common.IsErrBadRequest(err), "expected bad request, got %v", err)
+			require.Contains(t, err.Error(), "SMREPO-INSSME-IDSHORTDELIMITER")
+			require.Contains(t, err.Error(), tc.wantPath)
+
+			require.NoError(t, tx.Rollback())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateIdShortPathsRejectsIdShortWithPathDelimiter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectRollback()
+
+	handler, err := NewPostgreSQLSMECrudHandler(db)
+	require.NoError(t, err)
+	_, err = handler.UpdateIdShortPaths(tx, "sm-1", "parent.old", "foo.bar")
+	require.True(t, common.IsErrBadRequest(err), "expected bad request, got %v", err)
+	require.Regexp(t, regexp.MustCompile(`SMREPO-UPDPATH-IDSHORTDELIMITER idShort 'foo\.bar'`), err.Error())
+
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateIDShortPathSegmentAcceptsRegularIdShorts(t *testing.T) {
+	for _, idShort := range []string{"foo", "foo_bar", "foo-bar", "Foo1"} {
+		require.NoError(t, validateIDShortPathSegment(idShort, "TEST"), idShort)
+	}
+}
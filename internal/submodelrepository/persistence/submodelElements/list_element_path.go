@@ -0,0 +1,116 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	persistenceutils "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/utils"
+)
+
+// splitListIndexPath splits an idShort path that ends with a list index, such
+// as "myList[2]", into the path of the list and the index.
+func splitListIndexPath(idShortPath string) (string, int, bool) {
+	if !strings.HasSuffix(idShortPath, "]") {
+		return "", 0, false
+	}
+	bracket := strings.LastIndex(idShortPath, "[")
+	if bracket <= 0 {
+		return "", 0, false
+	}
+	index, err := strconv.Atoi(idShortPath[bracket+1 : len(idShortPath)-1])
+	if err != nil || index < 0 {
+		return "", 0, false
+	}
+	return idShortPath[:bracket], index, true
+}
+
+// ListIndexNotFoundError refines the not found error of an idShort path
+// without stored element. When the path addresses a child of a
+// SubmodelElementList by index, such as "myList[7]", the returned error names
+// the list and its size so that an out-of-range index can be told apart from
+// a missing list. All other paths keep notFound.
+//
+// Parameters:
+//   - tx: Transaction used for the lookup
+//   - submodelID: Identifier of the Submodel
+//   - idShortPath: Path that did not resolve to an element
+//   - code: Error code prefix of the calling operation
+//   - notFound: Error reported when the index is not out of range of a list
+//
+// Returns:
+//   - error: Not found error, or an internal server error when the lookup fails
+func ListIndexNotFoundError(tx *sql.Tx, submodelID string, idShortPath string, code string, notFound error) error {
+	listPath, index, ok := splitListIndexPath(idShortPath)
+	if !ok {
+		return notFound
+	}
+
+	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseID(tx, submodelID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return common.NewInternalServerError(code + "-GETSMID " + err.Error())
+	}
+
+	dialect := goqu.Dialect("postgres")
+	query, args, err := dialect.From(goqu.T("submodel_element").As("list")).
+		LeftJoin(
+			goqu.T("submodel_element").As("child"),
+			goqu.On(goqu.I("child.parent_sme_id").Eq(goqu.I("list.id"))),
+		).
+		Select(goqu.I("list.model_type"), goqu.COUNT(goqu.I("child.id"))).
+		Where(
+			goqu.I("list.submodel_id").Eq(submodelDatabaseID),
+			goqu.I("list.idshort_path").Eq(listPath),
+		).
+		GroupBy(goqu.I("list.id"), goqu.I("list.model_type")).
+		ToSQL()
+	if err != nil {
+		return common.NewInternalServerError(code + "-LISTSIZE-TOSQL " + err.Error())
+	}
+
+	var modelType types.ModelType
+	var size int
+	if err = tx.QueryRow(query, args...).Scan(&modelType, &size); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return common.NewInternalServerError(code + "-LISTSIZE-EXEC " + err.Error())
+	}
+	if modelType != types.ModelTypeSubmodelElementList {
+		return notFound
+	}
+	return common.NewErrNotFound(code + "-INDEXOUTOFRANGE index " + strconv.Itoa(index) + " is out of range for SubmodelElementList '" + listPath + "' with " + strconv.Itoa(size) + " elements")
+}
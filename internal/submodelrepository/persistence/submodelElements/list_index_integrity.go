@@ -0,0 +1,287 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strconv"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	persistenceutils "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/utils"
+)
+
+// ListIndexViolation describes a SubmodelElementList whose children are not
+// stored at the contiguous positions 0..n-1 or whose idShort paths do not
+// match their positions.
+type ListIndexViolation struct {
+	// ListPath is the idShort path of the affected SubmodelElementList.
+	ListPath string `json:"listPath"`
+	// Positions lists the stored child positions in list order; -1 marks a
+	// child without a stored position.
+	Positions []int `json:"positions"`
+	// MismatchedPaths lists child idShort paths that do not end with the
+	// index the child is expected at.
+	MismatchedPaths []string `json:"mismatchedPaths,omitempty"`
+}
+
+type listIndexGroup struct {
+	listPath string
+	children []listChildToCompact
+}
+
+// ValidateListIndices reports every SubmodelElementList of a Submodel whose
+// child positions contain gaps or duplicates, or whose child idShort paths are
+// out of sync with the positions.
+//
+// Parameters:
+//   - ctx: Request context used for the queries
+//   - tx: Transaction used for the read
+//   - submodelID: Identifier of the Submodel to inspect
+//
+// Returns:
+//   - []ListIndexViolation: One entry per inconsistent list, ordered by list path
+//   - error: Not found error when the Submodel does not exist, otherwise an
+//     internal server error when the children cannot be loaded
+func ValidateListIndices(ctx context.Context, tx *sql.Tx, submodelID string) ([]ListIndexViolation, error) {
+	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseID(tx, submodelID)
+	if err != nil {
+		return nil, mapListIndexSubmodelLookupError("SMREPO-VALIDATELISTIDX-GETSMID", submodelID, err)
+	}
+
+	groups, err := loadListIndexGroups(ctx, tx, submodelDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]ListIndexViolation, 0)
+	for _, group := range groups {
+		if violation, ok := findListIndexViolation(group); ok {
+			violations = append(violations, violation)
+		}
+	}
+	return violations, nil
+}
+
+// RepairListIndices renumbers the children of every inconsistent
+// SubmodelElementList of a Submodel to the contiguous positions 0..n-1 while
+// keeping their current order, and rewrites the idShort paths of the children
+// and their descendants accordingly.
+//
+// Affected children are first parked on negative temporary slots so the
+// renumbering cannot collide with the sibling uniqueness constraints.
+//
+// Parameters:
+//   - ctx: Request context used for the queries
+//   - tx: Transaction used for the repair; the Submodel row is locked
+//   - submodelID: Identifier of the Submodel to repair
+//
+// Returns:
+//   - []ListIndexViolation: The violations found before the repair, ordered
+//     by list path
+//   - error: Not found error when the Submodel does not exist, otherwise an
+//     internal server error when reading or updating fails
+func RepairListIndices(ctx context.Context, tx *sql.Tx, submodelID string) ([]ListIndexViolation, error) {
+	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseIDForUpdate(tx, submodelID)
+	if err != nil {
+		return nil, mapListIndexSubmodelLookupError("SMREPO-REPAIRLISTIDX-GETSMID", submodelID, err)
+	}
+
+	groups, err := loadListIndexGroups(ctx, tx, submodelDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the groups backwards like compactListIndices so nested lists are
+	// renumbered before an enclosing list rewrites their paths.
+	repaired := make([]ListIndexViolation, 0)
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		violation, ok := findListIndexViolation(group)
+		if !ok {
+			continue
+		}
+		if err = renumberListChildren(ctx, tx, submodelDatabaseID, group); err != nil {
+			return nil, err
+		}
+		repaired = append(repaired, violation)
+	}
+	slices.Reverse(repaired)
+	return repaired, nil
+}
+
+// compactListIndices renumbers the children of the given lists to the
+// contiguous positions 0..n-1 after bulk operations removed entries. Lists
+// that are already contiguous or no longer exist are left unchanged.
+func compactListIndices(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, listPaths map[string]struct{}) error {
+	if len(listPaths) == 0 {
+		return nil
+	}
+
+	groups, err := loadListIndexGroups(ctx, tx, submodelDatabaseID)
+	if err != nil {
+		return err
+	}
+	// Groups are ordered by list path, so walking them backwards renumbers
+	// nested lists before an enclosing list rewrites their paths.
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		if _, affected := listPaths[group.listPath]; !affected {
+			continue
+		}
+		if _, ok := findListIndexViolation(group); !ok {
+			continue
+		}
+		if err = renumberListChildren(ctx, tx, submodelDatabaseID, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mapListIndexSubmodelLookupError(code string, submodelID string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return common.NewErrNotFound(code + "-NOTFOUND Submodel with ID '" + submodelID + "' not found")
+	}
+	return common.NewInternalServerError(code + "-EXEC Failed to resolve Submodel: " + err.Error())
+}
+
+func loadListIndexGroups(ctx context.Context, tx *sql.Tx, submodelDatabaseID int) ([]listIndexGroup, error) {
+	dialect := goqu.Dialect("postgres")
+	query, args, err := dialect.From(goqu.T("submodel_element").As("child")).
+		InnerJoin(
+			goqu.T("submodel_element").As("parent"),
+			goqu.On(goqu.I("parent.id").Eq(goqu.I("child.parent_sme_id"))),
+		).
+		Select(
+			goqu.I("parent.idshort_path"),
+			goqu.I("child.id"),
+			goqu.I("child.idshort_path"),
+			goqu.I("child.position"),
+		).
+		Where(
+			goqu.I("child.submodel_id").Eq(submodelDatabaseID),
+			goqu.I("parent.model_type").Eq(types.ModelTypeSubmodelElementList),
+		).
+		Order(
+			goqu.I("parent.idshort_path").Asc(),
+			goqu.I("child.position").Asc().NullsLast(),
+			goqu.I("child.id").Asc(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-LISTIDX-SELECTCHILDREN-TOSQL Failed to build list children query: " + err.Error())
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-LISTIDX-SELECTCHILDREN-EXEC Failed to execute list children query: " + err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	groups := make([]listIndexGroup, 0)
+	for rows.Next() {
+		var listPath string
+		var child listChildToCompact
+		var position sql.NullInt64
+		if err = rows.Scan(&listPath, &child.id, &child.oldPath, &position); err != nil {
+			return nil, common.NewInternalServerError("SMREPO-LISTIDX-SELECTCHILDREN-SCAN Failed to scan list child row: " + err.Error())
+		}
+		child.oldPosition = -1
+		if position.Valid {
+			child.oldPosition = int(position.Int64)
+		}
+
+		if len(groups) == 0 || groups[len(groups)-1].listPath != listPath {
+			groups = append(groups, listIndexGroup{listPath: listPath})
+		}
+		groups[len(groups)-1].children = append(groups[len(groups)-1].children, child)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-LISTIDX-SELECTCHILDREN-ROWS Failed to read list child rows: " + err.Error())
+	}
+	return groups, nil
+}
+
+func findListIndexViolation(group listIndexGroup) (ListIndexViolation, bool) {
+	violation := ListIndexViolation{
+		ListPath:  group.listPath,
+		Positions: make([]int, 0, len(group.children)),
+	}
+	inconsistent := false
+	for index, child := range group.children {
+		violation.Positions = append(violation.Positions, child.oldPosition)
+		if child.oldPosition != index {
+			inconsistent = true
+		}
+		if child.oldPath != listChildPath(group.listPath, index) {
+			inconsistent = true
+			violation.MismatchedPaths = append(violation.MismatchedPaths, child.oldPath)
+		}
+	}
+	return violation, inconsistent
+}
+
+func renumberListChildren(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, group listIndexGroup) error {
+	pending := make([]listChildToCompact, 0, len(group.children))
+	targets := make([]int, 0, len(group.children))
+	for index, child := range group.children {
+		if child.oldPosition == index && child.oldPath == listChildPath(group.listPath, index) {
+			continue
+		}
+		temporaryPosition := -(index + 1)
+		temporaryPath := listChildPath(group.listPath, temporaryPosition)
+		if err := updateListChildPath(ctx, tx, submodelDatabaseID, child.oldPath, temporaryPath); err != nil {
+			return err
+		}
+		if err := updateListChildPosition(ctx, tx, submodelDatabaseID, child.id, temporaryPosition); err != nil {
+			return err
+		}
+		pending = append(pending, listChildToCompact{id: child.id, oldPath: temporaryPath, oldPosition: temporaryPosition})
+		targets = append(targets, index)
+	}
+
+	for i, child := range pending {
+		if err := updateListChildPath(ctx, tx, submodelDatabaseID, child.oldPath, listChildPath(group.listPath, targets[i])); err != nil {
+			return err
+		}
+		if err := updateListChildPosition(ctx, tx, submodelDatabaseID, child.id, targets[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listChildPath(listPath string, index int) string {
+	return listPath + "[" + strconv.Itoa(index) + "]"
+}
@@ -0,0 +1,267 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+var listIndexChildColumns = []string{"idshort_path", "id", "idshort_path", "position"}
+
+func TestValidateListIndicesDetectsGap(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "child" INNER JOIN "submodel_element" AS "parent"`).
+		WillReturnRows(sqlmock.NewRows(listIndexChildColumns).
+			AddRow("Intact", 10, "Intact[0]", 0).
+			AddRow("Intact", 11, "Intact[1]", 1).
+			AddRow("Gapped", 20, "Gapped[0]", 0).
+			AddRow("Gapped", 21, "Gapped[2]", 2))
+	mock.ExpectRollback()
+
+	violations, err := ValidateListIndices(t.Context(), tx, "sm-1")
+	require.NoError(t, err)
+	require.Equal(t, []ListIndexViolation{{
+		ListPath:        "Gapped",
+		Positions:       []int{0, 2},
+		MismatchedPaths: []string{"Gapped[2]"},
+	}}, violations)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateListIndicesReturnsNotFoundForUnknownSubmodel(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	_, err = ValidateListIndices(t.Context(), tx, "missing")
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepairListIndicesRenumbersGappedChildren(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel".*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "child"`).
+		WillReturnRows(sqlmock.NewRows(listIndexChildColumns).
+			AddRow("Gapped", 20, "Gapped[0]", 0).
+			AddRow("Gapped", 21, "Gapped[2]", 2).
+			AddRow("Gapped", 22, "Gapped[5]", 5))
+
+	expectListChildPathUpdate(mock, "Gapped[-2]", "Gapped[2]")
+	expectListChildPositionUpdate(mock, -2, 21)
+	expectListChildPathUpdate(mock, "Gapped[-3]", "Gapped[5]")
+	expectListChildPositionUpdate(mock, -3, 22)
+	expectListChildPathUpdate(mock, "Gapped[1]", "Gapped[-2]")
+	expectListChildPositionUpdate(mock, 1, 21)
+	expectListChildPathUpdate(mock, "Gapped[2]", "Gapped[-3]")
+	expectListChildPositionUpdate(mock, 2, 22)
+	mock.ExpectRollback()
+
+	repaired, err := RepairListIndices(t.Context(), tx, "sm-1")
+	require.NoError(t, err)
+	require.Len(t, repaired, 1)
+	require.Equal(t, []int{0, 2, 5}, repaired[0].Positions)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepairListIndicesRenumbersNestedListBeforeEnclosingList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel".*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "child"`).
+		WillReturnRows(sqlmock.NewRows(listIndexChildColumns).
+			AddRow("Outer", 30, "Outer[0]", 0).
+			AddRow("Outer", 31, "Outer[2]", 2).
+			AddRow("Outer[2]", 40, "Outer[2][0]", 0).
+			AddRow("Outer[2]", 41, "Outer[2][3]", 3))
+
+	// The inner list is repaired under its stored path first; renumbering
+	// the outer list afterwards moves the repaired subtree as a whole.
+	expectListChildPathUpdate(mock, "Outer[2][-2]", "Outer[2][3]")
+	expectListChildPositionUpdate(mock, -2, 41)
+	expectListChildPathUpdate(mock, "Outer[2][1]", "Outer[2][-2]")
+	expectListChildPositionUpdate(mock, 1, 41)
+	expectListChildPathUpdate(mock, "Outer[-2]", "Outer[2]")
+	expectListChildPositionUpdate(mock, -2, 31)
+	expectListChildPathUpdate(mock, "Outer[1]", "Outer[-2]")
+	expectListChildPositionUpdate(mock, 1, 31)
+	mock.ExpectRollback()
+
+	repaired, err := RepairListIndices(t.Context(), tx, "sm-1")
+	require.NoError(t, err)
+	require.Len(t, repaired, 2)
+	require.Equal(t, "Outer", repaired[0].ListPath)
+	require.Equal(t, "Outer[2]", repaired[1].ListPath)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepairListIndicesLeavesConsistentListsUntouched(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel".*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "child"`).
+		WillReturnRows(sqlmock.NewRows(listIndexChildColumns).
+			AddRow("Intact", 10, "Intact[0]", 0).
+			AddRow("Intact", 11, "Intact[1]", 1))
+	mock.ExpectRollback()
+
+	repaired, err := RepairListIndices(t.Context(), tx, "sm-1")
+	require.NoError(t, err)
+	require.Empty(t, repaired)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSubmodelElementsByPathsCompactsListOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel".*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	for _, deletedPath := range []string{"Items[1]", "Items[3]"} {
+		mock.ExpectQuery(`SELECT COUNT\(\*\).*lo_unlink.*file_data`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
+		mock.ExpectExec(`DELETE FROM "submodel_element".*` + regexp.QuoteMeta(`'`+deletedPath+`'`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "child"`).
+		WillReturnRows(sqlmock.NewRows(listIndexChildColumns).
+			AddRow("Items", 30, "Items[0]", 0).
+			AddRow("Items", 32, "Items[2]", 2).
+			AddRow("Items", 34, "Items[4]", 4))
+
+	expectListChildPathUpdate(mock, "Items[-2]", "Items[2]")
+	expectListChildPositionUpdate(mock, -2, 32)
+	expectListChildPathUpdate(mock, "Items[-3]", "Items[4]")
+	expectListChildPositionUpdate(mock, -3, 34)
+	expectListChildPathUpdate(mock, "Items[1]", "Items[-2]")
+	expectListChildPositionUpdate(mock, 1, 32)
+	expectListChildPathUpdate(mock, "Items[2]", "Items[-3]")
+	expectListChildPositionUpdate(mock, 2, 34)
+	mock.ExpectRollback()
+
+	err = DeleteSubmodelElementsByPaths(t.Context(), tx, "sm-1", []string{"Items[3]", "Items[1]", "Items[3].Nested"})
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSubmodelElementsByPathsReturnsNotFoundForMissingElement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel".*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT COUNT\(\*\).*lo_unlink.*file_data`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
+	mock.ExpectExec(`DELETE FROM "submodel_element"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = DeleteSubmodelElementsByPaths(t.Context(), tx, "sm-1", []string{"Missing"})
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutermostSubmodelElementPathsDropsNestedAndDuplicatePaths(t *testing.T) {
+	require.Equal(t,
+		[]string{"Items[10]", "Items[1]", "Other"},
+		outermostSubmodelElementPaths([]string{"Other", "Items[10]", "Items[1].Nested", "Items[1]", "Other", "Items[1][0]"}),
+	)
+}
+
+func expectListChildPathUpdate(mock sqlmock.Sqlmock, newPath string, oldPath string) {
+	mock.ExpectExec(regexp.QuoteMeta(`SET "idshort_path"='`+newPath+`' || SUBSTRING(idshort_path FROM `+strconv.Itoa(len(oldPath)+1)+`)`) +
+		`.*` + regexp.QuoteMeta(`("idshort_path" = '`+oldPath+`')`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectListChildPositionUpdate(mock sqlmock.Sqlmock, newPosition int, childID int) {
+	mock.ExpectExec(regexp.QuoteMeta(`SET "position"=` + strconv.Itoa(newPosition) + ` WHERE (("submodel_id" = 7) AND ("id" = ` + strconv.Itoa(childID) + `))`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
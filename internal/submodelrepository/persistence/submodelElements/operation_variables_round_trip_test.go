@@ -0,0 +1,148 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+)
+
+const operationWithVariablesJSON = `{
+	"modelType": "Operation",
+	"idShort": "Calibrate",
+	"inputVariables": [
+		{"value": {"modelType": "Property", "idShort": "Offset", "valueType": "xs:double", "value": "0.25"}},
+		{"value": {"modelType": "MultiLanguageProperty", "idShort": "Label", "value": [{"language": "en", "text": "calibration"}]}}
+	],
+	"outputVariables": [
+		{"value": {"modelType": "SubmodelElementCollection", "idShort": "Result", "value": [
+			{"modelType": "Property", "idShort": "Success", "valueType": "xs:boolean", "value": "true"},
+			{"modelType": "Range", "idShort": "Tolerance", "valueType": "xs:int", "min": "-1", "max": "1"}
+		]}}
+	],
+	"inoutputVariables": [
+		{"value": {"modelType": "Property", "idShort": "Counter", "valueType": "xs:int", "value": "3"}}
+	]
+}`
+
+func operationVariablesJsonable(t *testing.T, variables []types.IOperationVariable) []map[string]any {
+	t.Helper()
+
+	jsonables := make([]map[string]any, 0, len(variables))
+	for _, variable := range variables {
+		jsonable, err := jsonization.ToJsonable(variable)
+		require.NoError(t, err)
+		jsonables = append(jsonables, jsonable)
+	}
+	return jsonables
+}
+
+// Operation variables are SubmodelElements themselves and must survive a write/read cycle unchanged.
+func TestOperationVariablesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var jsonable map[string]any
+	require.NoError(t, json.Unmarshal([]byte(operationWithVariablesJSON), &jsonable))
+	element, err := jsonization.SubmodelElementFromJsonable(jsonable)
+	require.NoError(t, err)
+	operation, ok := element.(*types.Operation)
+	require.True(t, ok)
+
+	part, err := PostgreSQLOperationHandler{}.GetInsertQueryPart(nil, 11, operation)
+	require.NoError(t, err)
+	require.Equal(t, "operation_element", part.TableName)
+	require.Equal(t, 11, part.Record["id"])
+
+	// The read path returns the stored JSONB columns as one value object.
+	raw, err := json.Marshal(map[string]json.RawMessage{
+		"input_variables":    json.RawMessage(part.Record["input_variables"].(string)),
+		"output_variables":   json.RawMessage(part.Record["output_variables"].(string)),
+		"inoutput_variables": json.RawMessage(part.Record["inoutput_variables"].(string)),
+	})
+	require.NoError(t, err)
+	value := json.RawMessage(raw)
+
+	readBack, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+		IDShort:   sql.NullString{String: "Calibrate", Valid: true},
+		ModelType: int64(types.ModelTypeOperation),
+		Value:     &value,
+	}, nil)
+	require.NoError(t, err)
+	readOperation, ok := readBack.(*types.Operation)
+	require.True(t, ok)
+
+	require.Len(t, readOperation.InputVariables(), 2)
+	require.Len(t, readOperation.OutputVariables(), 1)
+	require.Len(t, readOperation.InoutputVariables(), 1)
+	require.Equal(t, operationVariablesJsonable(t, operation.InputVariables()), operationVariablesJsonable(t, readOperation.InputVariables()))
+	require.Equal(t, operationVariablesJsonable(t, operation.OutputVariables()), operationVariablesJsonable(t, readOperation.OutputVariables()))
+	require.Equal(t, operationVariablesJsonable(t, operation.InoutputVariables()), operationVariablesJsonable(t, readOperation.InoutputVariables()))
+
+	readJsonable, err := jsonization.ToJsonable(readOperation)
+	require.NoError(t, err)
+	require.Equal(t, jsonable, normalizeJSON(t, readJsonable))
+}
+
+func normalizeJSON(t *testing.T, value any) map[string]any {
+	t.Helper()
+
+	raw, err := json.Marshal(value)
+	require.NoError(t, err)
+	var normalized map[string]any
+	require.NoError(t, json.Unmarshal(raw, &normalized))
+	return normalized
+}
+
+func TestOperationUpdateRecordVariables(t *testing.T) {
+	t.Parallel()
+
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+	idShort := "Offset"
+	input := types.NewOperationVariable(types.NewProperty(types.DataTypeDefXSDDouble))
+	input.Value().SetIDShort(&idShort)
+
+	operation := types.NewOperation()
+	operation.SetInputVariables([]types.IOperationVariable{input})
+
+	patchRecord, err := buildUpdateOperationRecordObject(false, operation, json)
+	require.NoError(t, err)
+	require.Len(t, patchRecord, 1)
+	require.JSONEq(t, `[{"value":{"modelType":"Property","idShort":"Offset","valueType":"xs:double"}}]`, patchRecord["input_variables"].(string))
+
+	putRecord, err := buildUpdateOperationRecordObject(true, operation, json)
+	require.NoError(t, err)
+	require.Equal(t, patchRecord["input_variables"], putRecord["input_variables"])
+	require.Equal(t, "[]", putRecord["output_variables"])
+	require.Equal(t, "[]", putRecord["inoutput_variables"])
+}
@@ -0,0 +1,92 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+// Zero and false are real values and must not be confused with a missing (NULL) value.
+func TestPropertyZeroAndFalseValuesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	zero := "0"
+	falseValue := "false"
+	tests := []struct {
+		name      string
+		valueType types.DataTypeDefXSD
+		value     *string
+		column    func(TypedValue) sql.NullString
+	}{
+		{name: "int zero", valueType: types.DataTypeDefXSDInt, value: &zero, column: func(tv TypedValue) sql.NullString { return tv.Numeric }},
+		{name: "double zero", valueType: types.DataTypeDefXSDDouble, value: &zero, column: func(tv TypedValue) sql.NullString { return tv.Numeric }},
+		{name: "boolean false", valueType: types.DataTypeDefXSDBoolean, value: &falseValue, column: func(tv TypedValue) sql.NullString { return tv.Boolean }},
+		{name: "missing int", valueType: types.DataTypeDefXSDInt, column: func(tv TypedValue) sql.NullString { return tv.Numeric }},
+		{name: "missing boolean", valueType: types.DataTypeDefXSDBoolean, column: func(tv TypedValue) sql.NullString { return tv.Boolean }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stored := tt.column(MapValueByType(tt.valueType, tt.value))
+			require.Equal(t, tt.value != nil, stored.Valid, "only a missing value may be written as NULL")
+
+			// The read path renders the typed column as text and leaves SQL NULL as JSON null.
+			payload := map[string]any{"value_type": tt.valueType, "value": nil}
+			if stored.Valid {
+				payload["value"] = stored.String
+			}
+			raw, err := json.Marshal(payload)
+			require.NoError(t, err)
+			value := json.RawMessage(raw)
+
+			element, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+				IDShort:   sql.NullString{String: "ZeroValued", Valid: true},
+				ModelType: int64(types.ModelTypeProperty),
+				Value:     &value,
+			}, nil)
+			require.NoError(t, err)
+
+			property, ok := element.(*types.Property)
+			require.True(t, ok)
+			require.Equal(t, tt.valueType, property.ValueType())
+			if tt.value == nil {
+				require.Nil(t, property.Value())
+				return
+			}
+			require.NotNil(t, property.Value())
+			require.Equal(t, *tt.value, *property.Value())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+var rangeBoundColumns = []string{
+	"min_text", "max_text",
+	"min_num", "max_num",
+	"min_time", "max_time",
+	"min_date", "max_date",
+	"min_datetime", "max_datetime",
+}
+
+// requireOnlyRangeColumns asserts that exactly the expected typed columns carry a value.
+func requireOnlyRangeColumns(t *testing.T, record goqu.Record, expected map[string]string) {
+	t.Helper()
+
+	for _, column := range rangeBoundColumns {
+		stored, ok := record[column].(sql.NullString)
+		require.True(t, ok, "column %s must be present as sql.NullString", column)
+		want, expectedSet := expected[column]
+		require.Equal(t, expectedSet, stored.Valid, "unexpected validity for column %s", column)
+		if expectedSet {
+			require.Equal(t, want, stored.String, "unexpected value for column %s", column)
+		}
+	}
+}
+
+// readBackRange renders the stored record the way the read path does and rebuilds the Range.
+func readBackRange(t *testing.T, valueType types.DataTypeDefXSD, record goqu.Record) *types.Range {
+	t.Helper()
+
+	firstValid := func(columns ...string) any {
+		for _, column := range columns {
+			if stored := record[column].(sql.NullString); stored.Valid {
+				return stored.String
+			}
+		}
+		return nil
+	}
+	raw, err := json.Marshal(map[string]any{
+		"value_type": valueType,
+		"min":        firstValid("min_text", "min_num", "min_time", "min_date", "min_datetime"),
+		"max":        firstValid("max_text", "max_num", "max_time", "max_date", "max_datetime"),
+	})
+	require.NoError(t, err)
+	value := json.RawMessage(raw)
+
+	element, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+		IDShort:   sql.NullString{String: "Bounds", Valid: true},
+		ModelType: int64(types.ModelTypeRange),
+		Value:     &value,
+	}, nil)
+	require.NoError(t, err)
+
+	rangeElem, ok := element.(*types.Range)
+	require.True(t, ok)
+	return rangeElem
+}
+
+func TestRangeDoubleBoundsUseNumericColumns(t *testing.T) {
+	t.Parallel()
+
+	minVal := "-12.5"
+	maxVal := "1024.75"
+	idShort := "Bounds"
+	rangeElem := types.NewRange(types.DataTypeDefXSDDouble)
+	rangeElem.SetIDShort(&idShort)
+	rangeElem.SetMin(&minVal)
+	rangeElem.SetMax(&maxVal)
+
+	part, err := PostgreSQLRangeHandler{}.GetInsertQueryPart(nil, 7, rangeElem)
+	require.NoError(t, err)
+	require.Equal(t, "range_element", part.TableName)
+	require.Equal(t, 7, part.Record["id"])
+	require.Equal(t, types.DataTypeDefXSDDouble, part.Record["value_type"])
+	requireOnlyRangeColumns(t, part.Record, map[string]string{"min_num": minVal, "max_num": maxVal})
+
+	readBack := readBackRange(t, types.DataTypeDefXSDDouble, part.Record)
+	require.Equal(t, types.DataTypeDefXSDDouble, readBack.ValueType())
+	require.NotNil(t, readBack.Min())
+	require.NotNil(t, readBack.Max())
+	require.Equal(t, minVal, *readBack.Min())
+	require.Equal(t, maxVal, *readBack.Max())
+
+	putRecord := buildUpdateRangeRecordObject(rangeElem, true)
+	requireOnlyRangeColumns(t, putRecord, map[string]string{"min_num": minVal, "max_num": maxVal})
+}
+
+func TestRangeValueOnlyUpdateWritesTypedColumns(t *testing.T) {
+	t.Parallel()
+
+	minVal := "0.25"
+	maxVal := "99.5"
+	record := buildRangeValueOnlyUpdateRecord(types.DataTypeDefXSDDouble, model.RangeValue{Min: &minVal, Max: &maxVal})
+	requireOnlyRangeColumns(t, record, map[string]string{"min_num": minVal, "max_num": maxVal})
+
+	readBack := readBackRange(t, types.DataTypeDefXSDDouble, record)
+	require.Equal(t, minVal, *readBack.Min())
+	require.Equal(t, maxVal, *readBack.Max())
+
+	// Dates previously kept their old bounds because min_date/max_date were not reset.
+	dateMin := "2026-01-01"
+	record = buildRangeValueOnlyUpdateRecord(types.DataTypeDefXSDDate, model.RangeValue{Min: &dateMin})
+	requireOnlyRangeColumns(t, record, map[string]string{"min_date": dateMin})
+}
+
+func TestRangeValueOnlyUpdateFallsBackToTextForNonNumericBounds(t *testing.T) {
+	t.Parallel()
+
+	minVal := "not-a-number"
+	maxVal := "42"
+	record := buildRangeValueOnlyUpdateRecord(types.DataTypeDefXSDInt, model.RangeValue{Min: &minVal, Max: &maxVal})
+	requireOnlyRangeColumns(t, record, map[string]string{"min_text": minVal, "max_text": maxVal})
+}
@@ -0,0 +1,129 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func relationshipTestReferences() (types.IReference, types.IReference) {
+	first := types.NewReference(types.ReferenceTypesModelReference, []types.IKey{
+		types.NewKey(types.KeyTypesSubmodel, "urn:example:submodel:motor"),
+		types.NewKey(types.KeyTypesProperty, "Speed"),
+	})
+	second := types.NewReference(types.ReferenceTypesExternalReference, []types.IKey{
+		types.NewKey(types.KeyTypesGlobalReference, "urn:example:sensor:4711"),
+	})
+	second.SetReferredSemanticID(types.NewReference(types.ReferenceTypesExternalReference, []types.IKey{
+		types.NewKey(types.KeyTypesGlobalReference, "urn:example:semantic:sensor"),
+	}))
+	return first, second
+}
+
+// relationshipRowValue renders an insert record the way the read path returns the stored JSONB columns.
+func relationshipRowValue(t *testing.T, record map[string]any) *json.RawMessage {
+	t.Helper()
+
+	payload := map[string]json.RawMessage{}
+	for _, column := range []string{"first", "second"} {
+		stored, ok := record[column].(string)
+		require.True(t, ok, "expected %s to be stored as serialized JSON", column)
+		payload[column] = json.RawMessage(stored)
+	}
+	raw, err := json.Marshal(payload)
+	require.NoError(t, err)
+	value := json.RawMessage(raw)
+	return &value
+}
+
+func requireSameReference(t *testing.T, expected types.IReference, actual types.IReference) {
+	t.Helper()
+
+	require.NotNil(t, actual)
+	expectedJSON, err := jsonization.ToJsonable(expected)
+	require.NoError(t, err)
+	actualJSON, err := jsonization.ToJsonable(actual)
+	require.NoError(t, err)
+	require.Equal(t, expectedJSON, actualJSON)
+}
+
+func TestRelationshipElementReferencesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	first, second := relationshipTestReferences()
+	relationship := types.NewRelationshipElement()
+	relationship.SetFirst(first)
+	relationship.SetSecond(second)
+
+	part, err := PostgreSQLRelationshipElementHandler{}.GetInsertQueryPart(nil, 5, relationship)
+	require.NoError(t, err)
+	require.Equal(t, "relationship_element", part.TableName)
+
+	element, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+		IDShort:   sql.NullString{String: "drives", Valid: true},
+		ModelType: int64(types.ModelTypeRelationshipElement),
+		Value:     relationshipRowValue(t, part.Record),
+	}, nil)
+	require.NoError(t, err)
+
+	readBack, ok := element.(*types.RelationshipElement)
+	require.True(t, ok)
+	requireSameReference(t, first, readBack.First())
+	requireSameReference(t, second, readBack.Second())
+}
+
+func TestAnnotatedRelationshipElementReferencesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	first, second := relationshipTestReferences()
+	relationship := types.NewAnnotatedRelationshipElement()
+	relationship.SetFirst(first)
+	relationship.SetSecond(second)
+
+	part, err := PostgreSQLAnnotatedRelationshipElementHandler{}.GetInsertQueryPart(nil, 6, relationship)
+	require.NoError(t, err)
+	require.Equal(t, "annotated_relationship_element", part.TableName)
+
+	element, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+		IDShort:   sql.NullString{String: "annotatedDrives", Valid: true},
+		ModelType: int64(types.ModelTypeAnnotatedRelationshipElement),
+		Value:     relationshipRowValue(t, part.Record),
+	}, nil)
+	require.NoError(t, err)
+
+	readBack, ok := element.(*types.AnnotatedRelationshipElement)
+	require.True(t, ok)
+	requireSameReference(t, first, readBack.First())
+	requireSameReference(t, second, readBack.Second())
+}
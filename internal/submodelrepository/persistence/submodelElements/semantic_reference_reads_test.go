@@ -0,0 +1,131 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+const sharedSemanticIDPayload = `{"type":"ExternalReference","keys":[{"type":"GlobalReference","value":"urn:example:semantic"}]}`
+
+func semanticReferenceTestRows(count int, semanticPayload []byte) []loadedSMERow {
+	rows := make([]loadedSMERow, 0, count)
+	for i := 1; i <= count; i++ {
+		value := json.RawMessage(fmt.Sprintf(`{"value_type":%d,"value":"v"}`, types.DataTypeDefXSDString))
+		rows = append(rows, loadedSMERow{
+			row: model.SubmodelElementRow{
+				DbID:        sql.NullInt64{Int64: int64(i), Valid: true},
+				IDShort:     sql.NullString{String: fmt.Sprintf("p%d", i), Valid: true},
+				IDShortPath: fmt.Sprintf("p%d", i),
+				ModelType:   int64(types.ModelTypeProperty),
+				Value:       &value,
+			},
+			semanticPayload: semanticPayload,
+			semanticVisible: true,
+			valueVisible:    true,
+		})
+	}
+	return rows
+}
+
+// Semantic references travel inline with the element row, so repeated reads of the same
+// reference do not query the reference tables at all.
+func TestBuildSubmodelElementForestReadsSemanticIDsWithoutReferenceQueries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+	})
+
+	elements, err := buildSubmodelElementForestFromRows(db, semanticReferenceTestRows(50, []byte(sharedSemanticIDPayload)))
+	require.NoError(t, err)
+	require.Len(t, elements, 50)
+	for _, element := range elements {
+		require.NotNil(t, element.SemanticID())
+		require.Equal(t, "urn:example:semantic", element.SemanticID().Keys()[0].Value())
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// Rows without an inline payload fall back to the key tables with one batched lookup,
+// independent of the number of elements.
+func TestBuildSubmodelElementForestBatchesSemanticIDFallback(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+	})
+
+	typeRows := sqlmock.NewRows([]string{"id", "type"})
+	keyRows := sqlmock.NewRows([]string{"reference_id", "type", "value"})
+	for i := 1; i <= 50; i++ {
+		typeRows.AddRow(i, int64(types.ReferenceTypesExternalReference))
+		keyRows.AddRow(i, int64(types.KeyTypesGlobalReference), "urn:example:semantic")
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id", "type" FROM "submodel_element_semantic_id_reference"`)).
+		WillReturnRows(typeRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY "reference_id" ASC, "position" ASC`)).
+		WillReturnRows(keyRows)
+
+	elements, err := buildSubmodelElementForestFromRows(db, semanticReferenceTestRows(50, nil))
+	require.NoError(t, err)
+	require.Len(t, elements, 50)
+	for _, element := range elements {
+		require.NotNil(t, element.SemanticID())
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func BenchmarkBuildSubmodelElementForestInlineSemanticIDs(b *testing.B) {
+	db, _, err := sqlmock.New()
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_ = db.Close()
+	})
+	rows := semanticReferenceTestRows(100, []byte(sharedSemanticIDPayload))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := buildSubmodelElementForestFromRows(db, rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/FriedJannik/aas-go-sdk/stringification"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	persistenceutils "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/utils"
+)
+
+const ancestorChainCTE = "sme_ancestor_chain"
+
+// SubmodelElementAncestor is one entry of the ancestor chain of a submodel element.
+// List entries have no idShort, so IDShortPath is always set to address the entry.
+type SubmodelElementAncestor struct {
+	IDShort     string `json:"idShort,omitempty"`
+	ModelType   string `json:"modelType"`
+	IDShortPath string `json:"idShortPath"`
+}
+
+// GetSubmodelElementAncestors returns the elements that contain the element at
+// idShortPath, ordered from the top-level element of the Submodel down to the
+// direct parent. The chain follows the parent_sme_id links in one recursive
+// query, so the cost grows with the depth of the element, not with the size of
+// the Submodel. A top-level element has no ancestors and yields an empty slice.
+//
+// The query applies no ABAC filters; callers must check that the element is
+// visible first, which also requires every ancestor to be visible.
+//
+// Parameters:
+//   - ctx: Request context
+//   - db: Database connection
+//   - submodelID: Identifier of the Submodel
+//   - idShortPath: Path of the element whose ancestors are returned
+//
+// Returns:
+//   - []SubmodelElementAncestor: Ancestors from the root down to the parent
+//   - error: Not found error when the Submodel or the element does not exist
+func GetSubmodelElementAncestors(ctx context.Context, db *sql.DB, submodelID string, idShortPath string) ([]SubmodelElementAncestor, error) {
+	if idShortPath == "" {
+		return nil, common.NewErrBadRequest("SMREPO-GETSMEANCESTORS-EMPTYPATH idShort path must not be empty")
+	}
+
+	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseIDFromDB(db, submodelID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, common.NewErrNotFound("SMREPO-GETSMEANCESTORS-SMNOTFOUND Submodel with ID '" + submodelID + "' not found")
+		}
+		return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-GETSMDATABASEID " + err.Error())
+	}
+
+	query, args, err := buildSubmodelElementAncestorsQuery(submodelDatabaseID, idShortPath)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-BUILDQ " + err.Error())
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-EXECQ " + err.Error())
+	}
+	defer func() { _ = rows.Close() }()
+
+	// Rows arrive from the top-level element down to the element itself.
+	var chain []SubmodelElementAncestor
+	for rows.Next() {
+		var idShort sql.NullString
+		var modelType int64
+		var path string
+		if err = rows.Scan(&idShort, &modelType, &path); err != nil {
+			return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-SCANQ " + err.Error())
+		}
+		modelTypeLiteral, ok := stringification.ModelTypeToString(types.ModelType(modelType))
+		if !ok {
+			return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-BADMODELTYPE unknown model type for " + path)
+		}
+		chain = append(chain, SubmodelElementAncestor{
+			IDShort:     idShort.String,
+			ModelType:   modelTypeLiteral,
+			IDShortPath: path,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-GETSMEANCESTORS-ROWSERR " + err.Error())
+	}
+	if len(chain) == 0 {
+		return nil, common.NewErrNotFound("SMREPO-GETSMEANCESTORS-NOTFOUND Submodel-Element ID-Short: " + idShortPath)
+	}
+
+	return chain[:len(chain)-1], nil
+}
+
+func buildSubmodelElementAncestorsQuery(submodelDatabaseID int, idShortPath string) (string, []any, error) {
+	dialect := goqu.Dialect("postgres")
+	target := dialect.
+		From(goqu.T("submodel_element").As("sme")).
+		Select(
+			goqu.I("sme.id"),
+			goqu.I("sme.parent_sme_id"),
+			goqu.I("sme.id_short"),
+			goqu.I("sme.model_type"),
+			goqu.I("sme.idshort_path"),
+			goqu.V(0),
+		).
+		Where(
+			goqu.I("sme.submodel_id").Eq(submodelDatabaseID),
+			goqu.I("sme.idshort_path").Eq(idShortPath),
+		)
+	parents := dialect.
+		From(goqu.T("submodel_element").As("parent")).
+		InnerJoin(
+			goqu.T(ancestorChainCTE).As("child"),
+			goqu.On(goqu.I("parent.id").Eq(goqu.I("child.parent_sme_id"))),
+		).
+		Select(
+			goqu.I("parent.id"),
+			goqu.I("parent.parent_sme_id"),
+			goqu.I("parent.id_short"),
+			goqu.I("parent.model_type"),
+			goqu.I("parent.idshort_path"),
+			goqu.L(`"child"."hops" + 1`),
+		).
+		Where(goqu.I("parent.submodel_id").Eq(submodelDatabaseID))
+
+	return dialect.
+		From(ancestorChainCTE).
+		WithRecursive(ancestorChainCTE+"(id, parent_sme_id, id_short, model_type, idshort_path, hops)", target.UnionAll(parents)).
+		Select(goqu.C("id_short"), goqu.C("model_type"), goqu.C("idshort_path")).
+		Order(goqu.C("hops").Desc()).
+		ToSQL()
+}
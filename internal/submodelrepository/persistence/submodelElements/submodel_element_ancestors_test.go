@@ -0,0 +1,96 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+var ancestorChainColumns = []string{"id_short", "model_type", "idshort_path"}
+
+func TestGetSubmodelElementAncestorsReturnsChainFromRootToParent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`WITH RECURSIVE sme_ancestor_chain\(id, parent_sme_id, id_short, model_type, idshort_path, hops\) AS .*"sme"."idshort_path" = 'Machine.Axes\[2\].Spindle'.*ORDER BY "hops" DESC`).
+		WillReturnRows(sqlmock.NewRows(ancestorChainColumns).
+			AddRow("Machine", int64(types.ModelTypeSubmodelElementCollection), "Machine").
+			AddRow("Axes", int64(types.ModelTypeSubmodelElementList), "Machine.Axes").
+			AddRow(nil, int64(types.ModelTypeSubmodelElementCollection), "Machine.Axes[2]").
+			AddRow("Spindle", int64(types.ModelTypeProperty), "Machine.Axes[2].Spindle"))
+
+	ancestors, err := GetSubmodelElementAncestors(context.Background(), db, "sm-1", "Machine.Axes[2].Spindle")
+	require.NoError(t, err)
+	require.Equal(t, []SubmodelElementAncestor{
+		{IDShort: "Machine", ModelType: "SubmodelElementCollection", IDShortPath: "Machine"},
+		{IDShort: "Axes", ModelType: "SubmodelElementList", IDShortPath: "Machine.Axes"},
+		{ModelType: "SubmodelElementCollection", IDShortPath: "Machine.Axes[2]"},
+	}, ancestors)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSubmodelElementAncestorsReturnsEmptyChainForTopLevelElement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`WITH RECURSIVE sme_ancestor_chain`).
+		WillReturnRows(sqlmock.NewRows(ancestorChainColumns).
+			AddRow("Temperature", int64(types.ModelTypeProperty), "Temperature"))
+
+	ancestors, err := GetSubmodelElementAncestors(context.Background(), db, "sm-1", "Temperature")
+	require.NoError(t, err)
+	require.NotNil(t, ancestors)
+	require.Empty(t, ancestors)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSubmodelElementAncestorsReturnsNotFoundForMissingElement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`WITH RECURSIVE sme_ancestor_chain`).
+		WillReturnRows(sqlmock.NewRows(ancestorChainColumns))
+
+	_, err = GetSubmodelElementAncestors(context.Background(), db, "sm-1", "Missing.Child")
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
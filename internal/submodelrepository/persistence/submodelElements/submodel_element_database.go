@@ -34,8 +34,10 @@
 package submodelelements
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -116,8 +118,9 @@ func UpdateNestedElementsValueOnly(db *sql.DB, elems []ValueOnlyElementsToProces
 			continue // Skip the root element as it's already processed
 		}
 		modelType := elem.Element.GetModelType()
-		if modelType == types.ModelTypeFile {
+		if modelType == types.ModelTypeFile || modelType == types.ModelTypeRelationshipElement {
 			// We have to check the database because File could be ambiguous between File and Blob
+			// and a RelationshipElement value could belong to an AnnotatedRelationshipElement
 			actual, err := GetModelTypeByIdShortPathAndSubmodelIDTx(tx, submodelID, elem.IdShortPath)
 			if err != nil {
 				return err
@@ -273,6 +276,7 @@ func getModelTypeByIdShortPathAndSubmodelID(queryer queryRower, submodelID strin
 //   - Path updates for remaining list elements to reflect new indices
 //
 // Parameters:
+//   - ctx: Request context used for the list compaction queries
 //   - tx: Transaction context for atomic deletion operations
 //   - submodelID: ID of the parent submodel
 //   - idShortOrPath: Path to the element to delete (e.g., "prop1" or "collection.list[2]")
@@ -283,14 +287,14 @@ func getModelTypeByIdShortPathAndSubmodelID(queryer queryRower, submodelID strin
 // Example:
 //
 //	// Delete a simple property
-//	err := DeleteSubmodelElementByPath(tx, "submodel123", "temperature")
+//	err := DeleteSubmodelElementByPath(ctx, tx, "submodel123", "temperature")
 //
 //	// Delete an element in a list (adjusts indices of elements after it)
-//	err := DeleteSubmodelElementByPath(tx, "submodel123", "sensors[1]")
+//	err := DeleteSubmodelElementByPath(ctx, tx, "submodel123", "sensors[1]")
 //
 //	// Delete a nested collection and all its children
-//	err := DeleteSubmodelElementByPath(tx, "submodel123", "properties.metadata")
-func DeleteSubmodelElementByPath(tx *sql.Tx, submodelID string, idShortOrPath string) error {
+//	err := DeleteSubmodelElementByPath(ctx, tx, "submodel123", "properties.metadata")
+func DeleteSubmodelElementByPath(ctx context.Context, tx *sql.Tx, submodelID string, idShortOrPath string) error {
 	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseIDForUpdate(tx, submodelID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -320,7 +324,81 @@ func DeleteSubmodelElementByPath(tx *sql.Tx, submodelID string, idShortOrPath st
 	if err != nil {
 		return err
 	}
-	return compactListAfterDelete(tx, submodelDatabaseID, parentPath, deletedIndex)
+	return compactListAfterDelete(ctx, tx, submodelDatabaseID, parentPath, deletedIndex)
+}
+
+// DeleteSubmodelElementsByPaths removes several submodel elements of one submodel
+// including their nested elements and compacts every affected
+// SubmodelElementList once afterwards.
+//
+// All paths refer to the state before the deletion, so "list[1]" and "list[3]"
+// delete the second and fourth entry even though deleting the first shifts the
+// second. Paths nested below another listed path are covered by that path and
+// skipped. The remaining children of each affected list are renumbered to the
+// contiguous positions 0..n-1, keeping their order.
+//
+// Parameters:
+//   - ctx: Request context used for the list compaction queries
+//   - tx: Transaction context for the deletion; the Submodel row is locked
+//   - submodelID: ID of the parent submodel
+//   - idShortPaths: Paths of the elements to delete
+//
+// Returns:
+//   - error: Not found error when the submodel or one of the elements does not
+//     exist, otherwise an internal server error when a database operation fails
+func DeleteSubmodelElementsByPaths(ctx context.Context, tx *sql.Tx, submodelID string, idShortPaths []string) error {
+	submodelDatabaseID, err := persistenceutils.GetSubmodelDatabaseIDForUpdate(tx, submodelID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return common.NewErrNotFound("SMREPO-DELSMESBPATHS-SMNOTFOUND Submodel with ID '" + submodelID + "' not found")
+		}
+		return common.NewInternalServerError("SMREPO-DELSMESBPATHS-GETSMDATABASEID Failed to resolve Submodel database ID: " + err.Error())
+	}
+
+	affectedLists := make(map[string]struct{})
+	for _, idShortPath := range outermostSubmodelElementPaths(idShortPaths) {
+		if err = cleanupSubmodelElementTreeLargeObjects(tx, submodelDatabaseID, idShortPath, true, "SMREPO-DELSMESBPATHS"); err != nil {
+			return err
+		}
+		affectedRows, deleteErr := deleteSubmodelElementTree(tx, submodelDatabaseID, idShortPath)
+		if deleteErr != nil {
+			return deleteErr
+		}
+		if affectedRows == 0 {
+			return common.NewErrNotFound("SMREPO-DELSMESBPATHS-NOTFOUND Submodel-Element ID-Short: " + idShortPath)
+		}
+		if isListElementPath(idShortPath) {
+			parentPath, _, splitErr := splitListElementPath(idShortPath)
+			if splitErr != nil {
+				return splitErr
+			}
+			affectedLists[parentPath] = struct{}{}
+		}
+	}
+
+	return compactListIndices(ctx, tx, submodelDatabaseID, affectedLists)
+}
+
+// outermostSubmodelElementPaths returns the distinct paths in lexical order
+// without the paths nested below another path of the input.
+func outermostSubmodelElementPaths(idShortPaths []string) []string {
+	sorted := append([]string(nil), idShortPaths...)
+	sort.Strings(sorted)
+
+	outermost := make([]string, 0, len(sorted))
+	for _, idShortPath := range sorted {
+		covered := false
+		for _, ancestor := range outermost {
+			if idShortPath == ancestor || strings.HasPrefix(idShortPath, ancestor+".") || strings.HasPrefix(idShortPath, ancestor+"[") {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			outermost = append(outermost, idShortPath)
+		}
+	}
+	return outermost
 }
 
 func deleteSubmodelElementTree(tx *sql.Tx, submodelDatabaseID int, idShortOrPath string) (int64, error) {
@@ -422,7 +500,7 @@ type listChildToCompact struct {
 	oldPosition int
 }
 
-func compactListAfterDelete(tx *sql.Tx, submodelDatabaseID int, parentPath string, deletedIndex int) error {
+func compactListAfterDelete(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, parentPath string, deletedIndex int) error {
 	parentID, err := getListParentID(tx, submodelDatabaseID, parentPath)
 	if err != nil {
 		return err
@@ -434,7 +512,7 @@ func compactListAfterDelete(tx *sql.Tx, submodelDatabaseID int, parentPath strin
 	}
 
 	for _, child := range children {
-		if err = moveListChildOneSlotLeft(tx, submodelDatabaseID, parentPath, child); err != nil {
+		if err = moveListChildOneSlotLeft(ctx, tx, submodelDatabaseID, parentPath, child); err != nil {
 			return err
 		}
 	}
@@ -502,14 +580,14 @@ func getListChildrenAfterDeletedIndex(tx *sql.Tx, submodelDatabaseID int, parent
 	return children, nil
 }
 
-func moveListChildOneSlotLeft(tx *sql.Tx, submodelDatabaseID int, parentPath string, child listChildToCompact) error {
+func moveListChildOneSlotLeft(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, parentPath string, child listChildToCompact) error {
 	newPosition := child.oldPosition - 1
 	newPath := parentPath + "[" + strconv.Itoa(newPosition) + "]"
 
-	if err := updateListChildPath(tx, submodelDatabaseID, child.oldPath, newPath); err != nil {
+	if err := updateListChildPath(ctx, tx, submodelDatabaseID, child.oldPath, newPath); err != nil {
 		return err
 	}
-	return updateListChildPosition(tx, submodelDatabaseID, child.id, newPosition)
+	return updateListChildPosition(ctx, tx, submodelDatabaseID, child.id, newPosition)
 }
 
 func escapeSQLLikePattern(value string) string {
@@ -523,7 +601,7 @@ func idShortPathLikeEscaped(idShortPath goqu.Expression, pattern string) goqu.Ex
 	return goqu.L("? LIKE ? ESCAPE '!'", idShortPath, pattern)
 }
 
-func updateListChildPath(tx *sql.Tx, submodelDatabaseID int, oldPath string, newPath string) error {
+func updateListChildPath(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, oldPath string, newPath string) error {
 	dialect := goqu.Dialect("postgres")
 	escapedOldPath := escapeSQLLikePattern(oldPath)
 	query, args, err := dialect.Update("submodel_element").
@@ -543,13 +621,13 @@ func updateListChildPath(tx *sql.Tx, submodelDatabaseID int, oldPath string, new
 		return common.NewInternalServerError("SMREPO-DELSMEBPATH-UPDATEPATH-TOSQL Failed to build update path query: " + err.Error())
 	}
 
-	if _, err = tx.Exec(query, args...); err != nil {
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
 		return common.NewInternalServerError("SMREPO-DELSMEBPATH-UPDATEPATH-EXEC Failed to execute update path query: " + err.Error())
 	}
 	return nil
 }
 
-func updateListChildPosition(tx *sql.Tx, submodelDatabaseID int, childID int, newPosition int) error {
+func updateListChildPosition(ctx context.Context, tx *sql.Tx, submodelDatabaseID int, childID int, newPosition int) error {
 	dialect := goqu.Dialect("postgres")
 	query, args, err := dialect.Update("submodel_element").
 		Set(goqu.Record{"position": newPosition}).
@@ -562,7 +640,7 @@ func updateListChildPosition(tx *sql.Tx, submodelDatabaseID int, childID int, ne
 		return common.NewInternalServerError("SMREPO-DELSMEBPATH-UPDATEPOSITION-TOSQL Failed to build update position query: " + err.Error())
 	}
 
-	if _, err = tx.Exec(query, args...); err != nil {
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
 		return common.NewInternalServerError("SMREPO-DELSMEBPATH-UPDATEPOSITION-EXEC Failed to execute update position query: " + err.Error())
 	}
 	return nil
@@ -28,11 +28,15 @@ package submodelelements
 
 import (
 	"database/sql"
+	"errors"
 	"strconv"
+	"strings"
 
+	"github.com/FriedJannik/aas-go-sdk/stringification"
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/jackc/pgx/v5/pgconn"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -109,11 +113,6 @@ func flattenSubmodelElementsForInsert(db *sql.DB, elements []types.ISubmodelElem
 
 	for cursor := 0; cursor < len(pending); cursor++ {
 		item := pending[cursor]
-		handler, handlerErr := GetSMEHandler(item.element, db)
-		if handlerErr != nil {
-			return nil, nil, handlerErr
-		}
-
 		idShort := ""
 		if item.element.IDShort() != nil {
 			idShort = *item.element.IDShort()
@@ -123,7 +122,6 @@ func flattenSubmodelElementsForInsert(db *sql.DB, elements []types.ISubmodelElem
 
 		node := &flattenedInsertNode{
 			element:       item.element,
-			handler:       handler,
 			position:      item.position,
 			idShort:       idShort,
 			idShortPath:   idShortPath,
@@ -135,6 +133,18 @@ func flattenSubmodelElementsForInsert(db *sql.DB, elements []types.ISubmodelElem
 			dbID:          0,
 		}
 
+		if !item.isFromList {
+			if idShortErr := validateIDShortPathSegment(idShort, "SMREPO-INSSME-IDSHORTDELIMITER"); idShortErr != nil {
+				return nil, nil, submodelElementInsertError(node, idShortErr)
+			}
+		}
+
+		handler, handlerErr := GetSMEHandler(item.element, db)
+		if handlerErr != nil {
+			return nil, nil, submodelElementInsertError(node, handlerErr)
+		}
+		node.handler = handler
+
 		currentIndex := len(nodes)
 		if node.parentIndex == -1 {
 			rootNodeIndexes = append(rootNodeIndexes, currentIndex)
@@ -169,6 +179,16 @@ func flattenSubmodelElementsForInsert(db *sql.DB, elements []types.ISubmodelElem
 	return nodes, rootNodeIndexes, nil
 }
 
+// validateIDShortPathSegment rejects idShorts that contain the '.', '[' or ']'
+// delimiters of stored idShortPaths, since such values would be split into
+// several segments by every later path lookup.
+func validateIDShortPathSegment(idShort string, code string) error {
+	if strings.ContainsAny(idShort, ".[]") {
+		return common.NewErrBadRequest(code + " idShort '" + idShort + "' must not contain the idShortPath delimiters '.', '[' or ']'")
+	}
+	return nil
+}
+
 func buildIDShortPath(parentPath string, isFromList bool, position int, idShort string) string {
 	if parentPath == "" {
 		if isFromList {
@@ -313,7 +333,7 @@ func insertPayloadAndSemanticReferences(tx *sql.Tx, dialect goqu.DialectWrapper,
 	for _, node := range nodes {
 		payloadRecord, hasPayload, payloadBuildErr := buildSubmodelElementPayloadRecord(int64(node.dbID), node.element, jsonLib)
 		if payloadBuildErr != nil {
-			return payloadBuildErr
+			return submodelElementInsertError(node, payloadBuildErr)
 		}
 		if !hasPayload {
 			continue
@@ -349,7 +369,7 @@ func insertSupplementalSemanticReferences(tx *sql.Tx, nodes []*flattenedInsertNo
 			common.TblSubmodelElementSuppSemantic,
 			common.ColSubmodelElementID,
 		); err != nil {
-			return common.NewInternalServerError("SMREPO-INSSME-INSSUPPSEM " + err.Error())
+			return submodelElementInsertError(node, common.NewInternalServerError("SMREPO-INSSME-INSSUPPSEM "+err.Error()))
 		}
 	}
 	return nil
@@ -368,10 +388,10 @@ func insertSemanticReferencesBulk(tx *sql.Tx, dialect goqu.DialectWrapper, nodes
 
 		referencePayload, payloadErr := getReferenceAsJSON(semanticReference)
 		if payloadErr != nil {
-			return common.NewInternalServerError("SMREPO-INSSME-INSSEMREF-BUILDPAYLOAD " + payloadErr.Error())
+			return submodelElementInsertError(node, common.NewInternalServerError("SMREPO-INSSME-INSSEMREF-BUILDPAYLOAD "+payloadErr.Error()))
 		}
 		if !referencePayload.Valid {
-			return common.NewInternalServerError("SMREPO-INSSME-INSSEMREF-INVALIDPAYLOAD Invalid semantic reference payload")
+			return submodelElementInsertError(node, common.NewInternalServerError("SMREPO-INSSME-INSSEMREF-INVALIDPAYLOAD Invalid semantic reference payload"))
 		}
 
 		referenceRows = append(referenceRows, goqu.Record{
@@ -435,23 +455,29 @@ func insertSemanticReferencesBulk(tx *sql.Tx, dialect goqu.DialectWrapper, nodes
 }
 
 func insertTypeSpecificRows(tx *sql.Tx, dialect goqu.DialectWrapper, nodes []*flattenedInsertNode) error {
+	// Tables are filled in the order of their first element so that a failing
+	// element is reported deterministically.
+	tableNames := make([]string, 0)
 	typeSpecificRows := make(map[string][]goqu.Record)
+	typeSpecificNodes := make(map[string][]*flattenedInsertNode)
 
 	for _, node := range nodes {
 		queryPart, partErr := node.handler.GetInsertQueryPart(tx, node.dbID, node.element)
 		if partErr != nil {
-			return partErr
+			return submodelElementInsertError(node, partErr)
 		}
 		if queryPart != nil {
+			if _, seen := typeSpecificRows[queryPart.TableName]; !seen {
+				tableNames = append(tableNames, queryPart.TableName)
+			}
 			typeSpecificRows[queryPart.TableName] = append(typeSpecificRows[queryPart.TableName], queryPart.Record)
+			typeSpecificNodes[queryPart.TableName] = append(typeSpecificNodes[queryPart.TableName], node)
 		}
 	}
 
-	for tableName, rows := range typeSpecificRows {
-		if len(rows) == 0 {
-			continue
-		}
-		if err := executeRecordInsertChunked(tx, dialect, tableName, nil, rows, "SMREPO-INSSME-INSTYPE"); err != nil {
+	for _, tableName := range tableNames {
+		rows := typeSpecificRows[tableName]
+		if err := executeNodeRecordInsertChunked(tx, dialect, tableName, nil, rows, typeSpecificNodes[tableName], "SMREPO-INSSME-INSTYPE"); err != nil {
 			return err
 		}
 	}
@@ -512,7 +538,7 @@ func insertMultiLanguagePropertyPayloadRows(tx *sql.Tx, dialect goqu.DialectWrap
 
 		valueIDJSONString, serErr := serializeIClassSliceToJSON([]types.IClass{mlp.ValueID()}, "SMREPO-INSSME-MLP-VALREF")
 		if serErr != nil {
-			return serErr
+			return submodelElementInsertError(node, serErr)
 		}
 
 		mlpPayloadRows = append(mlpPayloadRows, goqu.Record{
@@ -553,7 +579,7 @@ func insertPropertyPayloadRows(tx *sql.Tx, dialect goqu.DialectWrapper, nodes []
 
 		valueIDJSONString, serErr := serializeIClassSliceToJSON([]types.IClass{property.ValueID()}, "SMREPO-INSSME-PROP-VALREF")
 		if serErr != nil {
-			return serErr
+			return submodelElementInsertError(node, serErr)
 		}
 
 		propertyPayloadRows = append(propertyPayloadRows, goqu.Record{
@@ -577,6 +603,13 @@ func insertPropertyPayloadRows(tx *sql.Tx, dialect goqu.DialectWrapper, nodes []
 }
 
 func executeRecordInsertChunked(tx *sql.Tx, dialect goqu.DialectWrapper, tableName string, cols []string, rows []goqu.Record, errCode string) error {
+	return executeNodeRecordInsertChunked(tx, dialect, tableName, cols, rows, nil, errCode)
+}
+
+// executeNodeRecordInsertChunked inserts rows in chunks. When rowNodes holds the
+// element of every row, a value rejected by PostgreSQL is reported for the
+// element that carries it.
+func executeNodeRecordInsertChunked(tx *sql.Tx, dialect goqu.DialectWrapper, tableName string, cols []string, rows []goqu.Record, rowNodes []*flattenedInsertNode, errCode string) error {
 	if len(rows) == 0 {
 		return nil
 	}
@@ -611,6 +644,9 @@ func executeRecordInsertChunked(tx *sql.Tx, dialect goqu.DialectWrapper, tableNa
 			if mappedErr := mapConflictInsertError(execErr); mappedErr != nil {
 				return mappedErr
 			}
+			if mappedErr := mapInvalidValueInsertError(execErr, chunk, chunkNodes(rowNodes, start, end), errCode); mappedErr != nil {
+				return mappedErr
+			}
 			return common.NewInternalServerError(errCode + "-EXECQ " + execErr.Error())
 		}
 	}
@@ -630,6 +666,88 @@ func mapConflictInsertError(err error) error {
 	return nil
 }
 
+// mapInvalidValueInsertError turns PostgreSQL data exceptions, such as a
+// Property value that cannot be cast to its column type, into a bad request.
+// PostgreSQL quotes the rejected value in the message, which identifies the
+// element when exactly one row of the chunk carries it.
+func mapInvalidValueInsertError(err error, rows []goqu.Record, nodes []*flattenedInsertNode, errCode string) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || !strings.HasPrefix(pgErr.Code, "22") {
+		return nil
+	}
+
+	invalidValueErr := common.NewErrBadRequest(errCode + "-INVALIDVALUE " + pgErr.Message)
+	if len(nodes) != len(rows) {
+		return invalidValueErr
+	}
+
+	separator := strings.LastIndex(pgErr.Message, `: "`)
+	if separator < 0 || !strings.HasSuffix(pgErr.Message, `"`) {
+		return invalidValueErr
+	}
+	rejected := pgErr.Message[separator+3 : len(pgErr.Message)-1]
+
+	var match *flattenedInsertNode
+	for index, row := range rows {
+		if !recordHasValue(row, rejected) {
+			continue
+		}
+		if match != nil {
+			return invalidValueErr
+		}
+		match = nodes[index]
+	}
+	if match == nil {
+		return invalidValueErr
+	}
+	return submodelElementInsertError(match, invalidValueErr)
+}
+
+func recordHasValue(record goqu.Record, value string) bool {
+	for _, column := range record {
+		switch typed := column.(type) {
+		case string:
+			if typed == value {
+				return true
+			}
+		case sql.NullString:
+			if typed.Valid && typed.String == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func chunkNodes(nodes []*flattenedInsertNode, start int, end int) []*flattenedInsertNode {
+	if len(nodes) < end {
+		return nil
+	}
+	return nodes[start:end]
+}
+
+// submodelElementInsertError names the element that could not be stored, by
+// idShortPath and model type, in front of the reason and keeps the status of err.
+func submodelElementInsertError(node *flattenedInsertNode, err error) error {
+	modelType, _ := stringification.ModelTypeToString(node.element.ModelType())
+	location := "SMREPO-INSSME-ELEMENT submodel element '" + node.idShortPath + "' (" + modelType + "): "
+
+	message := err.Error()
+	for _, status := range []struct {
+		prefix string
+		create func(string) error
+	}{
+		{prefix: "400 Bad Request: ", create: common.NewErrBadRequest},
+		{prefix: "409 Conflict: ", create: common.NewErrConflict},
+		{prefix: "500 Internal Server Error: ", create: common.NewInternalServerError},
+	} {
+		if strings.HasPrefix(message, status.prefix) {
+			return status.create(location + strings.TrimPrefix(message, status.prefix))
+		}
+	}
+	return common.NewInternalServerError(location + message)
+}
+
 // getChildElements extracts child elements from container-type submodel elements.
 // Returns an empty slice for element types that don't have children.
 func getChildElements(element types.ISubmodelElement) []types.ISubmodelElement {
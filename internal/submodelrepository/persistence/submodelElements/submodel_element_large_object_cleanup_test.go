@@ -49,7 +49,7 @@ func TestDeleteSubmodelElementByPathCleansLargeObjectsBeforeDeletingTree(t *test
 		WillReturnResult(sqlmock.NewResult(0, 2))
 	mock.ExpectRollback()
 
-	err = DeleteSubmodelElementByPath(tx, "sm-1", "Parent.File")
+	err = DeleteSubmodelElementByPath(t.Context(), tx, "sm-1", "Parent.File")
 	require.NoError(t, err)
 	require.NoError(t, tx.Rollback())
 	require.NoError(t, mock.ExpectationsWereMet())
@@ -30,7 +30,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -155,6 +154,7 @@ func GetSubmodelElementPathsBySubmodelID(ctx context.Context, db *sql.DB, submod
 	if collectorErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEPATHS-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
@@ -243,6 +243,7 @@ func GetSubmodelElementPathsPageBySubmodelID(ctx context.Context, db *sql.DB, su
 	if collectorErr != nil {
 		return nil, "", common.NewInternalServerError("SMREPO-GETSMEPATHSPAGE-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
@@ -260,13 +261,8 @@ func GetSubmodelElementPathsPageBySubmodelID(ctx context.Context, db *sql.DB, su
 		return nil, "", common.NewInternalServerError("SMREPO-GETSMEPATHSPAGE-ABACFILTER " + rowFilterErr.Error())
 	}
 	if cursor != "" {
-		cursorExists, cursorErr := submodelElementCursorExists(ctx, db, query, cursor)
-		if cursorErr != nil {
-			return nil, "", cursorErr
-		}
-		if !cursorExists {
-			return []string{}, "", nil
-		}
+		// The boundary is a key, so the page continues correctly even if the
+		// cursor element was deleted since the previous page.
 		query = addSMECursorBoundary(query, cursor)
 	}
 
@@ -344,12 +340,8 @@ func GetSubmodelElementPathsByPath(ctx context.Context, db *sql.DB, submodelID s
 	if level == "core" {
 		query = query.Where(goqu.I("sme.idshort_path").Eq(idShortPath))
 	} else {
-		descendantPattern := "^" + regexp.QuoteMeta(idShortPath) + `(\.|\[)`
 		query = query.Where(
-			goqu.Or(
-				goqu.I("sme.idshort_path").Eq(idShortPath),
-				goqu.L(`"sme"."idshort_path" ~ ?`, descendantPattern),
-			),
+			goqu.Or(submodelElementTreePathConditions(goqu.I("sme.idshort_path"), idShortPath, true)...),
 		)
 	}
 
@@ -357,6 +349,7 @@ func GetSubmodelElementPathsByPath(ctx context.Context, db *sql.DB, submodelID s
 	if collectorErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEPATHSBYPATH-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
@@ -654,8 +647,9 @@ func parseReferencePathSegments(idShortPath string) ([]submodelpath.Segment, err
 }
 
 type rootElementCursorRow struct {
-	id   int64
-	path string
+	id       int64
+	path     string
+	position int64
 }
 
 func getRootElementPage(ctx context.Context, db dbQueryer, submodelDatabaseID int64, limit *int, cursor string) ([]rootElementCursorRow, string, error) {
@@ -676,12 +670,20 @@ func getRootElementPage(ctx context.Context, db dbQueryer, submodelDatabaseID in
 			goqu.I("sme.parent_sme_id").IsNull(),
 		)
 
-	query = query.Order(goqu.I("sme.idshort_path").Asc(), goqu.I("sme.id").Asc())
+	orderByPosition := common.ElementOrderFromContext(ctx) == common.ElementOrderPosition
+	if orderByPosition {
+		query = query.
+			SelectAppend(goqu.I("sme.position")).
+			Order(goqu.I("sme.position").Asc(), goqu.I("sme.id").Asc())
+	} else {
+		query = query.Order(goqu.I("sme.idshort_path").Asc(), goqu.I("sme.id").Asc())
+	}
 
 	collector, collectorErr := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootSME)
 	if collectorErr != nil {
 		return nil, "", common.NewInternalServerError("SMREPO-GETROOTPATHS-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
 		return nil, "", common.NewInternalServerError("SMREPO-GETROOTPATHS-SHOULDENFORCE " + enforceErr.Error())
@@ -698,14 +700,24 @@ func getRootElementPage(ctx context.Context, db dbQueryer, submodelDatabaseID in
 		return nil, "", common.NewInternalServerError("SMREPO-GETROOTPATHS-ABACFILTER " + rowFilterErr.Error())
 	}
 	if cursor != "" {
-		cursorExists, cursorErr := submodelElementCursorExists(ctx, db, query, cursor)
-		if cursorErr != nil {
-			return nil, "", cursorErr
-		}
-		if !cursorExists {
-			return []rootElementCursorRow{}, "", nil
+		rootCursor, cursorPosition, hasCursorPosition := parsePositionCursor(cursor)
+		switch {
+		case !orderByPosition:
+			query = addSMECursorBoundary(query, rootCursor)
+		case hasCursorPosition:
+			query = addSMEPositionValueBoundary(query, cursorPosition, rootCursor)
+		default:
+			// Cursors without a position can only be resolved while their
+			// element still exists.
+			cursorExists, cursorErr := submodelElementCursorExists(ctx, db, query, rootCursor)
+			if cursorErr != nil {
+				return nil, "", cursorErr
+			}
+			if !cursorExists {
+				return []rootElementCursorRow{}, "", nil
+			}
+			query = addSMEPositionCursorBoundary(query, submodelDatabaseID, rootCursor)
 		}
-		query = addSMECursorBoundary(query, cursor)
 	}
 	if limit != nil && *limit > 0 {
 		//nolint:gosec // limit is validated to be > 0 before conversion
@@ -727,13 +739,16 @@ func getRootElementPage(ctx context.Context, db dbQueryer, submodelDatabaseID in
 	nextCursor := ""
 
 	for rows.Next() {
-		var id int64
-		var path string
-		if scanErr := rows.Scan(&id, &path); scanErr != nil {
+		var row rootElementCursorRow
+		scanTargets := []any{&row.id, &row.path}
+		if orderByPosition {
+			scanTargets = append(scanTargets, &row.position)
+		}
+		if scanErr := rows.Scan(scanTargets...); scanErr != nil {
 			return nil, "", common.NewInternalServerError("SMREPO-GETROOTPATHS-SCANROW " + scanErr.Error())
 		}
 
-		paths = append(paths, rootElementCursorRow{id: id, path: path})
+		paths = append(paths, row)
 	}
 
 	if rowsErr := rows.Err(); rowsErr != nil {
@@ -744,9 +759,12 @@ func getRootElementPage(ctx context.Context, db dbQueryer, submodelDatabaseID in
 		paths = paths[:*limit]
 		lastPath := paths[len(paths)-1]
 		nextCursor = formatRootCursor(lastPath.path, lastPath.id)
+		if orderByPosition {
+			nextCursor = formatPositionCursor(nextCursor, lastPath.position)
+		}
 	}
 
-	if limit != nil && *limit == -1 && cursor == "" {
+	if !orderByPosition && limit != nil && *limit == -1 && cursor == "" {
 		sort.SliceStable(paths, func(i, j int) bool {
 			if paths[i].path == paths[j].path {
 				return paths[i].id < paths[j].id
@@ -778,6 +796,29 @@ func formatRootCursor(path string, id int64) string {
 	return path + "|" + strconv.FormatInt(id, 10)
 }
 
+// positionCursorSeparator appends the position of the cursor element to a
+// position-ordered cursor. It cannot occur in idShort paths.
+const positionCursorSeparator = "@"
+
+// parsePositionCursor splits a position-ordered cursor into the "path|id" root
+// cursor and the position of its element. Cursors issued before the position
+// was included report no position.
+func parsePositionCursor(cursor string) (string, int64, bool) {
+	separatorIndex := strings.LastIndex(cursor, positionCursorSeparator)
+	if separatorIndex <= 0 {
+		return cursor, 0, false
+	}
+	position, parseErr := strconv.ParseInt(cursor[separatorIndex+1:], 10, 64)
+	if parseErr != nil {
+		return cursor, 0, false
+	}
+	return cursor[:separatorIndex], position, true
+}
+
+func formatPositionCursor(rootCursor string, position int64) string {
+	return rootCursor + positionCursorSeparator + strconv.FormatInt(position, 10)
+}
+
 func addSMECursorBoundary(query *goqu.SelectDataset, cursor string) *goqu.SelectDataset {
 	cursorPath, cursorID, hasCursorID := parseRootCursor(cursor)
 	if !hasCursorID {
@@ -794,6 +835,54 @@ func addSMECursorBoundary(query *goqu.SelectDataset, cursor string) *goqu.Select
 	)
 }
 
+// addSMEPositionCursorBoundary continues a position-ordered page after the
+// root element referenced by the cursor. The cursor keeps the "path|id" format
+// so it stays opaque to clients; the position is resolved from that element.
+func addSMEPositionCursorBoundary(query *goqu.SelectDataset, submodelDatabaseID int64, cursor string) *goqu.SelectDataset {
+	cursorPath, cursorID, hasCursorID := parseRootCursor(cursor)
+	cursorElement := goqu.Dialect("postgres").
+		From(goqu.T("submodel_element").As("cursor_sme")).
+		Select(goqu.I("cursor_sme.position")).
+		Where(
+			goqu.I("cursor_sme.submodel_id").Eq(submodelDatabaseID),
+			goqu.I("cursor_sme.parent_sme_id").IsNull(),
+			goqu.I("cursor_sme.idshort_path").Eq(cursorPath),
+		).
+		Limit(1)
+	if !hasCursorID {
+		return query.Where(goqu.I("sme.position").Gt(cursorElement))
+	}
+	cursorElement = cursorElement.Where(goqu.I("cursor_sme.id").Eq(cursorID))
+	return query.Where(
+		goqu.Or(
+			goqu.I("sme.position").Gt(cursorElement),
+			goqu.And(
+				goqu.I("sme.position").Eq(cursorElement),
+				goqu.I("sme.id").Gt(cursorID),
+			),
+		),
+	)
+}
+
+// addSMEPositionValueBoundary continues a position-ordered page after the
+// position and id stored in the cursor. Unlike addSMEPositionCursorBoundary it
+// does not need the cursor element, so deleting it between pages skips nothing.
+func addSMEPositionValueBoundary(query *goqu.SelectDataset, position int64, rootCursor string) *goqu.SelectDataset {
+	_, cursorID, hasCursorID := parseRootCursor(rootCursor)
+	if !hasCursorID {
+		return query.Where(goqu.I("sme.position").Gt(position))
+	}
+	return query.Where(
+		goqu.Or(
+			goqu.I("sme.position").Gt(position),
+			goqu.And(
+				goqu.I("sme.position").Eq(position),
+				goqu.I("sme.id").Gt(cursorID),
+			),
+		),
+	)
+}
+
 func submodelElementCursorExists(ctx context.Context, db dbQueryer, query *goqu.SelectDataset, cursor string) (bool, error) {
 	cursorPath, cursorID, hasCursorID := parseRootCursor(cursor)
 	cursorQuery := query.Where(goqu.I("sme.idshort_path").Eq(cursorPath))
@@ -972,6 +1061,7 @@ func addNormalizedSMERowFilterQueries(
 	if err != nil {
 		return nil, err
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	return auth.AddFilterQueriesFromContext(ctx, query, fragments, collector)
 }
 
@@ -1142,6 +1232,7 @@ func buildSMEPathAuthorizationQuery(
 	if formulaCollectorErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEBYPATH-BADCOLLECTOR " + formulaCollectorErr.Error())
 	}
+	formulaCollector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	shouldEnforceFormula, enforceErr := auth.ShouldEnforceFormula(ctx)
 	if enforceErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEBYPATH-SHOULDENFORCE " + enforceErr.Error())
@@ -1228,6 +1319,7 @@ func readSubmodelElementRowsByPath(ctx context.Context, db dbQueryer, submodelDa
 	if collectorErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEBYPATH-BADCOLLECTOR " + collectorErr.Error())
 	}
+	rowCollector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	maskRuntime, maskGroups, maskRuntimeErr := buildSMEMaskRuntime(ctx, rowCollector)
 	if maskRuntimeErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMEBYPATH-MASKRUNTIME " + maskRuntimeErr.Error())
@@ -1279,14 +1371,9 @@ func readSubmodelElementRowsByPath(ctx context.Context, db dbQueryer, submodelDa
 		}, maskRuntime.Projections()...)...)
 
 	if includeChildren {
-		escapedPath := escapeSQLLikePattern(idShortOrPath)
 		innerQuery = innerQuery.Where(
 			goqu.I("sme.submodel_id").Eq(submodelDatabaseID),
-			goqu.Or(
-				goqu.I("sme.idshort_path").Eq(idShortOrPath),
-				idShortPathLikeEscaped(goqu.I("sme.idshort_path"), escapedPath+".%"),
-				idShortPathLikeEscaped(goqu.I("sme.idshort_path"), escapedPath+"[%"),
-			),
+			goqu.Or(submodelElementTreePathConditions(goqu.I("sme.idshort_path"), idShortOrPath, true)...),
 		)
 	} else {
 		innerQuery = innerQuery.Where(
@@ -1358,6 +1445,7 @@ func readSubmodelElementRowsByRootIDs(ctx context.Context, db dbQueryer, submode
 	if collectorErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMES-BATCHREAD-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 	maskRuntime, maskGroups, maskRuntimeErr := buildSMEMaskRuntime(ctx, collector)
 	if maskRuntimeErr != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMES-BATCHREAD-MASKRUNTIME " + maskRuntimeErr.Error())
@@ -1662,51 +1750,60 @@ func buildSubmodelElementTreeFromRows(db dbQueryer, parsedRows []loadedSMERow, s
 		return rootNodes[i].path < rootNodes[j].path
 	})
 
-	attachEntityChildrenByPathFallback(rootNodes)
+	attachChildrenByPathFallback(rootNodes)
 
 	return rootNodes[0].element, nil
 }
 
-func attachEntityChildrenByPathFallback(rootNodes []*loadedSMENode) {
+// attachChildrenByPathFallback attaches direct children of an Entity or
+// AnnotatedRelationshipElement root whose rows were loaded without a resolvable
+// parent id, matching them by idShortPath instead.
+func attachChildrenByPathFallback(rootNodes []*loadedSMENode) {
 	if len(rootNodes) <= 1 {
 		return
 	}
 
 	root := rootNodes[0]
-	if root.element.ModelType() != types.ModelTypeEntity {
+	var attach func(types.ISubmodelElement, []*loadedSMENode)
+	switch root.element.ModelType() {
+	case types.ModelTypeEntity:
+		attach = setEntityChildren
+	case types.ModelTypeAnnotatedRelationshipElement:
+		attach = setAnnotatedRelationshipChildren
+	default:
 		return
 	}
 
-	orphanStatements := make([]*loadedSMENode, 0, len(rootNodes)-1)
+	orphanChildren := make([]*loadedSMENode, 0, len(rootNodes)-1)
 	for i := 1; i < len(rootNodes); i++ {
 		candidate := rootNodes[i]
-		if isDirectEntityStatementPath(root.path, candidate.path) {
-			orphanStatements = append(orphanStatements, candidate)
+		if isDirectChildPath(root.path, candidate.path) {
+			orphanChildren = append(orphanChildren, candidate)
 		}
 	}
 
-	if len(orphanStatements) == 0 {
+	if len(orphanChildren) == 0 {
 		return
 	}
 
-	sort.SliceStable(orphanStatements, func(i int, j int) bool {
-		if orphanStatements[i].position == orphanStatements[j].position {
-			return orphanStatements[i].path < orphanStatements[j].path
+	sort.SliceStable(orphanChildren, func(i int, j int) bool {
+		if orphanChildren[i].position == orphanChildren[j].position {
+			return orphanChildren[i].path < orphanChildren[j].path
 		}
-		return orphanStatements[i].position < orphanStatements[j].position
+		return orphanChildren[i].position < orphanChildren[j].position
 	})
 
-	setEntityChildren(root.element, orphanStatements)
+	attach(root.element, orphanChildren)
 }
 
-func isDirectEntityStatementPath(entityPath string, candidatePath string) bool {
-	if strings.HasPrefix(candidatePath, entityPath+".") {
-		suffix := strings.TrimPrefix(candidatePath, entityPath+".")
+func isDirectChildPath(parentPath string, candidatePath string) bool {
+	if strings.HasPrefix(candidatePath, parentPath+".") {
+		suffix := strings.TrimPrefix(candidatePath, parentPath+".")
 		return suffix != "" && !strings.Contains(suffix, ".") && !strings.Contains(suffix, "[")
 	}
 
-	if strings.HasPrefix(candidatePath, entityPath+"[") {
-		suffix := strings.TrimPrefix(candidatePath, entityPath)
+	if strings.HasPrefix(candidatePath, parentPath+"[") {
+		suffix := strings.TrimPrefix(candidatePath, parentPath)
 		return strings.Count(suffix, "[") == 1 && !strings.Contains(suffix, ".")
 	}
 
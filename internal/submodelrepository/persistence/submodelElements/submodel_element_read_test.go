@@ -27,6 +27,7 @@ package submodelelements
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -89,6 +90,86 @@ func TestEscapeSQLLikePatternEscapesWildcardCharacters(t *testing.T) {
 	require.Equal(t, "A!!B!_C!%", escapeSQLLikePattern("A!B_C%"))
 }
 
+// likeEscapedMatches evaluates a LIKE pattern using '!' as escape character the
+// way PostgreSQL does, so subtree conditions can be checked without a database.
+func likeEscapedMatches(pattern string, value string) bool {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '!' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		case c == '%':
+			expr.WriteString("(?s:.*)")
+		case c == '_':
+			expr.WriteString("(?s:.)")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String()).MatchString(value)
+}
+
+func selectsSubtreePath(t *testing.T, idShortOrPath string, candidate string) bool {
+	t.Helper()
+
+	_, args, err := goqu.Dialect("postgres").
+		From("submodel_element").
+		Prepared(true).
+		Where(submodelElementTreePathConditions(goqu.I("idshort_path"), idShortOrPath, false)...).
+		ToSQL()
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+	for _, arg := range args {
+		if likeEscapedMatches(arg.(string), candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSubmodelElementTreePathConditionsEscapeLikeWildcards(t *testing.T) {
+	t.Parallel()
+
+	sqlQuery, _, err := goqu.Dialect("postgres").
+		From(goqu.T("submodel_element").As("sme")).
+		Where(submodelElementTreeWhere(7, "a_b", true, "sme")).
+		ToSQL()
+	require.NoError(t, err)
+
+	require.Contains(t, sqlQuery, `("sme"."idshort_path" = 'a_b')`)
+	require.Contains(t, sqlQuery, `"sme"."idshort_path" LIKE 'a!_b.%' ESCAPE '!'`)
+	require.Contains(t, sqlQuery, `"sme"."idshort_path" LIKE 'a!_b[%' ESCAPE '!'`)
+}
+
+func TestSubmodelElementTreePathConditionsSelectExactSubtree(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		root      string
+		candidate string
+		selected  bool
+	}{
+		{root: "a_b", candidate: "a_b.child", selected: true},
+		{root: "a_b", candidate: "a_b[0]", selected: true},
+		{root: "a_b", candidate: "a_b[0].inner_x", selected: true},
+		{root: "a_b", candidate: "axb.child", selected: false},
+		{root: "a_b", candidate: "axb[0]", selected: false},
+		{root: "a_b", candidate: "a_bc.child", selected: false},
+		{root: "a_b", candidate: "a_b", selected: false},
+		{root: "p%", candidate: "p%.child", selected: true},
+		{root: "p%", candidate: "pq.child", selected: false},
+		{root: "p%", candidate: "p.child", selected: false},
+		{root: "outer.a_b", candidate: "outer.a_b.leaf", selected: true},
+		{root: "outer.a_b", candidate: "outer.aXb.leaf", selected: false},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.selected, selectsSubtreePath(t, tc.root, tc.candidate), "root %q candidate %q", tc.root, tc.candidate)
+	}
+}
+
 func TestAddSMERowFilterQueriesCorrelatesStructuralConditionToCurrentElement(t *testing.T) {
 	t.Parallel()
 
@@ -367,3 +448,141 @@ func TestNormalizeSMERowFiltersDoesNotMergeFieldMasks(t *testing.T) {
 	require.NotNil(t, rowFilter.Boolean)
 	require.True(t, *rowFilter.Boolean)
 }
+
+func TestGetReferencesFromKeyTablesPreservesKeyInsertionOrder(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+	})
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id", "type" FROM "submodel_element_semantic_id_reference"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type"}).AddRow(7, int64(types.ReferenceTypesModelReference)))
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY "reference_id" ASC, "position" ASC`)).
+		WillReturnRows(sqlmock.NewRows([]string{"reference_id", "type", "value"}).
+			AddRow(7, int64(types.KeyTypesSubmodel), "urn:z:submodel").
+			AddRow(7, int64(types.KeyTypesSubmodelElementCollection), "Middle").
+			AddRow(7, int64(types.KeyTypesProperty), "A"))
+
+	references, err := getReferencesFromKeyTables(
+		db,
+		"submodel_element_semantic_id_reference",
+		"submodel_element_semantic_id_reference_key",
+		[]int64{7},
+		"TEST",
+	)
+	require.NoError(t, err)
+	require.Contains(t, references, int64(7))
+
+	keys := references[7].Keys()
+	require.Len(t, keys, 3)
+	require.Equal(t, "urn:z:submodel", keys[0].Value())
+	require.Equal(t, "Middle", keys[1].Value())
+	require.Equal(t, "A", keys[2].Value())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRootElementPageOrdersByRequestedElementOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		order       string
+		cursor      string
+		expectCheck bool
+		wantOrder   string
+		wantBound   string
+		rows        [][]any
+		wantPaths   []string
+		wantCursor  string
+	}{
+		{
+			name:       "idShort",
+			order:      common.ElementOrderIDShort,
+			wantOrder:  `ORDER BY "sme"."idshort_path" ASC, "sme"."id" ASC`,
+			rows:       [][]any{{20, "Alpha"}, {30, "Bravo"}, {10, "Charlie"}},
+			wantPaths:  []string{"Alpha", "Bravo"},
+			wantCursor: "Bravo|30",
+		},
+		{
+			name:      "idShort after deleted cursor",
+			order:     common.ElementOrderIDShort,
+			cursor:    "Alpha|20",
+			wantOrder: `ORDER BY "sme"."idshort_path" ASC, "sme"."id" ASC`,
+			wantBound: `(("sme"."idshort_path" > 'Alpha') OR (("sme"."idshort_path" = 'Alpha') AND ("sme"."id" > 20)))`,
+			rows:      [][]any{{30, "Bravo"}},
+			wantPaths: []string{"Bravo"},
+		},
+		{
+			name:       "position",
+			order:      common.ElementOrderPosition,
+			wantOrder:  `ORDER BY "sme"."position" ASC, "sme"."id" ASC`,
+			rows:       [][]any{{10, "Charlie", 0}, {20, "Alpha", 1}, {30, "Bravo", 2}},
+			wantPaths:  []string{"Charlie", "Alpha"},
+			wantCursor: "Alpha|20@1",
+		},
+		{
+			name:      "position after deleted cursor",
+			order:     common.ElementOrderPosition,
+			cursor:    "Alpha|20@1",
+			wantOrder: `ORDER BY "sme"."position" ASC, "sme"."id" ASC`,
+			wantBound: `(("sme"."position" > 1) OR (("sme"."position" = 1) AND ("sme"."id" > 20)))`,
+			rows:      [][]any{{30, "Bravo", 2}},
+			wantPaths: []string{"Bravo"},
+		},
+		{
+			name:        "position after cursor without position",
+			order:       common.ElementOrderPosition,
+			cursor:      "Alpha|20",
+			expectCheck: true,
+			wantOrder:   `ORDER BY "sme"."position" ASC, "sme"."id" ASC`,
+			wantBound:   `("sme"."position" > (SELECT "cursor_sme"."position" FROM "submodel_element" AS "cursor_sme"`,
+			rows:        [][]any{{30, "Bravo", 2}},
+			wantPaths:   []string{"Bravo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+
+			columns := []string{"id", "idshort_path"}
+			if tt.order == common.ElementOrderPosition {
+				columns = append(columns, "position")
+			}
+			if tt.expectCheck {
+				mock.ExpectQuery(`SELECT .*"sme"\."idshort_path" = 'Alpha'`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(20, "Alpha", 1))
+			}
+			rows := sqlmock.NewRows(columns)
+			for _, row := range tt.rows {
+				values := make([]driver.Value, 0, len(row))
+				for _, value := range row {
+					values = append(values, value)
+				}
+				rows.AddRow(values...)
+			}
+			mock.ExpectQuery(regexp.QuoteMeta(tt.wantBound) + `.*` + regexp.QuoteMeta(tt.wantOrder)).WillReturnRows(rows)
+
+			ctx := common.WithElementOrder(contextWithABACDisabled(t), tt.order)
+			limit := 2
+			page, nextCursor, err := getRootElementPage(ctx, db, 7, &limit, tt.cursor)
+			require.NoError(t, err)
+
+			paths := make([]string, 0, len(page))
+			for _, element := range page {
+				paths = append(paths, element.path)
+			}
+			require.Equal(t, tt.wantPaths, paths)
+			require.Equal(t, tt.wantCursor, nextCursor)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/jsonization"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/builder"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmodelElementSupplementalSemanticIDsRoundTripThroughPayload(t *testing.T) {
+	t.Parallel()
+
+	version := types.NewReference(
+		types.ReferenceTypesExternalReference,
+		[]types.IKey{types.NewKey(types.KeyTypesGlobalReference, "https://example.com/ids/cd/version/2.1.0")},
+	)
+	unit := types.NewReference(
+		types.ReferenceTypesModelReference,
+		[]types.IKey{types.NewKey(types.KeyTypesConceptDescription, "urn:example:cd:unit")},
+	)
+	idShort := "VersionedProperty"
+	property := types.NewProperty(types.DataTypeDefXSDString)
+	property.SetIDShort(&idShort)
+	property.SetSupplementalSemanticIDs([]types.IReference{version, unit})
+
+	record, hasPayload, err := buildSubmodelElementPayloadRecord(1, property, jsoniter.ConfigCompatibleWithStandardLibrary)
+	require.NoError(t, err)
+	require.True(t, hasPayload, "supplementalSemanticIds alone must produce a payload row")
+	stored, ok := record["supplemental_semantic_ids_payload"].(string)
+	require.True(t, ok)
+
+	raw := json.RawMessage(stored)
+	element, _, err := builder.BuildSubmodelElement(model.SubmodelElementRow{
+		IDShort:                 sql.NullString{String: idShort, Valid: true},
+		ModelType:               int64(types.ModelTypeProperty),
+		SupplementalSemanticIDs: &raw,
+	}, nil)
+	require.NoError(t, err)
+
+	got := element.SupplementalSemanticIDs()
+	require.Len(t, got, 2)
+	for index, want := range []types.IReference{version, unit} {
+		wantJSON, err := jsonization.ToJsonable(want)
+		require.NoError(t, err)
+		gotJSON, err := jsonization.ToJsonable(got[index])
+		require.NoError(t, err)
+		require.Equal(t, wantJSON, gotJSON)
+	}
+}
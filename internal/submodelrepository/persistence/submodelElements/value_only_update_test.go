@@ -0,0 +1,82 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package submodelelements
+
+import (
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityValueOnlyUpdateRecordStoresEntityTypeEnum(t *testing.T) {
+	t.Parallel()
+
+	record, err := buildEntityValueOnlyUpdateRecord(gen.EntityValue{
+		EntityType:       "SelfManagedEntity",
+		GlobalAssetID:    "urn:example:asset:motor",
+		SpecificAssetIds: []map[string]any{{"name": "serialNumber", "value": "4711"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.EntityTypeSelfManagedEntity, record["entity_type"])
+	require.Equal(t, "urn:example:asset:motor", record["global_asset_id"])
+	require.JSONEq(t, `[{"name":"serialNumber","value":"4711"}]`, record["specific_asset_ids"].(string))
+}
+
+func TestEntityValueOnlyUpdateRecordKeepsEntityTypeWhenOmitted(t *testing.T) {
+	t.Parallel()
+
+	record, err := buildEntityValueOnlyUpdateRecord(gen.EntityValue{})
+	require.NoError(t, err)
+	require.NotContains(t, record, "entity_type")
+	require.Nil(t, record["global_asset_id"])
+	require.Equal(t, "[]", record["specific_asset_ids"])
+}
+
+func TestEntityValueOnlyUpdateRecordRejectsUnknownEntityType(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildEntityValueOnlyUpdateRecord(gen.EntityValue{EntityType: "Bogus"})
+	require.True(t, common.IsErrBadRequest(err), "expected bad request, got %v", err)
+}
+
+func TestAnnotatedRelationshipElementValueAcceptsRelationshipShape(t *testing.T) {
+	t.Parallel()
+
+	first := map[string]any{"type": "ExternalReference"}
+	second := map[string]any{"type": "ModelReference"}
+
+	value, ok := annotatedRelationshipElementValueOf(gen.RelationshipElementValue{First: first, Second: second})
+	require.True(t, ok)
+	require.Equal(t, first, value.First)
+	require.Equal(t, second, value.Second)
+	require.Empty(t, value.Annotations)
+
+	_, ok = annotatedRelationshipElementValueOf(gen.PropertyValue{Value: "x"})
+	require.False(t, ok)
+}
@@ -0,0 +1,124 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+// Author: Jannik Fried (Fraunhofer IESE)
+
+package persistence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// listFilterClause returns the joins and filters of the inner dataset of a
+// submodel list query, stripped of its order and page limit.
+func listFilterClause(t *testing.T, listSQL string) string {
+	t.Helper()
+
+	start := strings.Index(listSQL, ` FROM "submodel" INNER JOIN`)
+	end := strings.Index(listSQL, ` ORDER BY "submodel"."submodel_identifier" ASC LIMIT`)
+	require.True(t, start >= 0 && end > start, "unexpected list SQL: %s", listSQL)
+	return listSQL[start:end]
+}
+
+func TestCountSubmodelsByListFiltersUsesListFilters(t *testing.T) {
+	t.Parallel()
+
+	instance := types.ModellingKindInstance
+	withElements := true
+	tests := []struct {
+		name        string
+		idShort     string
+		semanticID  string
+		kind        *types.ModellingKind
+		hasElements *bool
+		createdFrom time.Time
+	}{
+		{name: "unfiltered"},
+		{name: "idShort", idShort: "FilterShort"},
+		{name: "semanticId", semanticID: "urn:example:semantic"},
+		{name: "kind and elements", kind: &instance, hasElements: &withElements},
+		{name: "all", idShort: "FilterShort", semanticID: "urn:example:semantic", kind: &instance, hasElements: &withElements, createdFrom: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var captured []string
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(_, actual string) error {
+				captured = append(captured, actual)
+				return nil
+			})))
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			sut := &SubmodelDatabase{db: db}
+			ctx := contextWithABACDisabled(t)
+
+			mock.ExpectQuery("list").WillReturnError(errors.New("query stopped"))
+			mock.ExpectQuery("count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+			_, _, err = sut.GetSubmodelsByListFilters(ctx, 2, "", tt.idShort, tt.semanticID, tt.kind, tt.hasElements, SubmodelListOrderID, tt.createdFrom, time.Time{})
+			require.Error(t, err)
+			count, err := sut.CountSubmodelsByListFilters(ctx, tt.idShort, tt.semanticID, tt.kind, tt.hasElements, tt.createdFrom, time.Time{})
+			require.NoError(t, err)
+			require.Equal(t, int64(7), count)
+			require.NoError(t, mock.ExpectationsWereMet())
+
+			require.Len(t, captured, 2)
+			countSQL := captured[1]
+			require.True(t, strings.HasPrefix(countSQL, `SELECT COUNT(*) FROM (SELECT "submodel"."id" FROM "submodel" INNER JOIN`), countSQL)
+			require.True(t, strings.HasSuffix(countSQL, listFilterClause(t, captured[0])+`) AS "submodel_count_data"`), countSQL)
+			require.NotContains(t, countSQL, "LIMIT")
+		})
+	}
+}
+
+func TestCountSubmodelsByListFiltersQueryError(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).WillReturnError(errors.New("query stopped"))
+
+	count, err := sut.CountSubmodelsByListFilters(contextWithABACDisabled(t), "", "", nil, nil, time.Time{}, time.Time{})
+	require.Error(t, err)
+	require.Zero(t, count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
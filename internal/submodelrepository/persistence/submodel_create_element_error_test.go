@@ -0,0 +1,85 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubmodelReportsFailingNestedElement(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	temperature := idShortProperty("temperature")
+	active := types.NewProperty(types.DataTypeDefXSDBoolean)
+	activeIDShort := "active"
+	activeValue := "maybe"
+	active.SetIDShort(&activeIDShort)
+	active.SetValue(&activeValue)
+
+	sut := &SubmodelDatabase{db: db, verificationMode: gen.VerificationModeOff}
+	submodel := types.NewSubmodel("urn:example:sm:invalid-nested-element")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{
+		idShortCollection("status", temperature, active),
+	})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO .*submodel.*RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec(`INSERT INTO "submodel_payload"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT nextval`).
+		WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(11).AddRow(12).AddRow(13))
+	mock.ExpectExec(`INSERT INTO "submodel_element"`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO "submodel_element_collection"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "property_element"`).
+		WillReturnError(&pgconn.PgError{Code: "22P02", Message: `invalid input syntax for type boolean: "maybe"`})
+	mock.ExpectRollback()
+
+	err = sut.CreateSubmodel(contextWithABACDisabled(t), submodel)
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err), err.Error())
+	require.Contains(t, err.Error(), "submodel element 'status.active' (Property)")
+	require.Contains(t, err.Error(), `invalid input syntax for type boolean: "maybe"`)
+	require.NotContains(t, err.Error(), "status.temperature")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -41,10 +41,24 @@ import (
 type SubmodelDatabase struct {
 	db               *sql.DB
 	privateKey       *rsa.PrivateKey
+	keySet           *jws.KeySet
 	signingOptions   jws.SigningOptions
 	verificationMode gen.VerificationMode
 }
 
+// SetJWSKeySet configures a reloadable key set for signed Submodel responses.
+// When set, it takes precedence over the private key passed to the
+// constructor, so that key rotations reach the signer without a restart.
+//
+// Parameters:
+//   - keySet: Key set whose current signing key signs responses.
+//
+// Returns:
+//   - None.
+func (s *SubmodelDatabase) SetJWSKeySet(keySet *jws.KeySet) {
+	s.keySet = keySet
+}
+
 // SetJWSCertificateChain configures the optional certificate chain embedded in
 // signed Submodel responses.
 //
@@ -29,6 +29,7 @@ package persistence
 import (
 	"database/sql"
 	"encoding/json"
+	"regexp"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/history"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,6 +94,7 @@ func TestPatchSubmodelSuccessReplacesSubmodel(t *testing.T) {
 	mock.ExpectQuery(`SELECT .*FROM .*submodel`).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
 	expectNoManagedFileReferences(mock)
+	expectSubmodelCreatedAtLoad(mock, replacedSubmodelCreatedAt)
 	mock.ExpectQuery(`SELECT .*file_oid.*FROM .*submodel_element.*file_data`).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
 	mock.ExpectExec(`DELETE FROM .*submodel`).
@@ -100,6 +103,7 @@ func TestPatchSubmodelSuccessReplacesSubmodel(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(200))
 	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectSubmodelCreatedAtRestore(mock, "sm-1", replacedSubmodelCreatedAt)
 	expectCurrentSubmodelSnapshotLoad(mock, "sm-1", "sm1")
 	mock.ExpectCommit()
 
@@ -127,6 +131,7 @@ func TestPatchSubmodelInTransactionAppendsHistory(t *testing.T) {
 	mock.ExpectQuery(`SELECT .*FROM .*submodel`).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
 	expectNoManagedFileReferences(mock)
+	expectSubmodelCreatedAtLoad(mock, replacedSubmodelCreatedAt)
 	mock.ExpectQuery(`SELECT .*file_oid.*FROM .*submodel_element.*file_data`).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
 	mock.ExpectExec(`DELETE FROM .*submodel`).
@@ -135,6 +140,7 @@ func TestPatchSubmodelInTransactionAppendsHistory(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(200))
 	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectSubmodelCreatedAtRestore(mock, "sm-1", replacedSubmodelCreatedAt)
 	expectCurrentSubmodelSnapshotLoad(mock, "sm-1", "sm1")
 	mock.ExpectRollback()
 
@@ -246,6 +252,7 @@ func TestPutSubmodelUpdatePathReturnsTrue(t *testing.T) {
 	mock.ExpectQuery(`SELECT .*FROM .*submodel`).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(400))
 	expectNoManagedFileReferences(mock)
+	expectSubmodelCreatedAtLoad(mock, replacedSubmodelCreatedAt)
 	mock.ExpectQuery(`SELECT .*file_oid.*FROM .*submodel_element.*file_data`).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
 	mock.ExpectExec(`DELETE FROM .*submodel`).
@@ -254,6 +261,7 @@ func TestPutSubmodelUpdatePathReturnsTrue(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(401))
 	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectSubmodelCreatedAtRestore(mock, "sm-existing", replacedSubmodelCreatedAt)
 	expectCurrentSubmodelSnapshotLoad(mock, "sm-existing", "smexisting")
 	mock.ExpectCommit()
 
@@ -263,6 +271,20 @@ func TestPutSubmodelUpdatePathReturnsTrue(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+// replacedSubmodelCreatedAt is the creation time of the row a replacement
+// deletes; the re-inserted row must carry it over.
+var replacedSubmodelCreatedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func expectSubmodelCreatedAtLoad(mock sqlmock.Sqlmock, createdAt time.Time) {
+	mock.ExpectQuery(`SELECT "db_created_at" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"db_created_at"}).AddRow(createdAt))
+}
+
+func expectSubmodelCreatedAtRestore(mock sqlmock.Sqlmock, submodelID string, createdAt time.Time) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "submodel" SET "db_created_at"='` + createdAt.Format(time.RFC3339Nano) + `' WHERE ("submodel_identifier" = '` + submodelID + `')`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func expectSubmodelHistoryAppend(mock sqlmock.Sqlmock) {
 	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -481,3 +503,26 @@ func TestPatchSubmodelElementByPathSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestPatchSubmodelElementValueOnlyRejectsInvalidReferenceKeyType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	value := gen.ReferenceElementValue{
+		Type: "ExternalReference",
+		Keys: []map[string]any{{"type": "NotAKeyType", "value": "urn:example:target"}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	err = sut.UpdateSubmodelElementValueOnly(contextWithABACDisabled(t), "sm-1", "ref", value)
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+	require.Contains(t, err.Error(), "SMREPO-UPDSMEVALONLY-INVALIDREF")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
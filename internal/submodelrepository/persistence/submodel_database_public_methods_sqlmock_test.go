@@ -29,6 +29,7 @@ package persistence
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -86,13 +87,123 @@ func TestGetSubmodelsByListFiltersUsesIDShortColumn(t *testing.T) {
 	mock.ExpectQuery(`"submodel"\."id_short" = 'FilterShort'`).
 		WillReturnError(errors.New("query stopped"))
 
-	items, cursor, err := sut.GetSubmodelsByListFilters(contextWithABACDisabled(t), 10, "", "FilterShort", "", time.Time{}, time.Time{})
+	items, cursor, err := sut.GetSubmodelsByListFilters(contextWithABACDisabled(t), 10, "", "FilterShort", "", nil, nil, SubmodelListOrderID, time.Time{}, time.Time{})
 	require.Error(t, err)
 	require.Nil(t, items)
 	require.Empty(t, cursor)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetSubmodelsByListFiltersFiltersByKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		kind      types.ModellingKind
+		wantWhere string
+	}{
+		{
+			name:      "instance includes unset kind",
+			kind:      types.ModellingKindInstance,
+			wantWhere: fmt.Sprintf(`\(\("submodel"\."kind" = %d\) OR \("submodel"\."kind" IS NULL\)\)`, types.ModellingKindInstance),
+		},
+		{
+			name:      "template",
+			kind:      types.ModellingKindTemplate,
+			wantWhere: fmt.Sprintf(`\("submodel"\."kind" = %d\)`, types.ModellingKindTemplate),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			sut := &SubmodelDatabase{db: db}
+			kind := tt.kind
+
+			mock.ExpectQuery(tt.wantWhere).
+				WillReturnError(errors.New("query stopped"))
+
+			items, _, err := sut.GetSubmodelsByListFilters(contextWithABACDisabled(t), 10, "", "", "", &kind, nil, SubmodelListOrderID, time.Time{}, time.Time{})
+			require.Error(t, err)
+			require.Nil(t, items)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetSubmodelsByListFiltersFiltersByElementPresence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		hasElements bool
+		wantWhere   string
+	}{
+		{
+			name:        "with elements",
+			hasElements: true,
+			wantWhere:   `WHERE .*EXISTS\(\(SELECT 1 FROM "submodel_element" AS "sme_presence" WHERE \("sme_presence"\."submodel_id" = "submodel"\."id"\)\)\)`,
+		},
+		{
+			name:        "without elements",
+			hasElements: false,
+			wantWhere:   `WHERE .*NOT EXISTS\(\(SELECT 1 FROM "submodel_element" AS "sme_presence" WHERE \("sme_presence"\."submodel_id" = "submodel"\."id"\)\)\)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			sut := &SubmodelDatabase{db: db}
+			hasElements := tt.hasElements
+
+			mock.ExpectQuery(tt.wantWhere).
+				WillReturnError(errors.New("query stopped"))
+
+			items, _, err := sut.GetSubmodelsByListFilters(contextWithABACDisabled(t), 10, "", "", "", nil, &hasElements, SubmodelListOrderID, time.Time{}, time.Time{})
+			require.Error(t, err)
+			require.Nil(t, items)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetSubmodelElementMaxDepthScansRecursiveDepth(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectQuery(`WITH RECURSIVE sme_depth\(id, depth\)`).
+		WithArgs("urn:example:sm:depth", 0).
+		WillReturnRows(sqlmock.NewRows([]string{"depth"}).AddRow(3))
+
+	depth, ok, err := sut.GetSubmodelElementMaxDepth(contextWithABACDisabled(t), "urn:example:sm:depth")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 3, depth)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetSubmodelByIDReturnsErrorWhenParallelReadsFail(t *testing.T) {
 	t.Parallel()
 
@@ -814,7 +925,7 @@ func TestGetSubmodelReferencesReturnsModelReferencesWithSingleSubmodelKey(t *tes
 
 	mock.ExpectQuery(`SELECT .*FROM .*submodel`).WillReturnRows(rows)
 
-	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 1, "", "", "")
+	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 1, "", "", "", nil)
 	require.NoError(t, err)
 	require.Len(t, references, 1)
 	require.Equal(t, "sm-2", cursor)
@@ -864,7 +975,7 @@ func TestGetSubmodelReferencesReturnsBadRequestForEmptySubmodelIdentifier(t *tes
 
 	mock.ExpectQuery(`SELECT .*FROM .*submodel`).WillReturnRows(rows)
 
-	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 10, "", "", "")
+	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 10, "", "", "", nil)
 	require.Error(t, err)
 	require.Nil(t, references)
 	require.Empty(t, cursor)
@@ -904,7 +1015,7 @@ func TestGetSubmodelReferencesWithSemanticIDFilterReturnsMatchingReference(t *te
 	semanticID := "urn:semantic:id:test"
 	mock.ExpectQuery(`SELECT .*FROM .*submodel.*ssrk_filter.*` + semanticID).WillReturnRows(rows)
 
-	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 10, "", "", semanticID)
+	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 10, "", "", semanticID, nil)
 	require.NoError(t, err)
 	require.Len(t, references, 1)
 	require.Empty(t, cursor)
@@ -925,6 +1036,44 @@ func TestGetSubmodelReferencesWithSemanticIDFilterReturnsMatchingReference(t *te
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetSubmodelReferencesWithKindFilterRestrictsQuery(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	rows := sqlmock.NewRows([]string{
+		"submodel_identifier",
+		"id_short",
+		"category",
+		"kind",
+		"description",
+		"display_name",
+		"administrative_information",
+		"embedded_data_specification",
+		"supplemental_semantic_ids",
+		"extensions",
+		"qualifiers",
+		"semantic_id",
+	}).
+		AddRow("sm-template-1", "idShort-template-1", nil, int64(types.ModellingKindTemplate), nil, nil, nil, nil, nil, nil, nil, nil)
+
+	mock.ExpectQuery(fmt.Sprintf(`SELECT .*FROM .*submodel.*"submodel"\."kind" = %d`, int(types.ModellingKindTemplate))).WillReturnRows(rows)
+
+	kind := types.ModellingKindTemplate
+	references, cursor, err := sut.GetSubmodelReferences(contextWithABACDisabled(t), 10, "", "", "", &kind)
+	require.NoError(t, err)
+	require.Len(t, references, 1)
+	require.Empty(t, cursor)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetSubmodelReferenceReturnsModelReference(t *testing.T) {
 	t.Parallel()
 
@@ -1130,9 +1279,6 @@ func TestGetSubmodelElementPathPageAcceptsCompositeCursor(t *testing.T) {
 	mock.ExpectQuery(`SELECT .*FROM "submodel"`).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
 
-	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "sme".*"sme"\."submodel_id" = 42.*"sme"\."idshort_path" = 'A'.*"sme"\."id" = 10`).
-		WillReturnRows(sqlmock.NewRows([]string{"idshort_path", "id"}).AddRow("A", int64(10)))
-
 	mock.ExpectQuery(`SELECT .*FROM "submodel_element" AS "sme".*"sme"\."idshort_path" > 'A'.*"sme"\."idshort_path" = 'A'.*"sme"\."id" > 10`).
 		WillReturnRows(sqlmock.NewRows([]string{"idshort_path", "id"}).
 			AddRow("A", int64(20)).
@@ -1145,3 +1291,43 @@ func TestGetSubmodelElementPathPageAcceptsCompositeCursor(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetSubmodelsByListFiltersLogsQueryPlanWhenEnabled(t *testing.T) {
+	var loggedQuery string
+	var loggedPlan []string
+	previousPlanLogger := common.SetQueryPlanLogger(func(query string, plan []string) {
+		loggedQuery = query
+		loggedPlan = plan
+	})
+	previousQueryLogger := common.SetQueryLogger(func(string, []any) {})
+	t.Cleanup(func() {
+		common.SetQueryPlanLogger(previousPlanLogger)
+		common.SetQueryLogger(previousQueryLogger)
+	})
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	cfg := &common.Config{}
+	cfg.Postgres.LogQueries = true
+	cfg.Postgres.ExplainQueries = true
+	ctx := common.ContextWithConfig(contextWithABACDisabled(t), cfg)
+
+	mock.ExpectQuery(`^EXPLAIN SELECT .*FROM .*submodel`).
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).
+			AddRow("Limit  (cost=0.15..8.17 rows=1 width=72)").
+			AddRow("  ->  Index Scan using submodel_pkey on submodel"))
+	mock.ExpectQuery(`^SELECT .*FROM .*submodel`).
+		WillReturnError(errors.New("query stopped"))
+
+	_, _, err = sut.GetSubmodelsByListFilters(ctx, 10, "", "FilterShort", "", nil, nil, SubmodelListOrderID, time.Time{}, time.Time{})
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Contains(t, loggedQuery, `FROM "submodel"`)
+	require.NotContains(t, loggedQuery, "FilterShort")
+	require.Equal(t, []string{"Limit  (cost=0.15..8.17 rows=1 width=72)", "  ->  Index Scan using submodel_pkey on submodel"}, loggedPlan)
+}
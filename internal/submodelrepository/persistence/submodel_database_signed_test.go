@@ -45,6 +45,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	commonjws "github.com/eclipse-basyx/basyx-go-components/internal/common/jws"
 	"github.com/stretchr/testify/require"
 	jose "gopkg.in/go-jose/go-jose.v2"
 )
@@ -171,6 +172,42 @@ func TestGetSignedSubmodelSignsValueOnlyRepresentation(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetSignedSubmodelWithKeySetWritesKeyIDPublishedInJWKS(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	mock.MatchExpectationsInOrder(false)
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	previousKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keySet, err := commonjws.NewKeySet(signingKey, &previousKey.PublicKey)
+	require.NoError(t, err)
+
+	sut := &SubmodelDatabase{db: db}
+	sut.SetJWSKeySet(keySet)
+	setupSignedSubmodelHappyPathExpectations(mock, "sm-kid")
+
+	jwsCompact, err := sut.GetSignedSubmodel(contextWithABACDisabled(t), "sm-kid")
+	require.NoError(t, err)
+	requireIDTAProtectedHeaders(t, jwsCompact, nil)
+
+	signed, err := jose.ParseSigned(jwsCompact)
+	require.NoError(t, err)
+	jwks := keySet.JWKS()
+	published := jwks.Key(signed.Signatures[0].Protected.KeyID)
+	require.Len(t, published, 1)
+	_, err = signed.Verify(published[0].Key)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func requireIDTAProtectedHeaders(t *testing.T, jwsCompact string, certificateChain []string) {
 	t.Helper()
 
@@ -0,0 +1,87 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+// ValidateSubmodelCreate runs every check of CreateSubmodel, including the
+// ABAC re-check, inside a transaction that is always rolled back. A nil error
+// means CreateSubmodel would currently succeed for the given Submodel.
+func (s *SubmodelDatabase) ValidateSubmodelCreate(ctx context.Context, submodel types.ISubmodel) error {
+	if err := s.verifySubmodel(submodel, "SMREPO-NEWSM-VERIFY"); err != nil {
+		return err
+	}
+	if err := ensureUniqueIDShorts(submodel, "SMREPO-NEWSM-DUPIDSHORT"); err != nil {
+		return err
+	}
+
+	return s.inRolledBackTransaction("SMREPO-DRYRUNNEWSM-STARTTX", func(tx *sql.Tx) error {
+		return s.createSubmodelInTransactionValidated(ctx, tx, submodel)
+	})
+}
+
+// ValidateSubmodelPut runs every check of PutSubmodel inside a transaction
+// that is always rolled back. It reports whether PutSubmodel would replace an
+// existing Submodel (true) or create a new one (false).
+func (s *SubmodelDatabase) ValidateSubmodelPut(ctx context.Context, submodelID string, submodel types.ISubmodel) (bool, error) {
+	if submodelID != submodel.ID() {
+		return false, common.NewErrBadRequest("SMREPO-PUTSM-IDMISMATCH Submodel ID in path and body do not match")
+	}
+	if err := s.verifySubmodel(submodel, "SMREPO-PUTSM-VERIFY"); err != nil {
+		return false, err
+	}
+
+	var isUpdate bool
+	err := s.inRolledBackTransaction("SMREPO-DRYRUNPUTSM-STARTTX", func(tx *sql.Tx) error {
+		var putErr error
+		isUpdate, putErr = s.putSubmodelInTransaction(ctx, tx, submodelID, submodel)
+		return putErr
+	})
+	if err != nil {
+		return false, err
+	}
+	return isUpdate, nil
+}
+
+// inRolledBackTransaction runs fn in a transaction that is rolled back
+// regardless of its outcome so that dry runs never persist anything.
+func (s *SubmodelDatabase) inRolledBackTransaction(startCode string, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return common.NewInternalServerError(startCode + " " + err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	return fn(tx)
+}
@@ -0,0 +1,158 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSubmodelCreateRollsBackValidSubmodel(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	submodel := types.NewSubmodel("sm-dry-run")
+	idShort := "dryRun"
+	submodel.SetIDShort(&idShort)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO .*submodel.*RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(500))
+	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	err = sut.ValidateSubmodelCreate(contextWithABACDisabled(t), submodel)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateSubmodelCreateRejectsDuplicateIDShortsWithoutDatabaseAccess(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	submodel := types.NewSubmodel("sm-dry-run-invalid")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{idShortProperty("temperature"), idShortProperty("temperature")})
+
+	err = sut.ValidateSubmodelCreate(contextWithABACDisabled(t), submodel)
+	require.Error(t, err)
+	require.True(t, common.IsErrBadRequest(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateSubmodelCreateRollsBackOnConflict(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	submodel := types.NewSubmodel("sm-dry-run-existing")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectRollback()
+
+	err = sut.ValidateSubmodelCreate(contextWithABACDisabled(t), submodel)
+	require.Error(t, err)
+	require.True(t, common.IsErrConflict(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateSubmodelPutReportsCreateAndRollsBack(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	submodel := types.NewSubmodel("sm-new")
+	idShort := "smnew"
+	submodel.SetIDShort(&idShort)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .*FROM .*submodel`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`INSERT INTO .*submodel.*RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(300))
+	mock.ExpectExec(`INSERT INTO .*submodel_payload`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectCurrentSubmodelSnapshotLoad(mock, "sm-new", "smnew")
+	mock.ExpectRollback()
+
+	isUpdate, err := sut.ValidateSubmodelPut(contextWithABACDisabled(t), "sm-new", submodel)
+	require.NoError(t, err)
+	require.False(t, isUpdate)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateSubmodelPutRejectsDuplicateIDShorts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	submodel := types.NewSubmodel("sm-put-invalid")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{
+		idShortCollection("nameplate", idShortProperty("serial"), idShortProperty("serial")),
+	})
+
+	isUpdate, err := sut.ValidateSubmodelPut(contextWithABACDisabled(t), "sm-put-invalid", submodel)
+	require.Error(t, err)
+	require.False(t, isUpdate)
+	require.True(t, common.IsErrBadRequest(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,139 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelelements "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/submodelElements"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSubmodelElementByPathRejectsStaleIfMatch(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectBegin()
+	expectIfMatchTargetRead(mock)
+	mock.ExpectRollback()
+
+	ctx := common.WithIfMatchHeader(contextWithABACDisabled(t), `"stale"`)
+	err = sut.DeleteSubmodelElementByPath(ctx, "sm", "Target")
+	require.Error(t, err)
+	require.Truef(t, common.IsErrPreconditionFailed(err), "expected precondition failed, got %v", err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnsureSubmodelElementMatchesIfMatch(t *testing.T) {
+	t.Parallel()
+
+	freshETag := ifMatchTargetETag(t)
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantErr bool
+	}{
+		{name: "fresh", ifMatch: freshETag},
+		{name: "fresh in list", ifMatch: `"other", ` + freshETag},
+		{name: "wildcard", ifMatch: "*"},
+		{name: "stale", ifMatch: `"stale"`, wantErr: true},
+		{name: "weak", ifMatch: "W/" + freshETag, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			mock.ExpectBegin()
+			expectIfMatchTargetRead(mock)
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			err = ensureSubmodelElementMatchesIfMatch(contextWithABACDisabled(t), tx, "sm", "Target", tt.ifMatch)
+			if tt.wantErr {
+				require.Truef(t, common.IsErrPreconditionFailed(err), "expected precondition failed, got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func expectIfMatchTargetRead(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT .*FROM .*submodel.*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT .*FROM .*submodel`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`sme_path_data`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"c_id", "c_parent_sme_id", "c_root_sme_id", "c_id_short", "c_idshort_path", "c_category", "c_model_type", "c_position",
+			"raw_embedded_data_specification_payload", "raw_supplemental_semantic_ids_payload", "raw_extensions_payload",
+			"raw_displayname_payload", "raw_description_payload", "raw_value_payload", "raw_semantic_id_referred_payload",
+			"raw_supplemental_semantic_ids_referred_payload", "raw_qualifiers_payload", "raw_semantic_payload",
+			"semantic_visible", "value_visible",
+		}).AddRow(ifMatchTargetRow()...))
+}
+
+func ifMatchTargetRow() []driver.Value {
+	valuePayload := fmt.Sprintf(`{"value":"42","value_type":%d}`, types.DataTypeDefXSDInt)
+	return []driver.Value{
+		int64(1), nil, int64(1), "Target", "Target", nil, int64(types.ModelTypeProperty), int64(0),
+		[]byte("[]"), []byte("[]"), []byte("[]"), []byte("[]"), []byte("[]"), []byte(valuePayload), []byte("[]"),
+		[]byte("[]"), []byte("[]"), nil, false, true,
+	}
+}
+
+func ifMatchTargetETag(t *testing.T) string {
+	t.Helper()
+
+	idShort := "Target"
+	value := "42"
+	property := types.NewProperty(types.DataTypeDefXSDInt)
+	property.SetIDShort(&idShort)
+	property.SetValue(&value)
+	etag, err := submodelelements.SubmodelElementETag(property)
+	require.NoError(t, err)
+	return etag
+}
@@ -0,0 +1,172 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func expectBulkValueSubmodelLock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT "id" FROM "submodel" .*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+}
+
+func expectPropertyValueUpdate(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "model_type" FROM "submodel_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"model_type"}).AddRow(types.ModelTypeProperty))
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "id" FROM "submodel_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(202))
+	mock.ExpectQuery(`SELECT "value_type" FROM "property_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"value_type"}).AddRow(types.DataTypeDefXSDString))
+	mock.ExpectExec(`UPDATE "property_element"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectMissingElementValueUpdate(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "model_type" FROM "submodel_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"model_type"}))
+}
+
+func TestUpdateSubmodelElementValuesAppliesAllPathsInOneTransaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	values := gen.SubmodelValue{
+		"b": gen.PropertyValue{Value: "second"},
+		"a": gen.PropertyValue{Value: "first"},
+	}
+
+	mock.ExpectBegin()
+	expectBulkValueSubmodelLock(mock)
+	expectPropertyValueUpdate(mock)
+	expectPropertyValueUpdate(mock)
+	expectMutatedSubmodelHistoryFallback(mock)
+	expectCurrentSubmodelSnapshotLoad(mock, "sm", "sm")
+	mock.ExpectCommit()
+
+	failures, err := sut.UpdateSubmodelElementValues(contextWithABACDisabled(t), "sm", values, true)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSubmodelElementValuesAtomicRollsBackOnFailingPath(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	values := gen.SubmodelValue{
+		"a":       gen.PropertyValue{Value: "first"},
+		"missing": gen.PropertyValue{Value: "second"},
+	}
+
+	mock.ExpectBegin()
+	expectBulkValueSubmodelLock(mock)
+	expectPropertyValueUpdate(mock)
+	expectMissingElementValueUpdate(mock)
+	mock.ExpectRollback()
+
+	failures, err := sut.UpdateSubmodelElementValues(contextWithABACDisabled(t), "sm", values, true)
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.Len(t, failures, 1)
+	require.Equal(t, "missing", failures[0].IDShortPath)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSubmodelElementValuesNonAtomicCommitsSuccessfulPaths(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	values := gen.SubmodelValue{
+		"a":       gen.PropertyValue{Value: "first"},
+		"missing": gen.PropertyValue{Value: "second"},
+	}
+
+	mock.ExpectBegin()
+	expectBulkValueSubmodelLock(mock)
+	mock.ExpectExec(`SAVEPOINT smrepo_bulk_value`).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectPropertyValueUpdate(mock)
+	mock.ExpectExec(`RELEASE SAVEPOINT smrepo_bulk_value`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SAVEPOINT smrepo_bulk_value`).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectMissingElementValueUpdate(mock)
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT smrepo_bulk_value`).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectMutatedSubmodelHistoryFallback(mock)
+	expectCurrentSubmodelSnapshotLoad(mock, "sm", "sm")
+	mock.ExpectCommit()
+
+	failures, err := sut.UpdateSubmodelElementValues(contextWithABACDisabled(t), "sm", values, false)
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	require.Equal(t, "missing", failures[0].IDShortPath)
+	require.True(t, common.IsErrNotFound(failures[0].Err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSubmodelElementValuesReturnsNotFoundForUnknownSubmodel(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel" .*FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	failures, err := sut.UpdateSubmodelElementValues(contextWithABACDisabled(t), "sm", gen.SubmodelValue{"a": gen.PropertyValue{Value: "x"}}, true)
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.Contains(t, err.Error(), "SMREPO-PATCHSMEVALUES-SMNOTFOUND")
+	require.Empty(t, failures)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -30,6 +30,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
@@ -146,6 +147,11 @@ func (s *SubmodelDatabase) GetSubmodelElementPathsByPath(ctx context.Context, su
 	return submodelelements.GetSubmodelElementPathsByPath(ctx, s.db, submodelID, idShortPath, level)
 }
 
+// GetSubmodelElementAncestors returns the ancestor chain of a submodel element from the top-level element down to its parent.
+func (s *SubmodelDatabase) GetSubmodelElementAncestors(ctx context.Context, submodelID string, idShortPath string) ([]submodelelements.SubmodelElementAncestor, error) {
+	return submodelelements.GetSubmodelElementAncestors(ctx, s.db, submodelID, idShortPath)
+}
+
 // GetSubmodelElementReferences retrieves SME references and applies optional ABAC formula filters from ctx.
 func (s *SubmodelDatabase) GetSubmodelElementReferences(ctx context.Context, submodelID string, limit *int, cursor string) ([]types.IReference, string, error) {
 	return submodelelements.GetSubmodelElementReferencesBySubmodelID(ctx, s.db, submodelID, limit, cursor)
@@ -538,6 +544,11 @@ func (s *SubmodelDatabase) DeleteSubmodelElementByPath(ctx context.Context, subm
 			return err
 		}
 	}
+	if ifMatch := common.IfMatchHeaderFromContext(ctx); ifMatch != "" {
+		if err = ensureSubmodelElementMatchesIfMatch(ctx, tx, submodelID, idShortPath, ifMatch); err != nil {
+			return err
+		}
+	}
 
 	deletedRootPath, err := submodelElementRootPath(idShortPath)
 	if err != nil {
@@ -548,7 +559,7 @@ func (s *SubmodelDatabase) DeleteSubmodelElementByPath(ctx context.Context, subm
 		return err
 	}
 
-	err = submodelelements.DeleteSubmodelElementByPath(tx, submodelID, idShortPath)
+	err = submodelelements.DeleteSubmodelElementByPath(ctx, tx, submodelID, idShortPath)
 	if err != nil {
 		return err
 	}
@@ -567,6 +578,96 @@ func (s *SubmodelDatabase) DeleteSubmodelElementByPath(ctx context.Context, subm
 	return tx.Commit()
 }
 
+// DeleteSubmodelElementsByPaths deletes several submodel elements of one submodel
+// in a single transaction. All paths refer to the state before the deletion;
+// affected SubmodelElementLists are compacted once after all paths were
+// removed. When any path is missing or denied, nothing is deleted.
+func (s *SubmodelDatabase) DeleteSubmodelElementsByPaths(ctx context.Context, submodelID string, idShortPaths []string) (err error) {
+	tx, cleanup, err := common.StartTransaction(s.db)
+	if err != nil {
+		return err
+	}
+	defer cleanup(&err)
+
+	shouldEnforce, enforceErr := shouldEnforceFormula(ctx, "SMREPO-DELSMESBPATHS-SHOULDENFORCE")
+	if enforceErr != nil {
+		return enforceErr
+	}
+	if shouldEnforce {
+		for _, idShortPath := range idShortPaths {
+			if err = s.ensureSubmodelElementCanBeDeleted(ctx, tx, submodelID, idShortPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	deletedPaths := make(map[string]struct{}, len(idShortPaths))
+	rootPaths := make([]string, 0, len(idShortPaths))
+	seenRoots := make(map[string]struct{}, len(idShortPaths))
+	for _, idShortPath := range idShortPaths {
+		rootPath, rootErr := submodelElementRootPath(idShortPath)
+		if rootErr != nil {
+			return rootErr
+		}
+		deletedPaths[idShortPath] = struct{}{}
+		if _, seen := seenRoots[rootPath]; !seen {
+			seenRoots[rootPath] = struct{}{}
+			rootPaths = append(rootPaths, rootPath)
+		}
+	}
+	previousSnapshot, err := s.loadSubmodelHistorySnapshotBeforeMutationTx(ctx, tx, submodelID)
+	if err != nil {
+		return err
+	}
+
+	if err = submodelelements.DeleteSubmodelElementsByPaths(ctx, tx, submodelID, idShortPaths); err != nil {
+		return err
+	}
+
+	mutations := make([]submodelElementRootMutation, 0, len(rootPaths))
+	for _, rootPath := range rootPaths {
+		currentRootPath := rootPath
+		if _, deleted := deletedPaths[rootPath]; deleted {
+			currentRootPath = ""
+		}
+		mutations = append(mutations, submodelElementRootMutation{
+			previousPath: rootPath,
+			currentPath:  currentRootPath,
+		})
+	}
+	if err = s.appendChangedSubmodelElementHistoryTx(ctx, tx, submodelID, previousSnapshot, mutations...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ensureSubmodelElementMatchesIfMatch rejects the write with 412 when the current
+// entity tag of the element does not satisfy ifMatch. The submodel row stays
+// locked until the transaction ends, so the element cannot change between this
+// check and the write.
+func ensureSubmodelElementMatchesIfMatch(ctx context.Context, tx *sql.Tx, submodelID string, idShortPath string, ifMatch string) error {
+	if _, err := persistenceutils.GetSubmodelDatabaseIDForUpdate(tx, submodelID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return common.NewErrNotFound("SMREPO-IFMATCH-SMNOTFOUND Submodel with ID '" + submodelID + "' not found")
+		}
+		return common.NewInternalServerError("SMREPO-IFMATCH-LOCKSM " + err.Error())
+	}
+
+	element, err := submodelelements.GetSubmodelElementByIDShortOrPathTx(ctx, tx, submodelID, idShortPath, false, "deep")
+	if err != nil {
+		return err
+	}
+	etag, err := submodelelements.SubmodelElementETag(element)
+	if err != nil {
+		return err
+	}
+	if !common.IfMatchSatisfied(ifMatch, etag) {
+		return common.NewErrPreconditionFailed("SMREPO-IFMATCH-MISMATCH Submodel element '" + idShortPath + "' has changed since the given entity tag was issued")
+	}
+	return nil
+}
+
 func (s *SubmodelDatabase) ensureSubmodelElementCanBeDeleted(ctx context.Context, tx *sql.Tx, submodelID string, idShortPath string) error {
 	exists, visible, err := s.checkSubmodelElementVisibilityInTx(ctx, tx, submodelID, idShortPath)
 	if err != nil {
@@ -682,8 +783,15 @@ func (s *SubmodelDatabase) UpdateSubmodelElementValueOnly(ctx context.Context, s
 }
 
 func (s *SubmodelDatabase) updateSubmodelElementValueOnly(tx *sql.Tx, submodelID string, idShortOrPath string, valueOnly gen.SubmodelElementValue) error {
+	if err := gen.ValidateValueOnlyReferences(valueOnly); err != nil {
+		return common.NewErrBadRequest("SMREPO-UPDSMEVALONLY-INVALIDREF " + idShortOrPath + ": " + err.Error())
+	}
+
 	modelType, err := submodelelements.GetModelTypeByIdShortPathAndSubmodelIDTx(tx, submodelID, idShortOrPath)
 	if err != nil {
+		if common.IsErrNotFound(err) {
+			return submodelelements.ListIndexNotFoundError(tx, submodelID, idShortOrPath, "SMREPO-UPDSMEVALONLY", err)
+		}
 		return err
 	}
 
@@ -729,6 +837,94 @@ func (s *SubmodelDatabase) UpdateSubmodelValueOnly(ctx context.Context, submodel
 	return tx.Commit()
 }
 
+// bulkValueSavepoint isolates the paths of a non-atomic bulk value update.
+const bulkValueSavepoint = "smrepo_bulk_value"
+
+// SubmodelElementValueFailure reports an idShortPath of a bulk value update that could not be applied.
+type SubmodelElementValueFailure struct {
+	IDShortPath string
+	Err         error
+}
+
+// UpdateSubmodelElementValues applies value-only updates to several submodel elements of one
+// submodel in a single transaction. Paths are applied in lexical order. In atomic mode the first
+// failing path rolls back all updates; otherwise every path runs under its own savepoint so that
+// failing paths are reported and all others are committed.
+func (s *SubmodelDatabase) UpdateSubmodelElementValues(ctx context.Context, submodelID string, values gen.SubmodelValue, atomic bool) (failures []SubmodelElementValueFailure, err error) {
+	tx, cleanup, err := common.StartTransaction(s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup(&err)
+
+	if _, err = persistenceutils.GetSubmodelDatabaseIDForUpdate(tx, submodelID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, common.NewErrNotFound("SMREPO-PATCHSMEVALUES-SMNOTFOUND Submodel with ID '" + submodelID + "' not found")
+		}
+		return nil, common.NewInternalServerError("SMREPO-PATCHSMEVALUES-LOCKSM " + err.Error())
+	}
+	previousSnapshot, err := s.loadSubmodelHistorySnapshotBeforeMutationTx(ctx, tx, submodelID)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(values))
+	for path := range values {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	mutations := make([]submodelElementRootMutation, 0, len(paths))
+	for _, path := range paths {
+		var pathErr error
+		if atomic {
+			pathErr = s.updateSubmodelElementValueOnly(tx, submodelID, path, values[path])
+		} else if pathErr, err = s.updateSubmodelElementValueOnlyInSavepoint(tx, submodelID, path, values[path]); err != nil {
+			return nil, err
+		}
+		if pathErr != nil {
+			failures = append(failures, SubmodelElementValueFailure{IDShortPath: path, Err: pathErr})
+			if atomic {
+				err = pathErr
+				return failures, err
+			}
+			continue
+		}
+		mutations = append(mutations, submodelElementRootMutation{
+			previousPath: path,
+			currentPath:  path,
+		})
+	}
+
+	if len(mutations) > 0 {
+		if err = s.appendChangedSubmodelElementHistoryTx(ctx, tx, submodelID, previousSnapshot, mutations...); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-PATCHSMEVALUES-COMMIT " + err.Error())
+	}
+	return failures, nil
+}
+
+// updateSubmodelElementValueOnlyInSavepoint returns the error of the path update separately from
+// errors of the savepoint handling, which abort the whole transaction.
+func (s *SubmodelDatabase) updateSubmodelElementValueOnlyInSavepoint(tx *sql.Tx, submodelID string, idShortPath string, valueOnly gen.SubmodelElementValue) (pathErr error, err error) {
+	if _, err = tx.Exec("SAVEPOINT " + bulkValueSavepoint); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-PATCHSMEVALUES-SAVEPOINT " + err.Error())
+	}
+	if pathErr = s.updateSubmodelElementValueOnly(tx, submodelID, idShortPath, valueOnly); pathErr != nil {
+		if _, err = tx.Exec("ROLLBACK TO SAVEPOINT " + bulkValueSavepoint); err != nil {
+			return nil, common.NewInternalServerError("SMREPO-PATCHSMEVALUES-ROLLBACKSAVEPOINT " + err.Error())
+		}
+		return pathErr, nil
+	}
+	if _, err = tx.Exec("RELEASE SAVEPOINT " + bulkValueSavepoint); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-PATCHSMEVALUES-RELEASESAVEPOINT " + err.Error())
+	}
+	return nil, nil
+}
+
 func (s *SubmodelDatabase) ensureVisibleSubmodelElementCreateDoesNotExist(
 	ctx context.Context,
 	tx *sql.Tx,
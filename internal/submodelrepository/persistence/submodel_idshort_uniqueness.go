@@ -0,0 +1,119 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+)
+
+// ensureUniqueIDShorts rejects submodels that contain the same idShort twice
+// within one namespace: the submodel root, a collection, an entity's statements,
+// a relationship's annotations or an operation's variables. Input, output and
+// inoutput variables share one namespace (AASd-134). Equal idShorts in different namespaces are
+// allowed. The check runs independently of the semantic verification mode
+// because duplicate paths cannot be stored.
+func ensureUniqueIDShorts(submodel types.ISubmodel, errorPrefix string) error {
+	duplicates := duplicateIDShortPaths(submodel)
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return common.NewErrBadRequest(errorPrefix + " Duplicate idShort within the same scope: " + strings.Join(duplicates, ", "))
+}
+
+// duplicateIDShortPaths returns the sorted idShortPaths that occur more than once.
+func duplicateIDShortPaths(submodel types.ISubmodel) []string {
+	if submodel == nil {
+		return nil
+	}
+	duplicates := map[string]struct{}{}
+	collectDuplicateIDShorts("", submodel.SubmodelElements(), duplicates)
+
+	paths := make([]string, 0, len(duplicates))
+	for path := range duplicates {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func collectDuplicateIDShorts(parentPath string, elements []types.ISubmodelElement, duplicates map[string]struct{}) {
+	seen := make(map[string]struct{}, len(elements))
+	for _, element := range elements {
+		if element == nil || element.IDShort() == nil {
+			continue
+		}
+		path := joinIDShortPath(parentPath, *element.IDShort())
+		if _, exists := seen[*element.IDShort()]; exists {
+			duplicates[path] = struct{}{}
+		}
+		seen[*element.IDShort()] = struct{}{}
+		collectNestedDuplicateIDShorts(path, element, duplicates)
+	}
+}
+
+func collectNestedDuplicateIDShorts(path string, element types.ISubmodelElement, duplicates map[string]struct{}) {
+	switch typed := element.(type) {
+	case types.ISubmodelElementCollection:
+		collectDuplicateIDShorts(path, typed.Value(), duplicates)
+	case types.IEntity:
+		collectDuplicateIDShorts(path, typed.Statements(), duplicates)
+	case types.IAnnotatedRelationshipElement:
+		annotations := make([]types.ISubmodelElement, 0, len(typed.Annotations()))
+		for _, annotation := range typed.Annotations() {
+			annotations = append(annotations, annotation)
+		}
+		collectDuplicateIDShorts(path, annotations, duplicates)
+	case types.IOperation:
+		var values []types.ISubmodelElement
+		for _, variables := range [][]types.IOperationVariable{typed.InputVariables(), typed.OutputVariables(), typed.InoutputVariables()} {
+			for _, variable := range variables {
+				if variable != nil {
+					values = append(values, variable.Value())
+				}
+			}
+		}
+		collectDuplicateIDShorts(path, values, duplicates)
+	case types.ISubmodelElementList:
+		// List items are addressed by index, so each item only opens its own scope.
+		for index, item := range typed.Value() {
+			if item != nil {
+				collectNestedDuplicateIDShorts(path+"["+strconv.Itoa(index)+"]", item, duplicates)
+			}
+		}
+	}
+}
+
+func joinIDShortPath(parentPath string, idShort string) string {
+	if parentPath == "" {
+		return idShort
+	}
+	return parentPath + "." + idShort
+}
@@ -0,0 +1,148 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func idShortProperty(idShort string) types.ISubmodelElement {
+	property := types.NewProperty(types.DataTypeDefXSDString)
+	property.SetIDShort(&idShort)
+	return property
+}
+
+func idShortCollection(idShort string, children ...types.ISubmodelElement) types.ISubmodelElement {
+	collection := types.NewSubmodelElementCollection()
+	collection.SetIDShort(&idShort)
+	collection.SetValue(children)
+	return collection
+}
+
+func idShortOperation(idShort string, input []types.ISubmodelElement, output []types.ISubmodelElement, inoutput []types.ISubmodelElement) types.ISubmodelElement {
+	toVariables := func(values []types.ISubmodelElement) []types.IOperationVariable {
+		variables := make([]types.IOperationVariable, 0, len(values))
+		for _, value := range values {
+			variables = append(variables, types.NewOperationVariable(value))
+		}
+		return variables
+	}
+	operation := types.NewOperation()
+	operation.SetIDShort(&idShort)
+	operation.SetInputVariables(toVariables(input))
+	operation.SetOutputVariables(toVariables(output))
+	operation.SetInoutputVariables(toVariables(inoutput))
+	return operation
+}
+
+func TestCreateSubmodelRejectsDuplicateIDShortsPerScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		elements []types.ISubmodelElement
+		wantPath string
+	}{
+		{
+			name:     "root level",
+			elements: []types.ISubmodelElement{idShortProperty("temperature"), idShortProperty("temperature")},
+			wantPath: "temperature",
+		},
+		{
+			name: "within collection",
+			elements: []types.ISubmodelElement{
+				idShortCollection("nameplate", idShortProperty("serial"), idShortProperty("serial")),
+			},
+			wantPath: "nameplate.serial",
+		},
+		{
+			name: "within operation input variables",
+			elements: []types.ISubmodelElement{
+				idShortOperation("start", []types.ISubmodelElement{idShortProperty("speed"), idShortProperty("speed")}, nil, nil),
+			},
+			wantPath: "start.speed",
+		},
+		{
+			name: "across operation variable kinds",
+			elements: []types.ISubmodelElement{
+				idShortOperation("start", []types.ISubmodelElement{idShortProperty("speed")}, nil, []types.ISubmodelElement{idShortProperty("speed")}),
+			},
+			wantPath: "start.speed",
+		},
+		{
+			name: "within operation variable collection",
+			elements: []types.ISubmodelElement{
+				idShortOperation("start", nil, []types.ISubmodelElement{idShortCollection("result", idShortProperty("code"), idShortProperty("code"))}, nil),
+			},
+			wantPath: "start.result.code",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			sut := &SubmodelDatabase{db: db, verificationMode: gen.VerificationModeOff}
+			submodel := types.NewSubmodel("urn:example:sm:duplicate-idshort")
+			submodel.SetSubmodelElements(tt.elements)
+
+			err = sut.CreateSubmodel(context.Background(), submodel)
+			require.Error(t, err)
+			require.True(t, common.IsErrBadRequest(err))
+			require.Contains(t, err.Error(), "SMREPO-NEWSM-DUPIDSHORT")
+			require.Contains(t, err.Error(), tt.wantPath)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDuplicateIDShortPathsAllowsSameIDShortInDifferentCollections(t *testing.T) {
+	t.Parallel()
+
+	submodel := types.NewSubmodel("urn:example:sm:distinct-scopes")
+	submodel.SetSubmodelElements([]types.ISubmodelElement{
+		idShortProperty("serial"),
+		idShortCollection("left", idShortProperty("serial")),
+		idShortCollection("right", idShortProperty("serial"), idShortCollection("left", idShortProperty("serial"))),
+		idShortOperation("run", []types.ISubmodelElement{idShortProperty("serial")}, nil, nil),
+	})
+
+	require.Empty(t, duplicateIDShortPaths(submodel))
+	require.NoError(t, ensureUniqueIDShorts(submodel, "SMREPO-NEWSM-DUPIDSHORT"))
+}
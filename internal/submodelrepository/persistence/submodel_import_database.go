@@ -0,0 +1,156 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelelements "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/submodelElements"
+)
+
+// importSavepoint isolates the submodels of a non-atomic import.
+const importSavepoint = "smrepo_import"
+
+// SubmodelImportItem is one submodel of an import together with the
+// attachments of its File elements.
+type SubmodelImportItem struct {
+	Submodel    types.ISubmodel
+	Attachments []SubmodelImportAttachment
+}
+
+// SubmodelImportAttachment is the content of one File element. Open is called
+// once, inside the import transaction, after the submodel has been created.
+type SubmodelImportAttachment struct {
+	IDShortPath string
+	FileName    string
+	Open        func() (io.ReadCloser, error)
+}
+
+// SubmodelImportFailure reports an item of an import that could not be stored.
+type SubmodelImportFailure struct {
+	Index      int
+	SubmodelID string
+	Err        error
+}
+
+// ImportSubmodels creates several submodels and their attachments in a single transaction. Items
+// are stored in order. In atomic mode the first failing item rolls back the whole import;
+// otherwise every item runs under its own savepoint so that failing items, for example
+// conflicting identifiers, are reported and all others are committed.
+func (s *SubmodelDatabase) ImportSubmodels(ctx context.Context, items []SubmodelImportItem, atomic bool) (failures []SubmodelImportFailure, err error) {
+	fileHandler, err := submodelelements.NewPostgreSQLFileHandler(s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, cleanup, err := common.StartTransaction(s.db)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-IMPORTSMS-STARTTX " + err.Error())
+	}
+	defer cleanup(&err)
+
+	for index, item := range items {
+		var itemErr error
+		if atomic {
+			itemErr = s.importSubmodelTx(ctx, tx, fileHandler, item)
+		} else if itemErr, err = s.importSubmodelInSavepoint(ctx, tx, fileHandler, item); err != nil {
+			return nil, err
+		}
+		if itemErr != nil {
+			failures = append(failures, SubmodelImportFailure{Index: index, SubmodelID: item.Submodel.ID(), Err: itemErr})
+			if atomic {
+				err = itemErr
+				return failures, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-IMPORTSMS-COMMIT " + err.Error())
+	}
+	return failures, nil
+}
+
+// importSubmodelInSavepoint returns the error of the item separately from
+// errors of the savepoint handling, which abort the whole transaction.
+func (s *SubmodelDatabase) importSubmodelInSavepoint(ctx context.Context, tx *sql.Tx, fileHandler *submodelelements.PostgreSQLFileHandler, item SubmodelImportItem) (itemErr error, err error) {
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT "+importSavepoint); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-IMPORTSMS-SAVEPOINT " + err.Error())
+	}
+	if itemErr = s.importSubmodelTx(ctx, tx, fileHandler, item); itemErr != nil {
+		if _, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+importSavepoint); err != nil {
+			return nil, common.NewInternalServerError("SMREPO-IMPORTSMS-ROLLBACKSAVEPOINT " + err.Error())
+		}
+		return itemErr, nil
+	}
+	if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+importSavepoint); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-IMPORTSMS-RELEASESAVEPOINT " + err.Error())
+	}
+	return nil, nil
+}
+
+func (s *SubmodelDatabase) importSubmodelTx(ctx context.Context, tx *sql.Tx, fileHandler *submodelelements.PostgreSQLFileHandler, item SubmodelImportItem) error {
+	if item.Submodel == nil {
+		return common.NewErrBadRequest("SMREPO-IMPORTSMS-NILSM submodel must not be nil")
+	}
+	if err := s.CreateSubmodelInTransaction(ctx, tx, item.Submodel); err != nil {
+		return err
+	}
+
+	for _, attachment := range item.Attachments {
+		if err := s.importAttachmentTx(ctx, tx, fileHandler, item.Submodel.ID(), attachment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SubmodelDatabase) importAttachmentTx(ctx context.Context, tx *sql.Tx, fileHandler *submodelelements.PostgreSQLFileHandler, submodelID string, attachment SubmodelImportAttachment) error {
+	if attachment.Open == nil {
+		return common.NewErrBadRequest("SMREPO-IMPORTSMS-NOATTACHMENT attachment content is required for " + attachment.IDShortPath)
+	}
+	content, err := attachment.Open()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	previousSnapshot, err := s.loadSubmodelHistorySnapshotBeforeMutationTx(ctx, tx, submodelID)
+	if err != nil {
+		return err
+	}
+	reference, contentType, err := fileHandler.UploadManagedFileAttachmentReaderTx(ctx, tx, submodelID, attachment.IDShortPath, content, attachment.FileName)
+	if err != nil {
+		return err
+	}
+	return s.recordFileUploadMutationTx(ctx, tx, submodelID, attachment.IDShortPath, previousSnapshot, reference, contentType)
+}
@@ -0,0 +1,96 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	gen "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubmodelElementValueOnlyPatchesOnlyIndexedListElement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "model_type" FROM "submodel_element" WHERE .*"idshort_path" = 'myList\[2\]'`).
+		WillReturnRows(sqlmock.NewRows([]string{"model_type"}).AddRow(types.ModelTypeProperty))
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "id" FROM "submodel_element" WHERE .*"idshort_path" = 'myList\[2\]'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(302))
+	mock.ExpectQuery(`SELECT "value_type" FROM "property_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"value_type"}).AddRow(types.DataTypeDefXSDString))
+	// The only write targets the property row of index 2; siblings and the
+	// positions stored on submodel_element are not touched.
+	mock.ExpectExec(`UPDATE "property_element" SET .*"value_text"='third'.* WHERE \("id" = 302\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectMutatedSubmodelHistoryFallback(mock)
+	expectCurrentSubmodelSnapshotLoad(mock, "sm", "sm")
+	mock.ExpectCommit()
+
+	err = sut.UpdateSubmodelElementValueOnly(contextWithABACDisabled(t), "sm", "myList[2]", gen.PropertyValue{Value: "third"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSubmodelElementValueOnlyRejectsOutOfRangeListIndex(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "model_type" FROM "submodel_element"`).
+		WillReturnRows(sqlmock.NewRows([]string{"model_type"}))
+	mock.ExpectQuery(`SELECT "id" FROM "submodel"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+	mock.ExpectQuery(`SELECT "list"."model_type", COUNT\("child"."id"\) FROM "submodel_element" AS "list" LEFT JOIN "submodel_element" AS "child" .*"list"."idshort_path" = 'myList'`).
+		WillReturnRows(sqlmock.NewRows([]string{"model_type", "count"}).AddRow(types.ModelTypeSubmodelElementList, 5))
+	mock.ExpectRollback()
+
+	err = sut.UpdateSubmodelElementValueOnly(contextWithABACDisabled(t), "sm", "myList[7]", gen.PropertyValue{Value: "eighth"})
+	require.Error(t, err)
+	require.True(t, common.IsErrNotFound(err))
+	require.Contains(t, err.Error(), "SMREPO-UPDSMEVALONLY-INDEXOUTOFRANGE index 7 is out of range for SubmodelElementList 'myList' with 5 elements")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,88 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	submodelelements "github.com/eclipse-basyx/basyx-go-components/internal/submodelrepository/persistence/submodelElements"
+)
+
+// ValidateListIndices reports the SubmodelElementLists of a Submodel whose
+// stored child positions or idShort paths are inconsistent. An empty result
+// means every list of the Submodel is intact.
+func (s *SubmodelDatabase) ValidateListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-VALIDATELISTIDX-STARTTX " + err.Error())
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	violations, err := submodelelements.ValidateListIndices(ctx, tx, submodelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-VALIDATELISTIDX-COMMIT " + err.Error())
+	}
+	committed = true
+	return violations, nil
+}
+
+// RepairListIndices renumbers the children of every inconsistent
+// SubmodelElementList of a Submodel to contiguous positions and returns the
+// violations that were repaired. Only the storage bookkeeping changes; element
+// order and values are preserved, so no history entry is written.
+func (s *SubmodelDatabase) RepairListIndices(ctx context.Context, submodelID string) ([]submodelelements.ListIndexViolation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, common.NewInternalServerError("SMREPO-REPAIRLISTIDX-STARTTX " + err.Error())
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	repaired, err := submodelelements.RepairListIndices(ctx, tx, submodelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, common.NewInternalServerError("SMREPO-REPAIRLISTIDX-COMMIT " + err.Error())
+	}
+	committed = true
+	return repaired, nil
+}
@@ -0,0 +1,127 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+// Author: Jannik Fried (Fraunhofer IESE)
+
+package persistence
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreviousSubmodelCursorReturnsFirstItemOfPreviousPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		order     SubmodelListOrder
+		wantQuery string
+	}{
+		{
+			name:      "identifier order",
+			order:     SubmodelListOrderID,
+			wantQuery: `("submodel"."submodel_identifier" < 'urn:sm:05') ORDER BY "submodel"."submodel_identifier" DESC LIMIT 2`,
+		},
+		{
+			name:      "creation order",
+			order:     SubmodelListOrderCreatedAt,
+			wantQuery: `("submodel"."db_created_at", "submodel"."id") < (SELECT "s2"."db_created_at", "s2"."id" FROM "submodel" AS "s2" WHERE ("s2"."submodel_identifier" = 'urn:sm:05')) ORDER BY "submodel"."db_created_at" DESC, "submodel"."id" DESC LIMIT 2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			sut := &SubmodelDatabase{db: db}
+
+			mock.ExpectQuery(`SELECT "submodel"."submodel_identifier" FROM "submodel" .*` + regexp.QuoteMeta(tt.wantQuery)).
+				WillReturnRows(sqlmock.NewRows([]string{"submodel_identifier"}).AddRow("urn:sm:04").AddRow("urn:sm:03"))
+
+			previous, err := sut.GetPreviousSubmodelCursor(contextWithABACDisabled(t), 2, "urn:sm:05", "", "", nil, nil, tt.order, time.Time{}, time.Time{})
+			require.NoError(t, err)
+			require.Equal(t, "urn:sm:03", previous)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetPreviousSubmodelCursorOnFirstPage(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+
+	previous, err := sut.GetPreviousSubmodelCursor(contextWithABACDisabled(t), 2, "", "", "", nil, nil, SubmodelListOrderID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Empty(t, previous)
+
+	mock.ExpectQuery(`"submodel"\."submodel_identifier" < 'urn:sm:01'`).
+		WillReturnRows(sqlmock.NewRows([]string{"submodel_identifier"}))
+
+	previous, err = sut.GetPreviousSubmodelCursor(contextWithABACDisabled(t), 2, "urn:sm:01", "", "", nil, nil, SubmodelListOrderID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Empty(t, previous)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPreviousSubmodelCursorUsesConfiguredDefaultPageLimit(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sut := &SubmodelDatabase{db: db}
+	ctx := common.ContextWithConfig(contextWithABACDisabled(t), &common.Config{
+		General: common.GeneralConfig{DefaultPageLimit: 25, DefaultSubmodelPageLimit: 7},
+	})
+
+	mock.ExpectQuery(regexp.QuoteMeta(`("submodel"."submodel_identifier" < 'urn:sm:09') ORDER BY "submodel"."submodel_identifier" DESC LIMIT 7`)).
+		WillReturnRows(sqlmock.NewRows([]string{"submodel_identifier"}).AddRow("urn:sm:08"))
+
+	previous, err := sut.GetPreviousSubmodelCursor(ctx, 0, "urn:sm:09", "", "", nil, nil, SubmodelListOrderID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "urn:sm:08", previous)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
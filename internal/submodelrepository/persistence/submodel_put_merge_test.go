@@ -0,0 +1,53 @@
+/*******************************************************************************
+* Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+*
+* Permission is hereby granted, free of charge, to any person obtaining
+* a copy of this software and associated documentation files (the
+* "Software"), to deal in the Software without restriction, including
+* without limitation the rights to use, copy, modify, merge, publish,
+* distribute, sublicense, and/or sell copies of the Software, and to
+* permit persons to whom the Software is furnished to do so, subject to
+* the following conditions:
+*
+* The above copyright notice and this permission notice shall be
+* included in all copies or substantial portions of the Software.
+*
+* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+* NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+* LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+* OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+* WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*
+* SPDX-License-Identifier: MIT
+******************************************************************************/
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeSubmodelElementsForPutKeepsUnsentElements(t *testing.T) {
+	t.Parallel()
+
+	requested := []types.ISubmodelElement{idShortProperty("voltage"), idShortProperty("current")}
+	stored := []types.ISubmodelElement{
+		idShortProperty("serial"),
+		idShortCollection("voltage", idShortProperty("min")),
+		idShortProperty("firmware"),
+	}
+
+	merged := mergeSubmodelElementsForPut(requested, stored)
+
+	idShorts := make([]string, 0, len(merged))
+	for _, element := range merged {
+		idShorts = append(idShorts, *element.IDShort())
+	}
+	require.Equal(t, []string{"voltage", "current", "serial", "firmware"}, idShorts)
+	require.Same(t, requested[0], merged[0], "requested elements replace stored ones with the same idShort")
+}
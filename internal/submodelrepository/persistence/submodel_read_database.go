@@ -96,17 +96,122 @@ func (s *SubmodelDatabase) GetSubmodelByID(ctx context.Context, submodelIdentifi
 
 // GetSubmodels retrieves submodels and applies optional ABAC formula filters from ctx.
 func (s *SubmodelDatabase) GetSubmodels(ctx context.Context, limit int32, cursor string, submodelIdentifier string, semanticID string, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error) {
-	return s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, submodelIdentifier, "", semanticID, createdFrom, updatedFrom)
+	return s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, submodelIdentifier, "", semanticID, nil, nil, SubmodelListOrderID, createdFrom, updatedFrom)
 }
 
+// SubmodelListOrder selects the sort key of submodel listings.
+type SubmodelListOrder string
+
+const (
+	// SubmodelListOrderID sorts by Submodel identifier. It is the default.
+	SubmodelListOrderID SubmodelListOrder = "id"
+	// SubmodelListOrderCreatedAt sorts by the time a Submodel was first stored.
+	SubmodelListOrderCreatedAt SubmodelListOrder = "createdAt"
+)
+
 // GetSubmodelsByListFilters retrieves submodels using public list filters.
-func (s *SubmodelDatabase) GetSubmodelsByListFilters(ctx context.Context, limit int32, cursor string, idShort string, semanticID string, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error) {
-	return s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, "", idShort, semanticID, createdFrom, updatedFrom)
+// A nil kind disables the modelling kind filter and a nil hasElements
+// disables the element presence filter. Cursors stay Submodel identifiers
+// for every order.
+func (s *SubmodelDatabase) GetSubmodelsByListFilters(ctx context.Context, limit int32, cursor string, idShort string, semanticID string, kind *types.ModellingKind, hasElements *bool, order SubmodelListOrder, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error) {
+	return s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, "", idShort, semanticID, kind, hasElements, order, createdFrom, updatedFrom)
+}
+
+// CountSubmodelsByListFilters counts the submodels GetSubmodelsByListFilters
+// would return across all pages. It honors the same filters and the ABAC
+// formula from ctx.
+func (s *SubmodelDatabase) CountSubmodelsByListFilters(ctx context.Context, idShort string, semanticID string, kind *types.ModellingKind, hasElements *bool, createdFrom time.Time, updatedFrom time.Time) (int64, error) {
+	selectDS, err := selectSubmodelsByListFilters(ctx, "SMREPO-COUNTSMS", idShort, semanticID, kind, hasElements, createdFrom, updatedFrom)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err := submodelqueries.BuildSubmodelCountSQL(selectDS)
+	if err != nil {
+		return 0, common.NewInternalServerError("SMREPO-COUNTSMS-BUILDSQL " + err.Error())
+	}
+	common.LogQuery(ctx, query, args)
+	var count int64
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, common.NewInternalServerError("SMREPO-COUNTSMS-EXECSQL " + err.Error())
+	}
+	return count, nil
+}
+
+// GetPreviousSubmodelCursor returns the cursor of the page that precedes the
+// page starting at cursor in a GetSubmodelsByListFilters listing with the
+// same filters and order. It is empty when no submodel precedes cursor.
+func (s *SubmodelDatabase) GetPreviousSubmodelCursor(ctx context.Context, limit int32, cursor string, idShort string, semanticID string, kind *types.ModellingKind, hasElements *bool, order SubmodelListOrder, createdFrom time.Time, updatedFrom time.Time) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	limit, err := common.ResolvePageLimitFor(ctx, common.PageLimitScopeSubmodels, limit)
+	if err != nil {
+		return "", err
+	}
+	selectDS, err := selectSubmodelsByListFilters(ctx, "SMREPO-PREVSMCURSOR", idShort, semanticID, kind, hasElements, createdFrom, updatedFrom)
+	if err != nil {
+		return "", err
+	}
+	query, args, err := submodelqueries.SelectPreviousSubmodelPage(selectDS, cursor, limit, order == SubmodelListOrderCreatedAt).ToSQL()
+	if err != nil {
+		return "", common.NewInternalServerError("SMREPO-PREVSMCURSOR-BUILDSQL " + err.Error())
+	}
+
+	common.LogQuery(ctx, query, args)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", common.NewInternalServerError("SMREPO-PREVSMCURSOR-EXECSQL " + err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	previousCursor := ""
+	for rows.Next() {
+		if err := rows.Scan(&previousCursor); err != nil {
+			return "", common.NewInternalServerError("SMREPO-PREVSMCURSOR-SCAN " + err.Error())
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", common.NewInternalServerError("SMREPO-PREVSMCURSOR-ROWS " + err.Error())
+	}
+	return previousCursor, nil
+}
+
+// selectSubmodelsByListFilters builds the unpaged submodel dataset of a
+// listing with its public filters and the ABAC formula from ctx. code
+// prefixes the error codes of the calling operation.
+func selectSubmodelsByListFilters(ctx context.Context, code string, idShort string, semanticID string, kind *types.ModellingKind, hasElements *bool, createdFrom time.Time, updatedFrom time.Time) (*goqu.SelectDataset, error) {
+	var idShortFilter *string
+	if idShort != "" {
+		idShortFilter = &idShort
+	}
+	selectDS, err := submodelqueries.SelectSubmodelDataset(nil, idShortFilter, nil, nil, createdFrom, updatedFrom, nil)
+	if err != nil {
+		return nil, err
+	}
+	selectDS = submodelqueries.ApplySubmodelSemanticIDFilter(selectDS, semanticID)
+	selectDS = submodelqueries.ApplySubmodelKindFilter(selectDS, kind)
+	selectDS = submodelqueries.ApplySubmodelHasElementsFilter(selectDS, hasElements)
+
+	queryFilter := auth.GetQueryFilter(ctx)
+	if queryFilter != nil && queryFilter.Formula != nil {
+		collector, collectorErr := grammar.NewResolvedFieldPathCollectorForRoot(grammar.CollectorRootSM)
+		if collectorErr != nil {
+			return nil, common.NewInternalServerError(code + "-BADCOLLECTOR " + collectorErr.Error())
+		}
+		collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
+		selectDS, err = auth.AddFormulaQueryFromContext(ctx, selectDS, collector)
+		if err != nil {
+			return nil, common.NewInternalServerError(code + "-ABACFORMULA " + err.Error())
+		}
+	}
+	return selectDS, nil
 }
 
 // GetSubmodelReferences retrieves references and applies optional ABAC formula filters from ctx.
-func (s *SubmodelDatabase) GetSubmodelReferences(ctx context.Context, limit int32, cursor string, idShort string, semanticID string) ([]types.IReference, string, error) {
-	submodels, nextCursor, err := s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, "", idShort, semanticID, time.Time{}, time.Time{})
+func (s *SubmodelDatabase) GetSubmodelReferences(ctx context.Context, limit int32, cursor string, idShort string, semanticID string, kind *types.ModellingKind) ([]types.IReference, string, error) {
+	submodels, nextCursor, err := s.getSubmodelsWithOptionalFilters(ctx, limit, cursor, "", idShort, semanticID, kind, nil, SubmodelListOrderID, time.Time{}, time.Time{})
 	if err != nil {
 		return nil, "", err
 	}
@@ -187,6 +292,7 @@ func (s *SubmodelDatabase) getSubmodelMetadataByIDInTransaction(ctx context.Cont
 		if collectorErr != nil {
 			return nil, common.NewInternalServerError("SMREPO-GETSMBYIDTX-BADCOLLECTOR " + collectorErr.Error())
 		}
+		collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 		selectDS, err = auth.AddFormulaQueryFromContext(ctx, selectDS, collector)
 		if err != nil {
 			return nil, common.NewInternalServerError("SMREPO-GETSMBYIDTX-ABACFORMULA " + err.Error())
@@ -198,6 +304,7 @@ func (s *SubmodelDatabase) getSubmodelMetadataByIDInTransaction(ctx context.Cont
 		return nil, common.NewInternalServerError("SMREPO-GETSMBYIDTX-BUILDSQL " + err.Error())
 	}
 
+	common.LogQuery(ctx, query, args)
 	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, common.NewInternalServerError("SMREPO-GETSMBYIDTX-EXECSQL " + err.Error())
@@ -255,7 +362,7 @@ func (s *SubmodelDatabase) QuerySubmodels(ctx context.Context, limit int32, curs
 }
 
 //nolint:revive // cyclomatic complexity is acceptable for this function due to query/filter orchestration in one flow
-func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context, limit int32, cursor string, submodelIdentifier string, idShort string, semanticID string, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error) {
+func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context, limit int32, cursor string, submodelIdentifier string, idShort string, semanticID string, kindFilter *types.ModellingKind, hasElementsFilter *bool, order SubmodelListOrder, createdFrom time.Time, updatedFrom time.Time) ([]types.ISubmodel, string, error) {
 	var limitFilter *int32
 
 	if limit == 0 {
@@ -290,6 +397,7 @@ func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context,
 	if collectorErr != nil {
 		return nil, "", common.NewInternalServerError("SMREPO-GETSMS-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	const dataAlias = "submodel_list_data"
 	maskedColumns := []auth.MaskedInnerColumnSpec{
@@ -310,11 +418,21 @@ func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context,
 	if filterSupplementalSemanticIDs {
 		additionalProjections = append(additionalProjections, goqu.I("submodel.id").As("supplemental_owner_id"))
 	}
-	selectDS, err := submodelqueries.SelectSubmodelDataset(submodelIdentifierFilter, idShortFilter, limitFilter, cursorFilter, createdFrom, updatedFrom, additionalProjections)
+	orderByCreation := order == SubmodelListOrderCreatedAt
+	identifierCursorFilter := cursorFilter
+	if orderByCreation {
+		identifierCursorFilter = nil
+	}
+	selectDS, err := submodelqueries.SelectSubmodelDataset(submodelIdentifierFilter, idShortFilter, limitFilter, identifierCursorFilter, createdFrom, updatedFrom, additionalProjections)
 	if err != nil {
 		return nil, "", err
 	}
+	if orderByCreation {
+		selectDS = submodelqueries.ApplySubmodelCreationOrder(selectDS, cursorFilter)
+	}
 	selectDS = submodelqueries.ApplySubmodelSemanticIDFilter(selectDS, semanticID)
+	selectDS = submodelqueries.ApplySubmodelKindFilter(selectDS, kindFilter)
+	selectDS = submodelqueries.ApplySubmodelHasElementsFilter(selectDS, hasElementsFilter)
 
 	queryFilter := auth.GetQueryFilter(ctx)
 	hasFormulaInContext := queryFilter != nil && queryFilter.Formula != nil
@@ -323,6 +441,7 @@ func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context,
 		if collectorErr != nil {
 			return nil, "", common.NewInternalServerError("SMREPO-GETSMS-BADCOLLECTOR " + collectorErr.Error())
 		}
+		collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 		selectDS, err = auth.AddFormulaQueryFromContext(ctx, selectDS, collector)
 		if err != nil {
 			return nil, "", common.NewInternalServerError("SMREPO-GETSMS-ABACFORMULA " + err.Error())
@@ -333,6 +452,7 @@ func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context,
 		dataAlias,
 		maskedExpressions,
 		filterSupplementalSemanticIDs,
+		orderByCreation,
 	)
 	if err != nil {
 		return nil, "", common.NewInternalServerError("SMREPO-GETSMS-BUILDSQL " + err.Error())
@@ -341,6 +461,8 @@ func (s *SubmodelDatabase) getSubmodelsWithOptionalFilters(ctx context.Context,
 	var identifier, rawIDShort, category, descriptionJsonString, displayNameJsonString, administrativeInformationJsonString, embeddedDataSpecificationJsonString, supplementalSemanticIDsJsonString, extensionsJsonString, qualifiersJsonString, semanticIDJSONString sql.NullString
 	var kind sql.NullInt64
 
+	common.LogQuery(ctx, query, args)
+	common.ExplainQuery(ctx, s.db, query, args)
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, "", err
@@ -462,6 +584,7 @@ func (s *SubmodelDatabase) submodelCursorExists(ctx context.Context, cursor stri
 	}
 
 	var one int
+	common.LogQuery(ctx, query, args)
 	if queryErr := s.db.QueryRowContext(ctx, query, args...).Scan(&one); queryErr != nil {
 		if errors.Is(queryErr, sql.ErrNoRows) {
 			return false, nil
@@ -471,6 +594,77 @@ func (s *SubmodelDatabase) submodelCursorExists(ctx context.Context, cursor stri
 	return true, nil
 }
 
+// CountSubmodelElementChildren returns the number of direct children for each
+// requested idShortPath of a submodel using a single COUNT query. Element-level
+// ABAC rules may hide children, so no counts are returned when ABAC formulas are
+// enforced for the request; callers then omit the count instead of exposing
+// the number of hidden elements.
+func (s *SubmodelDatabase) CountSubmodelElementChildren(ctx context.Context, submodelID string, idShortPaths []string) (map[string]int, error) {
+	if len(idShortPaths) == 0 {
+		return map[string]int{}, nil
+	}
+	shouldEnforce, enforceErr := shouldEnforceFormula(ctx, "SMREPO-COUNTSMECHILDREN-SHOULDENFORCE")
+	if enforceErr != nil {
+		return nil, enforceErr
+	}
+	if shouldEnforce {
+		return nil, nil
+	}
+
+	query, args, buildErr := submodelqueries.BuildSubmodelElementChildCountSQL(submodelID, idShortPaths)
+	if buildErr != nil {
+		return nil, common.NewInternalServerError("SMREPO-COUNTSMECHILDREN-BUILDSQL " + buildErr.Error())
+	}
+
+	common.LogQuery(ctx, query, args)
+	rows, queryErr := s.db.QueryContext(ctx, query, args...)
+	if queryErr != nil {
+		return nil, common.NewInternalServerError("SMREPO-COUNTSMECHILDREN-EXECSQL " + queryErr.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	counts := make(map[string]int, len(idShortPaths))
+	for rows.Next() {
+		var path string
+		var count int
+		if scanErr := rows.Scan(&path, &count); scanErr != nil {
+			return nil, common.NewInternalServerError("SMREPO-COUNTSMECHILDREN-SCAN " + scanErr.Error())
+		}
+		counts[path] = count
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, common.NewInternalServerError("SMREPO-COUNTSMECHILDREN-ROWS " + rowsErr.Error())
+	}
+	return counts, nil
+}
+
+// GetSubmodelElementMaxDepth returns the nesting depth of the deepest element
+// of a submodel, where top-level elements have depth 1. Like child counts, the
+// depth could reveal hidden elements, so ok is false when ABAC formulas are
+// enforced for the request.
+func (s *SubmodelDatabase) GetSubmodelElementMaxDepth(ctx context.Context, submodelID string) (depth int, ok bool, err error) {
+	shouldEnforce, enforceErr := shouldEnforceFormula(ctx, "SMREPO-SMEMAXDEPTH-SHOULDENFORCE")
+	if enforceErr != nil {
+		return 0, false, enforceErr
+	}
+	if shouldEnforce {
+		return 0, false, nil
+	}
+
+	query, args, buildErr := submodelqueries.BuildSubmodelElementMaxDepthSQL(submodelID)
+	if buildErr != nil {
+		return 0, false, common.NewInternalServerError("SMREPO-SMEMAXDEPTH-BUILDSQL " + buildErr.Error())
+	}
+
+	common.LogQuery(ctx, query, args)
+	if queryErr := s.db.QueryRowContext(ctx, query, args...).Scan(&depth); queryErr != nil {
+		return 0, false, common.NewInternalServerError("SMREPO-SMEMAXDEPTH-EXECSQL " + queryErr.Error())
+	}
+	return depth, true, nil
+}
+
 func buildSubmodelModelReference(submodelIdentifier string) (types.IReference, error) {
 	if submodelIdentifier == "" {
 		return nil, common.NewErrBadRequest("SMREPO-BUILDSMREF-INVALIDIDENTIFIER submodel identifier is required")
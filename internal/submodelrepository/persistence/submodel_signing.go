@@ -58,7 +58,7 @@ import (
 //   - error: Error when signing is not configured, the Submodel cannot be
 //     loaded, JSON conversion or canonicalization fails, or JWS signing fails.
 func (s *SubmodelDatabase) GetSignedSubmodel(ctx context.Context, submodelID string) (string, error) {
-	if s.privateKey == nil {
+	if s.privateKey == nil && s.keySet == nil {
 		return "", errors.New("JWS signing not configured: private key not loaded")
 	}
 
@@ -74,7 +74,7 @@ func (s *SubmodelDatabase) GetSignedSubmodel(ctx context.Context, submodelID str
 		return "", err
 	}
 
-	return jws.SignPayloadWithOptions(s.privateKey, payload, s.signingOptions)
+	return s.signPayload(payload)
 }
 
 // GetSignedSubmodelValueOnly returns a compact JWS for the requested Submodel
@@ -99,7 +99,7 @@ func (s *SubmodelDatabase) GetSignedSubmodel(ctx context.Context, submodelID str
 //     loaded, value-only conversion or canonicalization fails, or JWS signing
 //     fails.
 func (s *SubmodelDatabase) GetSignedSubmodelValueOnly(ctx context.Context, submodelID string) (string, error) {
-	if s.privateKey == nil {
+	if s.privateKey == nil && s.keySet == nil {
 		return "", errors.New("JWS signing not configured: private key not loaded")
 	}
 
@@ -113,6 +113,13 @@ func (s *SubmodelDatabase) GetSignedSubmodelValueOnly(ctx context.Context, submo
 		return "", err
 	}
 
+	return s.signPayload(payload)
+}
+
+func (s *SubmodelDatabase) signPayload(payload []byte) (string, error) {
+	if s.keySet != nil {
+		return s.keySet.Sign(payload, s.signingOptions)
+	}
 	return jws.SignPayloadWithOptions(s.privateKey, payload, s.signingOptions)
 }
 
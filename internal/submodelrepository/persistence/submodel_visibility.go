@@ -61,6 +61,7 @@ func (s *SubmodelDatabase) checkSubmodelVisibilityInTx(ctx context.Context, tx *
 	if collectorErr != nil {
 		return false, false, common.NewInternalServerError("SMREPO-ABACCHKSM-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	query, addFormulaErr := auth.AddFormulaQueryFromContext(ctx, query, collector)
 	if addFormulaErr != nil {
@@ -121,6 +122,7 @@ func (s *SubmodelDatabase) checkSubmodelElementVisibilityInTx(ctx context.Contex
 	if collectorErr != nil {
 		return false, false, common.NewInternalServerError("SMREPO-ABACCHKSME-BADCOLLECTOR " + collectorErr.Error())
 	}
+	collector.SetMaxFieldPathDepth(common.MaxFieldPathDepthFromContext(ctx))
 
 	filteredQuery, addFormulaErr := auth.AddFormulaQueryFromContext(ctx, baseQuery, collector)
 	if addFormulaErr != nil {
@@ -31,6 +31,7 @@ import (
 	"database/sql"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/FriedJannik/aas-go-sdk/types"
 	"github.com/FriedJannik/aas-go-sdk/verification"
@@ -51,6 +52,9 @@ func (s *SubmodelDatabase) CreateSubmodel(ctx context.Context, submodel types.IS
 	if err := s.verifySubmodel(submodel, "SMREPO-NEWSM-VERIFY"); err != nil {
 		return err
 	}
+	if err := ensureUniqueIDShorts(submodel, "SMREPO-NEWSM-DUPIDSHORT"); err != nil {
+		return err
+	}
 
 	tx, cu, err := common.StartTransaction(s.db)
 	if err != nil {
@@ -83,6 +87,9 @@ func (s *SubmodelDatabase) CreateSubmodelInTransaction(ctx context.Context, tx *
 	if err := s.verifySubmodel(submodel, "SMREPO-NEWSM-VERIFY"); err != nil {
 		return err
 	}
+	if err := ensureUniqueIDShorts(submodel, "SMREPO-NEWSM-DUPIDSHORT"); err != nil {
+		return err
+	}
 
 	if err := s.createSubmodelInTransactionValidated(ctx, tx, submodel); err != nil {
 		return err
@@ -467,6 +474,11 @@ func (s *SubmodelDatabase) putSubmodelInTransaction(ctx context.Context, tx *sql
 	if err != nil && !common.IsErrNotFound(err) {
 		return false, err
 	}
+	if common.SubmodelPutMergeFromContext(ctx) {
+		if err = s.mergeStoredSubmodelElementsTx(ctx, tx, submodel); err != nil {
+			return false, err
+		}
+	}
 
 	isUpdate, err := s.replaceSubmodelInTransaction(tx, submodelID, submodel, false)
 	if err != nil {
@@ -497,6 +509,44 @@ func (s *SubmodelDatabase) putSubmodelInTransaction(ctx context.Context, tx *sql
 	return isUpdate, nil
 }
 
+// mergeStoredSubmodelElementsTx appends the stored top-level elements whose
+// idShort does not occur in submodel, so that the following replacement only
+// exchanges the elements sent by the client. Stored elements are read without
+// ABAC query filters because hidden elements must survive the merge as well.
+func (s *SubmodelDatabase) mergeStoredSubmodelElementsTx(ctx context.Context, tx *sql.Tx, submodel types.ISubmodel) error {
+	stored, err := s.getSubmodelByIDInTransaction(auth.ContextWithoutQueryFilter(ctx), tx, submodel.ID(), "deep", false)
+	if err != nil {
+		if common.IsErrNotFound(err) || errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	submodel.SetSubmodelElements(mergeSubmodelElementsForPut(submodel.SubmodelElements(), stored.SubmodelElements()))
+	return nil
+}
+
+// mergeSubmodelElementsForPut returns the requested elements in request order
+// followed by the stored elements whose idShort was not requested.
+func mergeSubmodelElementsForPut(requested []types.ISubmodelElement, stored []types.ISubmodelElement) []types.ISubmodelElement {
+	requestedIDShorts := make(map[string]struct{}, len(requested))
+	for _, element := range requested {
+		if element != nil && element.IDShort() != nil {
+			requestedIDShorts[*element.IDShort()] = struct{}{}
+		}
+	}
+	merged := make([]types.ISubmodelElement, 0, len(requested)+len(stored))
+	merged = append(merged, requested...)
+	for _, element := range stored {
+		if element == nil || element.IDShort() == nil {
+			continue
+		}
+		if _, replaced := requestedIDShorts[*element.IDShort()]; !replaced {
+			merged = append(merged, element)
+		}
+	}
+	return merged
+}
+
 // DeleteSubmodel deletes a submodel and checks ABAC access on the existing submodel before delete when ABAC is enabled.
 func (s *SubmodelDatabase) DeleteSubmodel(ctx context.Context, submodelID string) (err error) {
 	tx, cleanup, err := common.StartTransaction(s.db)
@@ -597,6 +647,10 @@ func (s *SubmodelDatabase) replaceSubmodelInTransaction(tx *sql.Tx, submodelID s
 	if err != nil {
 		return false, err
 	}
+	createdAt, err := loadSubmodelCreatedAtTx(tx, int64(submodelDatabaseID))
+	if err != nil {
+		return false, err
+	}
 
 	err = cleanupSubmodelLargeObjects(tx, int64(submodelDatabaseID))
 	if err != nil {
@@ -612,6 +666,9 @@ func (s *SubmodelDatabase) replaceSubmodelInTransaction(tx *sql.Tx, submodelID s
 	if err != nil {
 		return false, err
 	}
+	if err = restoreSubmodelCreatedAtTx(tx, submodelID, createdAt); err != nil {
+		return false, err
+	}
 	if err = restoreManagedFileReferencesAfterReplacementTx(tx, submodelID, managedReferences); err != nil {
 		return false, err
 	}
@@ -619,6 +676,38 @@ func (s *SubmodelDatabase) replaceSubmodelInTransaction(tx *sql.Tx, submodelID s
 	return true, nil
 }
 
+// loadSubmodelCreatedAtTx reads the creation timestamp of the row that a
+// replacement is about to delete, so the re-inserted row keeps its position in
+// the creation-order listing.
+func loadSubmodelCreatedAtTx(tx *sql.Tx, submodelDatabaseID int64) (time.Time, error) {
+	query, args, err := goqu.From(goqu.T("submodel")).
+		Select(goqu.C("db_created_at")).
+		Where(goqu.C("id").Eq(submodelDatabaseID)).
+		ToSQL()
+	if err != nil {
+		return time.Time{}, common.NewInternalServerError("SMREPO-UPDSM-BUILDCREATEDAT " + err.Error())
+	}
+	var createdAt time.Time
+	if err = tx.QueryRow(query, args...).Scan(&createdAt); err != nil {
+		return time.Time{}, common.NewInternalServerError("SMREPO-UPDSM-LOADCREATEDAT " + err.Error())
+	}
+	return createdAt, nil
+}
+
+func restoreSubmodelCreatedAtTx(tx *sql.Tx, submodelID string, createdAt time.Time) error {
+	query, args, err := goqu.Update("submodel").
+		Set(goqu.Record{"db_created_at": createdAt}).
+		Where(goqu.C("submodel_identifier").Eq(submodelID)).
+		ToSQL()
+	if err != nil {
+		return common.NewInternalServerError("SMREPO-UPDSM-BUILDRESTORECREATEDAT " + err.Error())
+	}
+	if _, err = tx.Exec(query, args...); err != nil {
+		return common.NewInternalServerError("SMREPO-UPDSM-RESTORECREATEDAT " + err.Error())
+	}
+	return nil
+}
+
 func loadManagedFileReferencesForReplacementTx(tx *sql.Tx, submodelDatabaseID int64) ([]gen.ManagedFileReferenceForReplacement, error) {
 	query, args, err := goqu.From(goqu.T("submodel_element").As("sme")).
 		Join(goqu.T("file_element").As("fe"), goqu.On(goqu.I("fe.id").Eq(goqu.I("sme.id")))).
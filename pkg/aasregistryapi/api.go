@@ -28,6 +28,7 @@ type AssetAdministrationShellRegistryAPIAPIRouter interface {
 	PostAssetAdministrationShellDescriptor(http.ResponseWriter, *http.Request)
 	GetAssetAdministrationShellDescriptorById(http.ResponseWriter, *http.Request)
 	PutAssetAdministrationShellDescriptorById(http.ResponseWriter, *http.Request)
+	PatchAssetAdministrationShellDescriptorById(http.ResponseWriter, *http.Request)
 	DeleteAssetAdministrationShellDescriptorById(http.ResponseWriter, *http.Request)
 	GetAllSubmodelDescriptorsThroughSuperpath(http.ResponseWriter, *http.Request)
 	PostSubmodelDescriptorThroughSuperpath(http.ResponseWriter, *http.Request)
@@ -49,10 +50,11 @@ type DescriptionAPIAPIRouter interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type AssetAdministrationShellRegistryAPIAPIServicer interface {
-	GetAllAssetAdministrationShellDescriptors(context.Context, int32, string, model.AssetKind, string, []string, time.Time, time.Time) (model.ImplResponse, error)
+	GetAllAssetAdministrationShellDescriptors(context.Context, int32, string, model.AssetKind, string, []string, time.Time, time.Time, string, string, *bool) (model.ImplResponse, error)
 	PostAssetAdministrationShellDescriptor(context.Context, model.AssetAdministrationShellDescriptor) (model.ImplResponse, error)
 	GetAssetAdministrationShellDescriptorById(context.Context, string) (model.ImplResponse, error)
 	PutAssetAdministrationShellDescriptorById(context.Context, string, model.AssetAdministrationShellDescriptor) (model.ImplResponse, error)
+	PatchAssetAdministrationShellDescriptorById(context.Context, string, map[string]any) (model.ImplResponse, error)
 	DeleteAssetAdministrationShellDescriptorById(context.Context, string) (model.ImplResponse, error)
 	GetAllSubmodelDescriptorsThroughSuperpath(context.Context, string, int32, string) (model.ImplResponse, error)
 	PostSubmodelDescriptorThroughSuperpath(context.Context, string, model.SubmodelDescriptor) (model.ImplResponse, error)
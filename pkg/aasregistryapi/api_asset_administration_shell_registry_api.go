@@ -12,12 +12,14 @@
 package apis
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -90,6 +92,12 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) Routes() Routes {
 			"/shell-descriptors/{aasIdentifier}",
 			c.PutAssetAdministrationShellDescriptorById,
 		},
+		"PatchAssetAdministrationShellDescriptorById": Route{
+			"PatchAssetAdministrationShellDescriptorById",
+			strings.ToUpper("Patch"),
+			"/shell-descriptors/{aasIdentifier}",
+			c.PatchAssetAdministrationShellDescriptorById,
+		},
 		"DeleteAssetAdministrationShellDescriptorById": Route{
 			"DeleteAssetAdministrationShellDescriptorById",
 			strings.ToUpper("Delete"),
@@ -162,6 +170,12 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) OrderedRoutes() []Rou
 			"/shell-descriptors/{aasIdentifier}",
 			c.PutAssetAdministrationShellDescriptorById,
 		},
+		Route{
+			"PatchAssetAdministrationShellDescriptorById",
+			strings.ToUpper("Patch"),
+			"/shell-descriptors/{aasIdentifier}",
+			c.PatchAssetAdministrationShellDescriptorById,
+		},
 		Route{
 			"DeleteAssetAdministrationShellDescriptorById",
 			strings.ToUpper("Delete"),
@@ -256,6 +270,30 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) GetAllAssetAdministra
 	if query.Has("assetType") {
 		assetTypeParam = query.Get("assetType")
 	}
+	var endpointInterfaceParam string
+	if query.Has("endpointInterface") {
+		endpointInterfaceParam = strings.TrimSpace(query.Get("endpointInterface"))
+	}
+	var endpointProtocolParam string
+	if query.Has("endpointProtocol") {
+		endpointProtocolParam = strings.TrimSpace(query.Get("endpointProtocol"))
+	}
+	var reachableParam *bool
+	if query.Has("reachable") {
+		reachable, parseErr := strconv.ParseBool(strings.TrimSpace(query.Get("reachable")))
+		if parseErr != nil {
+			result := common.NewErrorResponse(
+				errors.New("AASREG-LISTAASDESC-BADREACHABLE reachable must be true or false"),
+				http.StatusBadRequest,
+				componentName,
+				"GetAllAssetAdministrationShellDescriptors",
+				"reachable",
+			)
+			EncodeJSONResponse(result.Body, &result.Code, w)
+			return
+		}
+		reachableParam = &reachable
+	}
 	assetIdsParam := query["assetIds"]
 	var createdFromParam time.Time
 	if query.Has("createdFrom") {
@@ -277,15 +315,46 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) GetAllAssetAdministra
 		}
 	}
 
-	result, err := c.service.GetAllAssetAdministrationShellDescriptors(r.Context(), limitParam, cursorParam, assetKindParam, assetTypeParam, assetIdsParam, createdFromParam, updatedFromParam)
+	ctx := r.Context()
+	var total *common.TotalCount
+	if query.Has("count") {
+		count, parseErr := strconv.ParseBool(strings.TrimSpace(query.Get("count")))
+		if parseErr != nil {
+			result := common.NewErrorResponse(
+				errors.New("AASREG-LISTAASDESC-BADCOUNT count must be true or false"),
+				http.StatusBadRequest,
+				componentName,
+				"GetAllAssetAdministrationShellDescriptors",
+				"count",
+			)
+			EncodeJSONResponse(result.Body, &result.Code, w)
+			return
+		}
+		if count {
+			total = &common.TotalCount{}
+			ctx = common.WithTotalCount(ctx, total)
+		}
+	}
+
+	result, err := c.service.GetAllAssetAdministrationShellDescriptors(ctx, limitParam, cursorParam, assetKindParam, assetTypeParam, assetIdsParam, createdFromParam, updatedFromParam, endpointInterfaceParam, endpointProtocolParam, reachableParam)
 	if err != nil {
 		log.Printf("🧩 [%s] Error in GetAllAssetAdministrationShellDescriptors: service failure (limit=%d cursor=%q assetKind=%q assetType=%q): %v", componentName, limitParam, cursorParam, string(assetKindParam), assetTypeParam, err)
 		c.errorHandler(w, r, err, &result)
 		return
 	}
+	common.WriteTotalCountHeader(w, total)
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// assertAASDescriptorConstraints runs the model constraints of a shell
+// descriptor together with the checks that depend on the request configuration.
+func assertAASDescriptorConstraints(ctx context.Context, descriptor model.AssetAdministrationShellDescriptor) error {
+	if err := model.AssertAssetAdministrationShellDescriptorConstraints(descriptor); err != nil {
+		return err
+	}
+	return model.AssertUniqueSubmodelDescriptorIDs(descriptor.SubmodelDescriptors, common.AllowDuplicateSubmodelDescriptorIDsFromContext(ctx))
+}
+
 func parseOptionalAssetKind(query url.Values, operation string) (model.AssetKind, *model.ImplResponse) {
 	if !query.Has("assetKind") {
 		return "", nil
@@ -329,7 +398,7 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) PostAssetAdministrati
 		EncodeJSONResponse(result.Body, &result.Code, w)
 		return
 	}
-	if err := model.AssertAssetAdministrationShellDescriptorConstraints(assetAdministrationShellDescriptorParam); err != nil {
+	if err := assertAASDescriptorConstraints(r.Context(), assetAdministrationShellDescriptorParam); err != nil {
 		log.Printf("🧩 [%s] Error in PostAssetAdministrationShellDescriptor: constraints validation failed: %v", componentName, err)
 		result := common.NewErrorResponse(
 			err,
@@ -423,7 +492,7 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) PutAssetAdministratio
 		EncodeJSONResponse(result.Body, &result.Code, w)
 		return
 	}
-	if err := model.AssertAssetAdministrationShellDescriptorConstraints(assetAdministrationShellDescriptorParam); err != nil {
+	if err := assertAASDescriptorConstraints(r.Context(), assetAdministrationShellDescriptorParam); err != nil {
 		log.Printf("🧩 [%s] Error in PutAssetAdministrationShellDescriptorById: constraints validation failed: %v", componentName, err)
 		result := common.NewErrorResponse(
 			err,
@@ -451,6 +520,46 @@ func (c *AssetAdministrationShellRegistryAPIAPIController) PutAssetAdministratio
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PatchAssetAdministrationShellDescriptorById - Updates the given fields of an existing Asset Administration Shell Descriptor
+func (c *AssetAdministrationShellRegistryAPIAPIController) PatchAssetAdministrationShellDescriptorById(w http.ResponseWriter, r *http.Request) {
+	aasIdentifierParam := chi.URLParam(r, "aasIdentifier")
+	if aasIdentifierParam == "" {
+		log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: missing path parameter aasIdentifier", componentName)
+		result := common.NewErrorResponse(
+			common.NewErrBadRequest("Missing path parameter 'aasIdentifier'"),
+			http.StatusBadRequest,
+			componentName,
+			"PatchAssetAdministrationShellDescriptorById",
+			"aasIdentifier",
+		)
+		EncodeJSONResponse(result.Body, &result.Code, w)
+		return
+	}
+	var patchParam map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&patchParam); err != nil || patchParam == nil {
+		if err == nil {
+			err = errors.New("AASREG-PATCHAASDESC-NOTOBJECT patch must be a JSON object")
+		}
+		log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: decode body: %v", componentName, err)
+		result := common.NewErrorResponse(
+			err,
+			http.StatusBadRequest,
+			componentName,
+			"PatchAssetAdministrationShellDescriptorById",
+			"RequestBody",
+		)
+		EncodeJSONResponse(result.Body, &result.Code, w)
+		return
+	}
+	result, err := c.service.PatchAssetAdministrationShellDescriptorById(r.Context(), aasIdentifierParam, patchParam)
+	if err != nil {
+		log.Printf("🧩 [%s] Error in PatchAssetAdministrationShellDescriptorById: service failure (aasIdentifier=%q): %v", componentName, aasIdentifierParam, err)
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // DeleteAssetAdministrationShellDescriptorById - Deletes an Asset Administration Shell Descriptor, i.e. de-registers an AAS
 func (c *AssetAdministrationShellRegistryAPIAPIController) DeleteAssetAdministrationShellDescriptorById(w http.ResponseWriter, r *http.Request) {
 	aasIdentifierParam := chi.URLParam(r, "aasIdentifier")
@@ -0,0 +1,113 @@
+package apis
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/go-chi/chi/v5"
+)
+
+type captureDescriptorWriteService struct {
+	AssetAdministrationShellRegistryAPIAPIServicer
+	invoked bool
+}
+
+func (s *captureDescriptorWriteService) PostAssetAdministrationShellDescriptor(_ context.Context, descriptor model.AssetAdministrationShellDescriptor) (model.ImplResponse, error) {
+	s.invoked = true
+	return model.Response(http.StatusCreated, descriptor), nil
+}
+
+func (s *captureDescriptorWriteService) PutAssetAdministrationShellDescriptorById(_ context.Context, _ string, _ model.AssetAdministrationShellDescriptor) (model.ImplResponse, error) {
+	s.invoked = true
+	return model.Response(http.StatusNoContent, nil), nil
+}
+
+func descriptorWithHref(href string) string {
+	return `{"id":"urn:aas:endpoint-test","endpoints":[{"interface":"AAS-3.0","protocolInformation":{"href":"` + href + `"}}]}`
+}
+
+func TestAssetAdministrationShellDescriptorWritesValidateEndpointHref(t *testing.T) {
+	tests := []struct {
+		name       string
+		href       string
+		wantCode   int
+		wantCalled bool
+	}{
+		{name: "https", href: "https://repo.example/shells/dXJuOmFhcw", wantCode: http.StatusCreated, wantCalled: true},
+		{name: "opc ua", href: "opc.tcp://plc.example:4840", wantCode: http.StatusCreated, wantCalled: true},
+		{name: "relative", href: "shells/dXJuOmFhcw", wantCode: http.StatusBadRequest},
+		{name: "unsupported scheme", href: "ftp://repo.example/shells", wantCode: http.StatusBadRequest},
+		{name: "missing host", href: "http:///shells", wantCode: http.StatusBadRequest},
+		{name: "whitespace", href: "http://repo example/shells", wantCode: http.StatusBadRequest},
+		{name: "invalid escape", href: "http://repo.example/%zz", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run("POST "+tt.name, func(t *testing.T) {
+			service := &captureDescriptorWriteService{}
+			controller := NewAssetAdministrationShellRegistryAPIAPIController(service, "")
+			response := httptest.NewRecorder()
+
+			controller.PostAssetAdministrationShellDescriptor(response, httptest.NewRequest(http.MethodPost, "/shell-descriptors", strings.NewReader(descriptorWithHref(tt.href))))
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d body=%s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if service.invoked != tt.wantCalled {
+				t.Fatalf("expected service invoked=%v, got %v", tt.wantCalled, service.invoked)
+			}
+			if tt.wantCode == http.StatusBadRequest && !strings.Contains(response.Body.String(), "COMMON-ENDPOINTCONSTRAINTS-") {
+				t.Fatalf("expected endpoint constraint error, got %s", response.Body.String())
+			}
+		})
+	}
+
+	t.Run("PUT relative", func(t *testing.T) {
+		service := &captureDescriptorWriteService{}
+		controller := NewAssetAdministrationShellRegistryAPIAPIController(service, "")
+		request := httptest.NewRequest(http.MethodPut, "/shell-descriptors/dXJuOmFhczplbmRwb2ludC10ZXN0", strings.NewReader(descriptorWithHref("shells/dXJuOmFhcw")))
+		routeContext := chi.NewRouteContext()
+		routeContext.URLParams.Add("aasIdentifier", "dXJuOmFhczplbmRwb2ludC10ZXN0")
+		request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, routeContext))
+		response := httptest.NewRecorder()
+
+		controller.PutAssetAdministrationShellDescriptorById(response, request)
+
+		if response.Code != http.StatusBadRequest || service.invoked {
+			t.Fatalf("expected 400 without service call, got %d invoked=%v body=%s", response.Code, service.invoked, response.Body.String())
+		}
+	})
+}
+
+func TestPostAssetAdministrationShellDescriptorRejectsDuplicateSubmodelDescriptorIDs(t *testing.T) {
+	service := &captureDescriptorWriteService{}
+	controller := NewAssetAdministrationShellRegistryAPIAPIController(service, "")
+	body := `{"id":"urn:aas:duplicate-submodels","submodelDescriptors":[` +
+		`{"id":"urn:sm:nameplate","endpoints":[{"interface":"SUBMODEL-3.0","protocolInformation":{"href":"https://repo.example/submodels/a"}}]},` +
+		`{"id":"urn:sm:nameplate","endpoints":[{"interface":"SUBMODEL-3.0","protocolInformation":{"href":"https://repo.example/submodels/b"}}]}]}`
+	response := httptest.NewRecorder()
+
+	controller.PostAssetAdministrationShellDescriptor(response, httptest.NewRequest(http.MethodPost, "/shell-descriptors", strings.NewReader(body)))
+
+	if response.Code != http.StatusBadRequest || service.invoked {
+		t.Fatalf("expected 400 without service call, got %d invoked=%v body=%s", response.Code, service.invoked, response.Body.String())
+	}
+	if !strings.Contains(response.Body.String(), "COMMON-SMDESCCONSTRAINTS-DUPLICATEID") {
+		t.Fatalf("expected duplicate submodel descriptor id error, got %s", response.Body.String())
+	}
+
+	allowCtx := common.ContextWithConfig(context.Background(), &common.Config{
+		General: common.GeneralConfig{AllowDuplicateSubmodelDescriptorIds: true},
+	})
+	response = httptest.NewRecorder()
+	controller.PostAssetAdministrationShellDescriptor(response, httptest.NewRequest(http.MethodPost, "/shell-descriptors", strings.NewReader(body)).WithContext(allowCtx))
+
+	if !service.invoked {
+		t.Fatalf("expected duplicates to reach the service when allowed, got %d body=%s", response.Code, response.Body.String())
+	}
+}
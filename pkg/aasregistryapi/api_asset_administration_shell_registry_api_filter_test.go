@@ -0,0 +1,89 @@
+package apis
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+type captureAssetFilterService struct {
+	AssetAdministrationShellRegistryAPIAPIServicer
+	invoked           bool
+	assetKind         model.AssetKind
+	assetType         string
+	endpointInterface string
+	endpointProtocol  string
+	reachable         *bool
+}
+
+func (s *captureAssetFilterService) GetAllAssetAdministrationShellDescriptors(_ context.Context, _ int32, _ string, assetKind model.AssetKind, assetType string, _ []string, _ time.Time, _ time.Time, endpointInterface string, endpointProtocol string, reachable *bool) (model.ImplResponse, error) {
+	s.invoked = true
+	s.assetKind = assetKind
+	s.assetType = assetType
+	s.endpointInterface = endpointInterface
+	s.endpointProtocol = endpointProtocol
+	s.reachable = reachable
+	return model.Response(http.StatusOK, nil), nil
+}
+
+func TestGetAllAssetAdministrationShellDescriptorsPassesListFilters(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		wantKind      model.AssetKind
+		wantType      string
+		wantInterface string
+		wantProtocol  string
+		wantReach     string
+		wantCode      int
+		wantCalled    bool
+	}{
+		{name: "assetKind only", target: "/shell-descriptors?assetKind=Instance", wantKind: model.ASSETKIND_INSTANCE, wantCode: http.StatusOK, wantCalled: true},
+		{name: "assetType only", target: "/shell-descriptors?assetType=dXJuOnR5cGU", wantType: "dXJuOnR5cGU", wantCode: http.StatusOK, wantCalled: true},
+		{name: "combined", target: "/shell-descriptors?assetKind=Type&assetType=dXJuOnR5cGU", wantKind: model.ASSETKIND_TYPE, wantType: "dXJuOnR5cGU", wantCode: http.StatusOK, wantCalled: true},
+		{name: "endpointProtocol", target: "/shell-descriptors?endpointProtocol=HTTP", wantProtocol: "HTTP", wantCode: http.StatusOK, wantCalled: true},
+		{name: "endpointInterface and endpointProtocol", target: "/shell-descriptors?endpointInterface=SUBMODEL-3.0&endpointProtocol=HTTP", wantInterface: "SUBMODEL-3.0", wantProtocol: "HTTP", wantCode: http.StatusOK, wantCalled: true},
+		{name: "reachable", target: "/shell-descriptors?reachable=true", wantReach: "true", wantCode: http.StatusOK, wantCalled: true},
+		{name: "unreachable", target: "/shell-descriptors?reachable=false", wantReach: "false", wantCode: http.StatusOK, wantCalled: true},
+		{name: "unknown assetKind", target: "/shell-descriptors?assetKind=Bogus", wantCode: http.StatusBadRequest},
+		{name: "invalid reachable", target: "/shell-descriptors?reachable=maybe", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &captureAssetFilterService{}
+			controller := NewAssetAdministrationShellRegistryAPIAPIController(service, "")
+			response := httptest.NewRecorder()
+
+			controller.GetAllAssetAdministrationShellDescriptors(response, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d body=%s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if service.invoked != tt.wantCalled {
+				t.Fatalf("expected service invoked=%v, got %v", tt.wantCalled, service.invoked)
+			}
+			if service.assetKind != tt.wantKind || service.assetType != tt.wantType {
+				t.Fatalf("expected assetKind=%q assetType=%q, got assetKind=%q assetType=%q", tt.wantKind, tt.wantType, service.assetKind, service.assetType)
+			}
+			if service.endpointInterface != tt.wantInterface {
+				t.Fatalf("expected endpointInterface=%q, got %q", tt.wantInterface, service.endpointInterface)
+			}
+			if service.endpointProtocol != tt.wantProtocol {
+				t.Fatalf("expected endpointProtocol=%q, got %q", tt.wantProtocol, service.endpointProtocol)
+			}
+			gotReach := ""
+			if service.reachable != nil {
+				gotReach = strconv.FormatBool(*service.reachable)
+			}
+			if gotReach != tt.wantReach {
+				t.Fatalf("expected reachable=%q, got %q", tt.wantReach, gotReach)
+			}
+		})
+	}
+}
@@ -1061,10 +1061,8 @@ func (c *AssetAdministrationShellRepositoryAPIAPIController) PatchSubmodelByIdMe
 		c.errorHandler(w, r, &ParsingError{Err: errors.New("metadata payload must be an object")}, nil)
 		return
 	}
-	if err := model.AssertSubmodelMetadataRequired(submodelMetadataParam); err != nil {
-		c.errorHandler(w, r, err, nil)
-		return
-	}
+	// Metadata PATCH is a merge patch: id and modelType may be omitted and are
+	// taken from the path and the stored submodel.
 	if err := model.AssertSubmodelMetadataConstraints(submodelMetadataParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
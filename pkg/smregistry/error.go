@@ -65,6 +65,11 @@ func DefaultErrorHandler(w http.ResponseWriter, _ *http.Request, err error, resu
 	if ok := errors.As(err, &parsingErr); ok {
 		status = http.StatusBadRequest
 		info = "ParseRequest"
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(parsingErr.Err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+			info = "RequestBodyTooLarge"
+		}
 	} else {
 		var requiredErr *RequiredError
 		if errors.As(err, &requiredErr) {
@@ -59,8 +59,10 @@ type SubmodelRepositoryAPIAPIRouter interface {
 	GetSubmodelByIDPath(http.ResponseWriter, *http.Request)
 	GetAllSubmodelElements(http.ResponseWriter, *http.Request)
 	PostSubmodelElementSubmodelRepo(http.ResponseWriter, *http.Request)
+	DeleteSubmodelElementsByPathSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetAllSubmodelElementsMetadataSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetAllSubmodelElementsValueOnlySubmodelRepo(http.ResponseWriter, *http.Request)
+	PatchSubmodelElementsValueOnlySubmodelRepo(http.ResponseWriter, *http.Request)
 	GetAllSubmodelElementsReferenceSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetAllSubmodelElementsPathSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetSubmodelElementByPathSubmodelRepo(http.ResponseWriter, *http.Request)
@@ -74,6 +76,8 @@ type SubmodelRepositoryAPIAPIRouter interface {
 	PatchSubmodelElementByPathValueOnlySubmodelRepo(http.ResponseWriter, *http.Request)
 	GetSubmodelElementByPathReferenceSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetSubmodelElementByPathPathSubmodelRepo(http.ResponseWriter, *http.Request)
+	GetSubmodelElementParentSubmodelRepo(http.ResponseWriter, *http.Request)
+	GetSubmodelElementAncestorsSubmodelRepo(http.ResponseWriter, *http.Request)
 	GetFileByPathSubmodelRepo(http.ResponseWriter, *http.Request)
 	PutFileByPathSubmodelRepo(http.ResponseWriter, *http.Request)
 	DeleteFileByPathSubmodelRepo(http.ResponseWriter, *http.Request)
@@ -101,6 +105,7 @@ type DescriptionAPIAPIServicer interface {
 // and updated with the logic required for the API.
 type SerializationAPIAPIServicer interface {
 	GenerateSerializationByIds(context.Context, []string, []string, bool) (model.ImplResponse, error)
+	ImportAASXPackage(context.Context, io.ReadSeeker) (model.ImplResponse, error)
 }
 
 // SubmodelRepositoryAPIAPIServicer defines the api actions for the SubmodelRepositoryAPIAPI service
@@ -109,13 +114,13 @@ type SerializationAPIAPIServicer interface {
 // and updated with the logic required for the API.
 type SubmodelRepositoryAPIAPIServicer interface {
 	QuerySubmodels(context.Context, int32, string, grammar.Query) (model.ImplResponse, error)
-	GetAllSubmodels(context.Context, string, string, int32, string, string, string, time.Time, time.Time) (model.ImplResponse, error)
+	GetAllSubmodels(context.Context, string, string, int32, string, string, string, time.Time, time.Time, string, string, string) (model.ImplResponse, error)
 	PostSubmodel(context.Context, types.ISubmodel) (model.ImplResponse, error)
-	GetAllSubmodelsMetadata(context.Context, string, string, int32, string) (model.ImplResponse, error)
-	GetAllSubmodelsValueOnly(context.Context, string, string, int32, string, string, string) (model.ImplResponse, error)
-	GetAllSubmodelsReference(context.Context, string, string, int32, string, string) (model.ImplResponse, error)
-	GetAllSubmodelsPath(context.Context, string, string, int32, string, string) (model.ImplResponse, error)
-	GetSubmodelByID(context.Context, string, string, string) (model.ImplResponse, error)
+	GetAllSubmodelsMetadata(context.Context, string, string, int32, string, string) (model.ImplResponse, error)
+	GetAllSubmodelsValueOnly(context.Context, string, string, int32, string, string, string, string) (model.ImplResponse, error)
+	GetAllSubmodelsReference(context.Context, string, string, int32, string, string, string) (model.ImplResponse, error)
+	GetAllSubmodelsPath(context.Context, string, string, int32, string, string, string) (model.ImplResponse, error)
+	GetSubmodelByID(context.Context, string, string, string, string) (model.ImplResponse, error)
 	GetSignedSubmodelByID(context.Context, string) (model.ImplResponse, error)
 	GetSignedSubmodelByIDValueOnly(context.Context, string) (model.ImplResponse, error)
 	GetSubmodelByIdAndDate(context.Context, string, string, string, time.Time) (model.ImplResponse, error)
@@ -129,10 +134,12 @@ type SubmodelRepositoryAPIAPIServicer interface {
 	PatchSubmodelByIDValueOnly(context.Context, string, model.SubmodelValue, string) (model.ImplResponse, error)
 	GetSubmodelByIDReference(context.Context, string) (model.ImplResponse, error)
 	GetSubmodelByIDPath(context.Context, string, string) (model.ImplResponse, error)
-	GetAllSubmodelElements(context.Context, string, int32, string, string, string) (model.ImplResponse, error)
+	GetAllSubmodelElements(context.Context, string, int32, string, string, string, string) (model.ImplResponse, error)
 	PostSubmodelElementSubmodelRepo(context.Context, string, types.ISubmodelElement) (model.ImplResponse, error)
+	DeleteSubmodelElementsByPathSubmodelRepo(context.Context, string, []string) (model.ImplResponse, error)
 	GetAllSubmodelElementsMetadataSubmodelRepo(context.Context, string, int32, string) (model.ImplResponse, error)
 	GetAllSubmodelElementsValueOnlySubmodelRepo(context.Context, string, int32, string, string, string) (model.ImplResponse, error)
+	PatchSubmodelElementsValueOnlySubmodelRepo(context.Context, string, model.SubmodelValue, bool) (model.ImplResponse, error)
 	GetAllSubmodelElementsReferenceSubmodelRepo(context.Context, string, int32, string, string) (model.ImplResponse, error)
 	GetAllSubmodelElementsPathSubmodelRepo(context.Context, string, int32, string, string) (model.ImplResponse, error)
 	GetSubmodelElementByPathSubmodelRepo(context.Context, string, string, string, string) (model.ImplResponse, error)
@@ -146,6 +153,8 @@ type SubmodelRepositoryAPIAPIServicer interface {
 	PatchSubmodelElementByPathValueOnlySubmodelRepo(context.Context, string, string, model.SubmodelElementValue, string) (model.ImplResponse, error)
 	GetSubmodelElementByPathReferenceSubmodelRepo(context.Context, string, string) (model.ImplResponse, error)
 	GetSubmodelElementByPathPathSubmodelRepo(context.Context, string, string, string) (model.ImplResponse, error)
+	GetSubmodelElementParentSubmodelRepo(context.Context, string, string, string, string) (model.ImplResponse, error)
+	GetSubmodelElementAncestorsSubmodelRepo(context.Context, string, string) (model.ImplResponse, error)
 	GetFileByPathSubmodelRepo(context.Context, string, string) (model.ImplResponse, error)
 	PutFileByPathSubmodelRepo(context.Context, string, string, string, io.Reader) (model.ImplResponse, error)
 	DeleteFileByPathSubmodelRepo(context.Context, string, string) (model.ImplResponse, error)
@@ -10,15 +10,21 @@
 package openapi
 
 import (
+	"log"
 	"net/http"
 	"strings"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 )
 
 // SerializationAPIAPIController binds http requests to an api service and writes the service results to the http response
 type SerializationAPIAPIController struct {
-	service      SerializationAPIAPIServicer
-	errorHandler ErrorHandler
-	contextPath  string
+	service            SerializationAPIAPIServicer
+	errorHandler       ErrorHandler
+	contextPath        string
+	uploadStager       common.UploadStager
+	maxUploadSizeBytes int64
 }
 
 // SerializationAPIAPIOption for how the controller is set up.
@@ -31,6 +37,14 @@ func WithSerializationAPIAPIErrorHandler(h ErrorHandler) SerializationAPIAPIOpti
 	}
 }
 
+// WithSerializationAPIAPIUploadStager configures seekable staging for uploaded AASX packages.
+func WithSerializationAPIAPIUploadStager(stager common.UploadStager, maxUploadSizeBytes int64) SerializationAPIAPIOption {
+	return func(c *SerializationAPIAPIController) {
+		c.uploadStager = stager
+		c.maxUploadSizeBytes = maxUploadSizeBytes
+	}
+}
+
 // NewSerializationAPIAPIController creates a default api controller
 func NewSerializationAPIAPIController(s SerializationAPIAPIServicer, contextPath string, opts ...SerializationAPIAPIOption) *SerializationAPIAPIController {
 	controller := &SerializationAPIAPIController{
@@ -54,6 +68,11 @@ func (c *SerializationAPIAPIController) Routes() Routes {
 			c.contextPath + "/serialization",
 			c.GenerateSerializationByIds,
 		},
+		"ImportAASXPackage": Route{
+			strings.ToUpper("Post"),
+			c.contextPath + "/upload",
+			c.ImportAASXPackage,
+		},
 	}
 }
 
@@ -88,7 +107,37 @@ func (c *SerializationAPIAPIController) GenerateSerializationByIds(w http.Respon
 		var param = true
 		includeConceptDescriptionsParam = param
 	}
-	result, err := c.service.GenerateSerializationByIds(r.Context(), aasIDsParam, submodelIDsParam, includeConceptDescriptionsParam)
+	requestContext := common.WithAcceptHeader(r.Context(), r.Header.Get("Accept"))
+	result, err := c.service.GenerateSerializationByIds(requestContext, aasIDsParam, submodelIDsParam, includeConceptDescriptionsParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	if err := EncodeJSONResponse(result.Body, &result.Code, w); err != nil {
+		// Streamed packages fail after the status line; the client sees a truncated download.
+		log.Printf("SMREPO-SERIALIZATIONAPI-STREAM response stream failed: %v", err)
+	}
+}
+
+// ImportAASXPackage - Stores the submodels and attachments of an uploaded AASX package
+func (c *SerializationAPIAPIController) ImportAASXPackage(w http.ResponseWriter, r *http.Request) {
+	if c.uploadStager == nil {
+		c.errorHandler(w, r, common.NewInternalServerError("SMREPO-SERIALIZATIONAPI-NOSTAGER upload stager is not configured"), nil)
+		return
+	}
+	maxUploadSizeBytes := c.maxUploadSizeBytes
+	if maxUploadSizeBytes <= 0 {
+		maxUploadSizeBytes = uploadMaxSizeFromRequestContext(r)
+	}
+	upload, err := common.ReadMultipartUpload(w, r, maxUploadSizeBytes, "file", c.uploadStager)
+	if err != nil {
+		c.errorHandler(w, r, err, &model.ImplResponse{Code: multipartUploadErrorStatus(err)})
+		return
+	}
+	defer func() { _ = upload.Close() }()
+	result, err := c.service.ImportAASXPackage(r.Context(), upload.File)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -97,3 +146,16 @@ func (c *SerializationAPIAPIController) GenerateSerializationByIds(w http.Respon
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+func multipartUploadErrorStatus(err error) int {
+	switch {
+	case common.IsErrPayloadTooLarge(err):
+		return http.StatusRequestEntityTooLarge
+	case common.IsErrServiceUnavailable(err):
+		return http.StatusServiceUnavailable
+	case common.IsInternalServerError(err):
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
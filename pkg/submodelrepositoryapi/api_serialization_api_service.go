@@ -12,6 +12,7 @@ package openapi
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 )
 
@@ -51,3 +52,8 @@ func (s *SerializationAPIAPIService) GenerateSerializationByIds(_ /*ctx*/ contex
 
 	return Response(http.StatusNotImplemented, nil), errors.New("GenerateSerializationByIds method not implemented")
 }
+
+// ImportAASXPackage - Stores the submodels and attachments of an uploaded AASX package
+func (s *SerializationAPIAPIService) ImportAASXPackage(_ /*ctx*/ context.Context, _ /*file*/ io.ReadSeeker) (ImplResponse, error) {
+	return Response(http.StatusNotImplemented, nil), errors.New("ImportAASXPackage method not implemented")
+}
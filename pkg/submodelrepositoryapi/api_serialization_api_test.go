@@ -26,12 +26,17 @@
 package openapi
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"errors"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
 	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
 	"github.com/stretchr/testify/require"
 )
@@ -39,12 +44,63 @@ import (
 type serializationServiceStub struct {
 	response model.ImplResponse
 	err      error
+	accept   *string
+	imported *[]byte
 }
 
-func (s serializationServiceStub) GenerateSerializationByIds(_ context.Context, _ []string, _ []string, _ bool) (model.ImplResponse, error) {
+func (s serializationServiceStub) GenerateSerializationByIds(ctx context.Context, _ []string, _ []string, _ bool) (model.ImplResponse, error) {
+	if s.accept != nil {
+		*s.accept = common.AcceptHeaderFromContext(ctx)
+	}
 	return s.response, s.err
 }
 
+func (s serializationServiceStub) ImportAASXPackage(_ context.Context, file io.ReadSeeker) (model.ImplResponse, error) {
+	if s.imported != nil {
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return model.ImplResponse{}, err
+		}
+		*s.imported = content
+	}
+	return s.response, s.err
+}
+
+type serializationMemoryStage struct{ *bytes.Reader }
+
+func (stage *serializationMemoryStage) Size() int64  { return stage.Reader.Size() }
+func (stage *serializationMemoryStage) Close() error { return nil }
+func (stage *serializationMemoryStage) Promote(context.Context, func(context.Context, *sql.Tx, int64, int64) error) error {
+	return nil
+}
+
+func serializationMemoryStager(_ context.Context, input io.Reader, maximum int64) (common.StagedUpload, error) {
+	content, err := io.ReadAll(io.LimitReader(input, maximum+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maximum {
+		return nil, common.NewErrPayloadTooLarge("SMREPO-TESTSTAGE-TOOLARGE")
+	}
+	return &serializationMemoryStage{Reader: bytes.NewReader(content)}, nil
+}
+
+func newPackageUploadRequest(t *testing.T, content []byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "submodels.aasx")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	request := httptest.NewRequest(http.MethodPost, "/upload", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return request
+}
+
 func TestSerializationRoutesIncludeContextPath(t *testing.T) {
 	t.Parallel()
 
@@ -52,6 +108,7 @@ func TestSerializationRoutesIncludeContextPath(t *testing.T) {
 	routes := ctrl.Routes()
 
 	require.Equal(t, "/api/v3/serialization", routes["GenerateSerializationByIds"].Pattern)
+	require.Equal(t, "/api/v3/upload", routes["ImportAASXPackage"].Pattern)
 }
 
 func TestGenerateSerializationByIdsReturnsNotImplemented(t *testing.T) {
@@ -69,3 +126,62 @@ func TestGenerateSerializationByIdsReturnsNotImplemented(t *testing.T) {
 
 	require.Equal(t, http.StatusNotImplemented, rr.Code)
 }
+
+func TestGenerateSerializationByIdsStreamsDownload(t *testing.T) {
+	t.Parallel()
+
+	var accept string
+	ctrl := NewSerializationAPIAPIController(serializationServiceStub{
+		response: model.Response(http.StatusOK, StreamingFileDownload{
+			ContentType: "application/asset-administration-shell-package+json",
+			Filename:    "submodels.aasx",
+			WriteTo: func(w io.Writer) error {
+				_, err := io.WriteString(w, "PK-package")
+				return err
+			},
+		}),
+		accept: &accept,
+	}, "")
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/serialization", nil)
+	req.Header.Set("Accept", "application/asset-administration-shell-package+json")
+
+	ctrl.GenerateSerializationByIds(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/asset-administration-shell-package+json", accept)
+	require.Equal(t, "application/asset-administration-shell-package+json", rr.Header().Get("Content-Type"))
+	require.Contains(t, rr.Header().Get("Content-Disposition"), "submodels.aasx")
+	require.Equal(t, "PK-package", rr.Body.String())
+}
+
+func TestImportAASXPackagePassesStagedUpload(t *testing.T) {
+	t.Parallel()
+
+	var imported []byte
+	ctrl := NewSerializationAPIAPIController(serializationServiceStub{
+		response: model.Response(http.StatusCreated, map[string]any{"success": true}),
+		imported: &imported,
+	}, "", WithSerializationAPIAPIUploadStager(serializationMemoryStager, 4096))
+
+	rr := httptest.NewRecorder()
+	ctrl.ImportAASXPackage(rr, newPackageUploadRequest(t, []byte("PK-package")))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	require.Equal(t, "PK-package", string(imported))
+}
+
+func TestImportAASXPackageRejectsOversizedUpload(t *testing.T) {
+	t.Parallel()
+
+	var imported []byte
+	ctrl := NewSerializationAPIAPIController(serializationServiceStub{imported: &imported}, "",
+		WithSerializationAPIAPIUploadStager(serializationMemoryStager, 128))
+
+	rr := httptest.NewRecorder()
+	ctrl.ImportAASXPackage(rr, newPackageUploadRequest(t, bytes.Repeat([]byte("x"), 1024)))
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code, rr.Body.String())
+	require.Nil(t, imported)
+}
@@ -10,12 +10,13 @@
 package openapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ type SubmodelRepositoryAPIAPIController struct {
 	errorHandler     ErrorHandler
 	contextPath      string
 	verificationMode model.VerificationMode
+	strictSchema     bool
 }
 
 // SubmodelRepositoryAPIAPIOption for how the controller is set up.
@@ -45,6 +47,14 @@ func WithSubmodelRepositoryAPIAPIErrorHandler(h ErrorHandler) SubmodelRepository
 	}
 }
 
+// WithStrictSchemaValidation validates Submodel request bodies against the
+// IDTA Part 1 JSON schema before they are unmarshalled.
+func WithStrictSchemaValidation(enabled bool) SubmodelRepositoryAPIAPIOption {
+	return func(c *SubmodelRepositoryAPIAPIController) {
+		c.strictSchema = enabled
+	}
+}
+
 // NewSubmodelRepositoryAPIAPIController creates a default api controller
 func NewSubmodelRepositoryAPIAPIController(s SubmodelRepositoryAPIAPIServicer, contextPath string, strictVerification string, opts ...SubmodelRepositoryAPIAPIOption) *SubmodelRepositoryAPIAPIController {
 	controller := &SubmodelRepositoryAPIAPIController{
@@ -61,6 +71,30 @@ func NewSubmodelRepositoryAPIAPIController(s SubmodelRepositoryAPIAPIServicer, c
 	return controller
 }
 
+// rejectsSchemaViolations validates bodyBytes against the named Part 1 schema
+// when strict schema validation is enabled. It writes a 400 response listing
+// every violation and returns true when the request must not proceed.
+func (c *SubmodelRepositoryAPIAPIController) rejectsSchemaViolations(w http.ResponseWriter, r *http.Request, schemaName string, function string, bodyBytes []byte) bool {
+	if !c.strictSchema {
+		return false
+	}
+	violations, err := common.ValidatePart1Schema(schemaName, bodyBytes)
+	if err != nil {
+		if common.IsErrBadRequest(err) {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		} else {
+			c.errorHandler(w, r, err, nil)
+		}
+		return true
+	}
+	if len(violations) == 0 {
+		return false
+	}
+	result := common.NewSchemaViolationResponse(violations, "SMREPO", function)
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+	return true
+}
+
 func parseControllerVerificationMode(strictVerification string) model.VerificationMode {
 	verificationMode, err := model.ParseVerificationMode(strictVerification)
 	if err == nil {
@@ -69,6 +103,64 @@ func parseControllerVerificationMode(strictVerification string) model.Verificati
 	return model.NormalizeVerificationMode(model.VerificationMode(strictVerification))
 }
 
+// contextWithLanguageFilter carries the ?language= selection to the service so
+// that LangString values are narrowed to the requested languages.
+func contextWithLanguageFilter(r *http.Request, query url.Values) context.Context {
+	return common.WithLanguageFilter(r.Context(), common.ParseLanguageList(query.Get("language")))
+}
+
+// contextWithElementDepth adds the `depth` query parameter of element reads to
+// ctx. Without the parameter ctx is returned unchanged.
+func contextWithElementDepth(ctx context.Context, query url.Values) (context.Context, error) {
+	if !query.Has("depth") {
+		return ctx, nil
+	}
+	depth, err := parseNumericParameter[int32](
+		query.Get("depth"),
+		WithParse[int32](parseInt32),
+		WithMinimum[int32](0),
+	)
+	if err != nil {
+		return nil, &ParsingError{Param: "depth", Err: err}
+	}
+	return common.WithElementDepth(ctx, int(depth)), nil
+}
+
+// contextWithTotalCount asks the listing for the number of matching items
+// when the `count` query parameter is true. The returned receiver is nil
+// otherwise.
+func contextWithTotalCount(ctx context.Context, query url.Values) (context.Context, *common.TotalCount, error) {
+	if !query.Has("count") {
+		return ctx, nil, nil
+	}
+	count, err := parseBoolParameter(query.Get("count"), WithParse[bool](parseBool))
+	if err != nil {
+		return nil, nil, &ParsingError{Param: "count", Err: err}
+	}
+	if !count {
+		return ctx, nil, nil
+	}
+	total := &common.TotalCount{}
+	return common.WithTotalCount(ctx, total), total, nil
+}
+
+// contextWithDryRun marks the request context as validate-only when the
+// `dryRun` query parameter is true.
+func contextWithDryRun(r *http.Request) (context.Context, error) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		return nil, &ParsingError{Err: err}
+	}
+	if !query.Has("dryRun") {
+		return r.Context(), nil
+	}
+	dryRun, err := parseBoolParameter(query.Get("dryRun"), WithParse[bool](parseBool))
+	if err != nil {
+		return nil, &ParsingError{Param: "dryRun", Err: err}
+	}
+	return common.WithDryRun(r.Context(), dryRun), nil
+}
+
 // Routes returns all the api routes for the SubmodelRepositoryAPIAPIController
 func (c *SubmodelRepositoryAPIAPIController) Routes() Routes {
 	return Routes{
@@ -172,6 +264,11 @@ func (c *SubmodelRepositoryAPIAPIController) Routes() Routes {
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements",
 			c.PostSubmodelElementSubmodelRepo,
 		},
+		"DeleteSubmodelElementsByPathSubmodelRepo": Route{
+			strings.ToUpper("Delete"),
+			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements",
+			c.DeleteSubmodelElementsByPathSubmodelRepo,
+		},
 		"GetAllSubmodelElementsMetadataSubmodelRepo": Route{
 			strings.ToUpper("Get"),
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/$metadata",
@@ -182,6 +279,11 @@ func (c *SubmodelRepositoryAPIAPIController) Routes() Routes {
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/$value",
 			c.GetAllSubmodelElementsValueOnlySubmodelRepo,
 		},
+		"PatchSubmodelElementsValueOnlySubmodelRepo": Route{
+			strings.ToUpper("Patch"),
+			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/$value",
+			c.PatchSubmodelElementsValueOnlySubmodelRepo,
+		},
 		"GetAllSubmodelElementsReferenceSubmodelRepo": Route{
 			strings.ToUpper("Get"),
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/$reference",
@@ -247,6 +349,16 @@ func (c *SubmodelRepositoryAPIAPIController) Routes() Routes {
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$path",
 			c.GetSubmodelElementByPathPathSubmodelRepo,
 		},
+		"GetSubmodelElementParentSubmodelRepo": Route{
+			strings.ToUpper("Get"),
+			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$parent",
+			c.GetSubmodelElementParentSubmodelRepo,
+		},
+		"GetSubmodelElementAncestorsSubmodelRepo": Route{
+			strings.ToUpper("Get"),
+			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/$ancestors",
+			c.GetSubmodelElementAncestorsSubmodelRepo,
+		},
 		"GetFileByPathSubmodelRepo": Route{
 			strings.ToUpper("Get"),
 			c.contextPath + "/submodels/{submodelIdentifier}/submodel-elements/{idShortPath}/attachment",
@@ -407,12 +519,30 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodels(w http.ResponseWrit
 			return
 		}
 	}
-	result, err := c.service.GetAllSubmodels(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, extentParam, createdFromParam, updatedFromParam)
+	var kindParam string
+	if query.Has("kind") {
+		kindParam = query.Get("kind")
+	}
+	var hasElementsParam string
+	if query.Has("hasElements") {
+		hasElementsParam = query.Get("hasElements")
+	}
+	var orderByParam string
+	if query.Has("orderBy") {
+		orderByParam = query.Get("orderBy")
+	}
+	ctx, total, err := contextWithTotalCount(contextWithLanguageFilter(r, query), query)
+	if err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetAllSubmodels(ctx, semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, extentParam, createdFromParam, updatedFromParam, kindParam, hasElementsParam, orderByParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
 		return
 	}
+	common.WriteTotalCountHeader(w, total)
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
@@ -425,6 +555,9 @@ func (c *SubmodelRepositoryAPIAPIController) PostSubmodel(w http.ResponseWriter,
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
+	if c.rejectsSchemaViolations(w, r, "Submodel", "PostSubmodel", bodyBytes) {
+		return
+	}
 	var jsonable interface{}
 	if err := json.Unmarshal(bodyBytes, &jsonable); err != nil {
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
@@ -436,7 +569,12 @@ func (c *SubmodelRepositoryAPIAPIController) PostSubmodel(w http.ResponseWriter,
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	result, err := c.service.PostSubmodel(r.Context(), submodelParam)
+	ctx, err := contextWithDryRun(r)
+	if err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostSubmodel(ctx, submodelParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -499,7 +637,11 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelsMetadata(w http.Resp
 		cursorParam = param
 	}
 
-	result, err := c.service.GetAllSubmodelsMetadata(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam)
+	var kindParam string
+	if query.Has("kind") {
+		kindParam = query.Get("kind")
+	}
+	result, err := c.service.GetAllSubmodelsMetadata(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, kindParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -570,7 +712,11 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelsValueOnly(w http.Res
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetAllSubmodelsValueOnly(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, extentParam)
+	var kindParam string
+	if query.Has("kind") {
+		kindParam = query.Get("kind")
+	}
+	result, err := c.service.GetAllSubmodelsValueOnly(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, extentParam, kindParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -632,7 +778,11 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelsReference(w http.Res
 		param := "core"
 		levelParam = param
 	}
-	result, err := c.service.GetAllSubmodelsReference(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam)
+	var kindParam string
+	if query.Has("kind") {
+		kindParam = query.Get("kind")
+	}
+	result, err := c.service.GetAllSubmodelsReference(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, kindParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -694,7 +844,11 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelsPath(w http.Response
 		param := "deep"
 		levelParam = param
 	}
-	result, err := c.service.GetAllSubmodelsPath(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam)
+	var kindParam string
+	if query.Has("kind") {
+		kindParam = query.Get("kind")
+	}
+	result, err := c.service.GetAllSubmodelsPath(r.Context(), semanticIDParam, idShortParam, limitParam, cursorParam, levelParam, kindParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -734,7 +888,11 @@ func (c *SubmodelRepositoryAPIAPIController) GetSubmodelByID(w http.ResponseWrit
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetSubmodelByID(r.Context(), submodelIdentifierParam, levelParam, extentParam)
+	var fieldsParam string
+	if query.Has("fields") {
+		fieldsParam = query.Get("fields")
+	}
+	result, err := c.service.GetSubmodelByID(contextWithLanguageFilter(r, query), submodelIdentifierParam, levelParam, extentParam, fieldsParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -873,6 +1031,9 @@ func (c *SubmodelRepositoryAPIAPIController) PutSubmodelByID(w http.ResponseWrit
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
+	if c.rejectsSchemaViolations(w, r, "Submodel", "PutSubmodelByID", bodyBytes) {
+		return
+	}
 	var jsonable interface{}
 	if err := json.Unmarshal(bodyBytes, &jsonable); err != nil {
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
@@ -883,7 +1044,12 @@ func (c *SubmodelRepositoryAPIAPIController) PutSubmodelByID(w http.ResponseWrit
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	result, err := c.service.PutSubmodelByID(r.Context(), submodelIdentifierParam, submodelParam)
+	ctx, err := contextWithDryRun(r)
+	if err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PutSubmodelByID(ctx, submodelIdentifierParam, submodelParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -1008,10 +1174,8 @@ func (c *SubmodelRepositoryAPIAPIController) PatchSubmodelByIDMetadata(w http.Re
 		c.errorHandler(w, r, &ParsingError{Err: errors.New("metadata payload must be an object")}, nil)
 		return
 	}
-	if err := model.AssertSubmodelMetadataRequired(submodelMetadataParam); err != nil {
-		c.errorHandler(w, r, err, nil)
-		return
-	}
+	// Metadata PATCH is a merge patch: id and modelType may be omitted and are
+	// taken from the path and the stored submodel.
 	if err := model.AssertSubmodelMetadataConstraints(submodelMetadataParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
@@ -1057,7 +1221,8 @@ func (c *SubmodelRepositoryAPIAPIController) GetSubmodelByIDValueOnly(w http.Res
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetSubmodelByIDValueOnly(r.Context(), submodelIdentifierParam, levelParam, extentParam)
+	ctx := common.WithIDShortPathFilter(r.Context(), query.Get("idShortPath"))
+	result, err := c.service.GetSubmodelByIDValueOnly(ctx, submodelIdentifierParam, levelParam, extentParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -1205,7 +1370,17 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelElements(w http.Respo
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetAllSubmodelElements(r.Context(), submodelIdentifierParam, limitParam, cursorParam, levelParam, extentParam)
+	var fieldsParam string
+	if query.Has("fields") {
+		fieldsParam = query.Get("fields")
+	}
+	orderByParam, err := common.ParseElementOrder(query.Get("orderBy"))
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "orderBy", Err: err}, nil)
+		return
+	}
+	ctx := common.WithElementOrder(contextWithLanguageFilter(r, query), orderByParam)
+	result, err := c.service.GetAllSubmodelElements(ctx, submodelIdentifierParam, limitParam, cursorParam, levelParam, extentParam, fieldsParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -1383,6 +1558,48 @@ func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelElementsValueOnlySubm
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PatchSubmodelElementsValueOnlySubmodelRepo - Updates the values of several submodel elements
+func (c *SubmodelRepositoryAPIAPIController) PatchSubmodelElementsValueOnlySubmodelRepo(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	submodelIdentifierParam := chi.URLParam(r, "submodelIdentifier")
+	if submodelIdentifierParam == "" {
+		c.errorHandler(w, r, &RequiredError{"submodelIdentifier"}, nil)
+		return
+	}
+	var bodyParam model.SubmodelValue
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&bodyParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var atomicParam bool
+	if query.Has("atomic") {
+		param, err := parseBoolParameter(
+			query.Get("atomic"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "atomic", Err: err}, nil)
+			return
+		}
+
+		atomicParam = param
+	}
+	result, err := c.service.PatchSubmodelElementsValueOnlySubmodelRepo(r.Context(), submodelIdentifierParam, bodyParam, atomicParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetAllSubmodelElementsReferenceSubmodelRepo - Returns the References of all submodel elements
 func (c *SubmodelRepositoryAPIAPIController) GetAllSubmodelElementsReferenceSubmodelRepo(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -1524,12 +1741,24 @@ func (c *SubmodelRepositoryAPIAPIController) GetSubmodelElementByPathSubmodelRep
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetSubmodelElementByPathSubmodelRepo(r.Context(), submodelIdentifierParam, idShortPathParam, levelParam, extentParam)
+	ctx, err := contextWithElementDepth(contextWithLanguageFilter(r, query), query)
+	if err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetSubmodelElementByPathSubmodelRepo(ctx, submodelIdentifierParam, idShortPathParam, levelParam, extentParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
 		return
 	}
+	// The entity tag describes the default representation only; If-Match on
+	// writes is checked against that representation.
+	if result.Code == http.StatusOK && levelParam == "deep" && extentParam == "withoutBlobValue" && !query.Has("language") && !query.Has("depth") {
+		if etag, etagErr := common.EntityTag(result.Body); etagErr == nil {
+			w.Header().Set("ETag", etag)
+		}
+	}
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
@@ -1693,7 +1922,35 @@ func (c *SubmodelRepositoryAPIAPIController) DeleteSubmodelElementByPathSubmodel
 		c.errorHandler(w, r, &RequiredError{"idShortPath"}, nil)
 		return
 	}
-	result, err := c.service.DeleteSubmodelElementByPathSubmodelRepo(r.Context(), submodelIdentifierParam, idShortPathParam)
+	requestContext := common.WithIfMatchHeader(r.Context(), r.Header.Get("If-Match"))
+	result, err := c.service.DeleteSubmodelElementByPathSubmodelRepo(requestContext, submodelIdentifierParam, idShortPathParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteSubmodelElementsByPathSubmodelRepo - Deletes several submodel elements in one transaction
+func (c *SubmodelRepositoryAPIAPIController) DeleteSubmodelElementsByPathSubmodelRepo(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	submodelIdentifierParam := chi.URLParam(r, "submodelIdentifier")
+	if submodelIdentifierParam == "" {
+		c.errorHandler(w, r, &RequiredError{"submodelIdentifier"}, nil)
+		return
+	}
+	idShortPathParam := query["idShortPath"]
+	if len(idShortPathParam) == 0 {
+		c.errorHandler(w, r, &RequiredError{"idShortPath"}, nil)
+		return
+	}
+	result, err := c.service.DeleteSubmodelElementsByPathSubmodelRepo(r.Context(), submodelIdentifierParam, idShortPathParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -1874,7 +2131,12 @@ func (c *SubmodelRepositoryAPIAPIController) GetSubmodelElementByPathValueOnlySu
 		param := "withoutBlobValue"
 		extentParam = param
 	}
-	result, err := c.service.GetSubmodelElementByPathValueOnlySubmodelRepo(r.Context(), submodelIdentifierParam, idShortPathParam, levelParam, extentParam)
+	ctx, err := contextWithElementDepth(contextWithLanguageFilter(r, query), query)
+	if err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetSubmodelElementByPathValueOnlySubmodelRepo(ctx, submodelIdentifierParam, idShortPathParam, levelParam, extentParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -1989,6 +2251,73 @@ func (c *SubmodelRepositoryAPIAPIController) GetSubmodelElementByPathPathSubmode
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetSubmodelElementParentSubmodelRepo - Returns the parent of a specific submodel element, or the Submodel reference for top-level elements
+func (c *SubmodelRepositoryAPIAPIController) GetSubmodelElementParentSubmodelRepo(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	submodelIdentifierParam := chi.URLParam(r, "submodelIdentifier")
+	if submodelIdentifierParam == "" {
+		c.errorHandler(w, r, &RequiredError{"submodelIdentifier"}, nil)
+		return
+	}
+	idShortPathParam := chi.URLParam(r, "idShortPath")
+	if idShortPathParam == "" {
+		c.errorHandler(w, r, &RequiredError{"idShortPath"}, nil)
+		return
+	}
+	var levelParam string
+	if query.Has("level") {
+		param := query.Get("level")
+
+		levelParam = param
+	} else {
+		param := "deep"
+		levelParam = param
+	}
+	var extentParam string
+	if query.Has("extent") {
+		param := query.Get("extent")
+
+		extentParam = param
+	} else {
+		param := "withoutBlobValue"
+		extentParam = param
+	}
+	result, err := c.service.GetSubmodelElementParentSubmodelRepo(contextWithLanguageFilter(r, query), submodelIdentifierParam, idShortPathParam, levelParam, extentParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetSubmodelElementAncestorsSubmodelRepo - Returns the ancestor chain of a specific submodel element
+func (c *SubmodelRepositoryAPIAPIController) GetSubmodelElementAncestorsSubmodelRepo(w http.ResponseWriter, r *http.Request) {
+	submodelIdentifierParam := chi.URLParam(r, "submodelIdentifier")
+	if submodelIdentifierParam == "" {
+		c.errorHandler(w, r, &RequiredError{"submodelIdentifier"}, nil)
+		return
+	}
+	idShortPathParam := chi.URLParam(r, "idShortPath")
+	if idShortPathParam == "" {
+		c.errorHandler(w, r, &RequiredError{"idShortPath"}, nil)
+		return
+	}
+	result, err := c.service.GetSubmodelElementAncestorsSubmodelRepo(r.Context(), submodelIdentifierParam, idShortPathParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetFileByPathSubmodelRepo - Downloads file content from a specific submodel element from the Submodel at a specified path
 func (c *SubmodelRepositoryAPIAPIController) GetFileByPathSubmodelRepo(w http.ResponseWriter, r *http.Request) {
 	submodelIdentifierParam := chi.URLParam(r, "submodelIdentifier")
@@ -2342,7 +2671,7 @@ const componentName = "SubmodelRepository"
 func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
 	if err != nil {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: parse query failed", componentName)
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "parse-query")
 		result := common.NewErrorResponse(
 			err,
 			http.StatusBadRequest,
@@ -2364,7 +2693,7 @@ func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWrite
 			WithMinimum[int32](1),
 		)
 		if err != nil {
-			log.Printf("🧩 [%s] Error in QuerySubmodels: parse limit failed", componentName)
+			common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "parse-limit")
 			result := common.NewErrorResponse(
 				err,
 				http.StatusBadRequest,
@@ -2388,8 +2717,22 @@ func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWrite
 	var queryParam grammar.Query
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
-	if err := d.Decode(&queryParam); err != nil && !errors.Is(err, io.EOF) {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: decode body: %v", componentName, err)
+	if err := d.Decode(&queryParam); errors.Is(err, io.EOF) {
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "empty-query-body")
+		result := common.NewErrorResponse(
+			errors.New("SMREPO-QUERYSMS-EMPTYBODY query request body must not be empty; send a query object with a $condition"),
+			http.StatusBadRequest,
+			componentName,
+			"QuerySubmodels",
+			"EmptyRequestBody",
+		)
+		err := EncodeJSONResponse(result.Body, &result.Code, w)
+		if err != nil {
+			c.errorHandler(w, r, err, nil)
+		}
+		return
+	} else if err != nil {
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "decode-body", "error", err)
 		result := common.NewErrorResponse(
 			err,
 			http.StatusBadRequest,
@@ -2404,7 +2747,7 @@ func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWrite
 		return
 	}
 	if err := grammar.AssertQueryRequired(queryParam); err != nil {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: required validation failed: %v", componentName, err)
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "required-validation", "error", err)
 		result := common.NewErrorResponse(
 			err,
 			http.StatusBadRequest,
@@ -2419,7 +2762,7 @@ func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWrite
 		return
 	}
 	if err := grammar.AssertQueryConstraints(queryParam); err != nil {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: constraints validation failed: %v", componentName, err)
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "constraints-validation", "error", err)
 		result := common.NewErrorResponse(
 			err,
 			http.StatusBadRequest,
@@ -2433,17 +2776,37 @@ func (c *SubmodelRepositoryAPIAPIController) QuerySubmodels(w http.ResponseWrite
 		}
 		return
 	}
+	queryResultLimit := common.QueryResultLimitFromContext(r.Context())
+	limitParam, truncated, err := queryResultLimit.Apply(limitParam)
+	if err != nil {
+		common.Logger(r.Context(), componentName).Warn("QuerySubmodels request rejected", "step", "result-limit-exceeded")
+		result := common.NewErrorResponse(
+			err,
+			http.StatusBadRequest,
+			componentName,
+			"QuerySubmodels",
+			"limit",
+		)
+		err = EncodeJSONResponse(result.Body, &result.Code, w)
+		if err != nil {
+			c.errorHandler(w, r, err, nil)
+		}
+		return
+	}
+	if truncated {
+		w.Header().Set(common.QueryResultTruncatedHeader, queryResultLimit.TruncationWarning())
+	}
 	result, err := c.service.QuerySubmodels(r.Context(), limitParam, cursorParam, queryParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: service failure", componentName)
+		common.Logger(r.Context(), componentName).Error("QuerySubmodels failed", "step", "service", "error", err)
 		c.errorHandler(w, r, err, &result)
 		return
 	}
 	// If no error, encode the body and the result code
 	err = EncodeJSONResponse(result.Body, &result.Code, w)
 	if err != nil {
-		log.Printf("🧩 [%s] Error in QuerySubmodels: encoding response failed", componentName)
+		common.Logger(r.Context(), componentName).Error("QuerySubmodels failed", "step", "encode-response", "error", err)
 		c.errorHandler(w, r, err, nil)
 		return
 	}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining
+ * a copy of this software and associated documentation files (the
+ * "Software"), to deal in the Software without restriction, including
+ * without limitation the rights to use, copy, modify, merge, publish,
+ * distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to
+ * the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be
+ * included in all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+ * EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+ * MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+ * NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+ * LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+ * OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+ * WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ *
+ * SPDX-License-Identifier: MIT
+ */
+
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+type countingListService struct {
+	SubmodelRepositoryAPIAPIServicer
+	called bool
+}
+
+func (s *countingListService) GetAllSubmodels(ctx context.Context, _ string, _ string, _ int32, _ string, _ string, _ string, _ time.Time, _ time.Time, _ string, _ string, _ string) (model.ImplResponse, error) {
+	s.called = true
+	if total := common.TotalCountFromContext(ctx); total != nil {
+		total.Set(42)
+	}
+	return model.Response(http.StatusOK, model.GetSubmodelsResult{}), nil
+}
+
+func TestGetAllSubmodelsWritesTotalCountHeader(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		wantCode   int
+		wantHeader string
+	}{
+		{name: "absent", target: "/submodels", wantCode: http.StatusOK},
+		{name: "false", target: "/submodels?count=false", wantCode: http.StatusOK},
+		{name: "true", target: "/submodels?count=true&limit=1", wantCode: http.StatusOK, wantHeader: "42"},
+		{name: "invalid", target: "/submodels?count=yes", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &countingListService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+			response := httptest.NewRecorder()
+			controller.GetAllSubmodels(response, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if tt.wantCode != http.StatusOK && service.called {
+				t.Fatal("expected the service not to be called for an invalid count")
+			}
+			if got := response.Header().Get(common.TotalCountHeader); got != tt.wantHeader {
+				t.Fatalf("expected %s %q, got %q", common.TotalCountHeader, tt.wantHeader, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining
+ * a copy of this software and associated documentation files (the
+ * "Software"), to deal in the Software without restriction, including
+ * without limitation the rights to use, copy, modify, merge, publish,
+ * distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to
+ * the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be
+ * included in all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+ * EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+ * MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+ * NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+ * LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+ * OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+ * WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ *
+ * SPDX-License-Identifier: MIT
+ */
+
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+type depthCaptureService struct {
+	SubmodelRepositoryAPIAPIServicer
+	depth    int
+	hasDepth bool
+	called   bool
+}
+
+func (s *depthCaptureService) GetSubmodelElementByPathSubmodelRepo(ctx context.Context, _ string, _ string, _ string, _ string) (model.ImplResponse, error) {
+	s.called = true
+	s.depth, s.hasDepth = common.ElementDepthFromContext(ctx)
+	return model.Response(http.StatusOK, map[string]any{}), nil
+}
+
+func TestGetSubmodelElementByPathSubmodelRepoPassesDepth(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       string
+		wantCode     int
+		wantDepth    int
+		wantHasDepth bool
+	}{
+		{name: "absent", target: "/submodels/sm/submodel-elements/Target", wantCode: http.StatusOK},
+		{name: "zero", target: "/submodels/sm/submodel-elements/Target?depth=0", wantCode: http.StatusOK, wantHasDepth: true},
+		{name: "two", target: "/submodels/sm/submodel-elements/Target?depth=2", wantCode: http.StatusOK, wantDepth: 2, wantHasDepth: true},
+		{name: "negative", target: "/submodels/sm/submodel-elements/Target?depth=-1", wantCode: http.StatusBadRequest},
+		{name: "not a number", target: "/submodels/sm/submodel-elements/Target?depth=deep", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			addRouteParam(request, "submodelIdentifier", "sm")
+			addRouteParam(request, "idShortPath", "Target")
+
+			service := &depthCaptureService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+			response := httptest.NewRecorder()
+			controller.GetSubmodelElementByPathSubmodelRepo(response, request)
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				if service.called {
+					t.Fatal("expected the service not to be called for an invalid depth")
+				}
+				return
+			}
+			if service.hasDepth != tt.wantHasDepth || service.depth != tt.wantDepth {
+				t.Fatalf("expected depth (%d, %t), got (%d, %t)", tt.wantDepth, tt.wantHasDepth, service.depth, service.hasDepth)
+			}
+		})
+	}
+}
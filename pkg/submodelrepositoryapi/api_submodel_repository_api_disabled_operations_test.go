@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining
+ * a copy of this software and associated documentation files (the
+ * "Software"), to deal in the Software without restriction, including
+ * without limitation the rights to use, copy, modify, merge, publish,
+ * distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to
+ * the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be
+ * included in all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+ * EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+ * MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+ * NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+ * LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+ * OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+ * WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ *
+ * SPDX-License-Identifier: MIT
+ */
+
+/*
+ * DotAAS Part 2 | HTTP/REST | Submodel Repository Service Specification
+ *
+ * The entire Submodel Repository Service Specification as part of the [Specification of the Asset Administration Shell: Part 2](http://industrialdigitaltwin.org/en/content-hub).   Publisher: Industrial Digital Twin Association (IDTA) 2023
+ *
+ * API version: V3.2.0
+ * Contact: info@idtwin.org
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/go-chi/chi/v5"
+)
+
+type readOnlyMirrorService struct {
+	SubmodelRepositoryAPIAPIServicer
+	reads int
+}
+
+func (s *readOnlyMirrorService) GetAllSubmodels(_ context.Context, _ string, _ string, _ int32, _ string, _ string, _ string, _ time.Time, _ time.Time, _ string, _ string, _ string) (model.ImplResponse, error) {
+	s.reads++
+	return model.Response(http.StatusOK, nil), nil
+}
+
+func TestDisabledWriteOperationsAreNotRouted(t *testing.T) {
+	cfg := &common.Config{Server: common.ServerConfig{DisabledOperations: []string{"POST", "PUT", "PATCH", "DELETE"}}}
+	service := &readOnlyMirrorService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+
+	router := chi.NewRouter()
+	common.ConfigureAPIRouter(router, "SubmodelRepositoryService")
+	for operation, rt := range controller.Routes() {
+		if common.IsOperationDisabled(cfg, operation, rt.Method) {
+			continue
+		}
+		router.Method(rt.Method, rt.Pattern, rt.HandlerFunc)
+	}
+
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/submodels", nil))
+	if response.Code != http.StatusOK || service.reads != 1 {
+		t.Fatalf("expected GET /submodels to be served, got status %d body=%s", response.Code, response.Body.String())
+	}
+
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest(http.MethodPost, "/submodels", bytes.NewBufferString(`{}`)))
+	if response.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected POST /submodels to return %d, got %d body=%s", http.StatusMethodNotAllowed, response.Code, response.Body.String())
+	}
+
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest(http.MethodPost, "/query/submodels", bytes.NewBufferString(`{}`)))
+	if response.Code != http.StatusNotFound {
+		t.Fatalf("expected POST-only /query/submodels to return %d, got %d", http.StatusNotFound, response.Code)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining
+ * a copy of this software and associated documentation files (the
+ * "Software"), to deal in the Software without restriction, including
+ * without limitation the rights to use, copy, modify, merge, publish,
+ * distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to
+ * the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be
+ * included in all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+ * EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+ * MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+ * NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+ * LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+ * OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+ * WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ *
+ * SPDX-License-Identifier: MIT
+ */
+
+/*
+ * DotAAS Part 2 | HTTP/REST | Submodel Repository Service Specification
+ *
+ * The entire Submodel Repository Service Specification as part of the [Specification of the Asset Administration Shell: Part 2](http://industrialdigitaltwin.org/en/content-hub).   Publisher: Industrial Digital Twin Association (IDTA) 2023
+ *
+ * API version: V3.2.0
+ * Contact: info@idtwin.org
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FriedJannik/aas-go-sdk/types"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+type captureDryRunService struct {
+	SubmodelRepositoryAPIAPIServicer
+	invoked bool
+	dryRun  bool
+}
+
+func (s *captureDryRunService) PostSubmodel(ctx context.Context, _ types.ISubmodel) (model.ImplResponse, error) {
+	s.invoked = true
+	s.dryRun = common.IsDryRun(ctx)
+	return model.Response(http.StatusOK, nil), nil
+}
+
+func (s *captureDryRunService) PutSubmodelByID(ctx context.Context, _ string, _ types.ISubmodel) (model.ImplResponse, error) {
+	s.invoked = true
+	s.dryRun = common.IsDryRun(ctx)
+	return model.Response(http.StatusOK, nil), nil
+}
+
+func TestSubmodelWritesPassDryRunQueryParameter(t *testing.T) {
+	const body = `{"modelType":"Submodel","id":"urn:example:sm"}`
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantCode   int
+		wantCalled bool
+		wantDryRun bool
+	}{
+		{name: "post default", method: http.MethodPost, target: "/submodels", wantCode: http.StatusOK, wantCalled: true},
+		{name: "post dry run", method: http.MethodPost, target: "/submodels?dryRun=true", wantCode: http.StatusOK, wantCalled: true, wantDryRun: true},
+		{name: "post dry run false", method: http.MethodPost, target: "/submodels?dryRun=false", wantCode: http.StatusOK, wantCalled: true},
+		{name: "post invalid dry run", method: http.MethodPost, target: "/submodels?dryRun=maybe", wantCode: http.StatusBadRequest},
+		{name: "put dry run", method: http.MethodPut, target: "/submodels/dXJuOmV4YW1wbGU6c20?dryRun=true", wantCode: http.StatusOK, wantCalled: true, wantDryRun: true},
+		{name: "put invalid dry run", method: http.MethodPut, target: "/submodels/dXJuOmV4YW1wbGU6c20?dryRun=1x", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &captureDryRunService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+			response := httptest.NewRecorder()
+			request := httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(body))
+
+			if tt.method == http.MethodPut {
+				addRouteParam(request, "submodelIdentifier", "dXJuOmV4YW1wbGU6c20")
+				controller.PutSubmodelByID(response, request)
+			} else {
+				controller.PostSubmodel(response, request)
+			}
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d body=%s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if service.invoked != tt.wantCalled {
+				t.Fatalf("expected service invoked=%v, got %v", tt.wantCalled, service.invoked)
+			}
+			if service.dryRun != tt.wantDryRun {
+				t.Fatalf("expected dryRun=%v, got %v", tt.wantDryRun, service.dryRun)
+			}
+		})
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/eclipse-basyx/basyx-go-components/internal/common"
@@ -128,3 +129,155 @@ func assertStandardizedOperationError(t *testing.T, responseBody []byte, expecte
 		t.Fatalf("expected populated standardized error fields, got %#v", body[0])
 	}
 }
+
+func TestQuerySubmodelsRejectsEmptyBodyWithSpecificError(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantText    string
+		notWantText string
+	}{
+		{name: "empty body", body: "", wantText: "SMREPO-QUERYSMS-EMPTYBODY"},
+		{name: "malformed body", body: "{", wantText: "unexpected EOF", notWantText: "EMPTYBODY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &operationRequestParsingService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+			response := httptest.NewRecorder()
+
+			controller.QuerySubmodels(response, httptest.NewRequest(http.MethodPost, "/query/submodels", bytes.NewBufferString(tt.body)))
+
+			if response.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d body=%s", http.StatusBadRequest, response.Code, response.Body.String())
+			}
+			var body []common.ErrorHandler
+			if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode standardized error response: %v", err)
+			}
+			if len(body) != 1 || !strings.Contains(body[0].Text, tt.wantText) {
+				t.Fatalf("expected error text containing %q, got %#v", tt.wantText, body)
+			}
+			if tt.notWantText != "" && strings.Contains(body[0].Text, tt.notWantText) {
+				t.Fatalf("expected error text without %q, got %q", tt.notWantText, body[0].Text)
+			}
+		})
+	}
+}
+
+func TestPostSubmodelRejectsReferenceWithInvalidKeyType(t *testing.T) {
+	const body = `{
+		"modelType": "Submodel",
+		"id": "urn:example:sm",
+		"submodelElements": [{
+			"modelType": "ReferenceElement",
+			"idShort": "ref",
+			"value": {"type": "ExternalReference", "keys": [{"type": "NotAKeyType", "value": "urn:example:target"}]}
+		}]
+	}`
+
+	service := &captureDryRunService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+	response := httptest.NewRecorder()
+
+	controller.PostSubmodel(response, httptest.NewRequest(http.MethodPost, "/submodels", bytes.NewBufferString(body)))
+
+	if response.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d body=%s", http.StatusBadRequest, response.Code, response.Body.String())
+	}
+	if service.invoked {
+		t.Fatal("expected invalid key type to be rejected before service invocation")
+	}
+}
+
+func TestPostSubmodelRejectsUnsupportedContentType(t *testing.T) {
+	const body = `{"modelType": "Submodel", "id": "urn:example:sm"}`
+
+	tests := []struct {
+		name        string
+		contentType string
+		wantCode    int
+		wantCalled  bool
+	}{
+		{name: "text/plain", contentType: "text/plain", wantCode: http.StatusUnsupportedMediaType},
+		{name: "application/xml", contentType: "application/xml", wantCode: http.StatusUnsupportedMediaType},
+		{name: "application/json", contentType: "application/json; charset=utf-8", wantCode: http.StatusOK, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &captureDryRunService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+			handler := common.JSONContentTypeMiddleware(http.HandlerFunc(controller.PostSubmodel))
+			request := httptest.NewRequest(http.MethodPost, "/submodels", bytes.NewBufferString(body))
+			request.Header.Set("Content-Type", tt.contentType)
+			response := httptest.NewRecorder()
+
+			handler.ServeHTTP(response, request)
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d body=%s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if service.invoked != tt.wantCalled {
+				t.Fatalf("expected service invoked=%v, got %v", tt.wantCalled, service.invoked)
+			}
+			if tt.wantCode == http.StatusUnsupportedMediaType {
+				assertStandardizedOperationError(t, response.Body.Bytes(), "415")
+			}
+		})
+	}
+}
+
+func TestPostSubmodelStrictSchemaValidation(t *testing.T) {
+	// Accepted by the SDK deserializer, but the schema requires a numeric
+	// version without leading zeros and at least one submodel element.
+	const body = `{
+		"modelType": "Submodel",
+		"id": "urn:example:sm",
+		"idShort": "Nameplate",
+		"administration": {"version": "01"},
+		"submodelElements": []
+	}`
+
+	tests := []struct {
+		name       string
+		strict     bool
+		wantCode   int
+		wantCalled bool
+	}{
+		{name: "disabled", strict: false, wantCode: http.StatusOK, wantCalled: true},
+		{name: "enabled", strict: true, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &captureDryRunService{}
+			controller := NewSubmodelRepositoryAPIAPIController(service, "", "", WithStrictSchemaValidation(tt.strict))
+			response := httptest.NewRecorder()
+
+			controller.PostSubmodel(response, httptest.NewRequest(http.MethodPost, "/submodels", bytes.NewBufferString(body)))
+
+			if response.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d body=%s", tt.wantCode, response.Code, response.Body.String())
+			}
+			if service.invoked != tt.wantCalled {
+				t.Fatalf("expected service invoked=%v, got %v", tt.wantCalled, service.invoked)
+			}
+			if !tt.strict {
+				return
+			}
+
+			var messages []common.ErrorHandler
+			if err := json.Unmarshal(response.Body.Bytes(), &messages); err != nil {
+				t.Fatalf("failed to decode standardized error response: %v", err)
+			}
+			if len(messages) != 2 {
+				t.Fatalf("expected two schema violations, got %#v", messages)
+			}
+			if !strings.Contains(messages[0].Text, "/administration/version") || !strings.Contains(messages[1].Text, "/submodelElements") {
+				t.Fatalf("expected JSON pointers in violation texts, got %q and %q", messages[0].Text, messages[1].Text)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (C) 2026 the Eclipse BaSyx Authors and Fraunhofer IESE
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining
+ * a copy of this software and associated documentation files (the
+ * "Software"), to deal in the Software without restriction, including
+ * without limitation the rights to use, copy, modify, merge, publish,
+ * distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to
+ * the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be
+ * included in all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+ * EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+ * MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+ * NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+ * LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+ * OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+ * WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ *
+ * SPDX-License-Identifier: MIT
+ */
+
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+)
+
+type ifMatchCaptureService struct {
+	SubmodelRepositoryAPIAPIServicer
+	ifMatch string
+}
+
+func (s *ifMatchCaptureService) DeleteSubmodelElementByPathSubmodelRepo(ctx context.Context, _ string, _ string) (model.ImplResponse, error) {
+	s.ifMatch = common.IfMatchHeaderFromContext(ctx)
+	return model.Response(http.StatusNoContent, nil), nil
+}
+
+func (s *ifMatchCaptureService) GetSubmodelElementByPathSubmodelRepo(_ context.Context, _ string, _ string, _ string, _ string) (model.ImplResponse, error) {
+	return model.Response(http.StatusOK, map[string]any{"modelType": "Property", "idShort": "Target", "valueType": "xs:int", "value": "42"}), nil
+}
+
+func TestDeleteSubmodelElementByPathSubmodelRepoPassesIfMatch(t *testing.T) {
+	request := httptest.NewRequest(http.MethodDelete, "/submodels/sm/submodel-elements/Target", nil)
+	request.Header.Set("If-Match", `"abc"`)
+	addRouteParam(request, "submodelIdentifier", "sm")
+	addRouteParam(request, "idShortPath", "Target")
+
+	service := &ifMatchCaptureService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+	controller.DeleteSubmodelElementByPathSubmodelRepo(httptest.NewRecorder(), request)
+
+	if service.ifMatch != `"abc"` {
+		t.Fatalf("expected If-Match to reach the service, got %q", service.ifMatch)
+	}
+}
+
+func TestGetSubmodelElementByPathSubmodelRepoSetsETagForDefaultRepresentation(t *testing.T) {
+	want, err := common.EntityTag(map[string]any{"modelType": "Property", "idShort": "Target", "valueType": "xs:int", "value": "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		want   string
+	}{
+		{name: "default", target: "/submodels/sm/submodel-elements/Target", want: want},
+		{name: "core", target: "/submodels/sm/submodel-elements/Target?level=core"},
+		{name: "with blob value", target: "/submodels/sm/submodel-elements/Target?extent=withBlobValue"},
+		{name: "language filter", target: "/submodels/sm/submodel-elements/Target?language=en"},
+		{name: "depth", target: "/submodels/sm/submodel-elements/Target?depth=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			addRouteParam(request, "submodelIdentifier", "sm")
+			addRouteParam(request, "idShortPath", "Target")
+
+			controller := NewSubmodelRepositoryAPIAPIController(&ifMatchCaptureService{}, "", "")
+			response := httptest.NewRecorder()
+			controller.GetSubmodelElementByPathSubmodelRepo(response, request)
+
+			if got := response.Header().Get("ETag"); got != tt.want {
+				t.Fatalf("expected ETag %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
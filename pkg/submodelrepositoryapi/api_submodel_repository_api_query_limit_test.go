@@ -0,0 +1,85 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eclipse-basyx/basyx-go-components/internal/common"
+	commonmodel "github.com/eclipse-basyx/basyx-go-components/internal/common/model"
+	"github.com/eclipse-basyx/basyx-go-components/internal/common/model/grammar"
+)
+
+const querySubmodelsBody = `{"$condition":{"$eq":[{"$field":"$sm#idShort"},{"$strVal":"sm"}]}}`
+
+type captureQuerySubmodelsService struct {
+	SubmodelRepositoryAPIAPIServicer
+	invoked bool
+	limit   int32
+}
+
+func (s *captureQuerySubmodelsService) QuerySubmodels(_ context.Context, limit int32, _ string, _ grammar.Query) (commonmodel.ImplResponse, error) {
+	s.invoked = true
+	s.limit = limit
+	return commonmodel.Response(http.StatusOK, commonmodel.GetSubmodelsResult{}), nil
+}
+
+func newQuerySubmodelsRequest(limit string, behavior string) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/query/submodels?limit="+limit, bytes.NewBufferString(querySubmodelsBody))
+	cfg := &common.Config{General: common.GeneralConfig{QueryMaxResults: 5, QueryMaxResultsBehavior: behavior}}
+	return request.WithContext(common.ContextWithConfig(request.Context(), cfg))
+}
+
+func TestQuerySubmodelsRejectsLimitAboveConfiguredMaximum(t *testing.T) {
+	service := &captureQuerySubmodelsService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+	response := httptest.NewRecorder()
+
+	controller.QuerySubmodels(response, newQuerySubmodelsRequest("6", common.QueryMaxResultsBehaviorReject))
+
+	if response.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d body=%s", http.StatusBadRequest, response.Code, response.Body.String())
+	}
+	if service.invoked {
+		t.Fatal("expected oversized query to be rejected before service invocation")
+	}
+	if !strings.Contains(response.Body.String(), "COMMON-QUERYLIMIT-EXCEEDED") {
+		t.Fatalf("expected query limit error code in body, got %s", response.Body.String())
+	}
+}
+
+func TestQuerySubmodelsTruncatesLimitAboveConfiguredMaximum(t *testing.T) {
+	service := &captureQuerySubmodelsService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+	response := httptest.NewRecorder()
+
+	controller.QuerySubmodels(response, newQuerySubmodelsRequest("50", common.QueryMaxResultsBehaviorTruncate))
+
+	if response.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d body=%s", http.StatusOK, response.Code, response.Body.String())
+	}
+	if service.limit != 5 {
+		t.Fatalf("expected service limit to be truncated to 5, got %d", service.limit)
+	}
+	if response.Header().Get(common.QueryResultTruncatedHeader) == "" {
+		t.Fatal("expected truncation warning header")
+	}
+}
+
+func TestQuerySubmodelsKeepsLimitWithinConfiguredMaximum(t *testing.T) {
+	service := &captureQuerySubmodelsService{}
+	controller := NewSubmodelRepositoryAPIAPIController(service, "", "")
+	response := httptest.NewRecorder()
+
+	controller.QuerySubmodels(response, newQuerySubmodelsRequest("5", common.QueryMaxResultsBehaviorReject))
+
+	if response.Code != http.StatusOK || service.limit != 5 {
+		t.Fatalf("expected limit 5 to pass unchanged, got status %d limit %d", response.Code, service.limit)
+	}
+	if response.Header().Get(common.QueryResultTruncatedHeader) != "" {
+		t.Fatal("expected no truncation warning header")
+	}
+}
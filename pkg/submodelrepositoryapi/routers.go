@@ -91,6 +91,15 @@ type FileDownload struct {
 	Filename    string
 }
 
+// StreamingFileDownload is a helper payload type for downloads that are
+// written directly to the response instead of being held in memory.
+// Errors returned by WriteTo occur after the status line has been sent.
+type StreamingFileDownload struct {
+	ContentType string
+	Filename    string
+	WriteTo     func(io.Writer) error
+}
+
 // EncodeJSONResponse encodes a response as JSON and writes it to the HTTP response writer.
 //
 // This function handles both file responses (detected by *os.File type) and JSON responses.
@@ -128,6 +137,14 @@ func EncodeJSONResponse(i interface{}, status *int, w http.ResponseWriter) error
 				}
 				return nil
 			}
+		case StreamingFileDownload:
+			model.SetSafeDownloadHeaders(wHeader, r.Filename, r.ContentType)
+			if status != nil {
+				w.WriteHeader(*status)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			return r.WriteTo(w)
 		case FileDownload:
 			model.SetSafeDownloadHeaders(wHeader, r.Filename, r.ContentType)
 			if status != nil {